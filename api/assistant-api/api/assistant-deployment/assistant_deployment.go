@@ -9,6 +9,7 @@ import (
 	"github.com/rapidaai/api/assistant-api/config"
 	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
 	internal_assistant_service "github.com/rapidaai/api/assistant-api/internal/services/assistant"
+	web_client "github.com/rapidaai/pkg/clients/web"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
 	"github.com/rapidaai/pkg/storages"
@@ -23,6 +24,8 @@ type assistantDeploymentApi struct {
 	postgres          connectors.PostgresConnector
 	deploymentService internal_services.AssistantDeploymentService
 	storage           storages.Storage
+	redis             connectors.RedisConnector
+	vaultClient       web_client.VaultClient
 }
 
 type assistantDeploymentGrpcApi struct {
@@ -31,6 +34,7 @@ type assistantDeploymentGrpcApi struct {
 
 func NewAssistantDeploymentGRPCApi(config *config.AssistantConfig, logger commons.Logger,
 	postgres connectors.PostgresConnector,
+	redis connectors.RedisConnector,
 ) assistant_api.AssistantDeploymentServiceServer {
 	return &assistantDeploymentGrpcApi{
 		assistantDeploymentApi{
@@ -39,6 +43,8 @@ func NewAssistantDeploymentGRPCApi(config *config.AssistantConfig, logger common
 			postgres:          postgres,
 			deploymentService: internal_assistant_service.NewAssistantDeploymentService(config, logger, postgres),
 			storage:           storage_files.NewStorage(config.AssetStoreConfig, logger),
+			redis:             redis,
+			vaultClient:       web_client.NewVaultClientGRPC(&config.AppConfig, logger, redis),
 		},
 	}
 }
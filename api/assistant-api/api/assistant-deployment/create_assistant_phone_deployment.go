@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/types"
 	"github.com/rapidaai/pkg/utils"
 	assistant_api "github.com/rapidaai/protos"
@@ -32,6 +33,16 @@ func (deploymentApi *assistantDeploymentApi) CreateAssistantPhoneDeployment(ctx
 			"Please check and provide valid deployment request for phone.",
 		)
 	}
+	phoneOptions := utils.Option{}
+	for _, v := range deployment.GetPhone().GetPhoneOptions() {
+		phoneOptions[v.GetKey()] = v.GetValue()
+	}
+	if err := internal_type.ValidateProviderRecording(phoneOptions); err != nil {
+		return utils.Error[assistant_api.GetAssistantPhoneDeploymentResponse](
+			err,
+			"Recording by the telephony provider can not be combined with sensitive capture toggled by dtmf, disable one of them.",
+		)
+	}
 	wpDeployment, err := deploymentApi.deploymentService.CreatePhoneDeployment(ctx,
 		iAuth, deployment.GetPhone().GetAssistantId(),
 		deployment.GetPhone().Greeting,
@@ -52,5 +63,8 @@ func (deploymentApi *assistantDeploymentApi) CreateAssistantPhoneDeployment(ctx
 			"Please provider valid a valid request to create assistant phone deployment.",
 		)
 	}
+	utils.Go(context.Background(), func() {
+		deploymentApi.warmPhoneDeployment(iAuth, deployment.GetPhone())
+	})
 	return utils.Success[assistant_api.GetAssistantPhoneDeploymentResponse](wpDeployment)
 }
@@ -0,0 +1,53 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_deployment_api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	assistant_api "github.com/rapidaai/protos"
+)
+
+// CreateAssistantSmsDeployment implements assistant_api.AssistantDeploymentServiceServer.
+func (deploymentApi *assistantDeploymentApi) CreateAssistantSmsDeployment(ctx context.Context, deployment *assistant_api.CreateAssistantDeploymentRequest) (*assistant_api.GetAssistantSmsDeploymentResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || iAuth.GetCurrentProjectId() == nil {
+		deploymentApi.logger.Errorf("unauthenticated request for invoke")
+		return utils.Error[assistant_api.GetAssistantSmsDeploymentResponse](
+			errors.New("unauthenticated request for create assistant sms deployment"),
+			"Please provider valid service credentials to perfom invoke, read docs @ docs.rapida.ai",
+		)
+	}
+
+	if deployment.GetSms() == nil {
+		return utils.Error[assistant_api.GetAssistantSmsDeploymentResponse](
+			errors.New("illegal parameters attached to deployment"),
+			"Please check and provide valid deployment request for sms.",
+		)
+	}
+	smsDeployment, err := deploymentApi.deploymentService.CreateSmsDeployment(ctx,
+		iAuth, deployment.GetSms().GetAssistantId(),
+		deployment.GetSms().Greeting,
+		deployment.GetSms().Mistake,
+		&deployment.GetSms().IdealTimeout,
+		&deployment.GetSms().IdealTimeoutBackoff,
+		&deployment.GetSms().IdealTimeoutMessage,
+		&deployment.GetSms().MaxSessionDuration,
+		deployment.GetSms().GetSmsProviderName(),
+		deployment.GetSms().GetSmsOptions(),
+	)
+
+	if err != nil {
+		return utils.Error[assistant_api.GetAssistantSmsDeploymentResponse](
+			errors.New("unauthenticated request for create assistant sms deployment"),
+			"Please provider valid service credentials to perfom invoke, read docs @ docs.rapida.ai",
+		)
+	}
+	return utils.Success[assistant_api.GetAssistantSmsDeploymentResponse](smsDeployment)
+}
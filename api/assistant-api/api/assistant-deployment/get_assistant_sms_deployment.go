@@ -0,0 +1,37 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_deployment_api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	assistant_api "github.com/rapidaai/protos"
+)
+
+// GetAssistantSmsDeployment implements assistant_api.AssistantDeploymentServiceServer.
+func (deploymentApi *assistantDeploymentGrpcApi) GetAssistantSmsDeployment(ctx context.Context, getter *assistant_api.GetAssistantDeploymentRequest) (*assistant_api.GetAssistantSmsDeploymentResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || iAuth.GetCurrentProjectId() == nil {
+		deploymentApi.logger.Errorf("unauthenticated request for invoke")
+		return utils.Error[assistant_api.GetAssistantSmsDeploymentResponse](
+			errors.New("unauthenticated request for get assistant sms deployment"),
+			"Please provider valid service credentials to perfom invoke, read docs @ docs.rapida.ai",
+		)
+	}
+	smsDeployment, err := deploymentApi.deploymentService.GetAssistantSmsDeployment(ctx, iAuth, getter.GetAssistantId())
+	if err != nil {
+		return utils.Error[assistant_api.GetAssistantSmsDeploymentResponse](err, "Unable to get deployment, please try again later.")
+	}
+	var out *assistant_api.AssistantSmsDeployment
+	err = utils.Cast(smsDeployment, &out)
+	if err != nil {
+		deploymentApi.logger.Errorf("unable to cast the sms deployment model to the response object")
+	}
+	return utils.Success[assistant_api.GetAssistantSmsDeploymentResponse, *assistant_api.AssistantSmsDeployment](out)
+}
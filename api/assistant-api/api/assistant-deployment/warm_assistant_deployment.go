@@ -0,0 +1,73 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_deployment_api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	internal_speech_cache "github.com/rapidaai/api/assistant-api/internal/speech_cache"
+	internal_telephony_factory "github.com/rapidaai/api/assistant-api/internal/telephony"
+	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	assistant_api "github.com/rapidaai/protos"
+)
+
+// warmPhoneDeployment renders the greeting and behavior messages of a phone deployment into the
+// speech cache, so the first words of a call are not waiting on the text to speech provider.
+// Messages rendered from templates differ per call and are not rendered.
+//
+// Only phone deployments are warmed when saved. The audio format is part of the cache key and
+// only telephony knows it upfront, web plugin, api and debugger clients choose theirs when the
+// session starts, so their messages are cached by the first session which speaks them. Whatsapp
+// and sms deployments do not speak.
+func (deploymentApi *assistantDeploymentApi) warmPhoneDeployment(auth types.SimplePrinciple, phone *assistant_api.AssistantPhoneDeployment) {
+	outputAudio := phone.GetOutputAudio()
+	if outputAudio == nil || outputAudio.GetAudioProvider() == "" {
+		return
+	}
+	audioConfig, err := internal_telephony_factory.GetAudioConfig(internal_telephony_factory.Telephony(phone.GetPhoneProviderName()))
+	if err != nil {
+		return
+	}
+	opts := utils.Option{}
+	for _, v := range outputAudio.GetAudioOptions() {
+		opts[v.GetKey()] = v.GetValue()
+	}
+	if enabled, err := opts.GetBool(internal_speech_cache.SpeechCacheOptionsKeyEnable); err == nil && !enabled {
+		return
+	}
+
+	ctx := context.Background()
+	credentialId, err := opts.GetUint64("rapida.credential_id")
+	if err != nil {
+		deploymentApi.logger.Warnf("speech cache: unable to find credential from options %+v", err)
+		return
+	}
+	credential, err := deploymentApi.vaultClient.GetCredential(ctx, auth, credentialId)
+	if err != nil {
+		deploymentApi.logger.Warnf("speech cache: api call to find credential failed %+v", err)
+		return
+	}
+
+	provider := outputAudio.GetAudioProvider()
+	cache := internal_speech_cache.NewSpeechCache(deploymentApi.logger, deploymentApi.storage, deploymentApi.redis,
+		fmt.Sprintf("%d", *auth.GetCurrentProjectId()),
+		internal_speech_cache.Voice{Provider: provider, Options: opts, AudioConfig: audioConfig},
+		func(ctx context.Context, text string) ([]byte, error) {
+			return internal_transformer.RenderTextToSpeech(ctx, deploymentApi.logger, provider, credential, audioConfig, opts, text)
+		})
+	for _, text := range []string{phone.GetGreeting(), phone.GetMistake(), phone.GetIdealTimeoutMessage()} {
+		if strings.TrimSpace(text) == "" || strings.Contains(text, "{{") || strings.Contains(text, "{%") {
+			continue
+		}
+		if err := cache.Warm(ctx, text); err != nil {
+			deploymentApi.logger.Warnf("speech cache: unable to render deployment message %v", err)
+		}
+	}
+}
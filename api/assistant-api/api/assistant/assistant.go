@@ -52,7 +52,7 @@ func NewAssistantGRPCApi(config *config.AssistantConfig, logger commons.Logger,
 			opensearch:                opensearch,
 			vectordb:                  vectordb,
 			assistantService:          internal_assistant_service.NewAssistantService(config, logger, postgres, opensearch),
-			knowledgeDocumentService:  internal_knowledge_service.NewKnowledgeDocumentService(config, logger, postgres, opensearch),
+			knowledgeDocumentService:  internal_knowledge_service.NewKnowledgeDocumentService(config, logger, postgres, redis, opensearch),
 			conversactionService:      internal_assistant_service.NewAssistantConversationService(logger, postgres, storage_files.NewStorage(config.AssetStoreConfig, logger)),
 			assistantWebhookService:   internal_assistant_service.NewAssistantWebhookService(logger, postgres, storage_files.NewStorage(config.AssetStoreConfig, logger)),
 			assistantAnalysisService:  internal_assistant_service.NewAssistantAnalysisService(logger, postgres),
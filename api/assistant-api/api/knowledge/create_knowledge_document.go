@@ -52,6 +52,12 @@ func (knowledgeApi *knowledgeGrpcApi) CreateKnowledgeDocument(ctx context.Contex
 			)
 		}
 
+		// tabular documents are stored as rows and never sent to the indexer
+		if cer.GetDocumentStructure() == internal_knowledge_gorm.DOCUMENT_STRUCTURE_TABULAR {
+			knowledgeApi.createKnowledgeTables(ctx, iAuth, _kn, cer.GetContents())
+			break
+		}
+
 		var docIds []uint64
 		for _, doc := range _kn {
 			docIds = append(docIds, doc.Id)
@@ -92,3 +98,22 @@ func (knowledgeApi *knowledgeGrpcApi) CreateKnowledgeDocument(ctx context.Contex
 	}
 	return utils.Success[knowledge_api.CreateKnowledgeDocumentResponse, []*knowledge_api.KnowledgeDocument](out)
 }
+
+// createKnowledgeTables imports the uploaded content of every tabular document, a document
+// that can not be imported carries the error instead of failing the upload
+func (knowledgeApi *knowledgeGrpcApi) createKnowledgeTables(ctx context.Context,
+	iAuth types.SimplePrinciple,
+	documents []*internal_knowledge_gorm.KnowledgeDocument,
+	contents []*knowledge_api.Content) {
+	for _, doc := range documents {
+		for _, cntnt := range contents {
+			if cntnt.GetName() != doc.Name {
+				continue
+			}
+			if _, err := knowledgeApi.tableService.Create(ctx, iAuth, doc, cntnt.GetContentType(), cntnt.GetContent()); err != nil {
+				knowledgeApi.logger.Errorf("unable to create table for knowledge document %d: %v", doc.Id, err)
+			}
+			break
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package knowledge_api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	knowledge_api "github.com/rapidaai/protos"
+)
+
+// CreateKnowledgeEvaluation implements knowledge_api.KnowledgeServiceServer.
+func (knowledgeApi *knowledgeGrpcApi) CreateKnowledgeEvaluation(ctx context.Context, eRequest *knowledge_api.CreateKnowledgeEvaluationRequest) (*knowledge_api.GetKnowledgeEvaluationResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		knowledgeApi.logger.Errorf("unauthenticated request for CreateKnowledgeEvaluation")
+		return utils.Error[knowledge_api.GetKnowledgeEvaluationResponse](
+			errors.New("unauthenticated request for CreateKnowledgeEvaluation"),
+			"Please provider valid service credentials to create knowledge evaluation, read docs @ docs.rapida.ai",
+		)
+	}
+	if _, err := knowledgeApi.knowledgeService.Get(ctx, iAuth, eRequest.GetKnowledgeId()); err != nil {
+		return utils.Error[knowledge_api.GetKnowledgeEvaluationResponse](
+			err,
+			"Unable to get knowledge, please try again later.",
+		)
+	}
+	evaluation, err := knowledgeApi.evaluationService.Create(ctx,
+		iAuth,
+		eRequest.GetKnowledgeId(),
+		eRequest.GetName(),
+		eRequest.GetDescription(),
+		eRequest.GetQuestions())
+	if err != nil {
+		return utils.Error[knowledge_api.GetKnowledgeEvaluationResponse](
+			err,
+			"Unable to create knowledge evaluation, every question requires expected documents or segments.",
+		)
+	}
+	out := &knowledge_api.KnowledgeEvaluation{}
+	err = utils.Cast(evaluation, out)
+	if err != nil {
+		knowledgeApi.logger.Errorf("unable to cast the knowledge evaluation model to the response object")
+	}
+	return utils.Success[knowledge_api.GetKnowledgeEvaluationResponse, *knowledge_api.KnowledgeEvaluation](out)
+}
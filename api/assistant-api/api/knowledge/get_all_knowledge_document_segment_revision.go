@@ -0,0 +1,42 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package knowledge_api
+
+import (
+	"context"
+
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	knowledge_api "github.com/rapidaai/protos"
+)
+
+// GetAllKnowledgeDocumentSegmentRevision implements knowledge_api.KnowledgeServiceServer.
+func (knowledgeApi *knowledgeGrpcApi) GetAllKnowledgeDocumentSegmentRevision(ctx context.Context, rRequest *knowledge_api.GetAllKnowledgeDocumentSegmentRevisionRequest) (*knowledge_api.GetAllKnowledgeDocumentSegmentRevisionResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		knowledgeApi.logger.Errorf("unauthenticated request for GetAllKnowledgeDocumentSegmentRevision")
+		return exceptions.AuthenticationError[knowledge_api.GetAllKnowledgeDocumentSegmentRevisionResponse]()
+	}
+	cnt, revisions, err := knowledgeApi.knowledgeDocumentService.GetAllDocumentSegmentRevision(ctx,
+		iAuth,
+		rRequest.GetKnowledgeId(),
+		rRequest.GetDocumentId(),
+		rRequest.GetPaginate())
+	if err != nil {
+		return exceptions.BadRequestError[knowledge_api.GetAllKnowledgeDocumentSegmentRevisionResponse]("Unable to get the segment revisions for given knowledge id.")
+	}
+	out := []*knowledge_api.KnowledgeDocumentSegmentRevision{}
+	err = utils.Cast(revisions, &out)
+	if err != nil {
+		knowledgeApi.logger.Errorf("unable to cast knowledge document segment revisions %v", err)
+	}
+
+	return utils.PaginatedSuccess[knowledge_api.GetAllKnowledgeDocumentSegmentRevisionResponse, []*knowledge_api.KnowledgeDocumentSegmentRevision](
+		uint32(cnt),
+		rRequest.GetPaginate().GetPage(),
+		out)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package knowledge_api
+
+import (
+	"context"
+
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	knowledge_api "github.com/rapidaai/protos"
+)
+
+// GetAllKnowledgeEmbeddingJob implements knowledge_api.KnowledgeServiceServer.
+func (knowledgeApi *knowledgeGrpcApi) GetAllKnowledgeEmbeddingJob(ctx context.Context, jRequest *knowledge_api.GetAllKnowledgeEmbeddingJobRequest) (*knowledge_api.GetAllKnowledgeEmbeddingJobResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		knowledgeApi.logger.Errorf("unauthenticated request for GetAllKnowledgeEmbeddingJob")
+		return exceptions.AuthenticationError[knowledge_api.GetAllKnowledgeEmbeddingJobResponse]()
+	}
+	cnt, jobs, err := knowledgeApi.embeddingService.GetAllJob(ctx,
+		iAuth,
+		jRequest.GetKnowledgeId(),
+		jRequest.GetCriterias(),
+		jRequest.GetPaginate())
+	if err != nil {
+		return exceptions.BadRequestError[knowledge_api.GetAllKnowledgeEmbeddingJobResponse]("Unable to get the knowledge embedding jobs for given knowledge id.")
+	}
+	out := []*knowledge_api.KnowledgeEmbeddingJob{}
+	err = utils.Cast(jobs, &out)
+	if err != nil {
+		knowledgeApi.logger.Errorf("unable to cast knowledge embedding jobs %v", err)
+	}
+
+	return utils.PaginatedSuccess[knowledge_api.GetAllKnowledgeEmbeddingJobResponse, []*knowledge_api.KnowledgeEmbeddingJob](
+		uint32(cnt),
+		jRequest.GetPaginate().GetPage(),
+		out)
+}
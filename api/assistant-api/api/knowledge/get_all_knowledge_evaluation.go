@@ -0,0 +1,42 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package knowledge_api
+
+import (
+	"context"
+
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	knowledge_api "github.com/rapidaai/protos"
+)
+
+// GetAllKnowledgeEvaluation implements knowledge_api.KnowledgeServiceServer.
+func (knowledgeApi *knowledgeGrpcApi) GetAllKnowledgeEvaluation(ctx context.Context, eRequest *knowledge_api.GetAllKnowledgeEvaluationRequest) (*knowledge_api.GetAllKnowledgeEvaluationResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		knowledgeApi.logger.Errorf("unauthenticated request for GetAllKnowledgeEvaluation")
+		return exceptions.AuthenticationError[knowledge_api.GetAllKnowledgeEvaluationResponse]()
+	}
+	cnt, evaluations, err := knowledgeApi.evaluationService.GetAll(ctx,
+		iAuth,
+		eRequest.GetKnowledgeId(),
+		eRequest.GetCriterias(),
+		eRequest.GetPaginate())
+	if err != nil {
+		return exceptions.BadRequestError[knowledge_api.GetAllKnowledgeEvaluationResponse]("Unable to get the knowledge evaluations for given knowledge id.")
+	}
+	out := []*knowledge_api.KnowledgeEvaluation{}
+	err = utils.Cast(evaluations, &out)
+	if err != nil {
+		knowledgeApi.logger.Errorf("unable to cast knowledge evaluations %v", err)
+	}
+
+	return utils.PaginatedSuccess[knowledge_api.GetAllKnowledgeEvaluationResponse, []*knowledge_api.KnowledgeEvaluation](
+		uint32(cnt),
+		eRequest.GetPaginate().GetPage(),
+		out)
+}
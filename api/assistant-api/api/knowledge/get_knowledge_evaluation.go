@@ -0,0 +1,40 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package knowledge_api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	knowledge_api "github.com/rapidaai/protos"
+)
+
+// GetKnowledgeEvaluation implements knowledge_api.KnowledgeServiceServer.
+func (knowledgeApi *knowledgeGrpcApi) GetKnowledgeEvaluation(ctx context.Context, eRequest *knowledge_api.GetKnowledgeEvaluationRequest) (*knowledge_api.GetKnowledgeEvaluationResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		knowledgeApi.logger.Errorf("unauthenticated request for GetKnowledgeEvaluation")
+		return utils.Error[knowledge_api.GetKnowledgeEvaluationResponse](
+			errors.New("unauthenticated request for GetKnowledgeEvaluation"),
+			"Please provider valid service credentials to get knowledge evaluation, read docs @ docs.rapida.ai",
+		)
+	}
+	evaluation, err := knowledgeApi.evaluationService.Get(ctx, iAuth, eRequest.GetId())
+	if err != nil {
+		return utils.Error[knowledge_api.GetKnowledgeEvaluationResponse](
+			err,
+			"Unable to get knowledge evaluation, please try again later.",
+		)
+	}
+	out := &knowledge_api.KnowledgeEvaluation{}
+	err = utils.Cast(evaluation, out)
+	if err != nil {
+		knowledgeApi.logger.Errorf("unable to cast the knowledge evaluation model to the response object")
+	}
+	return utils.Success[knowledge_api.GetKnowledgeEvaluationResponse, *knowledge_api.KnowledgeEvaluation](out)
+}
@@ -42,7 +42,7 @@ func NewDocumentGRPCApi(config *config.AssistantConfig, logger commons.Logger,
 			postgres:                 postgres,
 			redis:                    redis,
 			knowledgeService:         internal_knowledge_service.NewKnowledgeService(config, logger, postgres, storage_files.NewStorage(config.AssetStoreConfig, logger)),
-			knowledgeDocumentService: internal_knowledge_service.NewKnowledgeDocumentService(config, logger, postgres, opensearch),
+			knowledgeDocumentService: internal_knowledge_service.NewKnowledgeDocumentService(config, logger, postgres, redis, opensearch),
 			indexerServiceClient:     document_client.NewIndexerServiceClient(&config.AppConfig, logger, redis),
 		},
 	}
@@ -24,6 +24,9 @@ type knowledgeApi struct {
 	knowledgeService         internal_services.KnowledgeService
 	indexerServiceClient     document_client.IndexerServiceClient
 	knowledgeDocumentService internal_services.KnowledgeDocumentService
+	evaluationService        internal_services.KnowledgeEvaluationService
+	embeddingService         internal_services.KnowledgeEmbeddingService
+	tableService             internal_services.KnowledgeTableService
 }
 
 type knowledgeGrpcApi struct {
@@ -42,8 +45,11 @@ func NewKnowledgeGRPCApi(config *config.AssistantConfig, logger commons.Logger,
 			postgres:                 postgres,
 			redis:                    redis,
 			knowledgeService:         internal_knowledge_service.NewKnowledgeService(config, logger, postgres, storage_files.NewStorage(config.AssetStoreConfig, logger)),
-			knowledgeDocumentService: internal_knowledge_service.NewKnowledgeDocumentService(config, logger, postgres, opensearch),
+			knowledgeDocumentService: internal_knowledge_service.NewKnowledgeDocumentService(config, logger, postgres, redis, opensearch),
 			indexerServiceClient:     document_client.NewIndexerServiceClient(&config.AppConfig, logger, redis),
+			evaluationService:        internal_knowledge_service.NewKnowledgeEvaluationService(config, logger, postgres, redis, opensearch, storage_files.NewStorage(config.AssetStoreConfig, logger)),
+			embeddingService:         internal_knowledge_service.NewKnowledgeEmbeddingService(config, logger, postgres, redis, opensearch),
+			tableService:             internal_knowledge_service.NewKnowledgeTableService(config, logger, postgres),
 		},
 	}
 }
@@ -0,0 +1,51 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package knowledge_api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	knowledge_api "github.com/rapidaai/protos"
+)
+
+// ReindexKnowledge implements knowledge_api.KnowledgeServiceServer.
+func (knowledgeApi *knowledgeGrpcApi) ReindexKnowledge(ctx context.Context, rRequest *knowledge_api.ReindexKnowledgeRequest) (*knowledge_api.GetKnowledgeEmbeddingJobResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		knowledgeApi.logger.Errorf("unauthenticated request for ReindexKnowledge")
+		return utils.Error[knowledge_api.GetKnowledgeEmbeddingJobResponse](
+			errors.New("unauthenticated request for ReindexKnowledge"),
+			"Please provider valid service credentials to re-embed knowledge, read docs @ docs.rapida.ai",
+		)
+	}
+	_kn, err := knowledgeApi.knowledgeService.Get(ctx, iAuth, rRequest.GetKnowledgeId())
+	if err != nil {
+		return utils.Error[knowledge_api.GetKnowledgeEmbeddingJobResponse](
+			err,
+			"Unable to get knowledge, please try again later.",
+		)
+	}
+	job, err := knowledgeApi.embeddingService.Reindex(ctx,
+		iAuth,
+		_kn,
+		rRequest.GetEmbeddingModelProviderName(),
+		rRequest.GetKnowledgeEmbeddingModelOptions())
+	if err != nil {
+		return utils.Error[knowledge_api.GetKnowledgeEmbeddingJobResponse](
+			err,
+			"Unable to start re-embedding of the knowledge, please check the embedding model and credential.",
+		)
+	}
+	out := &knowledge_api.KnowledgeEmbeddingJob{}
+	err = utils.Cast(job, out)
+	if err != nil {
+		knowledgeApi.logger.Errorf("unable to cast the knowledge embedding job model to the response object")
+	}
+	return utils.Success[knowledge_api.GetKnowledgeEmbeddingJobResponse, *knowledge_api.KnowledgeEmbeddingJob](out)
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package knowledge_api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	knowledge_api "github.com/rapidaai/protos"
+)
+
+// RollbackKnowledgeEmbeddingJob implements knowledge_api.KnowledgeServiceServer.
+func (knowledgeApi *knowledgeGrpcApi) RollbackKnowledgeEmbeddingJob(ctx context.Context, jRequest *knowledge_api.RollbackKnowledgeEmbeddingJobRequest) (*knowledge_api.GetKnowledgeEmbeddingJobResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		knowledgeApi.logger.Errorf("unauthenticated request for RollbackKnowledgeEmbeddingJob")
+		return utils.Error[knowledge_api.GetKnowledgeEmbeddingJobResponse](
+			errors.New("unauthenticated request for RollbackKnowledgeEmbeddingJob"),
+			"Please provider valid service credentials to rollback knowledge embedding job, read docs @ docs.rapida.ai",
+		)
+	}
+	job, err := knowledgeApi.embeddingService.Rollback(ctx, iAuth, jRequest.GetId())
+	if err != nil {
+		return utils.Error[knowledge_api.GetKnowledgeEmbeddingJobResponse](
+			err,
+			"Unable to rollback knowledge embedding job, only the latest complete job can be rolled back.",
+		)
+	}
+	out := &knowledge_api.KnowledgeEmbeddingJob{}
+	err = utils.Cast(job, out)
+	if err != nil {
+		knowledgeApi.logger.Errorf("unable to cast the knowledge embedding job model to the response object")
+	}
+	return utils.Success[knowledge_api.GetKnowledgeEmbeddingJobResponse, *knowledge_api.KnowledgeEmbeddingJob](out)
+}
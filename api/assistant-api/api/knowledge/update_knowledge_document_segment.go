@@ -38,6 +38,8 @@ func (knowledgeApi *knowledgeGrpcApi) UpdateKnowledgeDocumentSegment(ctx context
 		dsr.GetQuantities(),
 		dsr.GetLocations(),
 		dsr.GetIndustries(),
+		dsr.GetText(),
+		dsr.GetReason(),
 	)
 	if err != nil {
 		knowledgeApi.logger.Errorf("unable to delete knowledge segment with error %v", err)
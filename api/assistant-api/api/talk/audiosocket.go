@@ -0,0 +1,76 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_talk_api
+
+import (
+	"context"
+	"net"
+
+	internal_adapter "github.com/rapidaai/api/assistant-api/internal/adapters"
+	telephony "github.com/rapidaai/api/assistant-api/internal/telephony"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+)
+
+// Connected runs the assistant on the audio socket asterisk opened for a call. The socket carries
+// no credential, the conversation created when the call was received or placed is found by the
+// uuid among the asterisk calls which have not ended, the socket is accepted only from the
+// asterisk.allow_from addresses and networks of the asterisk phone deployment of the project of
+// that conversation and the call runs in that project.
+func (cApi *ConversationApi) Connected(ctx context.Context, uuid string, source net.Addr) {
+	conversation, err := cApi.assistantConversationService.GetByTelephonyUuid(ctx, string(telephony.Asterisk), uuid)
+	if err != nil {
+		cApi.logger.Debugf("illegal audio socket %s without conversation", uuid)
+		return
+	}
+	auth := &types.ProjectScope{
+		ProjectId:      utils.Ptr(conversation.ProjectId),
+		OrganizationId: utils.Ptr(conversation.OrganizationId),
+		Status:         type_enums.RECORD_ACTIVE.String(),
+	}
+
+	assistant, vltC, err := cApi.phoneAssistant(ctx, auth, conversation.AssistantId, nil)
+	if err != nil {
+		cApi.logger.Errorf("error while connecting audio socket %s %v", uuid, err)
+		cApi.assistantConversationService.ApplyConversationMetrics(ctx, auth, conversation.AssistantId, conversation.Id, []*types.Metric{{Name: type_enums.STATUS.String(), Value: type_enums.RECORD_FAILED.String(), Description: "Invalid phone deployment"}})
+		return
+	}
+	if assistant.ProjectId != conversation.ProjectId || assistant.AssistantPhoneDeployment.TelephonyProvider != string(telephony.Asterisk) {
+		cApi.logger.Warnf("rejected audio socket %s for a conversation without asterisk phone deployment", uuid)
+		cApi.assistantConversationService.ApplyConversationMetrics(ctx, auth, conversation.AssistantId, conversation.Id, []*types.Metric{{Name: type_enums.STATUS.String(), Value: type_enums.RECORD_FAILED.String(), Description: "Invalid phone deployment"}})
+		return
+	}
+	// the uuid is no secret, it is sent in the clear by asterisk and known to the dialplan
+	allowFrom, _ := assistant.AssistantPhoneDeployment.GetOptions().GetString("asterisk.allow_from")
+	allowList, err := internal_type.NewSourceAllowList(allowFrom)
+	if err != nil || !allowList.Allows(source) {
+		cApi.logger.Warnf("rejected audio socket %s from %s outside asterisk.allow_from of the phone deployment", uuid, source)
+		cApi.assistantConversationService.ApplyConversationMetrics(ctx, auth, assistant.Id, conversation.Id, []*types.Metric{{Name: type_enums.STATUS.String(), Value: type_enums.RECORD_FAILED.String(), Description: "Audio socket from unknown source"}})
+		return
+	}
+
+	_telephony, err := telephony.GetTelephony(telephony.Asterisk, cApi.cfg, cApi.logger)
+	if err != nil {
+		return
+	}
+	streamer := _telephony.Streamer(nil, nil, assistant, conversation, vltC)
+	if streamer == nil {
+		cApi.assistantConversationService.ApplyConversationMetrics(ctx, auth, assistant.Id, conversation.Id, []*types.Metric{{Name: type_enums.STATUS.String(), Value: type_enums.RECORD_FAILED.String(), Description: "Audio socket has closed"}})
+		return
+	}
+	talker, err := internal_adapter.GetTalker(utils.PhoneCall, ctx, cApi.cfg, cApi.logger, cApi.postgres, cApi.opensearch, cApi.redis, cApi.storage, streamer)
+	if err != nil {
+		cApi.logger.Errorf("error while talking on audio socket %v", err)
+		cApi.assistantConversationService.ApplyConversationMetrics(ctx, auth, assistant.Id, conversation.Id, []*types.Metric{{Name: type_enums.STATUS.String(), Value: type_enums.RECORD_FAILED.String(), Description: "Internal server error"}})
+		return
+	}
+	// the conversation was created with the identifier of the caller
+	if err := talker.Talk(ctx, auth, conversation.Identifier); err != nil {
+		cApi.logger.Errorf("error while talking on audio socket %v", err)
+	}
+}
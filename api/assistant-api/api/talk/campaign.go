@@ -0,0 +1,307 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_talk_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	internal_campaign_entity "github.com/rapidaai/api/assistant-api/internal/entity/campaigns"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	gorm_models "github.com/rapidaai/pkg/models/gorm"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// CreateCampaign implements protos.TalkServiceServer.
+func (cApi *ConversationGrpcApi) CreateCampaign(ctx context.Context, ir *protos.CreateCampaignRequest) (*protos.GetCampaignResponse, error) {
+	auth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !auth.HasProject() {
+		cApi.logger.Errorf("unauthenticated request for CreateCampaign")
+		return utils.AuthenticateError[protos.GetCampaignResponse]()
+	}
+	if utils.IsEmpty(ir.GetName()) {
+		return utils.ErrorWithCode[protos.GetCampaignResponse](200, errors.New("missing name parameter"), "Please provide the name of the campaign.")
+	}
+
+	assistant, err := cApi.assistantService.Get(ctx, auth, ir.GetAssistant().GetAssistantId(), utils.GetVersionDefinition(ir.GetAssistant().GetVersion()), &internal_services.GetAssistantOption{InjectPhoneDeployment: true})
+	if err != nil {
+		cApi.logger.Debugf("illegal unable to find assistant %v", err)
+		return utils.ErrorWithCode[protos.GetCampaignResponse](200, err, "Invalid assistant id, please check and try again.")
+	}
+	if !assistant.IsPhoneDeploymentEnable() {
+		return utils.ErrorWithCode[protos.GetCampaignResponse](200, errors.New("phone deployment is not enabled"), "Phone deployment not enabled or incomplete, please check rapida console and update the deployment")
+	}
+	credentialID, err := assistant.AssistantPhoneDeployment.GetOptions().GetUint64("rapida.credential_id")
+	if err != nil {
+		return utils.ErrorWithCode[protos.GetCampaignResponse](200, err, "Please check the credential for telephony, please check and try again.")
+	}
+
+	opts, err := utils.AnyMapToInterfaceMap(ir.GetOptions())
+	if err != nil {
+		return utils.ErrorWithCode[protos.GetCampaignResponse](200, err, "Illegal options for campaign, please check and try again.")
+	}
+
+	campaign := &internal_campaign_entity.AssistantCampaign{
+		AssistantId:        assistant.Id,
+		AssistantVersion:   ir.GetAssistant().GetVersion(),
+		Name:               ir.GetName(),
+		FromNumber:         ir.GetFromNumber(),
+		Timezone:           ir.GetTimezone(),
+		WindowStart:        ir.GetWindowStart(),
+		WindowEnd:          ir.GetWindowEnd(),
+		WindowDays:         make(gorm_types.IntArray, 0, len(ir.GetWindowDays())),
+		MaxConcurrentCalls: ir.GetMaxConcurrentCalls(),
+		Options:            opts,
+		CredentialId:       credentialID,
+	}
+	for _, day := range ir.GetWindowDays() {
+		campaign.WindowDays = append(campaign.WindowDays, uint64(day))
+	}
+	for _, policy := range ir.GetRetryPolicies() {
+		campaign.RetryPolicies = append(campaign.RetryPolicies, internal_campaign_entity.CampaignRetryPolicy{
+			Disposition:       internal_campaign_entity.CallDisposition(policy.GetDisposition()),
+			MaxAttempts:       policy.GetMaxAttempts(),
+			RetryAfterSeconds: policy.GetRetryAfterSeconds(),
+		})
+	}
+	if ir.GetStartAt() != nil {
+		campaign.StartAt = gorm_models.TimeWrapper(ir.GetStartAt().AsTime())
+	}
+
+	contacts := make([]*internal_campaign_entity.AssistantCampaignContact, 0, len(ir.GetContacts()))
+	for i, ct := range ir.GetContacts() {
+		if utils.IsEmpty(ct.GetToNumber()) {
+			return utils.ErrorWithCode[protos.GetCampaignResponse](200, fmt.Errorf("missing to_number of contact %d", i), "Please provide the phone number of every contact.")
+		}
+		args, err := utils.AnyMapToInterfaceMap(ct.GetArgs())
+		if err != nil {
+			return utils.ErrorWithCode[protos.GetCampaignResponse](200, err, "Illegal arguments for contact, please check and try again.")
+		}
+		contacts = append(contacts, &internal_campaign_entity.AssistantCampaignContact{
+			ToNumber: ct.GetToNumber(),
+			Args:     args,
+		})
+	}
+
+	campaign, err = cApi.campaignService.Create(ctx, auth, campaign, contacts)
+	if err != nil {
+		return utils.ErrorWithCode[protos.GetCampaignResponse](200, err, fmt.Sprintf("Unable to create campaign, %v.", err))
+	}
+	out := &protos.Campaign{}
+	if err := utils.Cast(campaign, out); err != nil {
+		cApi.logger.Errorf("unable to cast campaign %v", err)
+	}
+	return utils.Success[protos.GetCampaignResponse, *protos.Campaign](out)
+}
+
+// GetCampaign implements protos.TalkServiceServer.
+func (cApi *ConversationGrpcApi) GetCampaign(ctx context.Context, ir *protos.GetCampaignRequest) (*protos.GetCampaignResponse, error) {
+	auth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !auth.HasProject() {
+		cApi.logger.Errorf("unauthenticated request for GetCampaign")
+		return utils.AuthenticateError[protos.GetCampaignResponse]()
+	}
+	campaign, err := cApi.campaignService.Get(ctx, auth, ir.GetId())
+	if err != nil {
+		return utils.ErrorWithCode[protos.GetCampaignResponse](200, err, "Unable to get the campaign for given campaign id.")
+	}
+	out := &protos.Campaign{}
+	if err := utils.Cast(campaign, out); err != nil {
+		cApi.logger.Errorf("unable to cast campaign %v", err)
+	}
+	return utils.Success[protos.GetCampaignResponse, *protos.Campaign](out)
+}
+
+// GetAllCampaign implements protos.TalkServiceServer.
+func (cApi *ConversationGrpcApi) GetAllCampaign(ctx context.Context, ir *protos.GetAllCampaignRequest) (*protos.GetAllCampaignResponse, error) {
+	auth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !auth.HasProject() {
+		cApi.logger.Errorf("unauthenticated request for GetAllCampaign")
+		return utils.AuthenticateError[protos.GetAllCampaignResponse]()
+	}
+	cnt, campaigns, err := cApi.campaignService.GetAll(ctx, auth, ir.GetCriterias(), ir.GetPaginate())
+	if err != nil {
+		return utils.ErrorWithCode[protos.GetAllCampaignResponse](200, err, "Unable to get the campaigns.")
+	}
+	out := []*protos.Campaign{}
+	if err := utils.Cast(campaigns, &out); err != nil {
+		cApi.logger.Errorf("unable to cast campaigns %v", err)
+	}
+	return utils.PaginatedSuccess[protos.GetAllCampaignResponse, []*protos.Campaign](
+		uint32(cnt),
+		ir.GetPaginate().GetPage(),
+		out)
+}
+
+// UpdateCampaignStatus implements protos.TalkServiceServer.
+func (cApi *ConversationGrpcApi) UpdateCampaignStatus(ctx context.Context, ir *protos.UpdateCampaignStatusRequest) (*protos.GetCampaignResponse, error) {
+	auth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !auth.HasProject() {
+		cApi.logger.Errorf("unauthenticated request for UpdateCampaignStatus")
+		return utils.AuthenticateError[protos.GetCampaignResponse]()
+	}
+	campaign, err := cApi.campaignService.UpdateStatus(ctx, auth, ir.GetId(), internal_campaign_entity.CampaignStatus(strings.ToUpper(ir.GetStatus())))
+	if err != nil {
+		return utils.ErrorWithCode[protos.GetCampaignResponse](200, err, fmt.Sprintf("Unable to update the campaign, %v.", err))
+	}
+	out := &protos.Campaign{}
+	if err := utils.Cast(campaign, out); err != nil {
+		cApi.logger.Errorf("unable to cast campaign %v", err)
+	}
+	return utils.Success[protos.GetCampaignResponse, *protos.Campaign](out)
+}
+
+// GetAllCampaignContact implements protos.TalkServiceServer.
+func (cApi *ConversationGrpcApi) GetAllCampaignContact(ctx context.Context, ir *protos.GetAllCampaignContactRequest) (*protos.GetAllCampaignContactResponse, error) {
+	auth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !auth.HasProject() {
+		cApi.logger.Errorf("unauthenticated request for GetAllCampaignContact")
+		return utils.AuthenticateError[protos.GetAllCampaignContactResponse]()
+	}
+	cnt, contacts, err := cApi.campaignService.GetAllContact(ctx, auth, ir.GetCampaignId(), ir.GetCriterias(), ir.GetPaginate())
+	if err != nil {
+		return utils.ErrorWithCode[protos.GetAllCampaignContactResponse](200, err, "Unable to get the contacts for given campaign id.")
+	}
+	out := []*protos.CampaignContact{}
+	if err := utils.Cast(contacts, &out); err != nil {
+		cApi.logger.Errorf("unable to cast campaign contacts %v", err)
+	}
+	for i, contact := range contacts {
+		if args, err := utils.InterfaceMapToAnyMap(contact.Args); err == nil {
+			out[i].Args = args
+		}
+	}
+	return utils.PaginatedSuccess[protos.GetAllCampaignContactResponse, []*protos.CampaignContact](
+		uint32(cnt),
+		ir.GetPaginate().GetPage(),
+		out)
+}
+
+// DialCampaigns places the calls of the running campaigns which are due at now, calls ending
+// without status callback are failed after staleAfter.
+func (cApi *ConversationApi) DialCampaigns(ctx context.Context, now time.Time, staleAfter time.Duration) {
+	if timedOut, err := cApi.campaignService.TimeoutCalls(ctx, staleAfter); err == nil && timedOut > 0 {
+		cApi.logger.Warnf("failed %d campaign calls without status for %s", timedOut, staleAfter)
+	}
+
+	campaigns, err := cApi.campaignService.GetDialable(ctx, now)
+	if err != nil {
+		return
+	}
+	for _, campaign := range campaigns {
+		contacts, err := cApi.campaignService.Claim(ctx, campaign, now)
+		if err != nil {
+			continue
+		}
+		// contacts claimed are bounded by the concurrent calls of the campaign
+		for _, contact := range contacts {
+			utils.Go(ctx, func() {
+				cApi.dial(ctx, campaign, contact)
+			})
+		}
+	}
+
+	if completed, err := cApi.campaignService.Complete(ctx); err == nil && completed > 0 {
+		cApi.logger.Infof("completed %d campaigns", completed)
+	}
+}
+
+// dial calls the contact as a service of the project of the campaign, the status callbacks of
+// the call are authenticated with the service token
+func (cApi *ConversationApi) dial(ctx context.Context, campaign *internal_campaign_entity.AssistantCampaign, contact *internal_campaign_entity.AssistantCampaignContact) {
+	failed := func(err error) {
+		cApi.logger.Errorf("unable to call contact %d of campaign %d %v", contact.Id, campaign.Id, err)
+		cApi.campaignService.ApplyDisposition(ctx, contact.Id, internal_campaign_entity.DISPOSITION_FAILED)
+	}
+
+	scope := &types.ServiceScope{
+		ProjectId:      utils.Ptr(campaign.ProjectId),
+		OrganizationId: utils.Ptr(campaign.OrganizationId),
+	}
+	token, err := types.CreateServiceScopeToken(scope, cApi.cfg.Secret)
+	if err != nil {
+		failed(err)
+		return
+	}
+	scope.CurrentToken = token
+	auth := &types.PlainClaimPrinciple[*types.ServiceScope]{Info: scope}
+
+	args, err := utils.InterfaceMapToAnyMap(contact.Args)
+	if err != nil {
+		failed(err)
+		return
+	}
+	opts, err := utils.InterfaceMapToAnyMap(campaign.Options)
+	if err != nil {
+		failed(err)
+		return
+	}
+	metadata, err := utils.InterfaceMapToAnyMap(map[string]interface{}{
+		"campaign.id":         fmt.Sprintf("%d", campaign.Id),
+		"campaign.contact_id": fmt.Sprintf("%d", contact.Id),
+		"campaign.attempt":    fmt.Sprintf("%d", contact.Attempts),
+	})
+	if err != nil {
+		failed(err)
+		return
+	}
+
+	grpcApi := &ConversationGrpcApi{ConversationApi: *cApi}
+	resp, _ := grpcApi.CreatePhoneCall(context.WithValue(ctx, types.CTX_, auth), &protos.CreatePhoneCallRequest{
+		Assistant: &protos.AssistantDefinition{
+			AssistantId: campaign.AssistantId,
+			Version:     campaign.AssistantVersion,
+		},
+		Metadata:   metadata,
+		Args:       args,
+		Options:    opts,
+		FromNumber: campaign.FromNumber,
+		ToNumber:   contact.ToNumber,
+	})
+	conversation := resp.GetData()
+	if conversation == nil {
+		failed(errors.New(resp.GetError().GetHumanMessage()))
+		return
+	}
+	cApi.campaignService.AttachConversation(ctx, contact.Id, conversation.GetId())
+	for _, metric := range conversation.GetMetrics() {
+		// the telephony provider did not accept the call
+		if metric.GetName() == "STATUS" && metric.GetValue() == type_enums.RECORD_FAILED.String() {
+			failed(errors.New("telephony call failed"))
+			return
+		}
+	}
+}
+
+// applyCampaignCallStatus moves the campaign contact called in the conversation forward with the
+// status of the call, answering machines are reported by twilio as AnsweredBy
+func (cApi *ConversationApi) applyCampaignCallStatus(ctx context.Context, conversationId uint64, evnts []*types.Event, mtrs []*types.Metric) {
+	var status, answeredBy string
+	for _, mtr := range mtrs {
+		if mtr.GetName() == "STATUS" {
+			status = mtr.GetValue()
+		}
+	}
+	for _, evnt := range evnts {
+		if v, ok := evnt.Payload["AnsweredBy"]; ok && v != nil {
+			answeredBy = fmt.Sprintf("%v", v)
+		}
+	}
+	// the asynchronous detection of twilio reports who answered without the status
+	if status == "" && answeredBy == "" {
+		return
+	}
+	if err := cApi.campaignService.ApplyCallStatus(ctx, conversationId, status, answeredBy); err != nil {
+		cApi.logger.Errorf("failed to apply call status to campaign contact: %v", err)
+	}
+}
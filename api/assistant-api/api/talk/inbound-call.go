@@ -6,15 +6,18 @@
 package assistant_talk_api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
 	"golang.org/x/sync/errgroup"
 
 	internal_adapter "github.com/rapidaai/api/assistant-api/internal/adapters"
+	internal_amd "github.com/rapidaai/api/assistant-api/internal/amd"
 	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
 	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
 	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
@@ -25,6 +28,9 @@ import (
 	"github.com/rapidaai/protos"
 )
 
+// the session picks up the result within seconds, it is kept for calls which connect late
+const answeringMachineResultTTL = time.Hour
+
 func (cApi *ConversationApi) UnviersalCallback(c *gin.Context) {
 	body, err := c.GetRawData() // Extract raw request body
 	if err != nil {
@@ -81,10 +87,37 @@ func (cApi *ConversationApi) Callback(c *gin.Context) {
 			return
 		}
 	}
+	cApi.applyProviderRecording(c, iAuth, _telephony, assistantId, conversationId, evnts)
+	cApi.applyCampaignCallStatus(c, conversationId, evnts, mtrs)
+	cApi.applyAnsweringMachineResult(c, conversationId, evnts, mtrs)
 	c.Status(http.StatusCreated)
 	return
 }
 
+// applyAnsweringMachineResult hands the answering machine detection of the telephony provider
+// to the session of the conversation, which may run on another instance
+func (cApi *ConversationApi) applyAnsweringMachineResult(ctx context.Context, conversationId uint64, evnts []*types.Event, mtrs []*types.Metric) {
+	var status, answeredBy string
+	for _, mtr := range mtrs {
+		if mtr.GetName() == type_enums.STATUS.String() {
+			status = mtr.GetValue()
+		}
+	}
+	for _, evnt := range evnts {
+		if v, ok := evnt.Payload["AnsweredBy"]; ok && v != nil {
+			answeredBy = fmt.Sprintf("%v", v)
+		}
+	}
+	result, beep, ok := internal_amd.ProviderResult(status, answeredBy)
+	if !ok {
+		return
+	}
+	res := cApi.redis.Cmd(ctx, "SET", []string{internal_amd.Key(conversationId), internal_amd.Encode(result, beep), "EX", fmt.Sprintf("%d", int64(answeringMachineResultTTL.Seconds()))})
+	if res == nil || res.HasError() {
+		cApi.logger.Errorf("unable to store answering machine result of conversation %d", conversationId)
+	}
+}
+
 // CallReciever handles incoming calls for the given assistant.
 // @Router /v1/call/:assistantId [post]
 // @Summary Recieve call for given assistant
@@ -137,6 +170,8 @@ func (cApi *ConversationApi) CallReciever(c *gin.Context) {
 	}
 
 	evnts, mtrs, metadatas := types.GetDifferentTelemetry(telemetries)
+	// the calling number, follow-ups of the call are sent to it
+	metadatas = append(metadatas, types.NewMetadata("telephony.fromPhone", *clientNumber))
 	var wg errgroup.Group
 	wg.Go(func() error {
 		if len(metadatas) > 0 {
@@ -178,7 +213,7 @@ func (cApi *ConversationApi) CallReciever(c *gin.Context) {
 		return
 	}
 
-	if err := _telephony.InboundCall(c, iAuth, assistant.Id, *clientNumber, conversation.Id); err != nil {
+	if err := _telephony.InboundCall(c, iAuth, assistant.Id, *clientNumber, conversation.Id, assistant.AssistantPhoneDeployment.GetOptions()); err != nil {
 		cApi.logger.Errorf("failed to initiate inbound call: %v", err)
 		c.JSON(http.StatusBadRequest, gin.H{"error": "unable to initiate talker"})
 		return
@@ -187,6 +222,44 @@ func (cApi *ConversationApi) CallReciever(c *gin.Context) {
 
 }
 
+// CallInstruction answers the provider which asks for the instructions once an outbound call is
+// answered, the call is connected to the assistant as an inbound call would be.
+func (cApi *ConversationApi) CallInstruction(c *gin.Context) {
+	iAuth, isAuthenticated := types.GetAuthPrinciple(c)
+	if !isAuthenticated {
+		cApi.logger.Debugf("illegal unable to authenticate")
+		c.JSON(http.StatusForbidden, gin.H{"error": "Unauthenticated request"})
+		return
+	}
+	assistantId, err := strconv.ParseUint(c.Param("assistantId"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid assistant ID"})
+		return
+	}
+	conversationId, err := strconv.ParseUint(c.Param("conversationId"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid conversation ID"})
+		return
+	}
+
+	_telephony, err := telephony.GetTelephony(telephony.Telephony(c.Param("telephony")), cApi.cfg, cApi.logger)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid telephony"})
+		return
+	}
+	// the phone deployment is loaded by the verification of the webhook
+	assistant, ok := c.Value(webhookAssistantKey).(*internal_assistant_entity.Assistant)
+	if !ok || !assistant.IsPhoneDeploymentEnable() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid phone deployment"})
+		return
+	}
+	if err := _telephony.InboundCall(c, iAuth, assistantId, c.Param("identifier"), conversationId, assistant.AssistantPhoneDeployment.GetOptions()); err != nil {
+		cApi.logger.Errorf("failed to instruct outbound call: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "unable to initiate talker"})
+		return
+	}
+}
+
 func (cApi *ConversationApi) CallTalker(c *gin.Context) {
 	upgrader := websocket.Upgrader{ReadBufferSize: 1024, WriteBufferSize: 1024, CheckOrigin: func(r *http.Request) bool { return true }}
 	websocketConnection, err := upgrader.Upgrade(c.Writer, c.Request, nil)
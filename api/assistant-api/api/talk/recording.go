@@ -0,0 +1,69 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_talk_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/redis/go-redis/v9"
+)
+
+// the recording of a long call takes a while to download, it is not fetched within the webhook
+const providerRecordingTimeout = 10 * time.Minute
+
+// providers retry the recording callback, a recording is claimed for a day so it is stored once
+const providerRecordingClaim = 24 * time.Hour
+
+func providerRecordingKey(conversationId uint64, recordingUrl string) string {
+	return fmt.Sprintf("provider_recording:%d:%s", conversationId, recordingUrl)
+}
+
+// applyProviderRecording stores the recording the telephony provider made of the call next to the
+// recording of the assistant, the recording event of the status callback carries its url. A
+// recording is downloaded once however often the provider retries the callback, the claim is
+// released when the download or the store fails so a retry stores it.
+func (cApi *ConversationApi) applyProviderRecording(c *gin.Context, auth types.SimplePrinciple, telephony internal_type.Telephony, assistantId, conversationId uint64, evnts []*types.Event) {
+	vltC, _ := c.Value(webhookCredentialKey).(*protos.VaultCredential)
+	for _, evnt := range evnts {
+		if evnt.EventType != internal_type.ProviderRecordingEvent {
+			continue
+		}
+		recordingUrl, ok := evnt.Payload[internal_type.ProviderRecordingUrlKey].(string)
+		if !ok || recordingUrl == "" {
+			continue
+		}
+		key := providerRecordingKey(conversationId, recordingUrl)
+		// set with nx answers nil when the key is kept already, a recording which can not be claimed
+		// is stored rather than lost
+		res := cApi.redis.Cmd(c, "SET", []string{key, "1", "NX", "EX", fmt.Sprintf("%d", int64(providerRecordingClaim.Seconds()))})
+		if res != nil && errors.Is(res.Err, redis.Nil) {
+			cApi.logger.Debugf("provider recording of conversation %d is already stored", conversationId)
+			continue
+		}
+		utils.Go(context.Background(), func() {
+			ctx, cancel := context.WithTimeout(context.Background(), providerRecordingTimeout)
+			defer cancel()
+			recording, err := telephony.Recording(ctx, vltC, recordingUrl)
+			if err != nil {
+				cApi.logger.Errorf("unable to download provider recording of conversation %d: %v", conversationId, err)
+				cApi.redis.Cmd(context.Background(), "DEL", []string{key})
+				return
+			}
+			if _, err := cApi.assistantConversationService.CreateConversationRecording(ctx, auth, assistantId, conversationId, recording); err != nil {
+				cApi.logger.Errorf("unable to store provider recording of conversation %d: %v", conversationId, err)
+				cApi.redis.Cmd(context.Background(), "DEL", []string{key})
+			}
+		})
+	}
+}
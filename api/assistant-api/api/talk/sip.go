@@ -0,0 +1,191 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_talk_api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	internal_adapter "github.com/rapidaai/api/assistant-api/internal/adapters"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	telephony "github.com/rapidaai/api/assistant-api/internal/telephony"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/authenticators"
+	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// Invite routes an inbound sip call to an assistant. Calls of the registered trunk go to the
+// assistant of the registration, any other call names the assistant as user of the request
+// uri and carries the project key as x-api-key parameter or X-Api-Key header.
+func (cApi *ConversationApi) Invite(ctx context.Context, call internal_type.SipCall) {
+	assistantId, apiKey := cApi.sipRoute(call)
+	if assistantId == 0 || apiKey == "" {
+		cApi.logger.Debugf("illegal sip call %s to %s without assistant", call.Id(), call.User())
+		call.Reject(404, "Not Found")
+		return
+	}
+
+	claim, err := authenticators.NewProjectAuthenticator(&cApi.cfg.AppConfig, cApi.logger, web_client.NewAuthenticator(&cApi.cfg.AppConfig, cApi.logger, cApi.redis)).Claim(ctx, apiKey)
+	if err != nil || !claim.Info.IsAuthenticated() {
+		cApi.logger.Debugf("illegal unable to authenticate sip call %s", call.Id())
+		call.Reject(403, "Forbidden")
+		return
+	}
+	auth := claim.Info
+
+	assistant, vltC, err := cApi.phoneAssistant(ctx, auth, assistantId, utils.GetVersionDefinition("latest"))
+	if err != nil {
+		cApi.logger.Debugf("illegal unable to find assistant for sip call %v", err)
+		call.Reject(404, "Not Found")
+		return
+	}
+
+	conversation, err := cApi.assistantConversationService.CreateConversation(ctx, auth, internal_adapter.Identifier(utils.PhoneCall, ctx, auth, call.From()), assistant.Id, assistant.AssistantProviderId, type_enums.DIRECTION_INBOUND, utils.PhoneCall)
+	if err != nil {
+		cApi.logger.Errorf("unable to create conversation for sip call %v", err)
+		call.Reject(500, "Server Internal Error")
+		return
+	}
+	mtdas, err := cApi.assistantConversationService.ApplyConversationMetadata(ctx, auth, assistant.Id, conversation.Id, []*types.Metadata{
+		types.NewMetadata("telephony.uuid", call.Id()),
+		types.NewMetadata("telephony.provider", string(telephony.Sip)),
+		types.NewMetadata("telephony.toPhone", call.To()),
+		types.NewMetadata("telephony.fromPhone", call.From()),
+	})
+	if err != nil {
+		cApi.logger.Errorf("failed to apply conversation metadata: %v", err)
+		call.Reject(500, "Server Internal Error")
+		return
+	}
+	conversation.Metadatas = mtdas
+
+	if err := call.Answer(); err != nil {
+		cApi.logger.Errorf("unable to answer sip call %s: %v", call.Id(), err)
+		cApi.assistantConversationService.ApplyConversationMetrics(ctx, auth, assistant.Id, conversation.Id, []*types.Metric{types.NewStatusMetric(type_enums.RECORD_FAILED)})
+		return
+	}
+	cApi.sipTalk(ctx, auth, call, assistant, conversation, vltC, call.From())
+}
+
+// Answered starts the conversation of an outbound sip call once the callee picked up
+func (cApi *ConversationApi) Answered(ctx context.Context, call internal_type.SipCall) {
+	dial := call.Outbound()
+	if dial == nil {
+		return
+	}
+	assistant, vltC, err := cApi.phoneAssistant(ctx, dial.Auth, dial.AssistantId, nil)
+	if err != nil {
+		cApi.logger.Errorf("error while answering sip call %v", err)
+		call.Hangup()
+		return
+	}
+	conversation, err := cApi.assistantConversationService.Get(ctx, dial.Auth, dial.AssistantId, dial.AssistantConversationId, internal_services.NewDefaultGetConversationOption())
+	if err != nil {
+		cApi.logger.Errorf("error while answering sip call %v", err)
+		call.Hangup()
+		return
+	}
+	// the callee may answer before the telemetry of the dial is stored
+	if _, err := conversation.GetMetadatas().GetString("telephony.uuid"); err != nil {
+		mtdas, err := cApi.assistantConversationService.ApplyConversationMetadata(ctx, dial.Auth, assistant.Id, conversation.Id, []*types.Metadata{types.NewMetadata("telephony.uuid", call.Id())})
+		if err != nil {
+			cApi.logger.Errorf("failed to apply conversation metadata: %v", err)
+			call.Hangup()
+			return
+		}
+		conversation.Metadatas = append(conversation.Metadatas, mtdas...)
+	}
+	cApi.sipTalk(ctx, dial.Auth, call, assistant, conversation, vltC, call.To())
+}
+
+// Ended records how an outbound sip call ended the way status callbacks of other telephony do
+func (cApi *ConversationApi) Ended(ctx context.Context, call internal_type.SipCall, status string, reason string) {
+	dial := call.Outbound()
+	if dial == nil {
+		return
+	}
+	mtrs := []*types.Metric{{Name: type_enums.STATUS.String(), Value: status, Description: "Status of telephony call"}}
+	evnts := []*types.Event{types.NewEvent(status, map[string]interface{}{"call_id": call.Id(), "reason": reason})}
+	if _, err := cApi.assistantConversationService.ApplyConversationMetrics(ctx, dial.Auth, dial.AssistantId, dial.AssistantConversationId, mtrs); err != nil {
+		cApi.logger.Errorf("failed to apply conversation metrics of sip call: %v", err)
+	}
+	if _, err := cApi.assistantConversationService.ApplyConversationTelephonyEvent(ctx, dial.Auth, string(telephony.Sip), dial.AssistantId, dial.AssistantConversationId, evnts); err != nil {
+		cApi.logger.Errorf("failed to apply telephony events of sip call: %v", err)
+	}
+	cApi.applyCampaignCallStatus(ctx, dial.AssistantConversationId, evnts, mtrs)
+}
+
+// sipRoute resolves the assistant and the project key an inbound call was placed to, the user
+// agent only hands over calls to the registration from the trunk or its allow list
+func (cApi *ConversationApi) sipRoute(call internal_type.SipCall) (uint64, string) {
+	registration := cApi.cfg.SipConfig.Register
+	if registration.Username != "" && call.User() == registration.Username {
+		return registration.AssistantId, registration.ApiKey
+	}
+	assistantId, err := strconv.ParseUint(call.User(), 10, 64)
+	if err != nil {
+		return 0, ""
+	}
+	apiKey := call.Param(types.PROJECT_SCOPE_KEY)
+	if apiKey == "" {
+		apiKey = call.Header(types.PROJECT_SCOPE_KEY)
+	}
+	return assistantId, apiKey
+}
+
+// phoneAssistant gets the assistant with its phone deployment and the credential of the deployment
+// for the telephonies which receive calls without a webhook, sip and asterisk
+func (cApi *ConversationApi) phoneAssistant(ctx context.Context, auth types.SimplePrinciple, assistantId uint64, version *uint64) (*internal_assistant_entity.Assistant, *protos.VaultCredential, error) {
+	assistant, err := cApi.assistantService.Get(ctx, auth, assistantId, version, &internal_services.GetAssistantOption{InjectPhoneDeployment: true})
+	if err != nil {
+		return nil, nil, err
+	}
+	if !assistant.IsPhoneDeploymentEnable() {
+		return nil, nil, fmt.Errorf("phone deployment is not enabled for assistant %d", assistantId)
+	}
+	credentialID, err := assistant.AssistantPhoneDeployment.GetOptions().GetUint64("rapida.credential_id")
+	if err != nil {
+		// calls routed to the user agent or asterisk directly need no credential
+		return assistant, nil, nil
+	}
+	vltC, err := cApi.vaultClient.GetCredential(ctx, auth, credentialID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return assistant, vltC, nil
+}
+
+// sipTalk runs the assistant on the answered call until either side hangs up
+func (cApi *ConversationApi) sipTalk(ctx context.Context, auth types.SimplePrinciple, call internal_type.SipCall, assistant *internal_assistant_entity.Assistant, conversation *internal_conversation_entity.AssistantConversation, vltC *protos.VaultCredential, identifier string) {
+	_telephony, err := telephony.GetTelephony(telephony.Sip, cApi.cfg, cApi.logger)
+	if err != nil {
+		call.Hangup()
+		return
+	}
+	streamer := _telephony.Streamer(nil, nil, assistant, conversation, vltC)
+	if streamer == nil {
+		cApi.assistantConversationService.ApplyConversationMetrics(ctx, auth, assistant.Id, conversation.Id, []*types.Metric{{Name: type_enums.STATUS.String(), Value: type_enums.RECORD_FAILED.String(), Description: "Sip call has ended"}})
+		return
+	}
+	talker, err := internal_adapter.GetTalker(utils.PhoneCall, ctx, cApi.cfg, cApi.logger, cApi.postgres, cApi.opensearch, cApi.redis, cApi.storage, streamer)
+	if err != nil {
+		cApi.logger.Errorf("error while talking on sip call %v", err)
+		cApi.assistantConversationService.ApplyConversationMetrics(ctx, auth, assistant.Id, conversation.Id, []*types.Metric{{Name: type_enums.STATUS.String(), Value: type_enums.RECORD_FAILED.String(), Description: "Internal server error"}})
+		call.Hangup()
+		return
+	}
+	if err := talker.Talk(ctx, auth, internal_adapter.Identifier(utils.PhoneCall, ctx, auth, identifier)); err != nil {
+		cApi.logger.Errorf("error while talking on sip call %v", err)
+	}
+	call.Hangup()
+}
@@ -0,0 +1,326 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_talk_api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	internal_adapter "github.com/rapidaai/api/assistant-api/internal/adapters"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	internal_sms "github.com/rapidaai/api/assistant-api/internal/sms"
+	internal_text_session "github.com/rapidaai/api/assistant-api/internal/text_session"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/parsers"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// smsDeployment resolves the provider of the path, the assistant and the vault credential of its
+// sms deployment, the provider has to be the one of the deployment
+func (cApi *ConversationApi) smsDeployment(c *gin.Context, auth types.SimplePrinciple) (internal_type.Sms, *internal_assistant_entity.Assistant, *protos.VaultCredential, error) {
+	assistantId, err := strconv.ParseUint(c.Param("assistantId"), 10, 64)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid assistant id: %w", err)
+	}
+	provider := c.Param("sms")
+	sms, err := internal_sms.GetSms(internal_sms.Sms(provider), cApi.cfg, cApi.logger)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	assistant, err := cApi.assistantService.Get(c, auth, assistantId, utils.GetVersionDefinition("latest"), &internal_services.GetAssistantOption{InjectSmsDeployment: true})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !assistant.IsSmsDeploymentEnable() || assistant.AssistantSmsDeployment.SmsProvider != provider {
+		return nil, nil, nil, fmt.Errorf("%s sms deployment is not enabled for assistant %d", provider, assistantId)
+	}
+	credentialID, err := assistant.AssistantSmsDeployment.GetOptions().GetUint64("rapida.credential_id")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	vltC, err := cApi.vaultClient.GetCredential(c, auth, credentialID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return sms, assistant, vltC, nil
+}
+
+// SmsReciever receives the messages of sms users and hands them to the text session of the user,
+// the opt-out and opt-in keywords of the carriers are handled before the session.
+// @Router /v1/talk/sms/:sms/prj/:assistantId/:x-api-key [post]
+// @Summary Recieve sms and respond
+// @Produce json
+// @Success 200 {object} commons.Response
+// @Failure 500 {object} commons.Response
+func (cApi *ConversationApi) SmsReciever(c *gin.Context) {
+	iAuth, isAuthenticated := types.GetAuthPrinciple(c)
+	if !isAuthenticated {
+		cApi.logger.Debugf("illegal unable to authenticate")
+		c.JSON(http.StatusForbidden, gin.H{"error": "Unauthenticated request"})
+		return
+	}
+	sms, assistant, vltC, err := cApi.smsDeployment(c, iAuth)
+	if err != nil {
+		cApi.logger.Debugf("illegal sms message %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid sms deployment"})
+		return
+	}
+
+	// the verifier may read the body, it is restored for the messages
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unable to read request"})
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	if err := sms.VerifyWebhook(c, vltC); err != nil {
+		cApi.logger.Warnf("rejected sms webhook for assistant %d: %v", assistant.Id, err)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Invalid webhook signature"})
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	messages, err := sms.ReceiveMessage(c)
+	if err != nil {
+		cApi.logger.Errorf("unable to read sms message %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid sms message"})
+		return
+	}
+	for _, message := range messages {
+		if err := cApi.onSmsMessage(c, iAuth, sms, assistant, vltC, message); err != nil {
+			cApi.logger.Errorf("unable to talk to sms user %s: %v", message.From, err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to initiate talker"})
+			return
+		}
+	}
+	sms.Acknowledge(c)
+}
+
+// onSmsMessage hands the message to the text session of the user, keywords opt the user out or in
+// and never reach the assistant
+func (cApi *ConversationApi) onSmsMessage(ctx context.Context, auth types.SimplePrinciple, sms internal_type.Sms, assistant *internal_assistant_entity.Assistant, vltC *protos.VaultCredential, message *internal_type.SmsMessage) error {
+	identifier := internal_adapter.Identifier(utils.SMS, ctx, auth, message.From)
+	key := internal_sms.Key(assistant.Id, identifier)
+	number := internal_sms.PhoneNumber(message.From)
+
+	if keyword, ok := internal_sms.OptOutKeyword(message.Text); ok {
+		if err := cApi.deploymentService.OptOutSms(ctx, auth, assistant.Id, number, keyword); err != nil {
+			return err
+		}
+		// the session is stopped and the conversation is not resumed, a later message of the user
+		// begins a new one
+		cApi.smsSessions.Remove(key)
+		cApi.redis.Cmd(ctx, "DEL", []string{key})
+		cApi.replySmsOption(ctx, sms, assistant, vltC, message, "sms.opt_out_message")
+		return nil
+	}
+	if _, ok := internal_sms.OptInKeyword(message.Text); ok {
+		if err := cApi.deploymentService.OptInSms(ctx, auth, assistant.Id, number); err != nil {
+			return err
+		}
+		cApi.replySmsOption(ctx, sms, assistant, vltC, message, "sms.opt_in_message")
+		return nil
+	}
+	optedOut, err := cApi.deploymentService.IsSmsOptedOut(ctx, auth, assistant.Id, number)
+	if err != nil {
+		return err
+	}
+	if optedOut {
+		cApi.logger.Debugf("ignoring sms of %s, the number opted out of the assistant %d", message.From, assistant.Id)
+		return nil
+	}
+
+	return cApi.onTextMessage(ctx, auth, assistant, textSession{
+		source:     utils.SMS,
+		sessions:   cApi.smsSessions,
+		key:        key,
+		identifier: identifier,
+		window:     internal_sms.ConversationWindow,
+		metadata: []*types.Metadata{
+			types.NewMetadata("sms.provider", assistant.AssistantSmsDeployment.SmsProvider),
+			types.NewMetadata("sms.fromPhone", message.From),
+			types.NewMetadata("sms.toPhone", message.To),
+		},
+		streamer: func(conversationId uint64) internal_type.TextStreamer {
+			replies := &smsReplies{Sms: sms, logger: cApi.logger, optedOut: func(ctx context.Context, number string) (bool, error) {
+				return cApi.deploymentService.IsSmsOptedOut(ctx, auth, assistant.Id, number)
+			}}
+			return internal_text_session.NewStreamer(cApi.logger, internal_sms.TextChannel, replies, assistant, conversationId, message.TextMessage(), vltC)
+		},
+	}, message.TextMessage())
+}
+
+// smsReplies sends the replies of a session, the opt-out of the number is checked before every
+// reply as the user may opt out on another instance while the session answers
+type smsReplies struct {
+	internal_type.Sms
+	logger   commons.Logger
+	optedOut func(ctx context.Context, number string) (bool, error)
+}
+
+func (r *smsReplies) SendMessage(ctx context.Context, vaultCredential *protos.VaultCredential, from, to, text string) error {
+	optedOut, err := r.optedOut(ctx, internal_sms.PhoneNumber(to))
+	if err != nil {
+		return err
+	}
+	if optedOut {
+		r.logger.Debugf("dropping sms reply to %s, the number opted out", to)
+		return nil
+	}
+	return r.Sms.SendMessage(ctx, vaultCredential, from, to, text)
+}
+
+// replySmsOption answers a keyword with the text of the deployment option, the carriers confirm
+// the keywords themselves when the deployment has no text for them
+func (cApi *ConversationApi) replySmsOption(ctx context.Context, sms internal_type.Sms, assistant *internal_assistant_entity.Assistant, vltC *protos.VaultCredential, message *internal_type.SmsMessage, option string) {
+	text, err := assistant.AssistantSmsDeployment.GetOptions().GetString(option)
+	if err != nil || strings.TrimSpace(text) == "" {
+		return
+	}
+	if err := sms.SendMessage(ctx, vltC, message.To, message.From, text); err != nil {
+		cApi.logger.Errorf("unable to answer the sms keyword of %s: %v", message.From, err)
+	}
+}
+
+// CreateSms implements protos.TalkServiceServer, the assistant writes first to the number and
+// the replies of the user continue the conversation.
+func (cApi *ConversationGrpcApi) CreateSms(ctx context.Context, ir *protos.CreateSmsRequest) (*protos.CreateSmsResponse, error) {
+	auth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated {
+		cApi.logger.Errorf("unable to resolve the authentication object, please check the parameter for authentication")
+		return utils.AuthenticateError[protos.CreateSmsResponse]()
+	}
+
+	toNumber := ir.GetToNumber()
+	if utils.IsEmpty(toNumber) {
+		return utils.ErrorWithCode[protos.CreateSmsResponse](200, fmt.Errorf("missing to_number parameter"), "Please provide the required to_number parameter.")
+	}
+
+	mtd, err := utils.AnyMapToInterfaceMap(ir.GetMetadata())
+	if err != nil {
+		return utils.ErrorWithCode[protos.CreateSmsResponse](200, err, "Illegal metadata for initialize request, please check and try again.")
+	}
+
+	args, err := utils.AnyMapToInterfaceMap(ir.GetArgs())
+	if err != nil {
+		return utils.ErrorWithCode[protos.CreateSmsResponse](200, err, "Illegal arguments for initialize request, please check and try again.")
+	}
+
+	opts, err := utils.AnyMapToInterfaceMap(ir.GetOptions())
+	if err != nil {
+		return utils.ErrorWithCode[protos.CreateSmsResponse](200, err, "Illegal options for initialize request, please check and try again.")
+	}
+
+	assistant, err := cApi.assistantService.Get(ctx, auth, ir.GetAssistant().GetAssistantId(), utils.GetVersionDefinition(ir.GetAssistant().GetVersion()), &internal_services.GetAssistantOption{InjectSmsDeployment: true})
+	if err != nil {
+		cApi.logger.Debugf("illegal unable to find assistant %v", err)
+		return utils.ErrorWithCode[protos.CreateSmsResponse](200, err, "Invalid assistant id, please check and try again.")
+	}
+
+	if !assistant.IsSmsDeploymentEnable() {
+		return utils.ErrorWithCode[protos.CreateSmsResponse](200, fmt.Errorf("sms deployment is not enabled"), "Sms deployment not enabled or incomplete, please check rapida console and update the deployment")
+	}
+
+	optedOut, err := cApi.deploymentService.IsSmsOptedOut(ctx, auth, assistant.Id, internal_sms.PhoneNumber(toNumber))
+	if err != nil {
+		return utils.ErrorWithCode[protos.CreateSmsResponse](200, err, "Unable to check the opt-out of the number, please try again.")
+	}
+	if optedOut {
+		return utils.ErrorWithCode[protos.CreateSmsResponse](200, fmt.Errorf("%s opted out", toNumber), "The number opted out of the messages of the assistant.")
+	}
+
+	text := ir.GetMessage()
+	if utils.IsEmpty(text) && assistant.AssistantSmsDeployment.Greeting != nil {
+		text = parsers.NewPongo2StringTemplateParser(cApi.logger).Parse(*assistant.AssistantSmsDeployment.Greeting, args)
+	}
+	if strings.TrimSpace(text) == "" {
+		return utils.ErrorWithCode[protos.CreateSmsResponse](200, fmt.Errorf("missing message parameter"), "Please provide the message or a greeting for the sms deployment.")
+	}
+
+	fromPhone := ir.GetFromNumber()
+	if utils.IsEmpty(fromPhone) {
+		fromNumber, err := assistant.AssistantSmsDeployment.GetOptions().GetString("phone")
+		if err != nil {
+			return utils.ErrorWithCode[protos.CreateSmsResponse](200, fmt.Errorf("failed to get sms phone number"), "Unable to retrieve the default phone number.")
+		}
+		fromPhone = fromNumber
+	}
+
+	credentialID, err := assistant.AssistantSmsDeployment.GetOptions().GetUint64("rapida.credential_id")
+	if err != nil {
+		return utils.ErrorWithCode[protos.CreateSmsResponse](200, err, "Please check the credential for sms, please check and try again.")
+	}
+	vltC, err := cApi.vaultClient.GetCredential(ctx, auth, credentialID)
+	if err != nil {
+		return utils.ErrorWithCode[protos.CreateSmsResponse](200, err, "Please check the credential for sms, please check and try again.")
+	}
+	sms, err := internal_sms.GetSms(internal_sms.Sms(assistant.AssistantSmsDeployment.SmsProvider), cApi.cfg, cApi.logger)
+	if err != nil {
+		return utils.ErrorWithCode[protos.CreateSmsResponse](200, err, "Please check the configuration for sms, please check and try again.")
+	}
+
+	// the identifier is the one of the replies of the user, they resume the conversation
+	identifier := internal_adapter.Identifier(utils.SMS, ctx, auth, toNumber)
+	conversation, err := cApi.assistantConversationService.CreateConversation(ctx, auth, identifier, assistant.Id, assistant.AssistantProviderId, type_enums.DIRECTION_OUTBOUND, utils.SMS)
+	if err != nil {
+		cApi.logger.Errorf("unable to create conversation %+v", err)
+		return utils.ErrorWithCode[protos.CreateSmsResponse](200, err, "Unable to create conversation session, please check and try again.")
+	}
+	o, err := cApi.assistantConversationService.ApplyConversationOption(ctx, auth, assistant.Id, conversation.Id, opts)
+	if err != nil {
+		cApi.logger.Debugf("unable to create options %v", err)
+		return utils.ErrorWithCode[protos.CreateSmsResponse](200, err, "Unable to create conversation options, please check and try again.")
+	}
+	conversation.Options = o
+	arguments, err := cApi.assistantConversationService.ApplyConversationArgument(ctx, auth, assistant.Id, conversation.Id, args)
+	if err != nil {
+		cApi.logger.Debugf("unable to create argument %v", err)
+		return utils.ErrorWithCode[protos.CreateSmsResponse](200, err, "Unable to create conversation arguments, please check and try again.")
+	}
+	conversation.Arguments = arguments
+	mtdas, err := cApi.assistantConversationService.ApplyConversationMetadata(ctx, auth, assistant.Id, conversation.Id, append([]*types.Metadata{
+		types.NewMetadata("sms.provider", assistant.AssistantSmsDeployment.SmsProvider),
+		types.NewMetadata("sms.fromPhone", fromPhone),
+		types.NewMetadata("sms.toPhone", toNumber),
+	}, types.NewMetadataList(mtd)...))
+	if err != nil {
+		cApi.logger.Errorf("failed to apply conversation metadata: %v", err)
+		return utils.ErrorWithCode[protos.CreateSmsResponse](200, err, "Unable to create conversation metadata, please check and try again.")
+	}
+	conversation.Metadatas = mtdas
+
+	if err := sms.SendMessage(ctx, vltC, fromPhone, toNumber, text); err != nil {
+		cApi.logger.Errorf("unable to send sms to %s: %v", toNumber, err)
+		cApi.assistantConversationService.ApplyConversationMetrics(ctx, auth, assistant.Id, conversation.Id, []*types.Metric{types.NewStatusMetric(type_enums.RECORD_FAILED)})
+		return utils.ErrorWithCode[protos.CreateSmsResponse](200, err, "Unable to send the sms, please check and try again.")
+	}
+	// the sent message is the history the reply of the user is answered with
+	if _, err := cApi.assistantConversationService.CreateConversationMessage(ctx, auth, utils.SMS, assistant.Id, assistant.AssistantProviderId, conversation.Id, uuid.NewString(), "assistant", text); err != nil {
+		cApi.logger.Errorf("unable to record the sms of conversation %d: %v", conversation.Id, err)
+	}
+	res := cApi.redis.Cmd(ctx, "SET", []string{internal_sms.Key(assistant.Id, identifier), fmt.Sprintf("%d", conversation.Id), "EX", fmt.Sprintf("%d", int64(internal_sms.ConversationWindow.Seconds()))})
+	if res == nil || res.HasError() {
+		cApi.logger.Errorf("unable to keep the sms conversation %d for the window", conversation.Id)
+	}
+
+	out := &protos.AssistantConversation{}
+	if err := utils.Cast(conversation, out); err != nil {
+		cApi.logger.Errorf("unable to cast assistant conversation %v", err)
+	}
+	return utils.Success[protos.CreateSmsResponse, *protos.AssistantConversation](out)
+}
@@ -13,6 +13,8 @@ import (
 	internal_grpc "github.com/rapidaai/api/assistant-api/internal/grpc"
 	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
 	internal_assistant_service "github.com/rapidaai/api/assistant-api/internal/services/assistant"
+	internal_campaign_service "github.com/rapidaai/api/assistant-api/internal/services/campaign"
+	internal_text_session "github.com/rapidaai/api/assistant-api/internal/text_session"
 	web_client "github.com/rapidaai/pkg/clients/web"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
@@ -33,7 +35,13 @@ type ConversationApi struct {
 
 	assistantConversationService internal_services.AssistantConversationService
 	assistantService             internal_services.AssistantService
+	campaignService              internal_services.CampaignService
+	deploymentService            internal_services.AssistantDeploymentService
 	vaultClient                  web_client.VaultClient
+
+	// text sessions of the whatsapp and sms users writing to this instance
+	whatsappSessions *internal_text_session.Sessions
+	smsSessions      *internal_text_session.Sessions
 }
 
 type ConversationGrpcApi struct {
@@ -55,8 +63,12 @@ func NewConversationGRPCApi(config *config.AssistantConfig, logger commons.Logge
 			opensearch:                   opensearch,
 			assistantConversationService: internal_assistant_service.NewAssistantConversationService(logger, postgres, storage_files.NewStorage(config.AssetStoreConfig, logger)),
 			assistantService:             internal_assistant_service.NewAssistantService(config, logger, postgres, opensearch),
+			campaignService:              internal_campaign_service.NewCampaignService(logger, postgres),
+			deploymentService:            internal_assistant_service.NewAssistantDeploymentService(config, logger, postgres),
 			storage:                      storage_files.NewStorage(config.AssetStoreConfig, logger),
 			vaultClient:                  web_client.NewVaultClientGRPC(&config.AppConfig, logger, redis),
+			whatsappSessions:             internal_text_session.NewSessions(),
+			smsSessions:                  internal_text_session.NewSessions(),
 		},
 	}
 }
@@ -75,8 +87,12 @@ func NewConversationApi(config *config.AssistantConfig, logger commons.Logger,
 		opensearch:                   opensearch,
 		assistantConversationService: internal_assistant_service.NewAssistantConversationService(logger, postgres, storage_files.NewStorage(config.AssetStoreConfig, logger)),
 		assistantService:             internal_assistant_service.NewAssistantService(config, logger, postgres, opensearch),
+		campaignService:              internal_campaign_service.NewCampaignService(logger, postgres),
+		deploymentService:            internal_assistant_service.NewAssistantDeploymentService(config, logger, postgres),
 		storage:                      storage_files.NewStorage(config.AssetStoreConfig, logger),
 		vaultClient:                  web_client.NewVaultClientGRPC(&config.AppConfig, logger, redis),
+		whatsappSessions:             internal_text_session.NewSessions(),
+		smsSessions:                  internal_text_session.NewSessions(),
 	}
 
 }
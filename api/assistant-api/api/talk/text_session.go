@@ -0,0 +1,109 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_talk_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	internal_adapter "github.com/rapidaai/api/assistant-api/internal/adapters"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_text_session "github.com/rapidaai/api/assistant-api/internal/text_session"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+)
+
+// textSession is the session of a whatsapp or sms user a message is handed to
+type textSession struct {
+	source   utils.RapidaSource
+	sessions *internal_text_session.Sessions
+	// the conversation of the user is kept in redis under the key for the window
+	key        string
+	identifier string
+	window     time.Duration
+	// metadata of the conversation the message begins
+	metadata []*types.Metadata
+	streamer func(conversationId uint64) internal_type.TextStreamer
+}
+
+// onTextMessage hands the message to the session of the user, a session is opened when the user
+// has none on this instance
+func (cApi *ConversationApi) onTextMessage(ctx context.Context, auth types.SimplePrinciple, assistant *internal_assistant_entity.Assistant, ts textSession, message *internal_type.TextMessage) error {
+	window := fmt.Sprintf("%d", int64(ts.window.Seconds()))
+	previous, ok := ts.sessions.Get(ts.key)
+	if ok {
+		err := previous.Receive(message)
+		if err == nil {
+			cApi.redis.Cmd(ctx, "EXPIRE", []string{ts.key, window})
+			return nil
+		}
+		if !errors.Is(err, internal_text_session.ErrSessionClosed) {
+			return err
+		}
+		if previous.Ended() {
+			cApi.redis.Cmd(ctx, "DEL", []string{ts.key})
+		}
+	}
+
+	conversationId, err := cApi.textConversation(ctx, auth, assistant, ts)
+	if err != nil {
+		return err
+	}
+	streamer := ts.streamer(conversationId)
+	// another message of the user may have opened a session meanwhile
+	if session, opened := ts.sessions.Open(ts.key, streamer, previous); !opened {
+		return session.Receive(message)
+	}
+	// the session outlives the webhook, it ends when the user stops writing
+	talker, err := internal_adapter.GetTalker(ts.source, context.Background(), cApi.cfg, cApi.logger, cApi.postgres, cApi.opensearch, cApi.redis, cApi.storage, streamer)
+	if err != nil {
+		ts.sessions.Close(ts.key, streamer)
+		return err
+	}
+	utils.Go(context.Background(), func() {
+		defer ts.sessions.Close(ts.key, streamer)
+		if err := talker.Talk(context.Background(), auth, ts.identifier); err != nil {
+			cApi.logger.Errorf("%s session of %s failed: %v", ts.source, message.From, err)
+		}
+		if streamer.Ended() {
+			cApi.redis.Cmd(context.Background(), "DEL", []string{ts.key})
+		}
+	})
+	return nil
+}
+
+// textConversation is the conversation the message continues, the conversation of the window is
+// resumed and a new one begins once the window closed
+func (cApi *ConversationApi) textConversation(ctx context.Context, auth types.SimplePrinciple, assistant *internal_assistant_entity.Assistant, ts textSession) (uint64, error) {
+	window := fmt.Sprintf("%d", int64(ts.window.Seconds()))
+	// a missing key is a nil value with mget, not an error
+	if res := cApi.redis.Cmd(ctx, "MGET", []string{ts.key}); res != nil && !res.HasError() {
+		if values, err := res.ResultSlice(); err == nil && len(values) > 0 && values[0] != nil {
+			if conversationId, err := strconv.ParseUint(fmt.Sprintf("%v", values[0]), 10, 64); err == nil {
+				cApi.redis.Cmd(ctx, "EXPIRE", []string{ts.key, window})
+				return conversationId, nil
+			}
+		}
+	}
+
+	conversation, err := cApi.assistantConversationService.CreateConversation(ctx, auth, ts.identifier, assistant.Id, assistant.AssistantProviderId, type_enums.DIRECTION_INBOUND, ts.source)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := cApi.assistantConversationService.ApplyConversationMetadata(ctx, auth, assistant.Id, conversation.Id, ts.metadata); err != nil {
+		cApi.logger.Errorf("failed to apply %s metadata: %v", ts.source, err)
+	}
+	res := cApi.redis.Cmd(ctx, "SET", []string{ts.key, fmt.Sprintf("%d", conversation.Id), "EX", window})
+	if res == nil || res.HasError() {
+		cApi.logger.Errorf("unable to keep the %s conversation %d for the window", ts.source, conversation.Id)
+	}
+	return conversation.Id, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_talk_api
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	telephony "github.com/rapidaai/api/assistant-api/internal/telephony"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+const (
+	webhookAssistantKey  = "telephony.assistant"
+	webhookCredentialKey = "telephony.credential"
+)
+
+// VerifyWebhook runs before the call and event handlers of telephony providers and rejects the
+// webhooks which are not signed with the keys of the phone deployment of the assistant. The provider
+// of the path has to be the one of the deployment, a forged request can not pick a provider which
+// does not sign its webhooks.
+func (cApi *ConversationApi) VerifyWebhook(c *gin.Context) {
+	iAuth, isAuthenticated := types.GetAuthPrinciple(c)
+	if !isAuthenticated {
+		cApi.logger.Debugf("illegal unable to authenticate")
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Unauthenticated request"})
+		return
+	}
+	assistantId, err := strconv.ParseUint(c.Param("assistantId"), 10, 64)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid assistant ID"})
+		return
+	}
+
+	tlp := c.Param("telephony")
+	_telephony, err := telephony.GetTelephony(telephony.Telephony(tlp), cApi.cfg, cApi.logger)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid telephony"})
+		return
+	}
+
+	assistant, err := cApi.assistantService.Get(c, iAuth, assistantId, utils.GetVersionDefinition("latest"), &internal_services.GetAssistantOption{InjectPhoneDeployment: true})
+	if err != nil || !assistant.IsPhoneDeploymentEnable() || assistant.AssistantPhoneDeployment.TelephonyProvider != tlp {
+		cApi.logger.Debugf("illegal %s webhook for assistant %d without phone deployment", tlp, assistantId)
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid phone deployment"})
+		return
+	}
+	var vltC *protos.VaultCredential
+	if credentialID, err := assistant.AssistantPhoneDeployment.GetOptions().GetUint64("rapida.credential_id"); err == nil {
+		vltC, err = cApi.vaultClient.GetCredential(c, iAuth, credentialID)
+		if err != nil {
+			cApi.logger.Errorf("unable to get credential to verify %s webhook %v", tlp, err)
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid phone deployment credential"})
+			return
+		}
+	}
+
+	// the verifier may read the body, it is restored for the handler
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Unable to read request"})
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	if err := _telephony.VerifyWebhook(c, vltC, assistant.AssistantPhoneDeployment.GetOptions()); err != nil {
+		cApi.logger.Warnf("rejected %s webhook for assistant %d: %v", tlp, assistantId, err)
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Invalid webhook signature"})
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	// the handlers answer with the phone deployment and fetch recordings with the same credential
+	c.Set(webhookAssistantKey, assistant)
+	c.Set(webhookCredentialKey, vltC)
+	c.Next()
+}
@@ -6,75 +6,174 @@
 package assistant_talk_api
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
-	"net/url"
-	"strings"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"github.com/rapidaai/pkg/commons"
+	internal_adapter "github.com/rapidaai/api/assistant-api/internal/adapters"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	internal_text_session "github.com/rapidaai/api/assistant-api/internal/text_session"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	internal_whatsapp "github.com/rapidaai/api/assistant-api/internal/whatsapp"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/redis/go-redis/v9"
 )
 
-// WhatsappReciever handles incoming WhatsApp messages and sends a response.
-// @Router /v1/whatsapp/:assistantId [post]
+// whatsappDeployment resolves the provider of the path, the assistant and the vault credential of
+// its whatsapp deployment, the provider has to be the one of the deployment
+func (cApi *ConversationApi) whatsappDeployment(c *gin.Context, auth types.SimplePrinciple) (internal_type.Whatsapp, *internal_assistant_entity.Assistant, *protos.VaultCredential, error) {
+	assistantId, err := strconv.ParseUint(c.Param("assistantId"), 10, 64)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid assistant id: %w", err)
+	}
+	provider := c.Param("whatsapp")
+	whatsapp, err := internal_whatsapp.GetWhatsapp(internal_whatsapp.Whatsapp(provider), cApi.cfg, cApi.logger)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	assistant, err := cApi.assistantService.Get(c, auth, assistantId, utils.GetVersionDefinition("latest"), &internal_services.GetAssistantOption{InjectWhatsappDeployment: true})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !assistant.IsWhatsappDeploymentEnable() || assistant.AssistantWhatsappDeployment.WhatsappProvider != provider {
+		return nil, nil, nil, fmt.Errorf("%s whatsapp deployment is not enabled for assistant %d", provider, assistantId)
+	}
+	credentialID, err := assistant.AssistantWhatsappDeployment.GetOptions().GetUint64("rapida.credential_id")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	vltC, err := cApi.vaultClient.GetCredential(c, auth, credentialID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return whatsapp, assistant, vltC, nil
+}
+
+// WhatsappSubscribe answers the handshake of the provider when the webhook of the whatsapp
+// deployment is subscribed.
+// @Router /v1/talk/whatsapp/:whatsapp/prj/:assistantId/:x-api-key [get]
+// @Summary Subscribe the whatsapp webhook of the assistant
+func (cApi *ConversationApi) WhatsappSubscribe(c *gin.Context) {
+	iAuth, isAuthenticated := types.GetAuthPrinciple(c)
+	if !isAuthenticated {
+		cApi.logger.Debugf("illegal unable to authenticate")
+		c.JSON(http.StatusForbidden, gin.H{"error": "Unauthenticated request"})
+		return
+	}
+	whatsapp, _, vltC, err := cApi.whatsappDeployment(c, iAuth)
+	if err != nil {
+		cApi.logger.Debugf("illegal whatsapp subscription %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid whatsapp deployment"})
+		return
+	}
+	if err := whatsapp.Subscribe(c, vltC); err != nil {
+		cApi.logger.Warnf("rejected whatsapp subscription: %v", err)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Invalid subscription"})
+		return
+	}
+}
+
+// WhatsappReciever receives the messages of whatsapp users and hands them to the text session of
+// the user, the session replies with the vault credential of the whatsapp deployment.
+// @Router /v1/talk/whatsapp/:whatsapp/prj/:assistantId/:x-api-key [post]
 // @Summary Recieve whatsapp message and respond
 // @Produce json
 // @Success 200 {object} commons.Response
 // @Failure 500 {object} commons.Response
 func (cApi *ConversationApi) WhatsappReciever(c *gin.Context) {
-	assistantId := c.Param("assistantId") // Extract assistantId from URL
-	from := c.PostForm("From")            // Sender's WhatsApp number
-	body := c.PostForm("Body")            // Message content
-	// Logging the received message
-	fmt.Printf("Assistant ID: %s, Received message from %s: %s\n", assistantId, from, body)
-
-	// Responding with a simple text message
-	responseMessage := "Hello! Thanks for your message. We'll get back to you shortly."
-
-	// Call a function to send WhatsApp message using Twilio
-	err := sendWhatsAppMessage(from, responseMessage)
+	iAuth, isAuthenticated := types.GetAuthPrinciple(c)
+	if !isAuthenticated {
+		cApi.logger.Debugf("illegal unable to authenticate")
+		c.JSON(http.StatusForbidden, gin.H{"error": "Unauthenticated request"})
+		return
+	}
+	whatsapp, assistant, vltC, err := cApi.whatsappDeployment(c, iAuth)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, commons.Response{
-			Code:    400,
-			Success: false,
-			Data:    &commons.ErrorMessage{},
-		})
+		cApi.logger.Debugf("illegal whatsapp message %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid whatsapp deployment"})
 		return
 	}
 
-	c.JSON(http.StatusOK, commons.Response{
-		Code:    200,
-		Success: true,
-	})
-}
-
-// Helper function to send WhatsApp message using Twilio
-func sendWhatsAppMessage(to, body string) error {
-	accountSid := "YOUR_TWILIO_ACCOUNT_SID"
-	authToken := "YOUR_TWILIO_AUTH_TOKEN"
-	from := "whatsapp:+YOUR_TWILIO_NUMBER"
-
-	msgData := url.Values{}
-	msgData.Set("To", to)
-	msgData.Set("From", from)
-	msgData.Set("Body", body)
-	msgDataReader := *strings.NewReader(msgData.Encode())
-
-	client := &http.Client{}
-	req, _ := http.NewRequest("POST", "https://api.twilio.com/2010-04-01/Accounts/"+accountSid+"/Messages.json", &msgDataReader)
-	req.SetBasicAuth(accountSid, authToken)
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	// the verifier may read the body, it is restored for the messages
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unable to read request"})
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	if err := whatsapp.VerifyWebhook(c, vltC); err != nil {
+		cApi.logger.Warnf("rejected whatsapp webhook for assistant %d: %v", assistant.Id, err)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Invalid webhook signature"})
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
 
-	resp, err := client.Do(req)
+	messages, err := whatsapp.ReceiveMessage(c)
 	if err != nil {
-		return err
+		cApi.logger.Errorf("unable to read whatsapp message %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid whatsapp message"})
+		return
+	}
+	// the provider retries the webhook when it is not acknowledged, the messages handed to a session
+	// before are claimed by their id and skipped, a message which failed is released for the retry
+	for _, message := range messages {
+		key := internal_whatsapp.MessageKey(assistant.Id, message.Id)
+		if !cApi.claimWhatsappMessage(c, key, message) {
+			cApi.logger.Debugf("whatsapp message %s is already received", message.Id)
+			continue
+		}
+		if err := cApi.onWhatsappMessage(c, iAuth, whatsapp, assistant, vltC, message); err != nil {
+			cApi.redis.Cmd(c, "DEL", []string{key})
+			if errors.Is(err, internal_text_session.ErrSessionBusy) {
+				cApi.logger.Warnf("whatsapp session of %s is busy, the message is delivered again", message.From)
+				c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Session is busy"})
+				return
+			}
+			cApi.logger.Errorf("unable to talk to whatsapp user %s: %v", message.From, err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to initiate talker"})
+			return
+		}
 	}
-	defer resp.Body.Close()
+	whatsapp.Acknowledge(c)
+}
 
-	if resp.StatusCode >= 300 {
-		return fmt.Errorf("failed to send message, status code: %d", resp.StatusCode)
+// claimWhatsappMessage tells whether the message is received for the first time, messages without
+// an id and messages which can not be claimed are handed to the session, a reply twice is better
+// than none
+func (cApi *ConversationApi) claimWhatsappMessage(ctx context.Context, key string, message *internal_type.WhatsappMessage) bool {
+	if message.Id == "" {
+		return true
 	}
+	// set with nx answers nil when the key is kept already
+	res := cApi.redis.Cmd(ctx, "SET", []string{key, "1", "NX", "EX", fmt.Sprintf("%d", int64(internal_whatsapp.MessageRetention.Seconds()))})
+	return res == nil || !errors.Is(res.Err, redis.Nil)
+}
 
-	return nil
+// onWhatsappMessage hands the message to the text session of the user
+func (cApi *ConversationApi) onWhatsappMessage(ctx context.Context, auth types.SimplePrinciple, whatsapp internal_type.Whatsapp, assistant *internal_assistant_entity.Assistant, vltC *protos.VaultCredential, message *internal_type.WhatsappMessage) error {
+	identifier := internal_adapter.Identifier(utils.Whatsapp, ctx, auth, message.From)
+	return cApi.onTextMessage(ctx, auth, assistant, textSession{
+		source:     utils.Whatsapp,
+		sessions:   cApi.whatsappSessions,
+		key:        internal_whatsapp.Key(assistant.Id, identifier),
+		identifier: identifier,
+		window:     internal_whatsapp.SessionWindow,
+		metadata: []*types.Metadata{
+			types.NewMetadata("whatsapp.provider", assistant.AssistantWhatsappDeployment.WhatsappProvider),
+			types.NewMetadata("whatsapp.from", message.From),
+			types.NewMetadata("whatsapp.to", message.To),
+			types.NewMetadata("whatsapp.name", message.Name),
+		},
+		streamer: func(conversationId uint64) internal_type.TextStreamer {
+			return internal_text_session.NewStreamer(cApi.logger, internal_whatsapp.TextChannel, whatsapp, assistant, conversationId, message.TextMessage(), vltC)
+		},
+	}, message.TextMessage())
 }
@@ -24,6 +24,43 @@ type AssistantConfig struct {
 	WeaviateConfig      configs.WeaviateConfig   `mapstructure:"weaviate"`
 	AssetStoreConfig    configs.AssetStoreConfig `mapstructure:"asset_store" validate:"required"`
 	PublicAssistantHost string                   `mapstructure:"public_assistant_host" validate:"required"`
+	SipConfig           SipConfig                `mapstructure:"sip"`
+	AudioSocketConfig   AudioSocketConfig        `mapstructure:"audio_socket"`
+	// webhooks of deployments without a webhook secret are accepted unverified while it is set,
+	// deployments created before their provider was verified keep working until the secret is added
+	UnverifiedWebhooks bool `mapstructure:"unverified_webhooks"`
+}
+
+// AudioSocketConfig is the server asterisk streams the audio of its calls to, it is not started
+// without a listen address
+type AudioSocketConfig struct {
+	// tcp address of the server, e.g. 0.0.0.0:9092
+	Listen string `mapstructure:"listen"`
+}
+
+// SipConfig is the user agent of the sip telephony, it is not started without a listen address
+type SipConfig struct {
+	// udp address the user agent listens on, e.g. 0.0.0.0:5060
+	Listen string `mapstructure:"listen"`
+	// address of the user agent in contact headers and sdp, the trunk sends signalling and media to it
+	PublicIp string `mapstructure:"public_ip"`
+	// udp ports of the audio of the calls, any free port is used when not set
+	RtpPortMin int `mapstructure:"rtp_port_min"`
+	RtpPortMax int `mapstructure:"rtp_port_max"`
+	// trunk the user agent registers to, calls of the trunk are routed to the assistant
+	Register SipRegistration `mapstructure:"register"`
+	// addresses or networks calls to the registration are accepted from besides the trunk, comma
+	// separated, e.g. 203.0.113.7,10.0.0.0/8
+	AllowFrom string `mapstructure:"allow_from"`
+}
+
+type SipRegistration struct {
+	Server      string `mapstructure:"server"`
+	Username    string `mapstructure:"username"`
+	Password    string `mapstructure:"password"`
+	Expires     int    `mapstructure:"expires"`
+	AssistantId uint64 `mapstructure:"assistant_id"`
+	ApiKey      string `mapstructure:"api_key"`
 }
 
 // reading config and intializing configs for application
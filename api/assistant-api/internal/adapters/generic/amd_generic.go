@@ -0,0 +1,150 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_generic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	internal_amd "github.com/rapidaai/api/assistant-api/internal/amd"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// result of the telephony provider is looked up this often while the detection waits
+const answeringMachinePollInterval = 500 * time.Millisecond
+
+// initializeAnsweringMachineDetection holds back the greeting of outbound calls when answering
+// machine detection is configured on the phone deployment.
+func (talking *GenericRequestor) initializeAnsweringMachineDetection(ctx context.Context, audioInputConfig, audioOutputConfig *protos.AudioConfig) {
+	talking.amd = nil
+	if talking.source != utils.PhoneCall || talking.assistant.AssistantPhoneDeployment == nil {
+		return
+	}
+	if talking.Conversation().Direction != type_enums.DIRECTION_OUTBOUND {
+		return
+	}
+	config, enabled, err := internal_amd.NewConfig(talking.assistant.AssistantPhoneDeployment.GetOptions())
+	if err != nil {
+		talking.logger.Warnf("illegal answering machine detection, greeting without detection %v", err)
+		return
+	}
+	if !enabled {
+		return
+	}
+	talking.amd = internal_amd.NewAnsweringMachineDetection(config, audioInputConfig, audioOutputConfig)
+	talking.watchAnsweringMachineResult(ctx)
+}
+
+// watchAnsweringMachineResult picks up the result the status callback stored for the
+// conversation when the telephony provider detects the answering machine itself
+func (talking *GenericRequestor) watchAnsweringMachineResult(ctx context.Context) {
+	amd := talking.amd
+	key := internal_amd.Key(talking.Conversation().Id)
+	utils.Go(ctx, func() {
+		ticker := time.NewTicker(answeringMachinePollInterval)
+		defer ticker.Stop()
+		for amd.Waiting() {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			// a missing key is a nil value with mget, not an error
+			res := talking.redis.Cmd(ctx, "MGET", []string{key})
+			if res == nil || res.HasError() {
+				continue
+			}
+			values, err := res.ResultSlice()
+			if err != nil || len(values) == 0 || values[0] == nil {
+				continue
+			}
+			result, beep := internal_amd.Decode(fmt.Sprintf("%v", values[0]))
+			talking.onAnsweringMachine(ctx, amd.Provider(result, beep))
+		}
+	})
+}
+
+// callAnsweringMachineDetection feeds the user audio to the detection, it reports whether the
+// audio was taken by the detection and must not reach the assistant
+func (talking *GenericRequestor) callAnsweringMachineDetection(ctx context.Context, vl internal_type.UserAudioPacket) bool {
+	if talking.amd == nil || !talking.amd.Listening() {
+		return false
+	}
+	talking.onAnsweringMachine(ctx, talking.amd.Feed(vl.Audio))
+	return true
+}
+
+// onAnsweringMachine greets, hangs up or leaves the voicemail once the detection moves on
+func (talking *GenericRequestor) onAnsweringMachine(ctx context.Context, outcome *internal_amd.Outcome) {
+	if outcome == nil {
+		return
+	}
+	if outcome.Decided {
+		talking.logger.Infof("call answered by %s (%s) for conversation %d", outcome.Result, outcome.Reason, talking.Conversation().Id)
+		talking.onAddMetrics(talking.Auth(), &types.Metric{
+			Name:        type_enums.ANSWERED_BY.String(),
+			Value:       string(outcome.Result),
+			Description: outcome.Reason,
+		})
+	}
+
+	switch outcome.Step {
+	case internal_amd.StepGreet:
+		behavior, err := talking.GetBehavior()
+		if err != nil {
+			return
+		}
+		talking.initializeGreeting(ctx, behavior)
+	case internal_amd.StepHangup:
+		talking.endAnsweringMachineCall(ctx)
+	case internal_amd.StepWaitBeep:
+		amd := talking.amd
+		time.AfterFunc(amd.Config().BeepTimeout, func() {
+			talking.onAnsweringMachine(ctx, amd.Timeout())
+		})
+	case internal_amd.StepVoicemail:
+		message := talking.templateParser.Parse(talking.amd.Config().VoicemailMessage, talking.GetArgs())
+		if message == "" {
+			talking.endAnsweringMachineCall(ctx)
+			return
+		}
+		voicemail := talking.messaging.Create("")
+		talking.amd.Voicemail(voicemail.GetId())
+		if err := talking.OnPacket(ctx, internal_type.StaticPacket{ContextID: voicemail.GetId(), Text: message}); err != nil {
+			talking.logger.Errorf("error while leaving voicemail message: %v", err)
+		}
+	}
+}
+
+// onVoicemailSpoken ends the call once the voicemail message has played to the machine
+func (talking *GenericRequestor) onVoicemailSpoken(ctx context.Context, contextId string) {
+	if talking.amd == nil {
+		return
+	}
+	remaining, ok := talking.amd.Remaining(contextId)
+	if !ok {
+		return
+	}
+	time.AfterFunc(remaining, func() {
+		talking.endAnsweringMachineCall(ctx)
+	})
+}
+
+func (talking *GenericRequestor) endAnsweringMachineCall(ctx context.Context) {
+	inputMessage, err := talking.messaging.GetMessage()
+	if err != nil {
+		inputMessage = talking.messaging.Create("")
+	}
+	talking.OnPacket(ctx, internal_type.LLMToolPacket{
+		ContextID: inputMessage.GetId(),
+		Action:    protos.AssistantConversationAction_END_CONVERSATION,
+	})
+}
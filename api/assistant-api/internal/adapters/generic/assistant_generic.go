@@ -99,6 +99,10 @@ func (gr *GenericRequestor) GetAssistant(
 	switch gr.source {
 	case utils.PhoneCall:
 		assistantOpts.InjectPhoneDeployment = true
+		// the sms deployment sends the follow-ups of a call
+		assistantOpts.InjectSmsDeployment = true
+	case utils.SMS:
+		assistantOpts.InjectSmsDeployment = true
 	case utils.Whatsapp:
 		assistantOpts.InjectWhatsappDeployment = true
 	case utils.SDK:
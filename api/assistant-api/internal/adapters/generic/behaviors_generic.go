@@ -34,6 +34,10 @@ func (r *GenericRequestor) GetBehavior() (*internal_assistant_entity.AssistantDe
 		if r.assistant.AssistantWhatsappDeployment != nil {
 			return &r.assistant.AssistantWhatsappDeployment.AssistantDeploymentBehavior, nil
 		}
+	case utils.SMS:
+		if r.assistant.AssistantSmsDeployment != nil {
+			return &r.assistant.AssistantSmsDeployment.AssistantDeploymentBehavior, nil
+		}
 	case utils.SDK:
 		if r.assistant.AssistantApiDeployment != nil {
 			return &r.assistant.AssistantApiDeployment.AssistantDeploymentBehavior, nil
@@ -51,6 +55,31 @@ func (r *GenericRequestor) GetBehavior() (*internal_assistant_entity.AssistantDe
 	return nil, errDeploymentNotEnabled
 }
 
+// GetDeploymentOptions retrieves the options of the deployment of the source, only telephony,
+// whatsapp and sms deployments carry options. Web plugin, api and debugger sessions get empty
+// options and run every policy on its defaults.
+func (r *GenericRequestor) GetDeploymentOptions() utils.Option {
+	if r.assistant == nil {
+		return utils.Option{}
+	}
+
+	switch r.source {
+	case utils.PhoneCall:
+		if r.assistant.AssistantPhoneDeployment != nil {
+			return r.assistant.AssistantPhoneDeployment.GetOptions()
+		}
+	case utils.Whatsapp:
+		if r.assistant.AssistantWhatsappDeployment != nil {
+			return r.assistant.AssistantWhatsappDeployment.GetOptions()
+		}
+	case utils.SMS:
+		if r.assistant.AssistantSmsDeployment != nil {
+			return r.assistant.AssistantSmsDeployment.GetOptions()
+		}
+	}
+	return utils.Option{}
+}
+
 // InitializeBehavior sets up the initial behavior configuration including greeting,
 // idle timeout, and max session duration timers.
 func (r *GenericRequestor) initializeBehavior(ctx context.Context) error {
@@ -65,11 +94,19 @@ func (r *GenericRequestor) initializeBehavior(ctx context.Context) error {
 	return nil
 }
 
-// initializeGreeting sends the greeting message if configured.
+// initializeGreeting sends the greeting message if configured, outbound calls with answering
+// machine detection are greeted once the detection knows who answered.
 func (r *GenericRequestor) initializeGreeting(ctx context.Context, behavior *internal_assistant_entity.AssistantDeploymentBehavior) {
 	if behavior.Greeting == nil {
 		return
 	}
+	if r.amd != nil && r.amd.Listening() {
+		return
+	}
+	// every session of a whatsapp or sms thread resumes its conversation, the thread is greeted once
+	if (r.source == utils.Whatsapp || r.source == utils.SMS) && len(r.GetConversationLogs()) > 0 {
+		return
+	}
 
 	greetingContent := r.templateParser.Parse(*behavior.Greeting, r.GetArgs())
 	if strings.TrimSpace(greetingContent) == "" {
@@ -77,6 +114,7 @@ func (r *GenericRequestor) initializeGreeting(ctx context.Context, behavior *int
 	}
 
 	message := r.messaging.Create("")
+	r.interruption.Greeting(message.GetId())
 	if err := r.OnPacket(ctx, internal_type.StaticPacket{ContextID: message.GetId(), Text: greetingContent}); err != nil {
 		r.logger.Errorf("error while sending greeting message: %v", err)
 	}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	internal_adapter_request_customizers "github.com/rapidaai/api/assistant-api/internal/adapters/customizers"
+	internal_interruption "github.com/rapidaai/api/assistant-api/internal/interruption"
 	internal_adapter_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
 	internal_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
@@ -85,9 +86,10 @@ func (talking *GenericRequestor) callSpeechToText(ctx context.Context, vl intern
 }
 
 func (spk *GenericRequestor) callSpeaking(ctx context.Context, result internal_type.LLMPacket) error {
+	textToSpeech, speechCache := spk.voice()
 	switch res := result.(type) {
 	case internal_type.LLMMessagePacket:
-		if spk.textToSpeechTransformer != nil {
+		if textToSpeech != nil {
 			inputMessage, err := spk.messaging.GetMessage()
 			if err != nil {
 				return nil
@@ -96,15 +98,33 @@ func (spk *GenericRequestor) callSpeaking(ctx context.Context, result internal_t
 			if result.ContextId() != inputMessage.GetId() {
 				return nil
 			}
+			// the provider has nothing to complete when all audio came from the cache
+			if speechCache.CachedOnly(res.ContextID) {
+				spk.OnPacket(ctx, internal_type.TextToSpeechEndPacket{ContextID: res.ContextID})
+				return nil
+			}
 			ctx, span, _ := spk.Tracer().StartSpan(spk.Context(), utils.AssistantSpeakingStage)
 			defer span.EndSpan(ctx, utils.AssistantSpeakingStage)
 			span.AddAttributes(ctx,
 				internal_adapter_telemetry.MessageKV(res.ContextID),
 				internal_adapter_telemetry.KV{K: "activity", V: internal_adapter_telemetry.StringValue("finish_speaking")},
 			)
-			if err := spk.textToSpeechTransformer.Transform(spk.Context(), res); err != nil {
+			if err := textToSpeech.Transform(spk.Context(), res); err != nil {
 				spk.logger.Errorf("speak: failed to send flush to text to speech transformer error: %v", err)
 			}
+			return nil
+		}
+		// messages are sent once the reply is complete, as a call speaks it once the speech is,
+		// text clients take the reply as complete with its last delta
+		if spk.source != utils.Whatsapp && spk.source != utils.SMS {
+			return nil
+		}
+		inputMessage, err := spk.messaging.GetMessage()
+		if err != nil || result.ContextId() != inputMessage.GetId() {
+			return nil
+		}
+		if err := spk.Notify(ctx, &protos.AssistantConversationAssistantMessage{Time: timestamppb.Now(), Id: res.ContextID, Completed: true}); err != nil {
+			spk.logger.Tracef(ctx, "error while outputting completion to the user: %w", err)
 		}
 	case internal_type.LLMStreamPacket:
 		inputMessage, err := spk.messaging.GetMessage()
@@ -115,7 +135,7 @@ func (spk *GenericRequestor) callSpeaking(ctx context.Context, result internal_t
 		if result.ContextId() != inputMessage.GetId() {
 			return nil
 		}
-		if spk.textToSpeechTransformer != nil {
+		if textToSpeech != nil && !spk.speakCached(ctx, res.ContextID, res.Text) {
 			ctx, span, _ := spk.Tracer().StartSpan(spk.Context(), utils.AssistantSpeakingStage)
 			defer span.EndSpan(ctx, utils.AssistantSpeakingStage)
 			span.AddAttributes(ctx,
@@ -123,9 +143,10 @@ func (spk *GenericRequestor) callSpeaking(ctx context.Context, result internal_t
 				internal_adapter_telemetry.KV{K: "activity", V: internal_adapter_telemetry.StringValue("speak")},
 				internal_adapter_telemetry.KV{K: "script", V: internal_adapter_telemetry.StringValue(res.Text)},
 			)
-			if err := spk.textToSpeechTransformer.Transform(spk.Context(), res); err != nil {
+			if err := textToSpeech.Transform(spk.Context(), res); err != nil {
 				spk.logger.Errorf("speak: failed to send flush to text to speech transformer error: %v", err)
 			}
+			speechCache.Synthesized(spk.Context(), res.ContextID, res.Text)
 		}
 		if err := spk.Notify(ctx, &protos.AssistantConversationAssistantMessage{Time: timestamppb.Now(), Id: res.ContextId(), Completed: true, Message: &protos.AssistantConversationAssistantMessage_Text{Text: &protos.AssistantConversationMessageTextContent{Content: res.Text}}}); err != nil {
 			spk.logger.Tracef(ctx, "error while outputting chunk to the user: %w", err)
@@ -143,6 +164,20 @@ func (talking *GenericRequestor) callTool(ctx context.Context, vl internal_type.
 			talking.logger.Errorf("error notifying end conversation action: %v", err)
 		}
 		return nil
+	case protos.AssistantConversationAction_TRANSFER_CONVERSATION:
+		if err := talking.Notify(ctx, &protos.AssistantMessagingResponse_Action{Action: &protos.AssistantConversationAction{Name: vl.Name, Action: vl.Action, Args: anyArgs}}); err != nil {
+			talking.logger.Errorf("error notifying transfer conversation action: %v", err)
+		}
+		return nil
+	case protos.AssistantConversationAction_CONFERENCE_CONVERSATION:
+		if err := talking.Notify(ctx, &protos.AssistantMessagingResponse_Action{Action: &protos.AssistantConversationAction{Name: vl.Name, Action: vl.Action, Args: anyArgs}}); err != nil {
+			talking.logger.Errorf("error notifying conference conversation action: %v", err)
+		}
+		return nil
+	case protos.AssistantConversationAction_SENSITIVE_CAPTURE:
+		active, _ := vl.Result["active"].(bool)
+		talking.toggleSensitiveCapture(ctx, active)
+		return nil
 	default:
 	}
 	return nil
@@ -153,11 +188,15 @@ func (talking *GenericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 	for _, p := range pkts {
 		switch vl := p.(type) {
 		case internal_type.UserTextPacket:
-			// interrupting
-			talking.OnPacket(ctx, internal_type.InterruptionPacket{ContextID: vl.ContextID, Source: internal_type.InterruptionSourceWord})
+			// interrupting, typed text is never held back by the interruption policy
+			talking.interrupt(ctx, internal_type.InterruptionPacket{ContextID: vl.ContextID, Source: internal_type.InterruptionSourceWord})
 
 			// creating interim message
-			interim := talking.messaging.Create(vl.Text)
+			text, redacted := talking.sensitive.Redact(vl.Text)
+			interim := talking.messaging.Create(text)
+			if redacted {
+				talking.sensitive.MarkRedacted(interim.GetId())
+			}
 			if err := talking.Notify(talking.Context(), &protos.AssistantConversationUserMessage{Id: interim.GetId(), Completed: false, Message: &protos.AssistantConversationUserMessage_Text{Text: &protos.AssistantConversationMessageTextContent{Content: interim.String()}}, Time: timestamppb.Now()}); err != nil {
 				talking.logger.Tracef(talking.Context(), "error while notifying the text input from user: %w", err)
 			}
@@ -184,10 +223,16 @@ func (talking *GenericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 				continue
 			}
 
-			if err := talking.callRecording(ctx, vl); err != nil {
+			// sensitive capture is recorded as silence
+			if err := talking.callRecording(ctx, internal_type.UserAudioPacket{ContextID: vl.ContextID, Audio: talking.sensitive.Silence(vl.Audio), NoiseReduced: vl.NoiseReduced}); err != nil {
 				talking.logger.Errorf("recorder error: %v", err)
 			}
 
+			// the callee does not talk to the assistant before answering machine detection is done
+			if talking.callAnsweringMachineDetection(ctx, vl) {
+				continue
+			}
+
 			if err := talking.callVadProcess(ctx, vl); err != nil {
 				talking.logger.Errorf("VAD process error: %v", err)
 			}
@@ -196,6 +241,10 @@ func (talking *GenericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 				talking.logger.Errorf("speech to text transform error: %v", err)
 			}
 			continue
+		case internal_type.UserDtmfPacket:
+			// digits are never forwarded, they only toggle sensitive capture
+			talking.onSensitiveCapture(ctx, talking.sensitive.Dtmf(vl.Digit))
+			continue
 		case internal_type.StaticPacket:
 			// when static packet is received it means that rapida system has something to speak
 			// do not abrupt it just send it to the assembler
@@ -209,6 +258,8 @@ func (talking *GenericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 			if err := talking.callCreateMessage(ctx, vl); err != nil {
 				talking.logger.Errorf("unable to create message from static packet %v", err)
 			}
+			talking.filler.Cancel(vl.ContextID)
+			talking.interruption.Observe(vl.ContextID, vl.Text)
 
 			// sending static packat to executor for any post processing
 			if err := talking.messaging.Transition(internal_adapter_request_customizers.LLMGenerating); err != nil {
@@ -220,55 +271,42 @@ func (talking *GenericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 				talking.logger.Errorf("assistant executor error: %v", err)
 			}
 
-			if err := talking.callTextAggregator(ctx, internal_type.LLMStreamPacket{ContextID: vl.ContextId(), Text: vl.Text}); err != nil {
-				talking.logger.Debugf("unable to send static packet to aggregator %v", err)
+			// greetings and behavior messages are played from the speech cache when rendered before
+			cached := talking.speakStatic(ctx, vl)
+			if !cached {
+				if err := talking.callTextAggregator(ctx, internal_type.LLMStreamPacket{ContextID: vl.ContextId(), Text: vl.Text}); err != nil {
+					talking.logger.Debugf("unable to send static packet to aggregator %v", err)
+				}
 			}
 
 			if err := talking.messaging.Transition(internal_adapter_request_customizers.LLMGenerated); err != nil {
 				talking.logger.Errorf("messaging transition error: %v", err)
 			}
-			if err := talking.callTextAggregator(ctx, internal_type.LLMMessagePacket{ContextID: vl.ContextId()}); err != nil {
-				talking.logger.Debugf("unable to send static packet to aggregator %v", err)
+			if !cached {
+				if err := talking.callTextAggregator(ctx, internal_type.LLMMessagePacket{ContextID: vl.ContextId()}); err != nil {
+					talking.logger.Debugf("unable to send static packet to aggregator %v", err)
+				}
 			}
 
 			continue
 		case internal_type.InterruptionPacket:
-			ctx, span, _ := talking.Tracer().StartSpan(talking.Context(), utils.AssistantUtteranceStage)
-			defer span.EndSpan(ctx, utils.AssistantUtteranceStage)
-
-			// calling end of speech analyzer
-			if err := talking.callEndOfSpeech(ctx, vl); err != nil {
-				talking.logger.Errorf("end of speech error: %v", err)
-			}
-			//
-			// recorder interrupted
-			if err := talking.callRecording(ctx, vl); err != nil {
-				talking.logger.Errorf("recorder error: %v", err)
+			if talking.interruption.Evaluate(vl) != internal_interruption.Interrupt {
+				continue
 			}
+			talking.interrupt(ctx, vl)
+			continue
+		case internal_type.SpeechToTextPacket:
+			// masked before it reaches the transcript, telemetry or the model
+			script, redacted := talking.sensitive.Redact(vl.Script)
+			vl.Script = script
 
-			switch vl.Source {
-			case internal_type.InterruptionSourceWord:
-				span.AddAttributes(ctx, internal_telemetry.KV{K: "activity_type", V: internal_telemetry.StringValue("word_interrupt")})
-				talking.resetIdleTimeoutTimer(talking.Context())
-				//
-				if err := talking.messaging.Transition(internal_adapter_request_customizers.Interrupted); err != nil {
-					continue
-				}
-				talking.Notify(ctx, &protos.AssistantConversationInterruption{Type: protos.AssistantConversationInterruption_INTERRUPTION_TYPE_WORD, Time: timestamppb.Now()})
-				continue
-			default:
-				// might be noise at first
-				if vl.StartAt < 3 {
-					continue
-				}
-				span.AddAttributes(ctx, internal_telemetry.KV{K: "activity_type", V: internal_telemetry.StringValue("vad_interrupt")})
-				if err := talking.messaging.Transition(internal_adapter_request_customizers.Interrupt); err != nil {
-					continue
-				}
-				talking.Notify(ctx, &protos.AssistantConversationInterruption{Type: protos.AssistantConversationInterruption_INTERRUPTION_TYPE_VAD, Time: timestamppb.Now()})
+			// speech over the assistant is dropped unless the user barges in
+			switch talking.interruption.Evaluate(vl) {
+			case internal_interruption.Ignore:
 				continue
+			case internal_interruption.Interrupt:
+				talking.interrupt(ctx, internal_type.InterruptionPacket{ContextID: vl.ContextID, Source: internal_type.InterruptionSourceWord})
 			}
-		case internal_type.SpeechToTextPacket:
 
 			ctx, span, _ := talking.Tracer().StartSpan(talking.Context(), utils.AssistantListeningStage,
 				internal_telemetry.KV{
@@ -286,12 +324,19 @@ func (talking *GenericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 			if err := talking.callEndOfSpeech(ctx, vl); err != nil {
 				if !vl.Interim {
 					msi := talking.messaging.Create(vl.Script)
+					if redacted {
+						talking.sensitive.MarkRedacted(msi.GetId())
+					}
 					talking.OnPacket(ctx, internal_type.EndOfSpeechPacket{ContextID: msi.Id, Speech: msi.String()})
 				}
 			}
 
 			if !vl.Interim {
+				talking.observeLanguage(vl.Language)
 				msi := talking.messaging.Create(vl.Script)
+				if redacted {
+					talking.sensitive.MarkRedacted(msi.GetId())
+				}
 				talking.Notify(ctx, &protos.AssistantConversationUserMessage{Id: msi.GetId(), Message: &protos.AssistantConversationUserMessage_Text{Text: &protos.AssistantConversationMessageTextContent{Content: msi.String()}}, Completed: false, Time: timestamppb.New(time.Now())})
 			}
 			continue
@@ -326,8 +371,20 @@ func (talking *GenericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 				}
 			})
 
-			//
-			if err := talking.assistantExecutor.Execute(ctx, talking, internal_type.UserTextPacket{ContextID: msg.GetId(), Text: msg.String()}); err != nil {
+			// the model never sees what was said during sensitive capture
+			input := msg.String()
+			if talking.sensitive.Redacted(msg.GetId()) {
+				input = talking.sensitive.Placeholder()
+			}
+
+			// the voice follows the language of the user from the response to this turn on
+			talking.applyLanguage(ctx)
+
+			// filler is played when the first token is slow, executors stream the response back
+			// as packets so the first delta or the done message cancels it, not the return
+			talking.filler.Wait(msg.GetId(), "")
+			if err := talking.assistantExecutor.Execute(ctx, talking, internal_type.UserTextPacket{ContextID: msg.GetId(), Text: input}); err != nil {
+				talking.filler.Cancel(msg.GetId())
 				talking.logger.Errorf("assistant executor error: %v", err)
 				talking.OnError(ctx)
 				continue
@@ -347,6 +404,9 @@ func (talking *GenericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 			if err := talking.messaging.Transition(internal_adapter_request_customizers.LLMGenerating); err != nil {
 				talking.logger.Errorf("messaging transition error: %v", err)
 			}
+			// the response has started
+			talking.filler.Cancel(vl.ContextID)
+			talking.interruption.Observe(vl.ContextID, vl.Text)
 			// sending to aggregator for assembling sentences
 			if err := talking.callTextAggregator(ctx, vl); err != nil {
 				talking.logger.Errorf("sentence aggregator error: %v, calling speak directly", err)
@@ -376,6 +436,7 @@ func (talking *GenericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 			if err := talking.messaging.Transition(internal_adapter_request_customizers.LLMGenerated); err != nil {
 				talking.logger.Errorf("messaging transition error: %v", err)
 			}
+			talking.filler.Cancel(vl.ContextID)
 			if err := talking.callCreateMessage(ctx, vl); err != nil {
 				talking.logger.Errorf("error creating message: %v", err)
 			}
@@ -387,10 +448,25 @@ func (talking *GenericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 				}
 			}
 
+			continue
+		case internal_type.LLMToolCallPacket:
+			inputMessage, err := talking.messaging.GetMessage()
+			if err != nil {
+				continue
+			}
+			// might be stale packet
+			if vl.ContextID != inputMessage.GetId() {
+				continue
+			}
+			// filler while the tool is executing
+			talking.filler.Wait(vl.ContextID, vl.Filler)
 			continue
 		case internal_type.LLMToolPacket:
 			talking.callTool(ctx, vl)
 			continue
+		case internal_type.TransformerFailoverPacket:
+			talking.onTransformerFailover(ctx, vl)
+			continue
 		case internal_type.MetricPacket:
 			// metrics update for the message
 			// later this can be used at each stage to calculate various metrics
@@ -400,6 +476,9 @@ func (talking *GenericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 				}
 			}
 		case internal_type.TextToSpeechEndPacket:
+			// the call ends once the voicemail message has played
+			talking.onVoicemailSpoken(ctx, vl.ContextID)
+
 			// notify the user about completion of tts
 			inputMessage, err := talking.messaging.GetMessage()
 			if err != nil {
@@ -437,6 +516,13 @@ func (talking *GenericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 				talking.logger.Tracef(talking.ctx, "error while outputing chunk to the user: %w", err)
 			}
 
+			// the interruption policy follows how long the assistant speaks
+			talking.interruption.Speak(vl.ContextID, vl.AudioChunk)
+			talking.filler.Output(vl.ContextID, vl.AudioChunk)
+			if talking.amd != nil {
+				talking.amd.Speak(vl.ContextID, vl.AudioChunk)
+			}
+
 			// for recording puposes
 			if err := talking.callRecording(ctx, vl); err != nil {
 				talking.logger.Errorf("recorder error: %v", err)
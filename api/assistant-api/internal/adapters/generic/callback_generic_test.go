@@ -0,0 +1,142 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_generic
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	internal_adapter_request_customizers "github.com/rapidaai/api/assistant-api/internal/adapters/customizers"
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_interruption "github.com/rapidaai/api/assistant-api/internal/interruption"
+	internal_assistant_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry/assistant"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingStreamer keeps every response sent to the client
+type recordingStreamer struct {
+	ctx       context.Context
+	mu        sync.Mutex
+	responses []*protos.AssistantMessagingResponse
+}
+
+func (s *recordingStreamer) Context() context.Context { return s.ctx }
+
+func (s *recordingStreamer) Recv() (*protos.AssistantMessagingRequest, error) { return nil, nil }
+
+func (s *recordingStreamer) Send(response *protos.AssistantMessagingResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func (s *recordingStreamer) interruptions() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	count := 0
+	for _, response := range s.responses {
+		if response.GetInterruption() != nil {
+			count++
+		}
+	}
+	return count
+}
+
+func newBargeInRequestor(t *testing.T, opts utils.Option) (*GenericRequestor, *recordingStreamer) {
+	logger, _ := commons.NewApplicationLogger()
+	policy, err := internal_interruption.NewInterruptionPolicy(opts, internal_audio.NewLinear16khzMonoAudioConfig())
+	require.NoError(t, err)
+	streamer := &recordingStreamer{ctx: context.Background()}
+	return &GenericRequestor{
+		logger:       logger,
+		ctx:          context.Background(),
+		streamer:     streamer,
+		tracer:       internal_assistant_telemetry.NewInMemoryTracer(logger),
+		messaging:    internal_adapter_request_customizers.NewMessaging(logger),
+		interruption: policy,
+		filler:       defaultFillerPolicy(),
+	}, streamer
+}
+
+// TestOnPacketBargeIn plays a response to the user and lets the user barge in while it plays
+func TestOnPacketBargeIn(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        utils.Option
+		response    string
+		barge       internal_type.InterruptionPacket
+		interrupted bool
+	}{
+		{
+			name:        "word interrupts an unprotected response",
+			opts:        utils.Option{},
+			response:    "let me check the order for you",
+			barge:       internal_type.InterruptionPacket{Source: internal_type.InterruptionSourceWord},
+			interrupted: true,
+		},
+		{
+			name:        "voice activity interrupts an unprotected response",
+			opts:        utils.Option{},
+			response:    "let me check the order for you",
+			barge:       internal_type.InterruptionPacket{Source: internal_type.InterruptionSourceVad, StartAt: 5, EndAt: 6},
+			interrupted: true,
+		},
+		{
+			name:        "word does not interrupt a protected response",
+			opts:        utils.Option{"interruption.protected_phrases": "terms and conditions"},
+			response:    "please listen to the Terms and Conditions",
+			barge:       internal_type.InterruptionPacket{Source: internal_type.InterruptionSourceWord},
+			interrupted: false,
+		},
+		{
+			name:        "voice activity does not interrupt a protected response",
+			opts:        utils.Option{"interruption.protected_phrases": "terms and conditions"},
+			response:    "please listen to the terms and conditions",
+			barge:       internal_type.InterruptionPacket{Source: internal_type.InterruptionSourceVad, StartAt: 5, EndAt: 6},
+			interrupted: false,
+		},
+		{
+			name:        "protected phrases only protect the responses which say them",
+			opts:        utils.Option{"interruption.protected_phrases": "terms and conditions"},
+			response:    "let me check the order for you",
+			barge:       internal_type.InterruptionPacket{Source: internal_type.InterruptionSourceWord},
+			interrupted: true,
+		},
+		{
+			name:        "disabled interruption keeps the response playing",
+			opts:        utils.Option{internal_interruption.InterruptionOptionsKeyMode: string(internal_interruption.DisabledInterruption)},
+			response:    "let me check the order for you",
+			barge:       internal_type.InterruptionPacket{Source: internal_type.InterruptionSourceWord},
+			interrupted: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestor, streamer := newBargeInRequestor(t, tt.opts)
+			ctx := context.Background()
+			contextId := requestor.messaging.Create("where is my order").GetId()
+
+			require.NoError(t, requestor.OnPacket(ctx,
+				internal_type.LLMStreamPacket{ContextID: contextId, Text: tt.response},
+				// two seconds of audio which is still playing when the user barges in
+				internal_type.TextToSpeechAudioPacket{ContextID: contextId, AudioChunk: make([]byte, 64000)},
+			))
+			tt.barge.ContextID = contextId
+			require.NoError(t, requestor.OnPacket(ctx, tt.barge))
+
+			assert.Equal(t, tt.interrupted, streamer.interruptions() == 1)
+			_, err := requestor.messaging.GetMessage()
+			assert.Equal(t, tt.interrupted, err != nil, "an interruption ends the message of the user")
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_generic
+
+import (
+	"context"
+
+	internal_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
+	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
+	internal_transformer_failover "github.com/rapidaai/api/assistant-api/internal/transformer/failover"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/utils"
+)
+
+// transformerFallbacks resolves the fallback provider of the transformer options, the transformer
+// runs without fallback when the fallback is not configured or its credential is not found
+func (talking *GenericRequestor) transformerFallbacks(ctx context.Context, options utils.Option) []internal_transformer.Fallback {
+	provider, err := options.GetString(internal_transformer_failover.OptionsKeyProvider)
+	if err != nil || provider == "" {
+		return nil
+	}
+	credentialId, err := options.GetUint64(internal_transformer_failover.OptionsKeyCredential)
+	if err != nil {
+		talking.logger.Warnf("unable to find fallback credential from options, running without fallback %+v", err)
+		return nil
+	}
+	credential, err := talking.VaultCaller().GetCredential(ctx, talking.Auth(), credentialId)
+	if err != nil {
+		talking.logger.Warnf("Api call to find fallback credential failed, running without fallback %+v", err)
+		return nil
+	}
+	return []internal_transformer.Fallback{{
+		Provider:   provider,
+		Credential: credential,
+		Options:    internal_transformer_failover.FallbackOptions(options),
+	}}
+}
+
+// onTransformerFailover records the switch to the fallback provider
+func (talking *GenericRequestor) onTransformerFailover(ctx context.Context, vl internal_type.TransformerFailoverPacket) {
+	stage := utils.AssistantListenConnectStage
+	if vl.Transformer == internal_transformer_failover.TransformerTextToSpeech {
+		stage = utils.AssistantSpeakConnectStage
+	}
+	talking.logger.Warnf("%s switched from %s to %s, replayed %d with error %s", vl.Transformer, vl.From, vl.To, vl.Replayed, vl.Reason)
+	ctx, span, _ := talking.Tracer().StartSpan(talking.Context(), stage)
+	defer span.EndSpan(ctx, stage)
+	span.AddAttributes(ctx,
+		internal_telemetry.KV{K: "activity", V: internal_telemetry.StringValue("failover")},
+		internal_telemetry.KV{K: "from", V: internal_telemetry.StringValue(vl.From)},
+		internal_telemetry.KV{K: "provider", V: internal_telemetry.StringValue(vl.To)},
+		internal_telemetry.KV{K: "reason", V: internal_telemetry.StringValue(vl.Reason)},
+		internal_telemetry.KV{K: "replayed", V: internal_telemetry.IntValue(vl.Replayed)},
+	)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_generic
+
+import (
+	"context"
+	"strings"
+
+	internal_filler "github.com/rapidaai/api/assistant-api/internal/filler"
+	internal_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// initializeFiller turns filler audio on when filler.enable is set on the deployment. Clips are
+// loaded from storage and must be raw audio in the output audio format.
+func (talking *GenericRequestor) initializeFiller(ctx context.Context, audioOutputConfig *protos.AudioConfig) {
+	opts := utils.Option{}
+	if audioOutputConfig != nil {
+		opts = talking.GetDeploymentOptions()
+	}
+	var clips [][]byte
+	if enabled, err := opts.GetBool(internal_filler.FillerOptionsKeyEnable); err == nil && enabled {
+		if keys, err := opts.GetString(internal_filler.FillerOptionsKeyClips); err == nil {
+			for _, key := range strings.Split(keys, commons.SEPARATOR) {
+				if key = strings.TrimSpace(key); key == "" {
+					continue
+				}
+				clip := talking.storage.Get(ctx, key)
+				if clip.Error != nil {
+					talking.logger.Warnf("unable to load filler clip %s %v", key, clip.Error)
+					continue
+				}
+				clips = append(clips, clip.Data)
+			}
+		}
+	}
+	talking.filler = internal_filler.NewFillerPolicy(opts, audioOutputConfig, clips, func(filler internal_filler.Filler) {
+		talking.playFiller(talking.Context(), filler)
+	})
+}
+
+// playFiller speaks the filler as part of the utterance being generated, it is neither saved
+// as a message nor added to the history of the model
+func (talking *GenericRequestor) playFiller(ctx context.Context, filler internal_filler.Filler) {
+	inputMessage, err := talking.messaging.GetMessage()
+	if err != nil {
+		return
+	}
+	// might be stale filler
+	if filler.ContextID != inputMessage.GetId() {
+		return
+	}
+
+	ctx, span, _ := talking.Tracer().StartSpan(ctx, utils.AssistantSpeakingStage)
+	defer span.EndSpan(ctx, utils.AssistantSpeakingStage)
+	span.AddAttributes(ctx,
+		internal_telemetry.MessageKV(filler.ContextID),
+		internal_telemetry.KV{K: "activity", V: internal_telemetry.StringValue("filler")},
+	)
+
+	if len(filler.Audio) > 0 {
+		talking.OnPacket(ctx, internal_type.TextToSpeechAudioPacket{ContextID: filler.ContextID, AudioChunk: filler.Audio})
+		return
+	}
+	textToSpeech, _ := talking.voice()
+	if textToSpeech == nil {
+		return
+	}
+	span.AddAttributes(ctx, internal_telemetry.KV{K: "script", V: internal_telemetry.StringValue(filler.Text)})
+	if err := textToSpeech.Transform(talking.Context(), internal_type.LLMStreamPacket{ContextID: filler.ContextID, Text: filler.Text}); err != nil {
+		talking.logger.Errorf("filler: failed to send filler to text to speech transformer error: %v", err)
+		return
+	}
+	if err := textToSpeech.Transform(talking.Context(), internal_type.LLMMessagePacket{ContextID: filler.ContextID}); err != nil {
+		talking.logger.Errorf("filler: failed to send flush to text to speech transformer error: %v", err)
+	}
+}
+
+// defaultFillerPolicy is used until the session configures filler audio, it never plays
+func defaultFillerPolicy() internal_filler.FillerPolicy {
+	return internal_filler.NewFillerPolicy(utils.Option{}, nil, nil, nil)
+}
@@ -7,10 +7,17 @@ package internal_adapter_generic
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/rapidaai/api/assistant-api/config"
 	internal_adapter_request_customizers "github.com/rapidaai/api/assistant-api/internal/adapters/customizers"
+	internal_amd "github.com/rapidaai/api/assistant-api/internal/amd"
+	internal_filler "github.com/rapidaai/api/assistant-api/internal/filler"
+	internal_interruption "github.com/rapidaai/api/assistant-api/internal/interruption"
+	internal_language "github.com/rapidaai/api/assistant-api/internal/language"
+	internal_sensitive "github.com/rapidaai/api/assistant-api/internal/sensitive"
+	internal_speech_cache "github.com/rapidaai/api/assistant-api/internal/speech_cache"
 	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
 	internal_assistant_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry/assistant"
 	internal_assistant_telemetry_exporters "github.com/rapidaai/api/assistant-api/internal/telemetry/assistant/exporters"
@@ -20,6 +27,7 @@ import (
 	internal_agent_executor "github.com/rapidaai/api/assistant-api/internal/agent/executor"
 	internal_agent_executor_llm "github.com/rapidaai/api/assistant-api/internal/agent/executor/llm"
 	internal_agent_rerankers "github.com/rapidaai/api/assistant-api/internal/agent/reranker"
+	internal_agent_retriever "github.com/rapidaai/api/assistant-api/internal/agent/retriever"
 	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
 	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
 	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
@@ -44,23 +52,27 @@ import (
 type GenericRequestor struct {
 	logger   commons.Logger
 	config   *config.AssistantConfig
+	storage  storages.Storage
+	redis    connectors.RedisConnector
 	ctx      context.Context
 	source   utils.RapidaSource
 	auth     types.SimplePrinciple
 	streamer internal_streamers.Streamer
 
 	// service
-	assistantService     internal_services.AssistantService
-	conversationService  internal_services.AssistantConversationService
-	webhookService       internal_services.AssistantWebhookService
-	knowledgeService     internal_services.KnowledgeService
-	assistantToolService internal_services.AssistantToolService
+	assistantService      internal_services.AssistantService
+	conversationService   internal_services.AssistantConversationService
+	webhookService        internal_services.AssistantWebhookService
+	knowledgeService      internal_services.KnowledgeService
+	knowledgeTableService internal_services.KnowledgeTableService
+	assistantToolService  internal_services.AssistantToolService
+	deploymentService     internal_services.AssistantDeploymentService
 
 	//
-	opensearch    connectors.OpenSearchConnector
-	vectordb      connectors.VectorConnector
-	queryEmbedder internal_agent_embeddings.QueryEmbedding
-	textReranker  internal_agent_rerankers.TextReranking
+	opensearch         connectors.OpenSearchConnector
+	vectordb           connectors.VectorConnector
+	knowledgeRetriever internal_agent_retriever.KnowledgeRetriever
+	textReranker       internal_agent_rerankers.TextReranking
 
 	// managing event
 	tracer internal_telemetry.VoiceAgentTracer
@@ -84,10 +96,32 @@ type GenericRequestor struct {
 	// speak
 	textToSpeechTransformer internal_type.TextToSpeechTransformer
 	textAggregator          internal_type.LLMTextAggregator
+	speechCache             internal_speech_cache.SpeechCache
 
 	recorder       internal_type.Recorder
 	templateParser parsers.StringTemplateParser
 
+	// pauses recording and masks transcripts while sensitive information is collected
+	sensitive internal_sensitive.SensitiveCapture
+
+	// decides when the user barges in while the assistant speaks
+	interruption internal_interruption.InterruptionPolicy
+
+	// plays short phrases or clips while the model or a tool is working
+	filler internal_filler.FillerPolicy
+
+	// holds back the greeting of outbound calls until it knows whether a machine answered,
+	// nil when detection is not configured
+	amd internal_amd.AnsweringMachineDetection
+
+	// switches the voice when the user speaks another language
+	language internal_language.LanguagePolicy
+	speaker  speakerConfig
+	// language the voice switches to when the next turn of the assistant starts
+	nextLanguage *internal_language.Language
+	// guards the voice and the arguments, a language switch replaces both while the session runs
+	voiceMu sync.RWMutex
+
 	// executor
 	assistantExecutor internal_agent_executor.AssistantExecutor
 
@@ -113,22 +147,26 @@ func NewGenericRequestor(ctx context.Context, config *config.AssistantConfig, lo
 	return GenericRequestor{
 		logger:   logger,
 		config:   config,
+		storage:  storage,
+		redis:    redis,
 		ctx:      ctx,
 		source:   source,
 		streamer: streamer,
 		// services
-		assistantService:     internal_assistant_service.NewAssistantService(config, logger, postgres, opensearch),
-		knowledgeService:     internal_knowledge_service.NewKnowledgeService(config, logger, postgres, storage),
-		conversationService:  internal_assistant_service.NewAssistantConversationService(logger, postgres, storage),
-		webhookService:       internal_assistant_service.NewAssistantWebhookService(logger, postgres, storage),
-		assistantToolService: internal_assistant_service.NewAssistantToolService(logger, postgres, storage),
-		templateParser:       parsers.NewPongo2StringTemplateParser(logger),
+		assistantService:      internal_assistant_service.NewAssistantService(config, logger, postgres, opensearch),
+		knowledgeService:      internal_knowledge_service.NewKnowledgeService(config, logger, postgres, storage),
+		knowledgeTableService: internal_knowledge_service.NewKnowledgeTableService(config, logger, postgres),
+		conversationService:   internal_assistant_service.NewAssistantConversationService(logger, postgres, storage),
+		webhookService:        internal_assistant_service.NewAssistantWebhookService(logger, postgres, storage),
+		assistantToolService:  internal_assistant_service.NewAssistantToolService(logger, postgres, storage),
+		deploymentService:     internal_assistant_service.NewAssistantDeploymentService(config, logger, postgres),
+		templateParser:        parsers.NewPongo2StringTemplateParser(logger),
 		//
 
-		opensearch:    opensearch,
-		vectordb:      opensearch,
-		queryEmbedder: internal_agent_embeddings.NewQueryEmbedding(logger, config, redis),
-		textReranker:  internal_agent_rerankers.NewTextReranker(logger, config, redis),
+		opensearch:         opensearch,
+		vectordb:           opensearch,
+		knowledgeRetriever: internal_agent_retriever.NewKnowledgeRetriever(logger, opensearch, internal_agent_embeddings.NewQueryEmbedding(logger, config, redis)),
+		textReranker:       internal_agent_rerankers.NewTextReranker(logger, config, redis),
 
 		// clients
 		deploymentClient:  endpoint_client.NewDeploymentServiceClientGRPC(&config.AppConfig, logger, redis),
@@ -143,6 +181,11 @@ func NewGenericRequestor(ctx context.Context, config *config.AssistantConfig, lo
 			)),
 
 		messaging:         internal_adapter_request_customizers.NewMessaging(logger),
+		sensitive:         internal_sensitive.NewSensitiveCapture(utils.Option{}, nil),
+		interruption:      defaultInterruptionPolicy(),
+		filler:            defaultFillerPolicy(),
+		language:          defaultLanguagePolicy(),
+		speechCache:       internal_speech_cache.NewSpeechCache(logger, nil, nil, "", internal_speech_cache.Voice{}, nil),
 		assistantExecutor: internal_agent_executor_llm.NewAssistantExecutor(logger),
 
 		// will change
@@ -258,6 +301,8 @@ func (talking *GenericRequestor) GetKnowledge(knowledgeId uint64) (*internal_kno
 }
 
 func (gr *GenericRequestor) GetArgs() map[string]interface{} {
+	gr.voiceMu.RLock()
+	defer gr.voiceMu.RUnlock()
 	return gr.args
 }
 
@@ -269,8 +314,8 @@ func (dm *GenericRequestor) GetHistories() []internal_type.MessagePacket {
 	return dm.histories
 }
 
-func (gr *GenericRequestor) CreateConversationRecording(body []byte) error {
-	if _, err := gr.conversationService.CreateConversationRecording(gr.ctx, gr.auth, gr.assistant.Id, gr.assistantConversation.Id, body); err != nil {
+func (gr *GenericRequestor) CreateConversationRecording(recording *internal_type.Recording) error {
+	if _, err := gr.conversationService.CreateConversationRecording(gr.ctx, gr.auth, gr.assistant.Id, gr.assistantConversation.Id, recording); err != nil {
 		gr.logger.Errorf("unable to create recording for the conversation id %d with error : %v", err)
 		return err
 	}
@@ -0,0 +1,72 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_generic
+
+import (
+	"context"
+
+	internal_adapter_request_customizers "github.com/rapidaai/api/assistant-api/internal/adapters/customizers"
+	internal_interruption "github.com/rapidaai/api/assistant-api/internal/interruption"
+	internal_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// initializeInterruptionPolicy reads the barge-in keys (interruption.*) of the deployment, the
+// output audio config tells the policy how long the assistant speaks.
+func (talking *GenericRequestor) initializeInterruptionPolicy(audioOutputConfig *protos.AudioConfig) {
+	policy, err := internal_interruption.NewInterruptionPolicy(talking.GetDeploymentOptions(), audioOutputConfig)
+	if err != nil {
+		talking.logger.Warnf("illegal interruption policy, using the default policy %v", err)
+		policy, _ = internal_interruption.NewInterruptionPolicy(utils.Option{}, audioOutputConfig)
+	}
+	talking.interruption = policy
+}
+
+// defaultInterruptionPolicy is used until the session configures the policy
+func defaultInterruptionPolicy() internal_interruption.InterruptionPolicy {
+	policy, _ := internal_interruption.NewInterruptionPolicy(utils.Option{}, nil)
+	return policy
+}
+
+// interrupt stops the assistant, the interruption policy has already decided the user barged in
+func (talking *GenericRequestor) interrupt(ctx context.Context, vl internal_type.InterruptionPacket) {
+	ctx, span, _ := talking.Tracer().StartSpan(talking.Context(), utils.AssistantUtteranceStage)
+	defer span.EndSpan(ctx, utils.AssistantUtteranceStage)
+
+	// calling end of speech analyzer
+	if err := talking.callEndOfSpeech(ctx, vl); err != nil {
+		talking.logger.Errorf("end of speech error: %v", err)
+	}
+	//
+	// recorder interrupted
+	if err := talking.callRecording(ctx, vl); err != nil {
+		talking.logger.Errorf("recorder error: %v", err)
+	}
+
+	switch vl.Source {
+	case internal_type.InterruptionSourceWord:
+		span.AddAttributes(ctx, internal_telemetry.KV{K: "activity_type", V: internal_telemetry.StringValue("word_interrupt")})
+		talking.resetIdleTimeoutTimer(talking.Context())
+		//
+		if err := talking.messaging.Transition(internal_adapter_request_customizers.Interrupted); err != nil {
+			return
+		}
+		talking.interruption.Interrupted()
+		talking.filler.Close()
+		talking.Notify(ctx, &protos.AssistantConversationInterruption{Type: protos.AssistantConversationInterruption_INTERRUPTION_TYPE_WORD, Time: timestamppb.Now()})
+	default:
+		span.AddAttributes(ctx, internal_telemetry.KV{K: "activity_type", V: internal_telemetry.StringValue("vad_interrupt")})
+		if err := talking.messaging.Transition(internal_adapter_request_customizers.Interrupt); err != nil {
+			return
+		}
+		talking.interruption.Interrupted()
+		talking.filler.Close()
+		talking.Notify(ctx, &protos.AssistantConversationInterruption{Type: protos.AssistantConversationInterruption_INTERRUPTION_TYPE_VAD, Time: timestamppb.Now()})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	internal_denoiser "github.com/rapidaai/api/assistant-api/internal/denoiser"
 	internal_end_of_speech "github.com/rapidaai/api/assistant-api/internal/end_of_speech"
 	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_speech_cache "github.com/rapidaai/api/assistant-api/internal/speech_cache"
 	internal_adapter_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
 	internal_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
 	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
@@ -31,6 +32,8 @@ func (io *GenericRequestor) Input(message *protos.AssistantConversationUserMessa
 		return io.OnPacket(io.Context(), internal_type.UserAudioPacket{Audio: msg.Audio.GetContent()})
 	case *protos.AssistantConversationUserMessage_Text:
 		return io.OnPacket(io.Context(), internal_type.UserTextPacket{Text: msg.Text.GetContent()})
+	case *protos.AssistantConversationUserMessage_Dtmf:
+		return io.OnPacket(io.Context(), internal_type.UserDtmfPacket{Digit: msg.Dtmf.GetDigit()})
 	default:
 		return fmt.Errorf("illegal input from the user %+v", msg)
 	}
@@ -134,7 +137,7 @@ func (listening *GenericRequestor) initializeSpeechToText(ctx context.Context, t
 		credential,
 		audioConfig,
 		func(pkt ...internal_type.Packet) error { return listening.OnPacket(ctx, pkt...) },
-		options)
+		options, listening.transformerFallbacks(ctx, options)...)
 	if err != nil {
 		listening.logger.Errorf("unable to create input audio transformer with error %v", err)
 		return err
@@ -205,6 +208,7 @@ func (spk *GenericRequestor) connectSpeaker(ctx context.Context, audioOutConfig
 				spk.logger.Errorf("unable to initialize text to speech transformer with error %v", err)
 				return
 			}
+			spk.initializeLanguage(outputTransformer, audioOutConfig, speakerOpts)
 		})
 	}
 	//
@@ -223,8 +227,8 @@ func (spk *GenericRequestor) connectSpeaker(ctx context.Context, audioOutConfig
 }
 
 func (spk *GenericRequestor) disconnectSpeaker() error {
-	if spk.textToSpeechTransformer != nil {
-		if err := spk.textToSpeechTransformer.Close(spk.Context()); err != nil {
+	if textToSpeech, _ := spk.voice(); textToSpeech != nil {
+		if err := textToSpeech.Close(spk.Context()); err != nil {
 			spk.logger.Errorf("cancel all output transformer with error %v", err)
 		}
 	}
@@ -235,6 +239,16 @@ func (spk *GenericRequestor) disconnectSpeaker() error {
 }
 
 func (spk *GenericRequestor) initializeTextToSpeech(context context.Context, transformerConfig *internal_assistant_entity.AssistantDeploymentAudio, audioConfig *protos.AudioConfig, speakerOpts utils.Option) error {
+	atransformer, speechCache, err := spk.createTextToSpeech(context, transformerConfig, audioConfig, speakerOpts)
+	if err != nil {
+		return err
+	}
+	spk.setVoice(atransformer, speechCache)
+	return nil
+}
+
+// createTextToSpeech connects a text to speech transformer and the speech cache of its voice
+func (spk *GenericRequestor) createTextToSpeech(context context.Context, transformerConfig *internal_assistant_entity.AssistantDeploymentAudio, audioConfig *protos.AudioConfig, speakerOpts utils.Option) (internal_type.TextToSpeechTransformer, internal_speech_cache.SpeechCache, error) {
 	context, span, _ := spk.Tracer().StartSpan(context, utils.AssistantSpeakConnectStage)
 	defer span.EndSpan(context, utils.AssistantSpeakConnectStage)
 	span.AddAttributes(context,
@@ -248,12 +262,12 @@ func (spk *GenericRequestor) initializeTextToSpeech(context context.Context, tra
 	credentialId, err := speakerOpts.GetUint64("rapida.credential_id")
 	if err != nil {
 		spk.logger.Errorf("unable to find credential from options %+v", err)
-		return err
+		return nil, nil, err
 	}
 	credential, err := spk.VaultCaller().GetCredential(context, spk.Auth(), credentialId)
 	if err != nil {
 		spk.logger.Errorf("Api call to find credential failed %+v", err)
-		return err
+		return nil, nil, err
 	}
 
 	atransformer, err := internal_transformer.GetTextToSpeechTransformer(
@@ -261,17 +275,33 @@ func (spk *GenericRequestor) initializeTextToSpeech(context context.Context, tra
 		transformerConfig.GetName(),
 		credential, audioConfig,
 		func(pkt ...internal_type.Packet) error { return spk.OnPacket(context, pkt...) },
-		speakerOpts)
+		speakerOpts, spk.transformerFallbacks(context, speakerOpts)...)
 	if err != nil {
 		spk.logger.Errorf("unable to create input audio transformer with error %v", err)
-		return err
+		return nil, nil, err
 	}
 	if err := atransformer.Initialize(); err != nil {
 		spk.logger.Errorf("unable to initilize transformer %v", err)
-		return err
+		return nil, nil, err
 	}
-	spk.textToSpeechTransformer = atransformer
-	return nil
+	return atransformer, spk.newSpeechCache(transformerConfig.GetName(), credential, audioConfig, speakerOpts), nil
+}
+
+// voice is the text to speech transformer and the speech cache the assistant currently speaks with
+func (spk *GenericRequestor) voice() (internal_type.TextToSpeechTransformer, internal_speech_cache.SpeechCache) {
+	spk.voiceMu.RLock()
+	defer spk.voiceMu.RUnlock()
+	return spk.textToSpeechTransformer, spk.speechCache
+}
+
+// setVoice replaces the voice and returns the transformer it replaced
+func (spk *GenericRequestor) setVoice(textToSpeech internal_type.TextToSpeechTransformer, speechCache internal_speech_cache.SpeechCache) internal_type.TextToSpeechTransformer {
+	spk.voiceMu.Lock()
+	defer spk.voiceMu.Unlock()
+	previous := spk.textToSpeechTransformer
+	spk.textToSpeechTransformer = textToSpeech
+	spk.speechCache = speechCache
+	return previous
 }
 
 func (spk *GenericRequestor) initializeTextAggregator(ctx context.Context, options utils.Option) error {
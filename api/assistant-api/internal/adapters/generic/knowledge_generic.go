@@ -11,22 +11,24 @@ import (
 	"fmt"
 	"time"
 
-	internal_agent_embeddings "github.com/rapidaai/api/assistant-api/internal/agent/embedding"
 	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
-	"github.com/rapidaai/pkg/connectors"
 	type_enums "github.com/rapidaai/pkg/types/enums"
 	"github.com/rapidaai/pkg/utils"
 )
 
-const (
-	defaultTopK           = 4
-	defaultScoreThreshold = 0.5
-)
-
 func (kr *GenericRequestor) RetrieveToolKnowledge(knowledge *internal_knowledge_gorm.Knowledge, messageId string, query string, filter map[string]interface{}, kc *internal_type.KnowledgeRetrieveOption) ([]internal_type.KnowledgeContextResult, error) {
+	return kr.retrieveWithLog(kr.Context(), "tool", knowledge, messageId, query, filter, kc)
+}
+
+func (kr *GenericRequestor) RetrieveAssistantKnowledge(ctx context.Context, knowledge *internal_knowledge_gorm.Knowledge, messageId string, query string, kc *internal_type.KnowledgeRetrieveOption) ([]internal_type.KnowledgeContextResult, error) {
+	return kr.retrieveWithLog(ctx, "assistant", knowledge, messageId, query, nil, kc)
+}
+
+// retrieveWithLog retrieves from the knowledge and records a knowledge log tagged with the source of retrieval
+func (kr *GenericRequestor) retrieveWithLog(ctx context.Context, source string, knowledge *internal_knowledge_gorm.Knowledge, messageId string, query string, filter map[string]interface{}, kc *internal_type.KnowledgeRetrieveOption) ([]internal_type.KnowledgeContextResult, error) {
 	start := time.Now()
-	result, err := kr.retrieve(kr.Context(), knowledge, query, filter, kc)
+	result, err := kr.retrieve(ctx, knowledge, query, filter, kc)
 	utils.Go(context.Background(), func() {
 		request, _ := json.Marshal(map[string]interface{}{
 			"query":  query,
@@ -50,7 +52,7 @@ func (kr *GenericRequestor) RetrieveToolKnowledge(knowledge *internal_knowledge_
 			len(result),
 			int64(time.Since(start)),
 			map[string]string{
-				"source":                         "tool",
+				"source":                         source,
 				"assistantId":                    fmt.Sprintf("%d", kr.assistant.Id),
 				"assistantConversationId":        fmt.Sprintf("%d", kr.assistantConversation.Id),
 				"assistantConversationMessageId": messageId,
@@ -64,127 +66,46 @@ func (kr *GenericRequestor) RetrieveToolKnowledge(knowledge *internal_knowledge_
 }
 
 func (kr *GenericRequestor) retrieve(ctx context.Context, knowledge *internal_knowledge_gorm.Knowledge, query string, filter map[string]interface{}, kc *internal_type.KnowledgeRetrieveOption) ([]internal_type.KnowledgeContextResult, error) {
-	topK := int(defaultTopK)
-	if kc.TopK != 0 {
-		topK = int(kc.TopK)
-	}
-	minScore := float32(defaultScoreThreshold)
-	if kc.ScoreThreshold != 0 {
-		minScore = float32(kc.ScoreThreshold)
-	}
-	Results := make([]internal_type.KnowledgeContextResult, 0)
-	//
-	switch kc.RetrievalMethod {
-	case "hybrid-search", "hybrid":
-		embeddingOpts := &internal_agent_embeddings.TextEmbeddingOption{
-			ProviderCredential: kc.EmbeddingProviderCredential,
-			ModelProviderName:  knowledge.EmbeddingModelProviderName,
-			Options:            knowledge.GetOptions(),
-			AdditionalData: map[string]string{
-				"knowledge_id": fmt.Sprintf("%d", knowledge.Id),
-			},
-		}
-		embeddings, err := kr.queryEmbedder.TextQueryEmbedding(ctx, kr.Auth(), query, embeddingOpts)
-		if err != nil {
-			kr.logger.Errorf("Unable to get query embedding from integration for query %s error %v", query, err)
-			return Results, err
-		}
-		matchedContents, err := kr.vectordb.HybridSearch(ctx,
-			knowledge.StorageNamespace,
-			query,
-			embeddings.Data[len(embeddings.Data)-1].GetEmbedding(),
-			filter,
-			connectors.NewDefaultVectorSearchOptions(
-				connectors.WithMinScore(minScore),
-				connectors.WithSource([]string{"text", "document_id", "metadata"}),
-				connectors.WithTopK(topK)))
-		if err != nil {
-			kr.logger.Errorf("Unable to get result from the vector dataset for given %s error %v", query, err)
-			return Results, err
-		}
-		for _, x := range matchedContents {
-			source := x["_source"].(map[string]interface{})
-			Results = append(Results, internal_type.KnowledgeContextResult{
-				ID:         x["_id"].(string),
-				DocumentID: source["document_id"].(string),
-				Metadata:   source["metadata"].(map[string]interface{}),
-				Content:    source["text"].(string),
-				Score:      x["_score"].(float64),
-			})
-		}
-		return Results, err
-
-	case "semantic-search", "semantic":
-		embeddings, err := kr.queryEmbedder.TextQueryEmbedding(
-			ctx,
-			kr.Auth(),
-			query, &internal_agent_embeddings.TextEmbeddingOption{
-				ProviderCredential: kc.EmbeddingProviderCredential,
-				ModelProviderName:  knowledge.EmbeddingModelProviderName,
-				Options:            knowledge.GetOptions(),
-				AdditionalData: map[string]string{
-					"knowledge_id": fmt.Sprintf("%d", knowledge.Id),
-				},
-			})
-		if err != nil {
-			kr.logger.Errorf("Unable to get query embedding from integration for query %s error %v", query, err)
-			return Results, err
-		}
-
-		matchedContents, err := kr.vectordb.VectorSearch(
-			ctx,
-			knowledge.StorageNamespace,
-			embeddings.Data[len(embeddings.Data)-1].GetEmbedding(),
-			filter,
-			connectors.NewDefaultVectorSearchOptions(
-				connectors.WithSource([]string{"text", "document_id", "metadata"}),
-				connectors.WithMinScore(minScore), connectors.WithTopK(topK)),
-		)
-		if err != nil {
-			kr.logger.Errorf("Unable to get result from the vector dataset for given %s error %v", query, err)
-			return Results, err
-		}
+	return kr.knowledgeRetriever.Retrieve(ctx, kr.Auth(), knowledge, query, filter, kc)
+}
 
-		for _, x := range matchedContents {
-			source := x["_source"].(map[string]interface{})
-			Results = append(Results, internal_type.KnowledgeContextResult{
-				ID:         x["_id"].(string),
-				DocumentID: source["document_id"].(string),
-				Metadata:   source["metadata"].(map[string]interface{}),
-				Content:    source["text"].(string),
-				Score:      x["_score"].(float64),
-			})
-		}
-		return Results, err
+func (kr *GenericRequestor) GetKnowledgeTable(knowledgeId uint64, knowledgeDocumentId uint64) (*internal_knowledge_gorm.KnowledgeTable, error) {
+	return kr.knowledgeTableService.Get(kr.Context(), kr.Auth(), knowledgeId, knowledgeDocumentId)
+}
 
-	case "text-search", "text":
-		matchedContents, err := kr.vectordb.TextSearch(
-			ctx,
-			knowledge.StorageNamespace,
-			query,
-			filter,
-			connectors.NewDefaultVectorSearchOptions(
-				connectors.WithSource([]string{"text", "document_id", "metadata"}),
-				connectors.WithMinScore(minScore),
-				connectors.WithTopK(topK)))
+// QueryKnowledgeTable queries the rows of the table and records a knowledge log tagged with the table source
+func (kr *GenericRequestor) QueryKnowledgeTable(table *internal_knowledge_gorm.KnowledgeTable, messageId string, query *internal_type.KnowledgeTableQuery, maxLimit uint32) ([]map[string]interface{}, error) {
+	start := time.Now()
+	result, err := kr.knowledgeTableService.Query(kr.Context(), kr.Auth(), table, query, maxLimit)
+	utils.Go(context.Background(), func() {
+		request, _ := json.Marshal(query)
+		var response []byte
+		status := type_enums.RECORD_COMPLETE
 		if err != nil {
-			kr.logger.Errorf("Unable to get result from the vector dataset for given %s error %v", query, err)
-			return Results, nil
-		}
-		for _, x := range matchedContents {
-			source := x["_source"].(map[string]interface{})
-			Results = append(Results, internal_type.KnowledgeContextResult{
-				ID:         x["_id"].(string),
-				DocumentID: source["document_id"].(string),
-				Metadata:   source["metadata"].(map[string]interface{}),
-				Content:    source["text"].(string),
-				Score:      x["_score"].(float64),
+			response, _ = json.Marshal(map[string]string{"error": err.Error()})
+			status = type_enums.RECORD_FAILED
+		} else {
+			response, _ = json.Marshal(map[string]interface{}{
+				"result": result,
 			})
 		}
-		return Results, nil
-
-	default:
-		kr.logger.Errorf("retrieve method is unexpected")
-		return Results, fmt.Errorf("retrieve method is unexpected")
-	}
+		kr.CreateKnowledgeLog(
+			table.KnowledgeId,
+			"table-query",
+			query.Limit,
+			0,
+			len(result),
+			int64(time.Since(start)),
+			map[string]string{
+				"source":                         "table",
+				"knowledgeDocumentId":            fmt.Sprintf("%d", table.KnowledgeDocumentId),
+				"assistantId":                    fmt.Sprintf("%d", kr.assistant.Id),
+				"assistantConversationId":        fmt.Sprintf("%d", kr.assistantConversation.Id),
+				"assistantConversationMessageId": messageId,
+			},
+			status,
+			request, response,
+		)
+	})
+	return result, err
 }
@@ -0,0 +1,103 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_generic
+
+import (
+	"context"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_language "github.com/rapidaai/api/assistant-api/internal/language"
+	internal_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// speakerConfig is what the text to speech transformer was created with, the transformer is
+// created again with the voice of the language the user speaks
+type speakerConfig struct {
+	transformer *internal_assistant_entity.AssistantDeploymentAudio
+	audioConfig *protos.AudioConfig
+	options     utils.Option
+}
+
+// initializeLanguage configures language switching from the text to speech options of the
+// deployment, the model is told the language through the language prompt variable.
+func (talking *GenericRequestor) initializeLanguage(transformerConfig *internal_assistant_entity.AssistantDeploymentAudio, audioConfig *protos.AudioConfig, speakerOpts utils.Option) {
+	policy, err := internal_language.NewLanguagePolicy(speakerOpts)
+	if err != nil {
+		talking.logger.Warnf("illegal language options, language switching is disabled %v", err)
+		policy, _ = internal_language.NewLanguagePolicy(utils.Option{})
+	}
+	talking.speaker = speakerConfig{transformer: transformerConfig, audioConfig: audioConfig, options: speakerOpts}
+	talking.language = policy
+	if policy.Enabled() && policy.Current() != "" {
+		talking.setLanguageArgument(policy.Current())
+	}
+}
+
+// observeLanguage keeps the language the voice switches to once the user keeps speaking another
+// language, the voice changes when the next turn of the assistant starts
+func (talking *GenericRequestor) observeLanguage(language string) {
+	next, ok := talking.language.Observe(language)
+	if !ok {
+		return
+	}
+	talking.voiceMu.Lock()
+	defer talking.voiceMu.Unlock()
+	talking.nextLanguage = &next
+}
+
+// applyLanguage switches to the language observed during the turn of the user. It runs before the
+// assistant responds, an utterance still playing was either interrupted or fully synthesized, so
+// closing the previous transformer cuts off nothing.
+func (talking *GenericRequestor) applyLanguage(ctx context.Context) {
+	talking.voiceMu.Lock()
+	next := talking.nextLanguage
+	talking.nextLanguage = nil
+	talking.voiceMu.Unlock()
+	if next == nil {
+		return
+	}
+	talking.switchLanguage(ctx, *next)
+}
+
+// switchLanguage creates the text to speech transformer again with the voice and normalizers of
+// the language, the previous transformer is closed once the new one is ready
+func (talking *GenericRequestor) switchLanguage(ctx context.Context, language internal_language.Language) {
+	ctx, span, _ := talking.Tracer().StartSpan(ctx, utils.AssistantSpeakConnectStage)
+	defer span.EndSpan(ctx, utils.AssistantSpeakConnectStage)
+	span.AddAttributes(ctx,
+		internal_telemetry.KV{K: "activity", V: internal_telemetry.StringValue("switch_language")},
+		internal_telemetry.KV{K: "language", V: internal_telemetry.StringValue(language.Code)},
+	)
+
+	if talking.speaker.transformer != nil {
+		textToSpeech, speechCache, err := talking.createTextToSpeech(ctx, talking.speaker.transformer, talking.speaker.audioConfig, utils.MergeMaps(talking.speaker.options, language.Options))
+		if err != nil {
+			talking.logger.Errorf("unable to switch text to speech to language %s with error %v", language.Code, err)
+			return
+		}
+		if previous := talking.setVoice(textToSpeech, speechCache); previous != nil {
+			if err := previous.Close(ctx); err != nil {
+				talking.logger.Errorf("unable to close text to speech transformer with error %v", err)
+			}
+		}
+	}
+	talking.setLanguageArgument(language.Code)
+}
+
+// setLanguageArgument replaces the arguments, the executor might be reading the previous ones
+func (talking *GenericRequestor) setLanguageArgument(language string) {
+	talking.voiceMu.Lock()
+	defer talking.voiceMu.Unlock()
+	talking.args = utils.MergeMaps(talking.args, map[string]interface{}{internal_language.LanguagePromptVariable: language})
+}
+
+// defaultLanguagePolicy is used until the session configures the policy, it never switches
+func defaultLanguagePolicy() internal_language.LanguagePolicy {
+	policy, _ := internal_language.NewLanguagePolicy(utils.Option{})
+	return policy
+}
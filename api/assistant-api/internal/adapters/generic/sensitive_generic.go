@@ -0,0 +1,66 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_generic
+
+import (
+	"context"
+
+	internal_sensitive "github.com/rapidaai/api/assistant-api/internal/sensitive"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// initializeSensitiveCapture sets up the dtmf toggle and the placeholder of sensitive capture,
+// both are keys of the deployment. Deployments saved before the provider recording and the dtmf
+// toggle were rejected together keep the recording and lose the toggle, the provider would record
+// what the caller says during the capture.
+func (talking *GenericRequestor) initializeSensitiveCapture(audioInputConfig *protos.AudioConfig) {
+	opts := talking.GetDeploymentOptions()
+	if err := internal_type.ValidateProviderRecording(opts); err != nil {
+		talking.logger.Warnf("dtmf toggle of sensitive capture is disabled, %v", err)
+		delete(opts, "sensitive_capture.dtmf_toggle")
+	}
+	talking.sensitive = internal_sensitive.NewSensitiveCapture(opts, audioInputConfig)
+}
+
+// toggleSensitiveCapture starts or stops sensitive capture on request of a tool
+func (talking *GenericRequestor) toggleSensitiveCapture(ctx context.Context, active bool) {
+	if active {
+		talking.onSensitiveCapture(ctx, talking.sensitive.Start(internal_sensitive.SourceTool))
+		return
+	}
+	talking.onSensitiveCapture(ctx, talking.sensitive.Stop(internal_sensitive.SourceTool))
+}
+
+// endSensitiveCapture closes a capture which is still active when the session ends so the
+// audit trail always has an end
+func (talking *GenericRequestor) endSensitiveCapture(ctx context.Context) {
+	talking.onSensitiveCapture(ctx, talking.sensitive.Stop("disconnect"))
+}
+
+// onSensitiveCapture stores the audit event of a capture which started or stopped and lets the
+// client know, nothing happens when the capture did not change.
+func (talking *GenericRequestor) onSensitiveCapture(ctx context.Context, event *types.Event) {
+	if event == nil {
+		return
+	}
+	talking.logger.Infof("%s for conversation %d", event.EventType, talking.Conversation().Id)
+	utils.Go(ctx, func() {
+		if _, err := talking.conversationService.ApplyConversationTelephonyEvent(ctx, talking.Auth(), talking.Source().Get(), talking.Assistant().Id, talking.Conversation().Id, []*types.Event{event}); err != nil {
+			talking.logger.Errorf("unable to store sensitive capture event %v", err)
+		}
+	})
+
+	args, _ := utils.InterfaceMapToAnyMap(map[string]interface{}{
+		"active": event.EventType == internal_sensitive.SensitiveCaptureStarted,
+		"source": event.Payload["source"],
+	})
+	if err := talking.Notify(ctx, &protos.AssistantMessagingResponse_Action{Action: &protos.AssistantConversationAction{Name: event.EventType, Action: protos.AssistantConversationAction_SENSITIVE_CAPTURE, Args: args}}); err != nil {
+		talking.logger.Tracef(ctx, "error notifying sensitive capture %v", err)
+	}
+}
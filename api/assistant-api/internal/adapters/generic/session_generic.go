@@ -68,6 +68,8 @@ func (r *GenericRequestor) Disconnect() {
 
 	// Phase 1: Close all session resources concurrently
 	r.closeSessionResources(ctx)
+	r.endSensitiveCapture(ctx)
+	r.filler.Close()
 
 	// Phase 2: Trigger end-of-conversation hooks
 	r.OnEndConversation()
@@ -204,6 +206,10 @@ func (r *GenericRequestor) OnCreateSession(
 
 	// Configure audio modes based on stream settings
 	audioInputConfig, audioOutputConfig := r.configureAudioModes(config.GetInputConfig(), config.GetOutputConfig())
+	r.initializeSensitiveCapture(audioInputConfig)
+	r.initializeAnsweringMachineDetection(ctx, audioInputConfig, audioOutputConfig)
+	r.initializeInterruptionPolicy(audioOutputConfig)
+	r.initializeFiller(ctx, audioOutputConfig)
 
 	// Initialize critical components concurrently
 	errGroup, _ := errgroup.WithContext(ctx)
@@ -268,6 +274,10 @@ func (r *GenericRequestor) OnResumeSession(
 
 	// Configure audio modes based on stream settings
 	audioInputConfig, audioOutputConfig := r.configureAudioModes(config.GetInputConfig(), config.GetOutputConfig())
+	r.initializeSensitiveCapture(audioInputConfig)
+	r.initializeAnsweringMachineDetection(ctx, audioInputConfig, audioOutputConfig)
+	r.initializeInterruptionPolicy(audioOutputConfig)
+	r.initializeFiller(ctx, audioOutputConfig)
 
 	// Initialize critical components concurrently
 	errGroup, _ := errgroup.WithContext(ctx)
@@ -364,12 +374,16 @@ func (r *GenericRequestor) flushFinalMetrics() {
 func (r *GenericRequestor) persistRecording(ctx context.Context) {
 	if r.recorder != nil {
 		utils.Go(r.Context(), func() {
-			audioData, err := r.recorder.Persist()
+			recording, err := r.recorder.Persist()
 			if err != nil {
 				r.logger.Tracef(ctx, "failed to persist audio recording: %+v", err)
 				return
 			}
-			if err = r.CreateConversationRecording(audioData); err != nil {
+			// streamed recordings are already in storage
+			if recording == nil {
+				return
+			}
+			if err = r.CreateConversationRecording(recording); err != nil {
 				r.logger.Tracef(ctx, "failed to create conversation recording record: %+v", err)
 			}
 		})
@@ -377,6 +391,22 @@ func (r *GenericRequestor) persistRecording(ctx context.Context) {
 
 }
 
+// recordingWriter starts streaming the recording to storage when recording.streaming is enabled,
+// it returns nil to keep the recording in memory when streaming is disabled or unavailable.
+func (r *GenericRequestor) recordingWriter(ctx context.Context, opts utils.Option) internal_type.RecordingWriter {
+	if streaming, err := opts.GetBool("recording.streaming"); err != nil || !streaming {
+		return nil
+	}
+	channels, _ := opts.GetUint32("recording.channels")
+	splitTracks, _ := opts.GetBool("recording.split_tracks")
+	writer, err := r.conversationService.CreateConversationRecordingWriter(ctx, r.auth, r.assistant.Id, r.assistantConversation.Id, channels, splitTracks)
+	if err != nil {
+		r.logger.Warnf("unable to stream recording, recording in memory: %v", err)
+		return nil
+	}
+	return writer
+}
+
 // exportTelemetry exports conversation telemetry data for analytics and monitoring.
 func (r *GenericRequestor) exportTelemetry(ctx context.Context) {
 	exportOptions := &internal_telemetry.VoiceAgentExportOption{
@@ -535,7 +565,9 @@ func (r *GenericRequestor) startBackgroundTasks(
 	// Initialize audio recorder when both input and output are configured
 	utils.Go(ctx, func() {
 		if audioInputConfig != nil && audioOutputConfig != nil {
-			rc, err := internal_audio_recorder.GetRecorder(r.logger, audioInputConfig, audioOutputConfig)
+			// layout and upload of the recording are options of the deployment
+			opts := r.GetDeploymentOptions()
+			rc, err := internal_audio_recorder.GetRecorder(r.logger, audioInputConfig, audioOutputConfig, opts, r.recordingWriter(ctx, opts))
 			if err != nil {
 				r.logger.Tracef(ctx, "failed to initialize audio recorder: %+v", err)
 				return
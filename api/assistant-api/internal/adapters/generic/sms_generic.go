@@ -0,0 +1,58 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_generic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	internal_sms "github.com/rapidaai/api/assistant-api/internal/sms"
+)
+
+var errSmsDeploymentNotEnabled = errors.New("sms deployment is not enabled for the assistant")
+
+// SendSms sends the text to the number with the sms deployment of the assistant, the number of
+// the deployment is the sender and numbers which opted out are not written to.
+func (r *GenericRequestor) SendSms(ctx context.Context, to, text string) error {
+	if r.assistant == nil || r.assistant.AssistantSmsDeployment == nil {
+		return errSmsDeploymentNotEnabled
+	}
+	if strings.TrimSpace(to) == "" || strings.TrimSpace(text) == "" {
+		return fmt.Errorf("sms needs a recipient and a text")
+	}
+	deployment := r.assistant.AssistantSmsDeployment
+	optedOut, err := r.deploymentService.IsSmsOptedOut(ctx, r.auth, r.assistant.Id, internal_sms.PhoneNumber(to))
+	if err != nil {
+		return err
+	}
+	if optedOut {
+		return fmt.Errorf("%s opted out of the sms of the assistant", to)
+	}
+
+	sms, err := internal_sms.GetSms(internal_sms.Sms(deployment.SmsProvider), r.config, r.logger)
+	if err != nil {
+		return err
+	}
+	from, err := deployment.GetOptions().GetString("phone")
+	if err != nil {
+		return err
+	}
+	credentialID, err := deployment.GetOptions().GetUint64("rapida.credential_id")
+	if err != nil {
+		return err
+	}
+	vltC, err := r.vaultClient.GetCredential(ctx, r.auth, credentialID)
+	if err != nil {
+		return err
+	}
+	if err := sms.SendMessage(ctx, vltC, from, to, text); err != nil {
+		r.logger.Errorf("unable to send sms to %s: %v", to, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_generic
+
+import (
+	"context"
+	"fmt"
+
+	internal_speech_cache "github.com/rapidaai/api/assistant-api/internal/speech_cache"
+	internal_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
+	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newSpeechCache sets up the cache of synthesized audio for the voice of the text to speech
+// transformer, audio of a project is shared between its assistants and calls.
+func (spk *GenericRequestor) newSpeechCache(provider string, credential *protos.VaultCredential, audioConfig *protos.AudioConfig, opts utils.Option) internal_speech_cache.SpeechCache {
+	return internal_speech_cache.NewSpeechCache(spk.logger, spk.storage, spk.redis,
+		fmt.Sprintf("%d", spk.Assistant().ProjectId),
+		internal_speech_cache.Voice{Provider: provider, Options: opts, AudioConfig: audioConfig},
+		func(ctx context.Context, text string) ([]byte, error) {
+			return internal_transformer.RenderTextToSpeech(ctx, spk.logger, provider, credential, audioConfig, opts, text)
+		})
+}
+
+// speakCached plays cached audio of text spoken by the assistant, it reports false when the text
+// has to be synthesized by the provider
+func (spk *GenericRequestor) speakCached(ctx context.Context, contextId, text string) bool {
+	textToSpeech, speechCache := spk.voice()
+	if textToSpeech == nil {
+		return false
+	}
+	audio, ok := speechCache.Lookup(ctx, contextId, text)
+	if !ok {
+		return false
+	}
+	ctx, span, _ := spk.Tracer().StartSpan(spk.Context(), utils.AssistantSpeakingStage)
+	defer span.EndSpan(ctx, utils.AssistantSpeakingStage)
+	span.AddAttributes(ctx,
+		internal_telemetry.MessageKV(contextId),
+		internal_telemetry.KV{K: "activity", V: internal_telemetry.StringValue("speak_cached")},
+		internal_telemetry.KV{K: "script", V: internal_telemetry.StringValue(text)},
+	)
+	spk.OnPacket(ctx, internal_type.TextToSpeechAudioPacket{ContextID: contextId, AudioChunk: audio})
+	return true
+}
+
+// speakStatic plays a static message from the cache as a whole, messages which repeat are rendered
+// into the cache
+func (spk *GenericRequestor) speakStatic(ctx context.Context, vl internal_type.StaticPacket) bool {
+	textToSpeech, speechCache := spk.voice()
+	if textToSpeech == nil {
+		return false
+	}
+	if !spk.speakCached(ctx, vl.ContextID, vl.Text) {
+		speechCache.Repeated(ctx, vl.ContextID, vl.Text)
+		return false
+	}
+	if err := spk.Notify(ctx, &protos.AssistantConversationAssistantMessage{Time: timestamppb.Now(), Id: vl.ContextID, Completed: true, Message: &protos.AssistantConversationAssistantMessage_Text{Text: &protos.AssistantConversationMessageTextContent{Content: vl.Text}}}); err != nil {
+		spk.logger.Tracef(ctx, "error while outputting chunk to the user: %w", err)
+	}
+	spk.OnPacket(ctx, internal_type.TextToSpeechEndPacket{ContextID: vl.ContextID})
+	return true
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_request_talking_whatsapp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_adapter_generic "github.com/rapidaai/api/assistant-api/internal/adapters/generic"
+	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/storages"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type whatsappTalking struct {
+	internal_adapter_generic.GenericRequestor
+	logger commons.Logger
+}
+
+// NewTalking talks to a whatsapp user in text, a session lives while the user writes
+func NewTalking(
+	ctx context.Context,
+	config *config.AssistantConfig,
+	logger commons.Logger,
+	postgres connectors.PostgresConnector,
+	opensearch connectors.OpenSearchConnector,
+	redis connectors.RedisConnector,
+	storage storages.Storage,
+	streamer internal_streamers.Streamer,
+) (internal_type.Talking, error) {
+	return &whatsappTalking{
+		logger:           logger,
+		GenericRequestor: internal_adapter_generic.NewGenericRequestor(ctx, config, logger, utils.Whatsapp, postgres, opensearch, redis, storage, streamer),
+	}, nil
+}
+
+func (talking *whatsappTalking) Talk(ctx context.Context, auth types.SimplePrinciple, identifier string) error {
+	talking.StartedAt = time.Now()
+	var initialized = false
+	for {
+		select {
+		case <-ctx.Done():
+			if initialized {
+				talking.Disconnect()
+			}
+			return ctx.Err()
+		default:
+			// Continue processing
+		}
+
+		req, err := talking.Streamer().Recv()
+		if err != nil {
+			if err == io.EOF || status.Code(err) == codes.Canceled {
+				if initialized {
+					talking.Disconnect()
+				}
+				break
+			}
+			return fmt.Errorf("stream.Recv error: %w", err)
+		}
+		switch msg := req.GetRequest().(type) {
+		case *protos.AssistantMessagingRequest_Message:
+			if initialized {
+				if err := talking.Input(req.GetMessage()); err != nil {
+					talking.logger.Errorf("error while accepting input %v", err)
+				}
+			}
+		case *protos.AssistantMessagingRequest_Configuration:
+			initialized = false
+			if err := talking.Connect(ctx, auth, identifier, msg.Configuration); err != nil {
+				talking.logger.Errorf("unexpected error while connect assistant, might be problem in configuration %+v", err)
+				return fmt.Errorf("talking.Connect error: %w", err)
+			}
+			initialized = true
+		}
+	}
+	return nil
+}
@@ -16,7 +16,9 @@ import (
 	internal_debugger "github.com/rapidaai/api/assistant-api/internal/adapters/internal/debugger"
 	internal_phone "github.com/rapidaai/api/assistant-api/internal/adapters/internal/phone"
 	internal_sdk "github.com/rapidaai/api/assistant-api/internal/adapters/internal/sdk"
+	internal_sms "github.com/rapidaai/api/assistant-api/internal/adapters/internal/sms"
 	internal_web_plugin "github.com/rapidaai/api/assistant-api/internal/adapters/internal/web-plugin"
+	internal_whatsapp "github.com/rapidaai/api/assistant-api/internal/adapters/internal/whatsapp"
 	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
@@ -57,6 +59,20 @@ func GetTalker(source utils.RapidaSource, ctx context.Context, cfg *config.Assis
 			logger.Errorf("assistant call talker failed with err %+v", err)
 		}
 		return talker, nil
+	case utils.Whatsapp:
+		talker, err := internal_whatsapp.NewTalking(ctx, cfg, logger, postgres, opensearch, redis, storage, streamer)
+		if err != nil {
+			logger.Errorf("assistant whatsapp talker failed with err %+v", err)
+			return nil, err
+		}
+		return talker, nil
+	case utils.SMS:
+		talker, err := internal_sms.NewTalking(ctx, cfg, logger, postgres, opensearch, redis, storage, streamer)
+		if err != nil {
+			logger.Errorf("assistant sms talker failed with err %+v", err)
+			return nil, err
+		}
+		return talker, nil
 	default:
 		talker, err := internal_debugger.NewTalking(ctx, cfg, logger, postgres, opensearch, redis, storage, streamer)
 		if err != nil {
@@ -80,6 +96,8 @@ func Identifier(source utils.RapidaSource, ctx context.Context, auth types.Simpl
 		return RapidaCallIdentifier(auth, identity)
 	case utils.Whatsapp:
 		return RapidaWhatsappIdentifier(auth, identity)
+	case utils.SMS:
+		return RapidaSmsIdentifier(auth, identity)
 	default:
 		return identity
 	}
@@ -222,3 +240,18 @@ func RapidaWhatsappIdentifier(auth types.SimplePrinciple, identity string) strin
 			*auth.GetCurrentProjectId(), *auth.GetCurrentOrganizationId()))
 	return vl
 }
+
+// RapidaSmsIdentifier generates a unique identifier for the sms user of a project, the identity
+// is the number of the user.
+//
+// The resulting identifier is a lowercase string with the format:
+// "sms-production-identity-projectid-organizationid"
+func RapidaSmsIdentifier(auth types.SimplePrinciple, identity string) string {
+	vl := strings.ToLower(
+		fmt.Sprintf(`%s-%s-%s-%d-%d`,
+			"sms",
+			"production",
+			identity,
+			*auth.GetCurrentProjectId(), *auth.GetCurrentOrganizationId()))
+	return vl
+}
@@ -6,9 +6,11 @@
 package internal_adapter
 
 import (
+	"context"
 	"strings"
 	"testing"
 
+	"github.com/rapidaai/pkg/types"
 	"github.com/rapidaai/pkg/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -153,6 +155,7 @@ func TestIdentifier_SourceRouting(t *testing.T) {
 		{"SDK", utils.SDK},
 		{"PhoneCall", utils.PhoneCall},
 		{"Whatsapp", utils.Whatsapp},
+		{"SMS", utils.SMS},
 	}
 
 	for _, tc := range sourceCases {
@@ -196,6 +199,12 @@ func TestIdentifierFunctions_FormatPatterns(t *testing.T) {
 	}
 }
 
+func TestRapidaSmsIdentifier(t *testing.T) {
+	auth := &types.ProjectScope{ProjectId: utils.Ptr[uint64](2), OrganizationId: utils.Ptr[uint64](3)}
+	assert.Equal(t, "sms-production-+15551234567-2-3", RapidaSmsIdentifier(auth, "+15551234567"))
+	assert.Equal(t, RapidaSmsIdentifier(auth, "+15551234567"), Identifier(utils.SMS, context.Background(), auth, "+15551234567"))
+}
+
 // Integration test validating multiple identifier types
 func TestIdentifierFunctions_AllTypes(t *testing.T) {
 	// Validate that all identifier functions are accessible
@@ -0,0 +1,183 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_model
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+const (
+	// prompt variable that receives the retrieved knowledge, knowledge is only
+	// retrieved when the prompt template declares this variable
+	knowledgePromptVariable = "knowledge"
+
+	defaultKnowledgeMaxTokens = 600
+	defaultKnowledgeTimeout   = 300 * time.Millisecond
+
+	// rough estimate used for the token budget, avoids tokenizing on the hot path
+	approxCharsPerToken = 4
+)
+
+// knowledgeSource is an assistant knowledge resolved for per-turn injection
+type knowledgeSource struct {
+	assistantKnowledge *internal_assistant_entity.AssistantKnowledge
+	credential         *protos.VaultCredential
+	maxTokens          int
+	timeout            time.Duration
+}
+
+// knowledgeInjector retrieves from the assistant knowledges against the user utterance
+// before the llm call, so factual questions are answered without a tool round-trip.
+type knowledgeInjector struct {
+	logger  commons.Logger
+	sources []*knowledgeSource
+}
+
+func newKnowledgeInjector(ctx context.Context, logger commons.Logger, communication internal_type.Communication) *knowledgeInjector {
+	injector := &knowledgeInjector{logger: logger, sources: make([]*knowledgeSource, 0)}
+	assistant := communication.Assistant()
+	if !injector.declared(assistant) {
+		return injector
+	}
+	for _, ak := range assistant.AssistantKnowledges {
+		if ak.Knowledge == nil {
+			continue
+		}
+		credentialId, err := ak.Knowledge.GetOptions().GetUint64("rapida.credential_id")
+		if err != nil {
+			logger.Errorf("error while getting knowledge credentials for knowledge %d, check the setup %v", ak.KnowledgeId, err)
+			continue
+		}
+		credential, err := communication.VaultCaller().GetCredential(ctx, communication.Auth(), credentialId)
+		if err != nil {
+			logger.Errorf("error while getting embedding credentials for knowledge %d: %v", ak.KnowledgeId, err)
+			continue
+		}
+
+		source := &knowledgeSource{
+			assistantKnowledge: ak,
+			credential:         credential,
+			maxTokens:          defaultKnowledgeMaxTokens,
+			timeout:            defaultKnowledgeTimeout,
+		}
+		opts := utils.Option(ak.GetOptions())
+		if maxTokens, err := opts.GetUint32("injection.max_tokens"); err == nil && maxTokens > 0 {
+			source.maxTokens = int(maxTokens)
+		}
+		if timeout, err := opts.GetUint64("injection.timeout"); err == nil && timeout > 0 {
+			source.timeout = time.Duration(timeout) * time.Millisecond
+		}
+		injector.sources = append(injector.sources, source)
+	}
+	return injector
+}
+
+// declared reports whether the prompt template of the assistant uses the knowledge variable
+func (ki *knowledgeInjector) declared(assistant *internal_assistant_entity.Assistant) bool {
+	if assistant.AssistantProviderModel == nil || len(assistant.AssistantKnowledges) == 0 {
+		return false
+	}
+	template := assistant.AssistantProviderModel.Template.GetTextChatCompleteTemplate()
+	if template == nil {
+		return false
+	}
+	for _, v := range template.Variables {
+		if v.Name == knowledgePromptVariable {
+			return true
+		}
+	}
+	return false
+}
+
+func (ki *knowledgeInjector) Enabled() bool {
+	return len(ki.sources) > 0
+}
+
+// Retrieve queries all knowledge sources in parallel, each bounded by its own
+// latency cap and token budget, sources that miss the deadline are dropped.
+func (ki *knowledgeInjector) Retrieve(ctx context.Context, communication internal_type.Communication, contextId, query string) string {
+	if !ki.Enabled() || strings.TrimSpace(query) == "" {
+		return ""
+	}
+	start := time.Now()
+	contents := make([]string, len(ki.sources))
+	var wg sync.WaitGroup
+	for idx, source := range ki.sources {
+		wg.Add(1)
+		utils.Go(ctx, func() {
+			defer wg.Done()
+			cCtx, cancel := context.WithTimeout(ctx, source.timeout)
+			defer cancel()
+			results, err := communication.RetrieveAssistantKnowledge(cCtx, source.assistantKnowledge.Knowledge, contextId, query, &internal_type.KnowledgeRetrieveOption{
+				EmbeddingProviderCredential: source.credential,
+				RetrievalMethod:             string(source.assistantKnowledge.RetrievalMethod),
+				TopK:                        source.assistantKnowledge.TopK,
+				ScoreThreshold:              source.assistantKnowledge.ScoreThreshold,
+			})
+			if err != nil {
+				ki.logger.Warnf("unable to retrieve knowledge %d for injection: %v", source.assistantKnowledge.KnowledgeId, err)
+				return
+			}
+			contents[idx] = withinBudget(results, source.maxTokens)
+		})
+	}
+	wg.Wait()
+	ki.logger.Benchmark("knowledgeInjector.Retrieve", time.Since(start))
+
+	var builder strings.Builder
+	for _, content := range contents {
+		if content == "" {
+			continue
+		}
+		if builder.Len() > 0 {
+			builder.WriteString("\n")
+		}
+		builder.WriteString(content)
+	}
+	return builder.String()
+}
+
+// withinBudget joins the highest scoring results until the token budget is exhausted,
+// a result that does not fit is cut at a word boundary.
+func withinBudget(results []internal_type.KnowledgeContextResult, maxTokens int) string {
+	// the results of the caller keep their order
+	ranked := append([]internal_type.KnowledgeContextResult(nil), results...)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Score > ranked[j].Score
+	})
+	remaining := maxTokens * approxCharsPerToken
+	var builder strings.Builder
+	for _, result := range ranked {
+		content := strings.TrimSpace(result.Content)
+		if content == "" {
+			continue
+		}
+		if len(content) > remaining {
+			cut := strings.LastIndex(content[:remaining], " ")
+			if cut <= 0 {
+				break
+			}
+			content = content[:cut]
+		}
+		builder.WriteString(content)
+		builder.WriteString("\n")
+		remaining -= len(content) + 1
+		if remaining <= 0 {
+			break
+		}
+	}
+	return strings.TrimSpace(builder.String())
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_model
+
+import (
+	"strings"
+	"testing"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithinBudget(t *testing.T) {
+	t.Run("orders by score", func(t *testing.T) {
+		out := withinBudget([]internal_type.KnowledgeContextResult{
+			{ID: "1", Content: "low", Score: 0.2},
+			{ID: "2", Content: "high", Score: 0.9},
+		}, 100)
+		assert.Equal(t, "high\nlow", out)
+	})
+
+	t.Run("keeps the order of the caller", func(t *testing.T) {
+		results := []internal_type.KnowledgeContextResult{
+			{ID: "1", Content: "low", Score: 0.2},
+			{ID: "2", Content: "high", Score: 0.9},
+		}
+		withinBudget(results, 100)
+		assert.Equal(t, "1", results[0].ID)
+		assert.Equal(t, "2", results[1].ID)
+	})
+
+	t.Run("drops results beyond the budget", func(t *testing.T) {
+		out := withinBudget([]internal_type.KnowledgeContextResult{
+			{ID: "1", Content: strings.Repeat("a", 30), Score: 0.9},
+			{ID: "2", Content: strings.Repeat("b", 30), Score: 0.8},
+		}, 8)
+		assert.Equal(t, strings.Repeat("a", 30), out)
+	})
+
+	t.Run("cuts oversized result at word boundary", func(t *testing.T) {
+		out := withinBudget([]internal_type.KnowledgeContextResult{
+			{ID: "1", Content: "the refund window is fourteen days from delivery", Score: 0.9},
+		}, 5)
+		assert.Equal(t, "the refund window", out)
+	})
+
+	t.Run("empty results", func(t *testing.T) {
+		assert.Equal(t, "", withinBudget(nil, 100))
+	})
+}
+
+func TestKnowledgeInjector_Declared(t *testing.T) {
+	assistantWith := func(variables []interface{}, knowledges int) *internal_assistant_entity.Assistant {
+		assistant := &internal_assistant_entity.Assistant{
+			AssistantProviderModel: &internal_assistant_entity.AssistantProviderModel{
+				Template: gorm_types.PromptMap{"prompt": []interface{}{}, "promptVariables": variables},
+			},
+		}
+		for i := 0; i < knowledges; i++ {
+			assistant.AssistantKnowledges = append(assistant.AssistantKnowledges, &internal_assistant_entity.AssistantKnowledge{})
+		}
+		return assistant
+	}
+	ki := &knowledgeInjector{}
+	assert.True(t, ki.declared(assistantWith([]interface{}{map[string]interface{}{"name": "knowledge"}}, 1)))
+	assert.False(t, ki.declared(assistantWith([]interface{}{map[string]interface{}{"name": "knowledge"}}, 0)))
+	assert.False(t, ki.declared(assistantWith([]interface{}{map[string]interface{}{"name": "name"}}, 1)))
+	assert.False(t, ki.declared(&internal_assistant_entity.Assistant{}))
+}
@@ -30,6 +30,10 @@ type modelAssistantExecutor struct {
 	providerCredential *protos.VaultCredential
 	inputBuilder       integration_client_builders.InputChatBuilder
 	history            []*protos.Message
+
+	// knowledge injected into the prompt for the current turn
+	knowledgeInjector *knowledgeInjector
+	knowledge         string
 }
 
 func NewModelAssistantExecutor(logger commons.Logger) internal_agent_executor.AssistantExecutor {
@@ -89,6 +93,13 @@ func (executor *modelAssistantExecutor) Initialize(ctx context.Context, communic
 		return nil
 	})
 
+	// Goroutine to resolve knowledge sources for per-turn injection
+	var injector *knowledgeInjector
+	g.Go(func() error {
+		injector = newKnowledgeInjector(gCtx, executor.logger, communication)
+		return nil
+	})
+
 	// Wait for all goroutines to complete
 	if err := g.Wait(); err != nil {
 		executor.logger.Errorf("Error during initialization: %v", err)
@@ -97,6 +108,7 @@ func (executor *modelAssistantExecutor) Initialize(ctx context.Context, communic
 
 	// Assign after goroutines complete to avoid race conditions
 	executor.providerCredential = providerCredential
+	executor.knowledgeInjector = injector
 	executor.history = append(executor.history, conversationLogs...)
 	span.AddAttributes(ctx, internal_adapter_telemetry.KV{K: "history_length", V: internal_adapter_telemetry.IntValue(len(executor.history))})
 
@@ -110,7 +122,7 @@ func (executor *modelAssistantExecutor) chat(
 	packet internal_type.LLMMessagePacket,
 	histories ...*protos.Message,
 ) error {
-	request := executor.buildChatRequest(communication, packet, histories...)
+	request := executor.buildChatRequest(ctx, communication, packet, histories...)
 	res, err := communication.IntegrationCaller().StreamChat(
 		ctx,
 		communication.Auth(),
@@ -126,12 +138,20 @@ func (executor *modelAssistantExecutor) chat(
 }
 
 // buildChatRequest constructs the chat request with all necessary parameters
-func (executor *modelAssistantExecutor) buildChatRequest(communication internal_type.Communication, packet internal_type.LLMMessagePacket, histories ...*protos.Message) *protos.ChatRequest {
+func (executor *modelAssistantExecutor) buildChatRequest(ctx context.Context, communication internal_type.Communication, packet internal_type.LLMMessagePacket, histories ...*protos.Message) *protos.ChatRequest {
 	assistant := communication.Assistant()
 	template := assistant.AssistantProviderModel.Template.GetTextChatCompleteTemplate()
+	arguments := utils.MergeMaps(executor.inputBuilder.PromptArguments(template.Variables), communication.GetArgs())
+	if executor.knowledgeInjector != nil && executor.knowledgeInjector.Enabled() {
+		// retrieve once per user turn, tool follow ups reuse the knowledge of the turn
+		if packet.Message != nil && packet.Message.Role == "user" {
+			executor.knowledge = executor.knowledgeInjector.Retrieve(ctx, communication, packet.ContextID, packet.Message.String())
+		}
+		arguments[knowledgePromptVariable] = executor.knowledge
+	}
 	messages := executor.inputBuilder.Message(
 		template.Prompt,
-		utils.MergeMaps(executor.inputBuilder.PromptArguments(template.Variables), communication.GetArgs()),
+		arguments,
 	)
 	messages = append(messages, histories...)
 	messages = append(messages, packet.Message.ToProto())
@@ -291,5 +311,6 @@ func (executor *modelAssistantExecutor) handleStaticPacket(packet internal_type.
 
 func (executor *modelAssistantExecutor) Close(ctx context.Context, communication internal_type.Communication) error {
 	executor.history = make([]*protos.Message, 0)
+	executor.knowledge = ""
 	return nil
 }
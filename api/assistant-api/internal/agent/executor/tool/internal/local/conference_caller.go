@@ -0,0 +1,109 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_tool_local
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	internal_tool "github.com/rapidaai/api/assistant-api/internal/agent/executor/tool/internal"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+)
+
+// conferenceCaller brings a human agent into the call, the assistant stays in the conference in
+// the mode of the tool. without an agent the tool only changes the mode of the running conference
+type conferenceCaller struct {
+	toolCaller
+	conferenceTo string
+	// empty when the tool has no mode, the default mode of the telephony is taken
+	mode internal_type.ConferenceMode
+}
+
+// Definition describes the conference when the tool has no description of its own
+func (tc *conferenceCaller) Definition() (*protos.FunctionDefinition, error) {
+	definition, err := tc.toolCaller.Definition()
+	if err != nil {
+		return nil, err
+	}
+	if definition.Description != "" {
+		return definition, nil
+	}
+	switch {
+	case tc.conferenceTo == "":
+		definition.Description = fmt.Sprintf("Change how you take part in the call with the human agent to %s.", tc.mode)
+	case tc.mode == internal_type.ConferenceModeListen:
+		definition.Description = "Bring a human agent into the call when the user needs one, you keep listening without speaking."
+	case tc.mode == internal_type.ConferenceModeWhisper:
+		definition.Description = "Bring a human agent into the call when the user needs one, only the agent hears your suggestions. Not available on twilio calls, twilio only lets you listen."
+	case tc.mode == internal_type.ConferenceModeSpeak:
+		definition.Description = "Bring a human agent into the call when the user needs one, the user and the agent both hear you. Not available on twilio calls, twilio only lets you listen."
+	default:
+		definition.Description = "Bring a human agent into the call when the user needs one."
+	}
+	return definition, nil
+}
+
+func (tc *conferenceCaller) Call(ctx context.Context, pkt internal_type.LLMPacket, toolId string, args string, communication internal_type.Communication) internal_type.LLMToolPacket {
+	// the telephony of the call is only known once the call is connected
+	provider, _ := communication.GetMetadata()["telephony.provider"].(string)
+	mode := tc.mode
+	if mode == "" {
+		mode = internal_type.DefaultConferenceMode(provider)
+	}
+	if !internal_type.IsConferenceSupported(provider, mode) {
+		tc.logger.Warnf("conference of the tool %s in %s mode is not supported on telephony %q", tc.Name(), mode, provider)
+		return internal_type.LLMToolPacket{Name: tc.Name(), ContextID: pkt.ContextId(), Result: tc.Result(fmt.Sprintf("The call can not be conferenced in %s mode.", mode), false)}
+	}
+
+	result := tc.Result("Bringing the agent into the call. "+conferenceInstruction(mode), true)
+	if tc.conferenceTo == "" {
+		result = tc.Result(fmt.Sprintf("Taking part in the call in %s mode. %s", mode, conferenceInstruction(mode)), true)
+	}
+	result["to"] = tc.conferenceTo
+	result["mode"] = string(mode)
+	return internal_type.LLMToolPacket{Name: tc.Name(), ContextID: pkt.ContextId(), Action: protos.AssistantConversationAction_CONFERENCE_CONVERSATION, Result: result}
+}
+
+// conferenceInstruction tells the model who hears it in the mode, the result of the tool stays in
+// the history so the replies which follow are meant for the right participant
+func conferenceInstruction(mode internal_type.ConferenceMode) string {
+	switch mode {
+	case internal_type.ConferenceModeListen:
+		return "From now on the user talks to the human agent and nobody hears you, do not reply."
+	case internal_type.ConferenceModeWhisper:
+		return "From now on the user talks to the human agent and only the agent hears you. What you hear is the user and the agent, reply to the agent with short suggestions of what to say or do, never answer the user as if you were talking to them."
+	default:
+		return "From now on the user and the human agent both hear you, reply to both of them."
+	}
+}
+
+func NewConferenceCaller(logger commons.Logger, toolOptions *internal_assistant_entity.AssistantTool, communication internal_type.Communication,
+) (internal_tool.ToolCaller, error) {
+	conferenceTo, _ := toolOptions.GetOptions().GetString("tool.conference_to")
+	mode, _ := toolOptions.GetOptions().GetString("tool.conference_mode")
+	var conferenceMode internal_type.ConferenceMode
+	if strings.TrimSpace(mode) != "" {
+		m, err := internal_type.NewConferenceMode(strings.TrimSpace(mode))
+		if err != nil {
+			return nil, fmt.Errorf("tool.conference_mode is not valid: %w", err)
+		}
+		conferenceMode = m
+	} else if strings.TrimSpace(conferenceTo) == "" {
+		return nil, fmt.Errorf("tool.conference_mode is required to change the mode of the conference")
+	}
+	return &conferenceCaller{
+		toolCaller: toolCaller{
+			logger:      logger,
+			toolOptions: toolOptions,
+		},
+		conferenceTo: strings.TrimSpace(conferenceTo),
+		mode:         conferenceMode,
+	}, nil
+}
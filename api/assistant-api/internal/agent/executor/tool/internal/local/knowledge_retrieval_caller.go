@@ -10,8 +10,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 
 	internal_tool "github.com/rapidaai/api/assistant-api/internal/agent/executor/tool/internal"
+	internal_agent_rewriter "github.com/rapidaai/api/assistant-api/internal/agent/rewriter"
 	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
 	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
@@ -27,6 +29,10 @@ type knowledgeRetrievalToolCaller struct {
 	scoreThreshold     float64
 	knowledge          *internal_knowledge_gorm.Knowledge
 	providerCredential *protos.VaultCredential
+
+	// optional query rewriting stage, nil when disabled
+	rewriter      internal_agent_rewriter.QueryRewriter
+	rewriteOption *internal_agent_rewriter.QueryRewriteOption
 }
 
 func (tc *knowledgeRetrievalToolCaller) argument(args string) (*string, map[string]interface{}, error) {
@@ -53,12 +59,7 @@ func (afkTool *knowledgeRetrievalToolCaller) Call(ctx context.Context, pkt inter
 	if err != nil || in == nil {
 		return internal_type.LLMToolPacket{Name: afkTool.Name(), ContextID: pkt.ContextId(), Action: protos.AssistantConversationAction_KNOWLEDGE_RETRIEVAL, Result: afkTool.Result("Required argument is missing or query, context is missing from argument list", false)}
 	} else {
-		knowledges, err := communication.RetrieveToolKnowledge(afkTool.knowledge, pkt.ContextId(), *in, v, &internal_type.KnowledgeRetrieveOption{
-			EmbeddingProviderCredential: afkTool.providerCredential,
-			RetrievalMethod:             afkTool.searchType,
-			TopK:                        afkTool.topK,
-			ScoreThreshold:              float32(afkTool.scoreThreshold),
-		})
+		knowledges, err := afkTool.retrieve(ctx, pkt, afkTool.queries(ctx, *in, communication), v, communication)
 
 		if len(knowledges) == 0 || err != nil {
 			return internal_type.LLMToolPacket{Name: afkTool.Name(), ContextID: pkt.ContextId(), Action: protos.AssistantConversationAction_KNOWLEDGE_RETRIEVAL, Result: afkTool.Result("Not able to find anything in knowledge from given documents.", true)}
@@ -75,6 +76,60 @@ func (afkTool *knowledgeRetrievalToolCaller) Call(ctx context.Context, pkt inter
 
 }
 
+// queries returns the queries to retrieve with, the original query when rewriting
+// is disabled or fails.
+func (afkTool *knowledgeRetrievalToolCaller) queries(ctx context.Context, query string, communication internal_type.Communication) []string {
+	if afkTool.rewriter == nil {
+		return []string{query}
+	}
+	queries, err := afkTool.rewriter.Rewrite(ctx, communication.Auth(), query, communication.GetHistories(), afkTool.rewriteOption)
+	if err != nil || len(queries) == 0 {
+		afkTool.logger.Warnf("unable to rewrite query, falling back to original query %v", err)
+		return []string{query}
+	}
+	afkTool.logger.Debugf("rewritten knowledge query %s to %v", query, queries)
+	return queries
+}
+
+// retrieve runs every query against the knowledge in parallel and fuses the
+// ranked results with reciprocal rank fusion.
+func (afkTool *knowledgeRetrievalToolCaller) retrieve(ctx context.Context, pkt internal_type.LLMPacket, queries []string, filter map[string]interface{}, communication internal_type.Communication) ([]internal_type.KnowledgeContextResult, error) {
+	retrieveOption := &internal_type.KnowledgeRetrieveOption{
+		EmbeddingProviderCredential: afkTool.providerCredential,
+		RetrievalMethod:             afkTool.searchType,
+		TopK:                        afkTool.topK,
+		ScoreThreshold:              float32(afkTool.scoreThreshold),
+	}
+	if len(queries) == 1 {
+		return communication.RetrieveToolKnowledge(afkTool.knowledge, pkt.ContextId(), queries[0], filter, retrieveOption)
+	}
+
+	rankings := make([][]internal_type.KnowledgeContextResult, len(queries))
+	errs := make([]error, len(queries))
+	var wg sync.WaitGroup
+	for idx, query := range queries {
+		wg.Add(1)
+		utils.Go(ctx, func() {
+			defer wg.Done()
+			rankings[idx], errs[idx] = communication.RetrieveToolKnowledge(afkTool.knowledge, pkt.ContextId(), query, filter, retrieveOption)
+		})
+	}
+	wg.Wait()
+
+	successful := make([][]internal_type.KnowledgeContextResult, 0, len(rankings))
+	for idx, ranking := range rankings {
+		if errs[idx] != nil {
+			afkTool.logger.Warnf("error while retrieving knowledge for query %s: %v", queries[idx], errs[idx])
+			continue
+		}
+		successful = append(successful, ranking)
+	}
+	if len(successful) == 0 {
+		return nil, errs[0]
+	}
+	return reciprocalRankFusion(successful, int(afkTool.topK)), nil
+}
+
 func NewKnowledgeRetrievalToolCaller(
 	logger commons.Logger,
 	toolOptions *internal_assistant_entity.AssistantTool,
@@ -124,7 +179,7 @@ func NewKnowledgeRetrievalToolCaller(
 		logger.Errorf("error while getting provider model credentials %v for embedding provide model id %d", err, knowledge.EmbeddingModelProviderName)
 		return nil, err
 	}
-	return &knowledgeRetrievalToolCaller{
+	caller := &knowledgeRetrievalToolCaller{
 		toolCaller: toolCaller{
 			logger:      logger,
 			toolOptions: toolOptions,
@@ -134,5 +189,47 @@ func NewKnowledgeRetrievalToolCaller(
 		scoreThreshold:     scoreThreshold,
 		providerCredential: providerCredential,
 		knowledge:          knowledge,
-	}, nil
+	}
+
+	// query rewriting is optional and only enabled when a mode is configured
+	if mode, err := opts.GetString("tool.query_rewrite"); err == nil && mode != "" && mode != "disabled" {
+		rewriteOption, err := newQueryRewriteOption(internal_agent_rewriter.QueryRewriteMode(mode), opts, communcation)
+		if err != nil {
+			logger.Errorf("error while setting up query rewrite, continuing without rewriting %v", err)
+			return caller, nil
+		}
+		caller.rewriter = internal_agent_rewriter.NewQueryRewriter(logger, communcation.IntegrationCaller())
+		caller.rewriteOption = rewriteOption
+	}
+	return caller, nil
+}
+
+// newQueryRewriteOption builds the rewrite options, rewriting uses the assistant's own model
+func newQueryRewriteOption(mode internal_agent_rewriter.QueryRewriteMode, opts utils.Option, communication internal_type.Communication) (*internal_agent_rewriter.QueryRewriteOption, error) {
+	providerModel := communication.Assistant().AssistantProviderModel
+	credentialId, err := providerModel.GetOptions().GetUint64("rapida.credential_id")
+	if err != nil {
+		return nil, fmt.Errorf("assistant provider model credential is missing: %w", err)
+	}
+	credential, err := communication.VaultCaller().GetCredential(communication.Context(), communication.Auth(), credentialId)
+	if err != nil {
+		return nil, err
+	}
+	rewriteOption := &internal_agent_rewriter.QueryRewriteOption{
+		ProviderCredential: credential,
+		ModelProviderName:  providerModel.ModelProviderName,
+		Options:            providerModel.GetOptions(),
+		Mode:               mode,
+		AdditionalData: map[string]string{
+			"assistant_id":                fmt.Sprintf("%d", communication.Assistant().Id),
+			"assistant_provider_model_id": fmt.Sprintf("%d", providerModel.Id),
+		},
+	}
+	if queryCount, err := opts.GetUint32("tool.query_count"); err == nil {
+		rewriteOption.QueryCount = queryCount
+	}
+	if historySize, err := opts.GetUint32("tool.history_size"); err == nil {
+		rewriteOption.HistorySize = historySize
+	}
+	return rewriteOption, nil
 }
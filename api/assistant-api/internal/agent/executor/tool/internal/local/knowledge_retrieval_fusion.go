@@ -0,0 +1,49 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_tool_local
+
+import (
+	"sort"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+)
+
+// rrfK dampens the contribution of lower ranked results, 60 is the value
+// proposed in the original reciprocal rank fusion paper.
+const rrfK = 60
+
+// reciprocalRankFusion merges multiple ranked result lists into one ranking where each
+// result scores sum(1 / (rrfK + rank)) across the lists it appears in. Scores of the
+// returned results are replaced with the fused score.
+func reciprocalRankFusion(rankings [][]internal_type.KnowledgeContextResult, topK int) []internal_type.KnowledgeContextResult {
+	scores := make(map[string]float64)
+	results := make(map[string]internal_type.KnowledgeContextResult)
+	order := make([]string, 0)
+	for _, ranking := range rankings {
+		for rank, result := range ranking {
+			if _, ok := results[result.ID]; !ok {
+				results[result.ID] = result
+				order = append(order, result.ID)
+			}
+			scores[result.ID] += 1.0 / float64(rrfK+rank+1)
+		}
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return scores[order[i]] > scores[order[j]]
+	})
+	if topK > 0 && len(order) > topK {
+		order = order[:topK]
+	}
+
+	fused := make([]internal_type.KnowledgeContextResult, 0, len(order))
+	for _, id := range order {
+		result := results[id]
+		result.Score = scores[id]
+		fused = append(fused, result)
+	}
+	return fused
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_tool_local
+
+import (
+	"testing"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/stretchr/testify/assert"
+)
+
+func results(ids ...string) []internal_type.KnowledgeContextResult {
+	out := make([]internal_type.KnowledgeContextResult, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, internal_type.KnowledgeContextResult{ID: id, Content: "content " + id})
+	}
+	return out
+}
+
+func ids(in []internal_type.KnowledgeContextResult) []string {
+	out := make([]string, 0, len(in))
+	for _, r := range in {
+		out = append(out, r.ID)
+	}
+	return out
+}
+
+func TestReciprocalRankFusion(t *testing.T) {
+	t.Run("single ranking keeps order", func(t *testing.T) {
+		fused := reciprocalRankFusion([][]internal_type.KnowledgeContextResult{results("a", "b", "c")}, 0)
+		assert.Equal(t, []string{"a", "b", "c"}, ids(fused))
+		assert.InDelta(t, 1.0/61, fused[0].Score, 1e-9)
+	})
+
+	t.Run("results found by multiple queries rank higher", func(t *testing.T) {
+		fused := reciprocalRankFusion([][]internal_type.KnowledgeContextResult{
+			results("a", "b", "c"),
+			results("c", "d"),
+			results("e", "c"),
+		}, 0)
+		assert.Equal(t, "c", fused[0].ID)
+		assert.Len(t, fused, 5)
+		assert.Equal(t, "content c", fused[0].Content)
+	})
+
+	t.Run("truncates to top k", func(t *testing.T) {
+		fused := reciprocalRankFusion([][]internal_type.KnowledgeContextResult{
+			results("a", "b", "c"),
+			results("b", "a", "c"),
+		}, 2)
+		assert.Len(t, fused, 2)
+		assert.ElementsMatch(t, []string{"a", "b"}, ids(fused))
+	})
+
+	t.Run("empty rankings", func(t *testing.T) {
+		assert.Empty(t, reciprocalRankFusion(nil, 4))
+	})
+}
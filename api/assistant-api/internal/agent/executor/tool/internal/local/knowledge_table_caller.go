@@ -0,0 +1,145 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_tool_local
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	internal_tool "github.com/rapidaai/api/assistant-api/internal/agent/executor/tool/internal"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	protos "github.com/rapidaai/protos"
+)
+
+// knowledgeTableToolCaller answers questions over a tabular knowledge document, the llm translates
+// the question into column filters which are executed as a parameterized query
+type knowledgeTableToolCaller struct {
+	toolCaller
+	table   *internal_knowledge_gorm.KnowledgeTable
+	maxRows uint32
+}
+
+// Definition describes the columns of the table so the llm can only filter on known columns
+func (tc *knowledgeTableToolCaller) Definition() (*protos.FunctionDefinition, error) {
+	columns := make([]string, 0, len(tc.table.Columns))
+	described := make([]string, 0, len(tc.table.Columns))
+	for _, c := range tc.table.Columns {
+		columns = append(columns, c.Name)
+		described = append(described, fmt.Sprintf("%s (%s)", c.Name, c.Type))
+	}
+	description := fmt.Sprintf("Look up exact rows of the table %s. Columns: %s.", tc.table.Name, strings.Join(described, ", "))
+	if tc.toolOptions.Description != nil && *tc.toolOptions.Description != "" {
+		description = *tc.toolOptions.Description + "\n" + description
+	}
+	return &protos.FunctionDefinition{
+		Name:        tc.Name(),
+		Description: description,
+		Parameters: &protos.FunctionParameter{
+			Type: "object",
+			Properties: map[string]*protos.FunctionParameterProperty{
+				"filters": {
+					Type:        "array",
+					Description: "Conditions the rows must all match, use contains for partial text matches and in for a list of values.",
+					Items: &protos.FunctionParameter{
+						Type:     "object",
+						Required: []string{"column", "operator", "value"},
+						Properties: map[string]*protos.FunctionParameterProperty{
+							"column":   {Type: "string", Enum: columns},
+							"operator": {Type: "string", Enum: internal_type.KnowledgeTableOperators},
+							"value":    {Type: "string", Description: "Value to compare with, a comma separated list for in."},
+						},
+					},
+				},
+				"columns": {
+					Type:        "array",
+					Description: "Columns to return, all columns when empty.",
+					Items:       &protos.FunctionParameter{Type: "string"},
+				},
+				"order_by":   {Type: "string", Enum: columns, Description: "Column to sort the rows by."},
+				"descending": {Type: "boolean", Description: "Sort from the highest to the lowest value."},
+				"limit":      {Type: "integer", Description: fmt.Sprintf("Maximum rows to return, at most %d.", tc.maxRows)},
+			},
+		},
+	}, nil
+}
+
+func (tc *knowledgeTableToolCaller) argument(args string) (*internal_type.KnowledgeTableQuery, error) {
+	var query internal_type.KnowledgeTableQuery
+	if err := json.Unmarshal([]byte(args), &query); err != nil {
+		tc.logger.Debugf("illegal input from llm for table query %v", args)
+		return nil, err
+	}
+	for idx, filter := range query.Filters {
+		if value, ok := filter.Value.(string); ok && filter.Operator == "in" {
+			items := make([]interface{}, 0)
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+			query.Filters[idx].Value = items
+		}
+	}
+	return &query, nil
+}
+
+func (tc *knowledgeTableToolCaller) Call(ctx context.Context, pkt internal_type.LLMPacket, toolId string, args string, communication internal_type.Communication) internal_type.LLMToolPacket {
+	query, err := tc.argument(args)
+	if err != nil {
+		return internal_type.LLMToolPacket{Name: tc.Name(), ContextID: pkt.ContextId(), Action: protos.AssistantConversationAction_KNOWLEDGE_RETRIEVAL, Result: tc.Result("Arguments are not valid, filters must be a list of column, operator and value.", false)}
+	}
+	rows, err := communication.QueryKnowledgeTable(tc.table, pkt.ContextId(), query, tc.maxRows)
+	if err != nil {
+		// the error names the offending column or value so the llm can correct the call
+		return internal_type.LLMToolPacket{Name: tc.Name(), ContextID: pkt.ContextId(), Action: protos.AssistantConversationAction_KNOWLEDGE_RETRIEVAL, Result: tc.Result(fmt.Sprintf("Unable to query the table: %v", err), false)}
+	}
+	if len(rows) == 0 {
+		return internal_type.LLMToolPacket{Name: tc.Name(), ContextID: pkt.ContextId(), Action: protos.AssistantConversationAction_KNOWLEDGE_RETRIEVAL, Result: tc.Result("No rows matched the given filters.", true)}
+	}
+	out, err := json.Marshal(rows)
+	if err != nil {
+		return internal_type.LLMToolPacket{Name: tc.Name(), ContextID: pkt.ContextId(), Action: protos.AssistantConversationAction_KNOWLEDGE_RETRIEVAL, Result: tc.Result("Unable to read the matched rows.", false)}
+	}
+	return internal_type.LLMToolPacket{Name: tc.Name(), ContextID: pkt.ContextId(), Action: protos.AssistantConversationAction_KNOWLEDGE_RETRIEVAL, Result: tc.Result(string(out), true)}
+}
+
+func NewKnowledgeTableToolCaller(
+	logger commons.Logger,
+	toolOptions *internal_assistant_entity.AssistantTool,
+	communcation internal_type.Communication,
+) (internal_tool.ToolCaller, error) {
+	opts := toolOptions.GetOptions()
+	knowledgeID, err := opts.GetUint64("tool.knowledge_id")
+	if err != nil {
+		return nil, fmt.Errorf("tool.knowledge_id is not a valid number: %v", err)
+	}
+	knowledgeDocumentID, err := opts.GetUint64("tool.knowledge_document_id")
+	if err != nil {
+		return nil, fmt.Errorf("tool.knowledge_document_id is not a valid number: %v", err)
+	}
+	table, err := communcation.GetKnowledgeTable(knowledgeID, knowledgeDocumentID)
+	if err != nil {
+		logger.Errorf("error while getting knowledge table %v", err)
+		return nil, err
+	}
+	maxRows, err := opts.GetUint32("tool.max_rows")
+	if err != nil || maxRows == 0 {
+		maxRows = 20
+	}
+	return &knowledgeTableToolCaller{
+		toolCaller: toolCaller{
+			logger:      logger,
+			toolOptions: toolOptions,
+		},
+		table:   table,
+		maxRows: maxRows,
+	}, nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_tool_local
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	internal_tool "github.com/rapidaai/api/assistant-api/internal/agent/executor/tool/internal"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// sendSmsCaller sends a follow-up sms to the user of the conversation with the sms deployment of
+// the assistant, the text is written by the llm or fixed by the tool, eg: a booking link
+type sendSmsCaller struct {
+	toolCaller
+	message string
+}
+
+// Definition asks the llm for the text unless the tool fixes it
+func (sc *sendSmsCaller) Definition() (*protos.FunctionDefinition, error) {
+	description := "Send a text message to the user, for example a link or details the user asked to receive in writing."
+	if sc.toolOptions.Description != nil && *sc.toolOptions.Description != "" {
+		description = *sc.toolOptions.Description
+	}
+	definition := &protos.FunctionDefinition{
+		Name:        sc.Name(),
+		Description: description,
+		Parameters: &protos.FunctionParameter{
+			Type:       "object",
+			Properties: map[string]*protos.FunctionParameterProperty{},
+		},
+	}
+	if sc.message == "" {
+		definition.Parameters.Required = []string{"message"}
+		definition.Parameters.Properties["message"] = &protos.FunctionParameterProperty{Type: "string", Description: "The text message to send to the user."}
+	}
+	return definition, nil
+}
+
+func (sc *sendSmsCaller) Call(ctx context.Context, pkt internal_type.LLMPacket, toolId string, args string, communication internal_type.Communication) internal_type.LLMToolPacket {
+	message := sc.message
+	if message == "" {
+		var argument struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal([]byte(args), &argument); err != nil || strings.TrimSpace(argument.Message) == "" {
+			sc.logger.Debugf("illegal input from llm for send sms %v", args)
+			return internal_type.LLMToolPacket{Name: sc.Name(), ContextID: pkt.ContextId(), Result: sc.Result("message is required to send a text message.", false)}
+		}
+		message = argument.Message
+	}
+
+	to := recipient(communication)
+	if to == "" {
+		return internal_type.LLMToolPacket{Name: sc.Name(), ContextID: pkt.ContextId(), Result: sc.Result("The number of the user is not known, the text message can not be sent.", false)}
+	}
+	if err := communication.SendSms(ctx, to, message); err != nil {
+		sc.logger.Errorf("unable to send sms of the tool %s: %v", sc.Name(), err)
+		return internal_type.LLMToolPacket{Name: sc.Name(), ContextID: pkt.ContextId(), Result: sc.Result("The text message could not be sent.", false)}
+	}
+	return internal_type.LLMToolPacket{Name: sc.Name(), ContextID: pkt.ContextId(), Result: sc.Result("The text message was sent to the user.", true)}
+}
+
+// recipient is the number of the user, the called number of an outbound conversation and the
+// calling number of an inbound one
+func recipient(communication internal_type.Communication) string {
+	prefix := "telephony"
+	if communication.Source() == utils.SMS {
+		prefix = "sms"
+	}
+	key := prefix + ".fromPhone"
+	if conversation := communication.Conversation(); conversation != nil && conversation.Direction == type_enums.DIRECTION_OUTBOUND {
+		key = prefix + ".toPhone"
+	}
+	number, _ := communication.GetMetadata()[key].(string)
+	return strings.TrimSpace(number)
+}
+
+func NewSendSmsCaller(logger commons.Logger, toolOptions *internal_assistant_entity.AssistantTool, communication internal_type.Communication,
+) (internal_tool.ToolCaller, error) {
+	message, _ := toolOptions.GetOptions().GetString("tool.message")
+	return &sendSmsCaller{
+		toolCaller: toolCaller{
+			logger:      logger,
+			toolOptions: toolOptions,
+		},
+		message: strings.TrimSpace(message),
+	}, nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_tool_local
+
+import (
+	"context"
+	"encoding/json"
+
+	internal_tool "github.com/rapidaai/api/assistant-api/internal/agent/executor/tool/internal"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// sensitiveCaptureCaller lets the llm pause the recording and mask the transcript before asking
+// for sensitive information such as card numbers, and resume once it has been collected
+type sensitiveCaptureCaller struct {
+	toolCaller
+}
+
+// Definition always exposes the active argument the conversation toggles on
+func (sc *sensitiveCaptureCaller) Definition() (*protos.FunctionDefinition, error) {
+	description := "Start sensitive capture before asking the user for payment or personal details and stop it once they have been given. While active the user is not recorded and what they say is hidden from you."
+	if sc.toolOptions.Description != nil && *sc.toolOptions.Description != "" {
+		description = *sc.toolOptions.Description
+	}
+	return &protos.FunctionDefinition{
+		Name:        sc.Name(),
+		Description: description,
+		Parameters: &protos.FunctionParameter{
+			Type:     "object",
+			Required: []string{"active"},
+			Properties: map[string]*protos.FunctionParameterProperty{
+				"active": {Type: "boolean", Description: "true to start sensitive capture, false to stop it."},
+			},
+		},
+	}, nil
+}
+
+func (sc *sensitiveCaptureCaller) Call(ctx context.Context, pkt internal_type.LLMPacket, toolId string, args string, communication internal_type.Communication) internal_type.LLMToolPacket {
+	var argument struct {
+		Active *bool `json:"active"`
+	}
+	if err := json.Unmarshal([]byte(args), &argument); err != nil || argument.Active == nil {
+		sc.logger.Debugf("illegal input from llm for sensitive capture %v", args)
+		return internal_type.LLMToolPacket{Name: sc.Name(), ContextID: pkt.ContextId(), Result: sc.Result("active is required to start or stop sensitive capture.", false)}
+	}
+
+	// the provider records the whole call, what the user says would be kept in its recording
+	if *argument.Active && providerRecorded(communication) {
+		sc.logger.Warnf("sensitive capture refused, the call is recorded by the provider")
+		return internal_type.LLMToolPacket{Name: sc.Name(), ContextID: pkt.ContextId(), Result: sc.Result("Sensitive capture is not available as the call is recorded, do not ask the user for payment or personal details.", false)}
+	}
+
+	msg := "Sensitive capture stopped, the user is recorded again."
+	if *argument.Active {
+		msg = "Sensitive capture started, what the user says next is hidden until it is stopped."
+	}
+	result := sc.Result(msg, true)
+	result["active"] = *argument.Active
+	return internal_type.LLMToolPacket{Name: sc.Name(), ContextID: pkt.ContextId(), Action: protos.AssistantConversationAction_SENSITIVE_CAPTURE, Result: result}
+}
+
+// providerRecorded tells whether the provider records the call of the phone deployment
+func providerRecorded(communication internal_type.Communication) bool {
+	if communication.Source() != utils.PhoneCall {
+		return false
+	}
+	assistant := communication.Assistant()
+	if assistant == nil || assistant.AssistantPhoneDeployment == nil {
+		return false
+	}
+	return internal_type.IsProviderRecordingEnabled(assistant.AssistantPhoneDeployment.GetOptions())
+}
+
+func NewSensitiveCaptureCaller(logger commons.Logger, toolOptions *internal_assistant_entity.AssistantTool, communcation internal_type.Communication,
+) (internal_tool.ToolCaller, error) {
+	return &sensitiveCaptureCaller{
+		toolCaller: toolCaller{
+			logger:      logger,
+			toolOptions: toolOptions,
+		},
+	}, nil
+}
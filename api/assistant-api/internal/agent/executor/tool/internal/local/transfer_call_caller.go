@@ -0,0 +1,64 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_tool_local
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	internal_tool "github.com/rapidaai/api/assistant-api/internal/agent/executor/tool/internal"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+)
+
+// transferCallCaller hands the caller over to the destination of the tool, a number or a sip
+// uri, the telephony of the call carries out the transfer
+type transferCallCaller struct {
+	toolCaller
+	transferTo string
+}
+
+// Definition describes the transfer when the tool has no description of its own
+func (tc *transferCallCaller) Definition() (*protos.FunctionDefinition, error) {
+	definition, err := tc.toolCaller.Definition()
+	if err != nil {
+		return nil, err
+	}
+	if definition.Description == "" {
+		definition.Description = "Transfer the call to a human agent when the user asks for one or you can not help any further."
+	}
+	return definition, nil
+}
+
+func (tc *transferCallCaller) Call(ctx context.Context, pkt internal_type.LLMPacket, toolId string, args string, communication internal_type.Communication) internal_type.LLMToolPacket {
+	// the telephony of the call is only known once the call is connected
+	provider, _ := communication.GetMetadata()["telephony.provider"].(string)
+	if !internal_type.IsTransferSupported(provider) {
+		tc.logger.Warnf("transfer of the tool %s is not supported on telephony %q", tc.Name(), provider)
+		return internal_type.LLMToolPacket{Name: tc.Name(), ContextID: pkt.ContextId(), Result: tc.Result("The call can not be transferred.", false)}
+	}
+	result := tc.Result("Transferring the call.", true)
+	result["to"] = tc.transferTo
+	return internal_type.LLMToolPacket{Name: tc.Name(), ContextID: pkt.ContextId(), Action: protos.AssistantConversationAction_TRANSFER_CONVERSATION, Result: result}
+}
+
+func NewTransferCallCaller(logger commons.Logger, toolOptions *internal_assistant_entity.AssistantTool, communication internal_type.Communication,
+) (internal_tool.ToolCaller, error) {
+	transferTo, err := toolOptions.GetOptions().GetString("tool.transfer_to")
+	if err != nil || strings.TrimSpace(transferTo) == "" {
+		return nil, fmt.Errorf("tool.transfer_to is required to transfer the call")
+	}
+	return &transferCallCaller{
+		toolCaller: toolCaller{
+			logger:      logger,
+			toolOptions: toolOptions,
+		},
+		transferTo: strings.TrimSpace(transferTo),
+	}, nil
+}
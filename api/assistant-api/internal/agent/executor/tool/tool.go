@@ -31,6 +31,7 @@ import (
 type toolExecutor struct {
 	logger                 commons.Logger
 	tools                  map[string]internal_tool.ToolCaller
+	fillers                map[string]string
 	availableToolFunctions []*protos.FunctionDefinition
 	mcpClients             []*internal_tool_mcp.Client
 }
@@ -40,13 +41,17 @@ func NewToolExecutor(logger commons.Logger) internal_agent_executor.ToolExecutor
 		logger:                 logger,
 		mcpClients:             make([]*internal_tool_mcp.Client, 0),
 		tools:                  make(map[string]internal_tool.ToolCaller),
+		fillers:                make(map[string]string),
 		availableToolFunctions: make([]*protos.FunctionDefinition, 0),
 	}
 }
 
-// registerTool safely registers a tool caller and its definition
-func (executor *toolExecutor) registerTool(caller internal_tool.ToolCaller, def *protos.FunctionDefinition) {
+// registerTool safely registers a tool caller, its definition and the message spoken while it executes
+func (executor *toolExecutor) registerTool(caller internal_tool.ToolCaller, def *protos.FunctionDefinition, filler string) {
 	executor.tools[caller.Name()] = caller
+	if filler != "" {
+		executor.fillers[caller.Name()] = filler
+	}
 	executor.availableToolFunctions = append(executor.availableToolFunctions, def)
 }
 
@@ -61,6 +66,8 @@ func (executor *toolExecutor) initializeLocalTool(logger commons.Logger, toolOpt
 	switch toolOpts.ExecutionMethod {
 	case "knowledge_retrieval":
 		return internal_tool_local.NewKnowledgeRetrievalToolCaller(logger, toolOpts, communication)
+	case "knowledge_table_query":
+		return internal_tool_local.NewKnowledgeTableToolCaller(logger, toolOpts, communication)
 	case "api_request":
 		return internal_tool_local.NewApiRequestToolCaller(logger, toolOpts, communication)
 	case "endpoint":
@@ -69,6 +76,14 @@ func (executor *toolExecutor) initializeLocalTool(logger commons.Logger, toolOpt
 		return internal_tool_local.NewPutOnHoldToolCaller(logger, toolOpts, communication)
 	case "end_of_conversation":
 		return internal_tool_local.NewEndOfConversationCaller(logger, toolOpts, communication)
+	case "sensitive_capture":
+		return internal_tool_local.NewSensitiveCaptureCaller(logger, toolOpts, communication)
+	case "transfer_call":
+		return internal_tool_local.NewTransferCallCaller(logger, toolOpts, communication)
+	case "conference":
+		return internal_tool_local.NewConferenceCaller(logger, toolOpts, communication)
+	case "send_sms":
+		return internal_tool_local.NewSendSmsCaller(logger, toolOpts, communication)
 	default:
 		return nil, errors.New("illegal tool action provided")
 	}
@@ -91,7 +106,7 @@ func (executor *toolExecutor) initializeTools(ctx context.Context, tools []*inte
 			for i, def := range definitions {
 				caller := internal_tool_mcp.NewMCPToolCaller(executor.logger, client, tool.Id+uint64(i), def.Name, def)
 				tracer.AddAttributes(ctx, internal_adapter_telemetry.KV{K: caller.Name(), V: internal_adapter_telemetry.StringValue(caller.ExecutionMethod())})
-				executor.registerTool(caller, def)
+				executor.registerTool(caller, def, tool.GetFillerMessage())
 			}
 		default:
 			caller, err := executor.initializeLocalTool(executor.logger, tool, communication)
@@ -107,7 +122,7 @@ func (executor *toolExecutor) initializeTools(ctx context.Context, tools []*inte
 			}
 
 			tracer.AddAttributes(communication.Context(), internal_adapter_telemetry.KV{K: caller.Name(), V: internal_adapter_telemetry.StringValue(caller.ExecutionMethod())})
-			executor.registerTool(caller, def)
+			executor.registerTool(caller, def, tool.GetFillerMessage())
 		}
 
 	}
@@ -189,6 +204,15 @@ func (executor *toolExecutor) ExecuteAll(ctx context.Context, message internal_t
 	contents := make([]internal_type.Packet, 0, len(calls))
 	result := make([]*types.Content, 0, len(calls))
 
+	// callers hear the filler of the tool while waiting for the result
+	for _, xt := range calls {
+		communication.OnPacket(ctx, internal_type.LLMToolCallPacket{
+			Name:      xt.GetFunction().GetName(),
+			ContextID: message.ContextId(),
+			Filler:    executor.fillers[xt.GetFunction().GetName()],
+		})
+	}
+
 	var wg sync.WaitGroup
 	for _, xt := range calls {
 		xtCopy := xt
@@ -24,19 +24,28 @@ import (
 // - in: An object of type O, representing the input to be reranked.
 // - query: A string representing the query against which the reranking is performed.
 //
-// The method returns a slice of reranked objects of type O and an error if any occurs during the process.
+// The method returns the reranked objects of type O ordered by relevance (most relevant first)
+// and an error if any occurs during the process.
 
 type RerankingOption struct {
-	ProviderCredential protos.VaultCredential
+	ProviderCredential *protos.VaultCredential
 	ModelProviderName  string
 	ModelProviderId    uint64
 	Options            map[string]interface{}
 }
+
+// RerankingResult is a reranked object, Index is the position of the object in the input
+type RerankingResult[O any] struct {
+	Index   int32
+	Content O
+	Score   float64
+}
+
 type Reranking[O any] interface {
 	Rerank(ctx context.Context,
 		auth types.SimplePrinciple,
 		config *RerankingOption,
-		in []O, query string, additionalData map[string]string) (map[int32]O, error)
+		in []O, query string, additionalData map[string]string) ([]RerankingResult[O], error)
 }
 
 type TextReranking interface {
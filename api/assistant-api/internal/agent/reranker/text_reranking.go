@@ -7,6 +7,7 @@ package internal_agent_rerankers
 
 import (
 	"context"
+	"sort"
 
 	"github.com/rapidaai/api/assistant-api/config"
 	integration_client "github.com/rapidaai/pkg/clients/integration"
@@ -34,7 +35,7 @@ func NewTextReranker(logger commons.Logger, cfg *config.AssistantConfig, redis c
 func (qe *textReranker) Rerank(ctx context.Context,
 	auth types.SimplePrinciple,
 	config *RerankingOption,
-	in []string, query string, additionalData map[string]string) (map[int32]string, error) {
+	in []string, query string, additionalData map[string]string) ([]RerankingResult[string], error) {
 
 	contents := make(map[int32]*protos.Content)
 	for idx, s := range in {
@@ -45,28 +46,41 @@ func (qe *textReranker) Rerank(ctx context.Context,
 		}
 	}
 
+	request := qe.inputBuilder.Reranking(
+		qe.
+			inputBuilder.
+			Credential(config.ProviderCredential.GetId(), config.ProviderCredential.GetValue()),
+		qe.
+			inputBuilder.
+			Options(config.Options, nil),
+		additionalData,
+		contents,
+	)
+	request.Query = query
 	res, err := qe.integrationCaller.Reranking(ctx,
 		auth,
 		config.ModelProviderName,
-		qe.inputBuilder.Reranking(
-			qe.
-				inputBuilder.
-				Credential(config.ProviderCredential.GetId(), config.ProviderCredential.GetValue()),
-			qe.
-				inputBuilder.
-				Options(config.Options, nil),
-			additionalData,
-			contents,
-		))
+		request)
 	if err != nil {
 		qe.logger.Errorf("Error while building embedding request for text query %v", err)
 		return nil, err
 	}
 
 	reranked := res.GetData()
-	output := make(map[int32]string, len(reranked))
+	output := make([]RerankingResult[string], 0, len(reranked))
 	for _, rk := range reranked {
-		output[rk.GetIndex()] = types.ContentString(rk.GetContent())
+		// providers keep the input position, chunks dropped by top n are left empty
+		if rk == nil {
+			continue
+		}
+		output = append(output, RerankingResult[string]{
+			Index:   rk.GetIndex(),
+			Content: types.ContentString(rk.GetContent()),
+			Score:   rk.GetRelevanceScore(),
+		})
 	}
+	sort.SliceStable(output, func(i, j int) bool {
+		return output[i].Score > output[j].Score
+	})
 	return output, nil
 }
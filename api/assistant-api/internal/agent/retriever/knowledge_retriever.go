@@ -0,0 +1,145 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_agent_retriever
+
+import (
+	"context"
+	"fmt"
+
+	internal_agent_embeddings "github.com/rapidaai/api/assistant-api/internal/agent/embedding"
+	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/types"
+)
+
+const (
+	defaultTopK           = 4
+	defaultScoreThreshold = 0.5
+)
+
+type knowledgeRetriever struct {
+	logger        commons.Logger
+	vectordb      connectors.VectorConnector
+	queryEmbedder internal_agent_embeddings.QueryEmbedding
+}
+
+func NewKnowledgeRetriever(logger commons.Logger, vectordb connectors.VectorConnector, queryEmbedder internal_agent_embeddings.QueryEmbedding) KnowledgeRetriever {
+	return &knowledgeRetriever{
+		logger:        logger,
+		vectordb:      vectordb,
+		queryEmbedder: queryEmbedder,
+	}
+}
+
+func (kr *knowledgeRetriever) Retrieve(ctx context.Context,
+	auth types.SimplePrinciple,
+	knowledge *internal_knowledge_gorm.Knowledge,
+	query string,
+	filter map[string]interface{},
+	kc *internal_type.KnowledgeRetrieveOption) ([]internal_type.KnowledgeContextResult, error) {
+	topK := int(defaultTopK)
+	if kc.TopK != 0 {
+		topK = int(kc.TopK)
+	}
+	minScore := float32(defaultScoreThreshold)
+	if kc.ScoreThreshold != 0 {
+		minScore = float32(kc.ScoreThreshold)
+	}
+	Results := make([]internal_type.KnowledgeContextResult, 0)
+	//
+	switch kc.RetrievalMethod {
+	case "hybrid-search", "hybrid":
+		embeddings, err := kr.queryEmbedder.TextQueryEmbedding(ctx, auth, query, kr.embeddingOption(knowledge, kc))
+		if err != nil {
+			kr.logger.Errorf("Unable to get query embedding from integration for query %s error %v", query, err)
+			return Results, err
+		}
+		matchedContents, err := kr.vectordb.HybridSearch(ctx,
+			knowledge.StorageNamespace,
+			query,
+			embeddings.Data[len(embeddings.Data)-1].GetEmbedding(),
+			filter,
+			connectors.NewDefaultVectorSearchOptions(
+				connectors.WithMinScore(minScore),
+				connectors.WithSource([]string{"text", "document_id", "metadata"}),
+				connectors.WithTopK(topK)))
+		if err != nil {
+			kr.logger.Errorf("Unable to get result from the vector dataset for given %s error %v", query, err)
+			return Results, err
+		}
+		return append(Results, toResults(matchedContents)...), nil
+
+	case "semantic-search", "semantic":
+		embeddings, err := kr.queryEmbedder.TextQueryEmbedding(ctx, auth, query, kr.embeddingOption(knowledge, kc))
+		if err != nil {
+			kr.logger.Errorf("Unable to get query embedding from integration for query %s error %v", query, err)
+			return Results, err
+		}
+
+		matchedContents, err := kr.vectordb.VectorSearch(
+			ctx,
+			knowledge.StorageNamespace,
+			embeddings.Data[len(embeddings.Data)-1].GetEmbedding(),
+			filter,
+			connectors.NewDefaultVectorSearchOptions(
+				connectors.WithSource([]string{"text", "document_id", "metadata"}),
+				connectors.WithMinScore(minScore), connectors.WithTopK(topK)),
+		)
+		if err != nil {
+			kr.logger.Errorf("Unable to get result from the vector dataset for given %s error %v", query, err)
+			return Results, err
+		}
+		return append(Results, toResults(matchedContents)...), nil
+
+	case "text-search", "text", "full-text-search", "fullText":
+		matchedContents, err := kr.vectordb.TextSearch(
+			ctx,
+			knowledge.StorageNamespace,
+			query,
+			filter,
+			connectors.NewDefaultVectorSearchOptions(
+				connectors.WithSource([]string{"text", "document_id", "metadata"}),
+				connectors.WithMinScore(minScore),
+				connectors.WithTopK(topK)))
+		if err != nil {
+			kr.logger.Errorf("Unable to get result from the vector dataset for given %s error %v", query, err)
+			return Results, nil
+		}
+		return append(Results, toResults(matchedContents)...), nil
+
+	default:
+		kr.logger.Errorf("retrieve method is unexpected")
+		return Results, fmt.Errorf("retrieve method is unexpected")
+	}
+}
+
+func (kr *knowledgeRetriever) embeddingOption(knowledge *internal_knowledge_gorm.Knowledge, kc *internal_type.KnowledgeRetrieveOption) *internal_agent_embeddings.TextEmbeddingOption {
+	return &internal_agent_embeddings.TextEmbeddingOption{
+		ProviderCredential: kc.EmbeddingProviderCredential,
+		ModelProviderName:  knowledge.EmbeddingModelProviderName,
+		Options:            knowledge.GetOptions(),
+		AdditionalData: map[string]string{
+			"knowledge_id": fmt.Sprintf("%d", knowledge.Id),
+		},
+	}
+}
+
+func toResults(matchedContents []map[string]interface{}) []internal_type.KnowledgeContextResult {
+	results := make([]internal_type.KnowledgeContextResult, 0, len(matchedContents))
+	for _, x := range matchedContents {
+		source := x["_source"].(map[string]interface{})
+		results = append(results, internal_type.KnowledgeContextResult{
+			ID:         x["_id"].(string),
+			DocumentID: source["document_id"].(string),
+			Metadata:   source["metadata"].(map[string]interface{}),
+			Content:    source["text"].(string),
+			Score:      x["_score"].(float64),
+		})
+	}
+	return results
+}
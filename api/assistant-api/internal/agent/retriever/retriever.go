@@ -0,0 +1,28 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_agent_retriever
+
+import (
+	"context"
+
+	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/types"
+)
+
+// KnowledgeRetriever searches the storage namespace of a knowledge with the
+// retrieval method given in the option (text, semantic or hybrid).
+//
+// It is shared by the conversation (tool and prompt injection) and the
+// knowledge evaluation so both measure exactly the same retrieval path.
+type KnowledgeRetriever interface {
+	Retrieve(ctx context.Context,
+		auth types.SimplePrinciple,
+		knowledge *internal_knowledge_gorm.Knowledge,
+		query string,
+		filter map[string]interface{},
+		kc *internal_type.KnowledgeRetrieveOption) ([]internal_type.KnowledgeContextResult, error)
+}
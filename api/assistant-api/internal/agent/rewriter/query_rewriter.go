@@ -0,0 +1,173 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_agent_rewriter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	integration_client "github.com/rapidaai/pkg/clients/integration"
+	integration_client_builders "github.com/rapidaai/pkg/clients/integration/builders"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/protos"
+)
+
+const (
+	defaultHistorySize = 6
+	maxQueryCount      = 5
+
+	rewritePrompt = `You rewrite the latest user query into a standalone search query for a knowledge base.
+Resolve pronouns and references such as "it", "that one" or "the second one" using the conversation.
+Do not answer the query. Respond with the rewritten query only, on a single line.`
+
+	multiQueryPrompt = `You generate search queries for a knowledge base.
+Resolve pronouns and references in the latest user query using the conversation.
+On the first line write the standalone rewritten query, then write %d alternative queries that
+approach the same information need from different angles, one per line.
+Do not number the lines and do not answer the query.`
+
+	hydePrompt = `You help search a knowledge base.
+Resolve pronouns and references in the latest user query using the conversation.
+On the first line write the standalone rewritten query, then write %d short hypothetical passages
+(one to two sentences each) that would answer it as if taken from a reference document, one per line.
+Do not number the lines.`
+)
+
+type queryRewriter struct {
+	logger            commons.Logger
+	integrationCaller integration_client.IntegrationServiceClient
+	inputBuilder      integration_client_builders.InputChatBuilder
+}
+
+func NewQueryRewriter(logger commons.Logger, integrationCaller integration_client.IntegrationServiceClient) QueryRewriter {
+	return &queryRewriter{
+		logger:            logger,
+		integrationCaller: integrationCaller,
+		inputBuilder:      integration_client_builders.NewChatInputBuilder(logger),
+	}
+}
+
+func (qr *queryRewriter) Rewrite(ctx context.Context,
+	auth types.SimplePrinciple,
+	query string,
+	histories []internal_type.MessagePacket,
+	opts *QueryRewriteOption) ([]string, error) {
+	queries := []string{query}
+	res, err := qr.integrationCaller.Chat(ctx,
+		auth,
+		opts.ModelProviderName,
+		qr.inputBuilder.Chat(
+			qr.inputBuilder.Credential(opts.ProviderCredential.GetId(), opts.ProviderCredential.GetValue()),
+			qr.inputBuilder.Options(opts.Options, nil),
+			nil,
+			opts.AdditionalData,
+			message("system", qr.instruction(opts)),
+			message("user", qr.conversation(query, histories, opts)),
+		))
+	if err != nil {
+		qr.logger.Errorf("error while rewriting query %s: %v", query, err)
+		return queries, err
+	}
+	if !res.GetSuccess() || res.GetData() == nil {
+		qr.logger.Warnf("unable to rewrite query %s: %s", query, res.GetError().GetHumanMessage())
+		return queries, fmt.Errorf("query rewrite failed: %s", res.GetError().GetHumanMessage())
+	}
+	return parseQueries(query, types.OnlyStringProtoContent(res.GetData().GetContents()), opts), nil
+}
+
+func (qr *queryRewriter) instruction(opts *QueryRewriteOption) string {
+	switch opts.Mode {
+	case QueryRewriteModeMultiQuery:
+		return fmt.Sprintf(multiQueryPrompt, queryCount(opts))
+	case QueryRewriteModeHyDE:
+		return fmt.Sprintf(hydePrompt, queryCount(opts))
+	default:
+		return rewritePrompt
+	}
+}
+
+// conversation renders the most recent turns followed by the query to rewrite
+func (qr *queryRewriter) conversation(query string, histories []internal_type.MessagePacket, opts *QueryRewriteOption) string {
+	historySize := defaultHistorySize
+	if opts.HistorySize != 0 {
+		historySize = int(opts.HistorySize)
+	}
+	if len(histories) > historySize {
+		histories = histories[len(histories)-historySize:]
+	}
+	var builder strings.Builder
+	builder.WriteString("Conversation:\n")
+	for _, h := range histories {
+		content := strings.TrimSpace(h.Content())
+		if content == "" {
+			continue
+		}
+		builder.WriteString(h.Role())
+		builder.WriteString(": ")
+		builder.WriteString(content)
+		builder.WriteString("\n")
+	}
+	builder.WriteString("\nLatest user query: ")
+	builder.WriteString(query)
+	return builder.String()
+}
+
+func message(role, text string) *protos.Message {
+	return &protos.Message{
+		Role: role,
+		Contents: []*protos.Content{{
+			ContentType:   commons.TEXT_CONTENT.String(),
+			ContentFormat: commons.TEXT_CONTENT_FORMAT_RAW.String(),
+			Content:       []byte(text),
+		}},
+	}
+}
+
+func queryCount(opts *QueryRewriteOption) int {
+	if opts.QueryCount == 0 {
+		return 1
+	}
+	return min(int(opts.QueryCount), maxQueryCount)
+}
+
+// listMarker is the numbering or bullet the llm puts before a line, digits of the query itself
+// are kept
+var listMarker = regexp.MustCompile(`^\s*(\d+[.)]|[-*•])\s+`)
+
+// parseQueries converts the line based llm output to queries, the first line is the
+// standalone rewrite and the rest are sub queries or hypothetical passages.
+func parseQueries(original, output string, opts *QueryRewriteOption) []string {
+	limit := 1
+	if opts.Mode == QueryRewriteModeMultiQuery || opts.Mode == QueryRewriteModeHyDE {
+		limit += queryCount(opts)
+	}
+	queries := make([]string, 0, limit)
+	seen := make(map[string]struct{}, limit)
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(listMarker.ReplaceAllString(line, ""))
+		line = strings.Trim(line, `"`)
+		if line == "" {
+			continue
+		}
+		key := strings.ToLower(line)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		queries = append(queries, line)
+		if len(queries) == limit {
+			break
+		}
+	}
+	if len(queries) == 0 {
+		return []string{original}
+	}
+	return queries
+}
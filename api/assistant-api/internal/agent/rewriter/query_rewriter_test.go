@@ -0,0 +1,57 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_agent_rewriter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQueries(t *testing.T) {
+	tests := []struct {
+		name     string
+		output   string
+		opts     *QueryRewriteOption
+		expected []string
+	}{
+		{
+			name:     "rewrite keeps first line only",
+			output:   "pricing of the premium plan\nsomething else",
+			opts:     &QueryRewriteOption{Mode: QueryRewriteModeRewrite},
+			expected: []string{"pricing of the premium plan"},
+		},
+		{
+			name:     "multi query strips numbering and duplicates",
+			output:   "premium plan price\n1. premium plan price\n2) cost of premium subscription\n- premium monthly fee\n\n",
+			opts:     &QueryRewriteOption{Mode: QueryRewriteModeMultiQuery, QueryCount: 3},
+			expected: []string{"premium plan price", "cost of premium subscription", "premium monthly fee"},
+		},
+		{
+			name:     "numbers of the query are kept",
+			output:   "2024 pricing\n1. 401k limits\n2) 3.5 percent rate\n- 10 day refunds",
+			opts:     &QueryRewriteOption{Mode: QueryRewriteModeMultiQuery, QueryCount: 3},
+			expected: []string{"2024 pricing", "401k limits", "3.5 percent rate", "10 day refunds"},
+		},
+		{
+			name:     "hyde limited to query count",
+			output:   "refund policy\n\"Refunds are issued within 14 days.\"\nRefunds require a receipt.\nExtra passage.",
+			opts:     &QueryRewriteOption{Mode: QueryRewriteModeHyDE, QueryCount: 1},
+			expected: []string{"refund policy", "Refunds are issued within 14 days."},
+		},
+		{
+			name:     "empty output falls back to original",
+			output:   "  \n ",
+			opts:     &QueryRewriteOption{Mode: QueryRewriteModeMultiQuery, QueryCount: 2},
+			expected: []string{"what about the second one?"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseQueries("what about the second one?", tt.output, tt.opts))
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_agent_rewriter
+
+import (
+	"context"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/types"
+	protos "github.com/rapidaai/protos"
+)
+
+// QueryRewriteMode decides what the rewriter produces in addition to the
+// standalone (history aware) version of the query.
+type QueryRewriteMode string
+
+const (
+	// QueryRewriteModeRewrite only resolves the query against conversation history.
+	QueryRewriteModeRewrite QueryRewriteMode = "rewrite"
+
+	// QueryRewriteModeMultiQuery generates N alternative phrasings of the query.
+	QueryRewriteModeMultiQuery QueryRewriteMode = "multi-query"
+
+	// QueryRewriteModeHyDE generates N hypothetical answer passages (HyDE).
+	QueryRewriteModeHyDE QueryRewriteMode = "hyde"
+)
+
+type QueryRewriteOption struct {
+	ProviderCredential *protos.VaultCredential
+	ModelProviderName  string
+	Options            map[string]interface{}
+	AdditionalData     map[string]string
+
+	// Mode of rewriting, defaults to QueryRewriteModeRewrite
+	Mode QueryRewriteMode
+
+	// number of sub queries or hypothetical passages for multi-query and hyde
+	QueryCount uint32
+
+	// number of most recent conversation turns used to resolve the query
+	HistorySize uint32
+}
+
+// QueryRewriter turns a (possibly context dependent) query into one or more
+// self-contained retrieval queries. The first query returned is always the
+// standalone rewrite of the original query; implementations must fall back to
+// the original query when rewriting is not possible.
+type QueryRewriter interface {
+	Rewrite(ctx context.Context,
+		auth types.SimplePrinciple,
+		query string,
+		histories []internal_type.MessagePacket,
+		opts *QueryRewriteOption) ([]string, error)
+}
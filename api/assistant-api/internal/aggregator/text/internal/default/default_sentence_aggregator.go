@@ -191,8 +191,15 @@ func (st *textAggregator) extractTextsByBoundaryLocked(contextId string) {
 		return
 	}
 
-	// Extract complete sentences up to the last boundary
-	lastBoundary := matches[len(matches)-1][1]
+	// Extract complete sentences up to the last boundary outside of SSML markup
+	splittable := splittablePositions(text)
+	lastBoundary := 0
+	for i := len(matches) - 1; i >= 0; i-- {
+		if splittable[matches[i][1]] {
+			lastBoundary = matches[i][1]
+			break
+		}
+	}
 	if lastBoundary > 0 {
 		completeText := strings.TrimSpace(text[:lastBoundary])
 		if completeText != "" {
@@ -210,6 +217,53 @@ func (st *textAggregator) extractTextsByBoundaryLocked(contextId string) {
 	}
 }
 
+// splittablePositions reports for every byte offset of the text whether a sentence may end
+// there. Text is never split inside a tag such as <break time="500ms"/> or inside an element
+// such as <sub alias="Doctor">Dr.</sub>, a tag which is not closed yet holds the rest of the text.
+func splittablePositions(text string) []bool {
+	splittable := make([]bool, len(text)+1)
+	depth := 0
+	for i := 0; i <= len(text); i++ {
+		splittable[i] = depth == 0
+		if i == len(text) || !isTagStart(text, i) {
+			continue
+		}
+		end := strings.IndexByte(text[i:], '>')
+		if end < 0 {
+			// incomplete tag, wait for the rest of it
+			for j := i + 1; j <= len(text); j++ {
+				splittable[j] = false
+			}
+			return splittable
+		}
+		tag := text[i : i+end+1]
+		switch {
+		case strings.HasPrefix(tag, "</"):
+			if depth > 0 {
+				depth--
+			}
+		case !strings.HasSuffix(tag, "/>"):
+			depth++
+		}
+		// the tag itself is never split, the position after it depends on the depth
+		for j := i + 1; j < i+end+1; j++ {
+			splittable[j] = false
+		}
+		i += end
+	}
+	return splittable
+}
+
+// isTagStart reports whether a tag starts at i, a < followed by a letter or a slash. Text such
+// as 3 < 4 is not a tag.
+func isTagStart(text string, i int) bool {
+	if text[i] != '<' || i+1 >= len(text) {
+		return false
+	}
+	next := text[i+1]
+	return next == '/' || (next >= 'a' && next <= 'z') || (next >= 'A' && next <= 'Z')
+}
+
 // Result returns a read-only channel that receives complete sentences.
 //
 // The channel is closed when Close() is called. Callers should use a range loop
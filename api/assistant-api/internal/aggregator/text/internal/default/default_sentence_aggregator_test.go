@@ -943,6 +943,66 @@ func TestLLMStreamingUnformattedButComplete(t *testing.T) {
 		t.Errorf("expected second result to be Flush message, got %T", results[1])
 	}
 }
+func TestSSMLMarkupIsNeverSplit(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+
+	testCases := []struct {
+		name     string
+		chunks   []string
+		expected []string
+	}{
+		{
+			name:     "boundary inside element",
+			chunks:   []string{`Call <sub alias="Doctor">Dr.</sub> Smith today.`},
+			expected: []string{`Call <sub alias="Doctor">Dr.</sub> Smith today.`},
+		},
+		{
+			name:     "boundary inside tag attribute",
+			chunks:   []string{`Wait <break time="0.5s"/> please. Thanks.`},
+			expected: []string{`Wait <break time="0.5s"/> please.`, `Thanks.`},
+		},
+		{
+			name:     "element across chunks",
+			chunks:   []string{`Visit <phoneme alphabet="ipa" ph="ræˈpiːdə">Rapida.`, ` AI</phoneme> now.`},
+			expected: []string{`Visit <phoneme alphabet="ipa" ph="ræˈpiːdə">Rapida. AI</phoneme> now.`},
+		},
+		{
+			name:     "tag across chunks",
+			chunks:   []string{`Hello. <sub alias="e.`, `g.">eg</sub> done.`},
+			expected: []string{`Hello.`, `<sub alias="e.g.">eg</sub> done.`},
+		},
+		{
+			name:     "less than is not a tag",
+			chunks:   []string{`Three < four. Yes.`},
+			expected: []string{`Three < four.`, `Yes.`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			aggregator, _ := NewDefaultLLMTextAggregator(t.Context(), logger, newMockOptions(".!?"))
+			ctx := context.Background()
+
+			go func() {
+				for _, chunk := range tc.chunks {
+					_ = aggregator.Aggregate(ctx, internal_type.LLMStreamPacket{ContextID: "llm", Text: chunk})
+				}
+				aggregator.Close()
+			}()
+
+			var sentences []string
+			for r := range aggregator.Result() {
+				if ts, ok := r.(internal_type.LLMStreamPacket); ok {
+					sentences = append(sentences, ts.Text)
+				}
+			}
+			if fmt.Sprint(sentences) != fmt.Sprint(tc.expected) {
+				t.Errorf("got %q, expected %q", sentences, tc.expected)
+			}
+		})
+	}
+}
+
 func TestStringRepresentation(t *testing.T) {
 	logger, _ := commons.NewApplicationLogger()
 	opts := newMockOptions(".")
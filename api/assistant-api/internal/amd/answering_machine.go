@@ -0,0 +1,325 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_amd
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+type Result string
+
+const (
+	Human   Result = "human"
+	Machine Result = "machine"
+	Unknown Result = "unknown"
+)
+
+// Action is what the assistant does when an answering machine picks up
+type Action string
+
+const (
+	// end the call without speaking
+	ActionHangup Action = "hangup"
+	// leave the voicemail message after the beep and end the call
+	ActionVoicemail Action = "voicemail"
+	// greet as if a person answered
+	ActionContinue Action = "continue"
+)
+
+// Step is what the assistant has to do next
+type Step string
+
+const (
+	StepGreet     Step = "greet"
+	StepHangup    Step = "hangup"
+	StepWaitBeep  Step = "wait_beep"
+	StepVoicemail Step = "voicemail"
+)
+
+const (
+	OptionsKeyEnabled          = "amd.enabled"
+	OptionsKeyAction           = "amd.action"
+	OptionsKeyVoicemailMessage = "amd.voicemail_message"
+
+	// the greeting of a machine is rarely longer, the message is left without the beep after it
+	defaultBeepTimeout = 30 * time.Second
+)
+
+// Config is the answering machine detection of a phone deployment
+type Config struct {
+	Action           Action
+	VoicemailMessage string
+	BeepTimeout      time.Duration
+	Thresholds       Thresholds
+}
+
+// NewConfig reads the detection from the options of the phone deployment, it reports false
+// when detection is not enabled.
+func NewConfig(opts utils.Option) (*Config, bool, error) {
+	if enabled, err := opts.GetBool(OptionsKeyEnabled); err != nil || !enabled {
+		return nil, false, nil
+	}
+	config := &Config{Action: ActionHangup, BeepTimeout: defaultBeepTimeout, Thresholds: DefaultThresholds()}
+	if action, err := opts.GetString(OptionsKeyAction); err == nil && action != "" {
+		switch Action(action) {
+		case ActionHangup, ActionVoicemail, ActionContinue:
+			config.Action = Action(action)
+		default:
+			return nil, false, fmt.Errorf("illegal answering machine action %s", action)
+		}
+	}
+	if message, err := opts.GetString(OptionsKeyVoicemailMessage); err == nil {
+		config.VoicemailMessage = strings.TrimSpace(message)
+	}
+	if config.Action == ActionVoicemail && config.VoicemailMessage == "" {
+		return nil, false, fmt.Errorf("voicemail message is required to leave a voicemail")
+	}
+	if timeout, err := opts.GetUint32("amd.beep_timeout"); err == nil && timeout > 0 {
+		config.BeepTimeout = time.Duration(timeout) * time.Second
+	}
+	if v, err := opts.GetUint32("amd.initial_silence"); err == nil && v > 0 {
+		config.Thresholds.InitialSilence = time.Duration(v) * time.Millisecond
+	}
+	if v, err := opts.GetUint32("amd.greeting"); err == nil && v > 0 {
+		config.Thresholds.Greeting = time.Duration(v) * time.Millisecond
+	}
+	if v, err := opts.GetUint32("amd.after_greeting_silence"); err == nil && v > 0 {
+		config.Thresholds.AfterGreetingSilence = time.Duration(v) * time.Millisecond
+	}
+	if v, err := opts.GetUint32("amd.total_analysis_time"); err == nil && v > 0 {
+		config.Thresholds.TotalAnalysis = time.Duration(v) * time.Millisecond
+	}
+	return config, true, nil
+}
+
+// Key is where the status callback stores the result reported by the telephony provider for
+// the session of the conversation to pick up
+func Key(assistantConversationId uint64) string {
+	return fmt.Sprintf("amd::%d", assistantConversationId)
+}
+
+// ProviderResult reads the result of the detection from a status callback, twilio reports it
+// as AnsweredBy and vonage as the status of the call. Beep is true when the greeting of the
+// machine has ended.
+func ProviderResult(status, answeredBy string) (result Result, beep bool, ok bool) {
+	switch answeredBy {
+	case "human":
+		return Human, false, true
+	case "machine_start", "fax":
+		return Machine, false, true
+	case "machine_end_beep", "machine_end_silence", "machine_end_other":
+		return Machine, true, true
+	case "unknown":
+		return Unknown, false, true
+	}
+	switch status {
+	case "human":
+		return Human, false, true
+	case "machine":
+		return Machine, false, true
+	}
+	return "", false, false
+}
+
+// Encode and Decode carry the result through the status callback store
+func Encode(result Result, beep bool) string {
+	if beep {
+		return string(result) + ":beep"
+	}
+	return string(result)
+}
+
+func Decode(value string) (Result, bool) {
+	result, beep := strings.CutSuffix(value, ":beep")
+	return Result(result), beep
+}
+
+// Outcome is the decision of the detection and the step which follows
+type Outcome struct {
+	Result Result
+	Reason string
+	Step   Step
+	// the outcome carries the result for the first time
+	Decided bool
+}
+
+// AnsweringMachineDetection holds back the greeting of an outbound call until it knows who
+// answered. The result comes from the local detector or from the telephony provider, whichever
+// decides first.
+type AnsweringMachineDetection interface {
+	// Listening reports whether the user audio belongs to the detection and must not reach the
+	// assistant, only a greeted callee talks to the assistant
+	Listening() bool
+
+	// Waiting reports whether the detection still waits for the result or the beep
+	Waiting() bool
+
+	// Feed analyses the user audio, an outcome is returned once the detection moves on
+	Feed(audio []byte) *Outcome
+
+	// Provider applies the result reported by the telephony provider
+	Provider(result Result, beep bool) *Outcome
+
+	// Timeout leaves the voicemail when the beep was not heard in time
+	Timeout() *Outcome
+
+	// Voicemail marks the utterance of the voicemail message
+	Voicemail(contextId string)
+
+	// Speak tracks audio of the voicemail message sent to the user
+	Speak(contextId string, audio []byte)
+
+	// Remaining is how long the voicemail message still plays, it reports false for any other
+	// utterance
+	Remaining(contextId string) (time.Duration, bool)
+
+	Config() *Config
+}
+
+type answeringMachineDetection struct {
+	mu       sync.Mutex
+	now      func() time.Time
+	config   *Config
+	detector Detector
+	beep     BeepDetector
+	step     Step
+	result   Result
+
+	bytesPerSecond int
+	voicemailId    string
+	voicemailUntil time.Time
+}
+
+// NewAnsweringMachineDetection starts the detection for user audio in the format of the input
+// audio config, the output audio config is used to know how long the voicemail message plays.
+func NewAnsweringMachineDetection(config *Config, inputConfig, outputConfig *protos.AudioConfig) AnsweringMachineDetection {
+	return &answeringMachineDetection{
+		now:            time.Now,
+		config:         config,
+		detector:       NewDetector(config.Thresholds, inputConfig),
+		beep:           NewBeepDetector(inputConfig),
+		bytesPerSecond: internal_audio.BytesPerSecond(outputConfig),
+	}
+}
+
+func (a *answeringMachineDetection) Config() *Config {
+	return a.config
+}
+
+func (a *answeringMachineDetection) Listening() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.step != StepGreet
+}
+
+func (a *answeringMachineDetection) Waiting() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.step == "" || a.step == StepWaitBeep
+}
+
+func (a *answeringMachineDetection) Feed(audio []byte) *Outcome {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	switch a.step {
+	case "":
+		if result, reason, ok := a.detector.Feed(audio); ok {
+			return a.decide(result, reason)
+		}
+	case StepWaitBeep:
+		if a.beep.Feed(audio) {
+			return a.move(StepVoicemail, "beep")
+		}
+	}
+	return nil
+}
+
+func (a *answeringMachineDetection) Provider(result Result, beep bool) *Outcome {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	switch a.step {
+	case "":
+		outcome := a.decide(result, "provider")
+		// the greeting of the machine already ended
+		if beep && outcome.Step == StepWaitBeep {
+			a.step = StepVoicemail
+			outcome.Step = StepVoicemail
+		}
+		return outcome
+	case StepWaitBeep:
+		if beep {
+			return a.move(StepVoicemail, "provider beep")
+		}
+	}
+	return nil
+}
+
+func (a *answeringMachineDetection) Timeout() *Outcome {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.step != StepWaitBeep {
+		return nil
+	}
+	return a.move(StepVoicemail, "beep timeout")
+}
+
+func (a *answeringMachineDetection) decide(result Result, reason string) *Outcome {
+	a.result = result
+	step := StepGreet
+	if result == Machine {
+		switch a.config.Action {
+		case ActionVoicemail:
+			step = StepWaitBeep
+		case ActionHangup:
+			step = StepHangup
+		}
+	}
+	outcome := a.move(step, reason)
+	outcome.Decided = true
+	return outcome
+}
+
+func (a *answeringMachineDetection) move(step Step, reason string) *Outcome {
+	a.step = step
+	return &Outcome{Result: a.result, Reason: reason, Step: step}
+}
+
+func (a *answeringMachineDetection) Voicemail(contextId string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.voicemailId = contextId
+	a.voicemailUntil = a.now()
+}
+
+func (a *answeringMachineDetection) Speak(contextId string, audio []byte) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if contextId != a.voicemailId || a.bytesPerSecond == 0 {
+		return
+	}
+	// audio is sent faster than it plays, chunks queue up behind each other
+	now := a.now()
+	if a.voicemailUntil.Before(now) {
+		a.voicemailUntil = now
+	}
+	a.voicemailUntil = a.voicemailUntil.Add(time.Duration(len(audio)) * time.Second / time.Duration(a.bytesPerSecond))
+}
+
+func (a *answeringMachineDetection) Remaining(contextId string) (time.Duration, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.voicemailId == "" || contextId != a.voicemailId {
+		return 0, false
+	}
+	return max(a.voicemailUntil.Sub(a.now()), 0), true
+}
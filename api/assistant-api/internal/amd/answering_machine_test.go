@@ -0,0 +1,176 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_amd
+
+import (
+	"testing"
+	"time"
+
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConfig(t *testing.T) {
+	_, enabled, err := NewConfig(utils.Option{})
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	config, enabled, err := NewConfig(utils.Option{OptionsKeyEnabled: "true"})
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	assert.Equal(t, ActionHangup, config.Action)
+	assert.Equal(t, DefaultThresholds(), config.Thresholds)
+
+	config, _, err = NewConfig(utils.Option{
+		OptionsKeyEnabled:          true,
+		OptionsKeyAction:           "voicemail",
+		OptionsKeyVoicemailMessage: " Hi {{name}}, call us back ",
+		"amd.beep_timeout":         "10",
+		"amd.greeting":             "2000",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ActionVoicemail, config.Action)
+	assert.Equal(t, "Hi {{name}}, call us back", config.VoicemailMessage)
+	assert.Equal(t, 10*time.Second, config.BeepTimeout)
+	assert.Equal(t, 2*time.Second, config.Thresholds.Greeting)
+
+	_, _, err = NewConfig(utils.Option{OptionsKeyEnabled: true, OptionsKeyAction: "transfer"})
+	assert.Error(t, err)
+	_, _, err = NewConfig(utils.Option{OptionsKeyEnabled: true, OptionsKeyAction: "voicemail"})
+	assert.Error(t, err)
+}
+
+func TestProviderResult(t *testing.T) {
+	tests := []struct {
+		status     string
+		answeredBy string
+		result     Result
+		beep       bool
+		ok         bool
+	}{
+		{answeredBy: "human", result: Human, ok: true},
+		{answeredBy: "machine_start", result: Machine, ok: true},
+		{answeredBy: "machine_end_beep", result: Machine, beep: true, ok: true},
+		{answeredBy: "machine_end_silence", result: Machine, beep: true, ok: true},
+		{answeredBy: "fax", result: Machine, ok: true},
+		{answeredBy: "unknown", result: Unknown, ok: true},
+		{status: "human", result: Human, ok: true},
+		{status: "machine", result: Machine, ok: true},
+		{status: "completed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status+"/"+tt.answeredBy, func(t *testing.T) {
+			result, beep, ok := ProviderResult(tt.status, tt.answeredBy)
+			assert.Equal(t, tt.result, result)
+			assert.Equal(t, tt.beep, beep)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	for _, beep := range []bool{true, false} {
+		result, decodedBeep := Decode(Encode(Machine, beep))
+		assert.Equal(t, Machine, result)
+		assert.Equal(t, beep, decodedBeep)
+	}
+}
+
+func TestAnsweringMachineDetection(t *testing.T) {
+	machine := join(speech(2*time.Second), silence(100*time.Millisecond))
+	tests := []struct {
+		name   string
+		action Action
+		audio  []byte
+		step   Step
+		result Result
+	}{
+		{name: "human is greeted", action: ActionHangup, audio: join(speech(400*time.Millisecond), silence(time.Second)), step: StepGreet, result: Human},
+		{name: "machine is hung up", action: ActionHangup, audio: machine, step: StepHangup, result: Machine},
+		{name: "machine is greeted", action: ActionContinue, audio: machine, step: StepGreet, result: Machine},
+		{name: "voicemail waits for the beep", action: ActionVoicemail, audio: machine, step: StepWaitBeep, result: Machine},
+		{name: "unknown is greeted", action: ActionHangup, audio: join(speech(60*time.Millisecond), silence(6*time.Second)), step: StepGreet, result: Unknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &Config{Action: tt.action, VoicemailMessage: "call us back", Thresholds: DefaultThresholds()}
+			amd := NewAnsweringMachineDetection(config, internal_audio.NewLinear8khzMonoAudioConfig(), internal_audio.NewMulaw8khzMonoAudioConfig())
+			assert.True(t, amd.Listening())
+			outcome := amd.Feed(tt.audio)
+			require.NotNil(t, outcome)
+			assert.Equal(t, tt.step, outcome.Step)
+			assert.Equal(t, tt.result, outcome.Result)
+			assert.True(t, outcome.Decided)
+			assert.Equal(t, tt.step != StepGreet, amd.Listening())
+			assert.Equal(t, tt.step == StepWaitBeep, amd.Waiting())
+			// decided by the audio, the provider comes too late
+			assert.Nil(t, amd.Provider(Human, false))
+		})
+	}
+}
+
+func TestAnsweringMachineDetection_Voicemail(t *testing.T) {
+	config := &Config{Action: ActionVoicemail, VoicemailMessage: "call us back", Thresholds: DefaultThresholds()}
+
+	amd := NewAnsweringMachineDetection(config, internal_audio.NewLinear8khzMonoAudioConfig(), internal_audio.NewMulaw8khzMonoAudioConfig())
+	require.Equal(t, StepWaitBeep, amd.Feed(speech(2*time.Second)).Step)
+	assert.Nil(t, amd.Feed(speech(time.Second)))
+	outcome := amd.Feed(sine(1000, 300*time.Millisecond))
+	require.NotNil(t, outcome)
+	assert.Equal(t, StepVoicemail, outcome.Step)
+	assert.Equal(t, "beep", outcome.Reason)
+	assert.False(t, outcome.Decided)
+	assert.True(t, amd.Listening())
+	assert.False(t, amd.Waiting())
+	assert.Nil(t, amd.Timeout())
+
+	// the provider reports the end of the greeting
+	amd = NewAnsweringMachineDetection(config, internal_audio.NewLinear8khzMonoAudioConfig(), internal_audio.NewMulaw8khzMonoAudioConfig())
+	require.Equal(t, StepWaitBeep, amd.Provider(Machine, false).Step)
+	assert.Equal(t, StepVoicemail, amd.Provider(Machine, true).Step)
+
+	// the provider detects after the greeting ended
+	amd = NewAnsweringMachineDetection(config, internal_audio.NewLinear8khzMonoAudioConfig(), internal_audio.NewMulaw8khzMonoAudioConfig())
+	outcome = amd.Provider(Machine, true)
+	assert.Equal(t, StepVoicemail, outcome.Step)
+	assert.True(t, outcome.Decided)
+
+	// no beep
+	amd = NewAnsweringMachineDetection(config, internal_audio.NewLinear8khzMonoAudioConfig(), internal_audio.NewMulaw8khzMonoAudioConfig())
+	assert.Nil(t, amd.Timeout())
+	require.Equal(t, StepWaitBeep, amd.Provider(Machine, false).Step)
+	outcome = amd.Timeout()
+	require.NotNil(t, outcome)
+	assert.Equal(t, StepVoicemail, outcome.Step)
+	assert.Equal(t, "beep timeout", outcome.Reason)
+}
+
+func TestAnsweringMachineDetection_VoicemailPlayout(t *testing.T) {
+	config := &Config{Action: ActionVoicemail, VoicemailMessage: "call us back", Thresholds: DefaultThresholds()}
+	amd := NewAnsweringMachineDetection(config, internal_audio.NewLinear8khzMonoAudioConfig(), internal_audio.NewMulaw8khzMonoAudioConfig()).(*answeringMachineDetection)
+	now := time.Now()
+	amd.now = func() time.Time { return now }
+
+	_, ok := amd.Remaining("greeting")
+	assert.False(t, ok)
+
+	amd.Voicemail("voicemail")
+	// two seconds of mu-law at 8khz
+	amd.Speak("voicemail", make([]byte, 8000))
+	amd.Speak("voicemail", make([]byte, 8000))
+	amd.Speak("greeting", make([]byte, 8000))
+
+	now = now.Add(500 * time.Millisecond)
+	remaining, ok := amd.Remaining("voicemail")
+	assert.True(t, ok)
+	assert.Equal(t, 1500*time.Millisecond, remaining)
+
+	now = now.Add(3 * time.Second)
+	remaining, _ = amd.Remaining("voicemail")
+	assert.Equal(t, time.Duration(0), remaining)
+}
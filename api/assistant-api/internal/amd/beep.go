@@ -0,0 +1,96 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_amd
+
+import (
+	"math"
+	"time"
+
+	"github.com/rapidaai/protos"
+)
+
+const (
+	// answering machines beep with a single tone in this range
+	minBeepFrequency = 500.0
+	maxBeepFrequency = 2500.0
+	beepStep         = 25.0
+
+	// share of the energy of a frame in a single frequency for the frame to be a tone
+	tonePurity = 0.8
+
+	// the tone must hold its frequency for this long to be a beep
+	minBeepLength = 160 * time.Millisecond
+	beepDrift     = 50.0
+)
+
+// BeepDetector detects the beep an answering machine plays once its greeting ends and it starts
+// recording.
+type BeepDetector interface {
+	// Feed analyses the user audio, it reports whether the beep was heard
+	Feed(audio []byte) bool
+}
+
+type beepDetector struct {
+	frames    *frames
+	frequency float64
+	tone      time.Duration
+}
+
+// NewBeepDetector creates a beep detector for user audio in the format of the audio config
+func NewBeepDetector(audioConfig *protos.AudioConfig) BeepDetector {
+	return &beepDetector{frames: newFrames(audioConfig)}
+}
+
+func (b *beepDetector) Feed(audio []byte) bool {
+	for _, frame := range b.frames.Feed(audio) {
+		frequency, ok := tone(frame, b.frames.SampleRate())
+		switch {
+		case !ok:
+			b.tone = 0
+		case b.tone > 0 && math.Abs(frequency-b.frequency) <= beepDrift:
+			b.tone += frameDuration
+		default:
+			b.tone = frameDuration
+			b.frequency = frequency
+		}
+		if b.tone >= minBeepLength {
+			b.tone = 0
+			return true
+		}
+	}
+	return false
+}
+
+// tone reports the frequency of the frame when nearly all of its energy is in a single
+// frequency, the frequencies of a beep are scanned with the goertzel algorithm
+func tone(samples []float64, sampleRate float64) (float64, bool) {
+	if rms(samples) < silenceThreshold {
+		return 0, false
+	}
+	var energy float64
+	for _, s := range samples {
+		energy += s * s
+	}
+	n := float64(len(samples))
+	best, purity := 0.0, 0.0
+	for frequency := minBeepFrequency; frequency <= maxBeepFrequency; frequency += beepStep {
+		// a pure tone at the frequency has a power of n/2 times its energy
+		if p := goertzel(samples, frequency, sampleRate) / (energy * n / 2); p > purity {
+			best, purity = frequency, p
+		}
+	}
+	return best, purity >= tonePurity
+}
+
+func goertzel(samples []float64, frequency, sampleRate float64) float64 {
+	coeff := 2 * math.Cos(2*math.Pi*frequency/sampleRate)
+	var s1, s2 float64
+	for _, x := range samples {
+		s0 := x + coeff*s1 - s2
+		s2, s1 = s1, s0
+	}
+	return s1*s1 + s2*s2 - coeff*s1*s2
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_amd
+
+import (
+	"testing"
+	"time"
+
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBeepDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		audio []byte
+		beep  bool
+	}{
+		{name: "beep", audio: join(speech(time.Second), silence(200*time.Millisecond), sine(1000, 400*time.Millisecond)), beep: true},
+		{name: "high beep", audio: sine(1400, 200*time.Millisecond), beep: true},
+		{name: "beep between bins", audio: sine(1012, 200*time.Millisecond), beep: true},
+		{name: "short tone", audio: join(sine(1000, 100*time.Millisecond), silence(100*time.Millisecond), sine(1000, 100*time.Millisecond)), beep: false},
+		{name: "tone out of range", audio: sine(300, time.Second), beep: false},
+		{name: "speech", audio: speech(3 * time.Second), beep: false},
+		{name: "silence", audio: silence(time.Second), beep: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBeepDetector(internal_audio.NewLinear8khzMonoAudioConfig())
+			beep := false
+			for i := 0; i < len(tt.audio) && !beep; i += 960 {
+				beep = b.Feed(tt.audio[i:min(i+960, len(tt.audio))])
+			}
+			assert.Equal(t, tt.beep, beep)
+		})
+	}
+}
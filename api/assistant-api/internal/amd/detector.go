@@ -0,0 +1,181 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_amd
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/rapidaai/protos"
+	"github.com/zaf/g711"
+)
+
+const (
+	// audio is analysed in frames of 20ms
+	frameDuration = 20 * time.Millisecond
+
+	// root mean square of a frame, on a scale of 0 to 1, below which the frame is silence
+	silenceThreshold = 0.01
+
+	// voice shorter than this is noise and not counted as a word
+	minWordLength = 100 * time.Millisecond
+)
+
+// Thresholds follow the answering machine detection of asterisk. A person answers with a short
+// greeting like "hello?" and waits, a machine either plays a long greeting or stays silent.
+type Thresholds struct {
+	// silence before any voice after which the callee is a machine
+	InitialSilence time.Duration
+	// voice after which the callee is a machine
+	Greeting time.Duration
+	// silence after the greeting after which the callee is a person
+	AfterGreetingSilence time.Duration
+	// audio after which the detector gives up
+	TotalAnalysis time.Duration
+	// words in the greeting after which the callee is a machine
+	MaxWords int
+}
+
+func DefaultThresholds() Thresholds {
+	return Thresholds{
+		InitialSilence:       2500 * time.Millisecond,
+		Greeting:             1500 * time.Millisecond,
+		AfterGreetingSilence: 800 * time.Millisecond,
+		TotalAnalysis:        5000 * time.Millisecond,
+		MaxWords:             3,
+	}
+}
+
+// Detector decides from the first seconds of the user audio whether the call was answered by a
+// person or by an answering machine.
+type Detector interface {
+	// Feed analyses the user audio, it reports the result and the reason once decided
+	Feed(audio []byte) (Result, string, bool)
+}
+
+type detector struct {
+	thresholds Thresholds
+	frames     *frames
+
+	elapsed  time.Duration
+	silence  time.Duration
+	greeting time.Duration
+	word     time.Duration
+	words    int
+	voiced   bool
+	decided  bool
+}
+
+// NewDetector creates a detector for user audio in the format of the audio config
+func NewDetector(thresholds Thresholds, audioConfig *protos.AudioConfig) Detector {
+	return &detector{thresholds: thresholds, frames: newFrames(audioConfig)}
+}
+
+func (d *detector) Feed(audio []byte) (Result, string, bool) {
+	if d.decided {
+		return "", "", false
+	}
+	for _, frame := range d.frames.Feed(audio) {
+		if result, reason, ok := d.frame(frame); ok {
+			d.decided = true
+			return result, reason, true
+		}
+	}
+	return "", "", false
+}
+
+func (d *detector) frame(samples []float64) (Result, string, bool) {
+	d.elapsed += frameDuration
+	if rms(samples) >= silenceThreshold {
+		d.silence = 0
+		d.voiced = true
+		d.word += frameDuration
+		if d.word == minWordLength {
+			d.words++
+		}
+	} else {
+		d.silence += frameDuration
+		d.word = 0
+	}
+	// the greeting runs from the first voice, pauses between words included
+	if d.voiced {
+		d.greeting += frameDuration
+	}
+
+	switch {
+	case !d.voiced && d.silence >= d.thresholds.InitialSilence:
+		return Machine, "initial silence", true
+	case d.words > d.thresholds.MaxWords:
+		return Machine, "too many words", true
+	case d.voiced && d.greeting-d.silence >= d.thresholds.Greeting:
+		return Machine, "long greeting", true
+	case d.words > 0 && d.silence >= d.thresholds.AfterGreetingSilence:
+		return Human, "short greeting", true
+	case d.elapsed >= d.thresholds.TotalAnalysis:
+		return Unknown, "not sure", true
+	}
+	return "", "", false
+}
+
+// frames splits the audio into frames of samples, audio which does not fill a frame is kept
+// for the next chunk
+type frames struct {
+	audioConfig *protos.AudioConfig
+	size        int
+	pending     []float64
+}
+
+func newFrames(audioConfig *protos.AudioConfig) *frames {
+	sampleRate := int(audioConfig.GetSampleRate())
+	if sampleRate == 0 {
+		sampleRate = 8000
+	}
+	return &frames{audioConfig: audioConfig, size: sampleRate * int(frameDuration/time.Millisecond) / 1000}
+}
+
+func (f *frames) Feed(audio []byte) [][]float64 {
+	f.pending = append(f.pending, decode(audio, f.audioConfig)...)
+	var out [][]float64
+	for len(f.pending) >= f.size {
+		out = append(out, f.pending[:f.size:f.size])
+		f.pending = f.pending[f.size:]
+	}
+	return out
+}
+
+// SampleRate is the rate of the samples in a frame
+func (f *frames) SampleRate() float64 {
+	return float64(f.size) / frameDuration.Seconds()
+}
+
+// decode converts mu-law or linear16 audio of the first channel to samples between -1 and 1
+func decode(audio []byte, audioConfig *protos.AudioConfig) []float64 {
+	channels := int(audioConfig.GetChannels())
+	if channels == 0 {
+		channels = 1
+	}
+	pcm := audio
+	if audioConfig.GetAudioFormat() == protos.AudioConfig_MuLaw8 {
+		pcm = g711.DecodeUlaw(audio)
+	}
+	samples := make([]float64, 0, len(pcm)/(2*channels))
+	for i := 0; i+1 < len(pcm); i += 2 * channels {
+		samples = append(samples, float64(int16(binary.LittleEndian.Uint16(pcm[i:i+2])))/32768.0)
+	}
+	return samples
+}
+
+func rms(samples []float64) float64 {
+	if len(samples) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, s := range samples {
+		sum += s * s
+	}
+	return math.Sqrt(sum / float64(len(samples)))
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_amd
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	"github.com/stretchr/testify/assert"
+	"github.com/zaf/g711"
+)
+
+// linear16 audio at 8khz
+
+func silence(d time.Duration) []byte {
+	return make([]byte, int(d.Seconds()*8000)*2)
+}
+
+func sine(frequency float64, d time.Duration) []byte {
+	samples := int(d.Seconds() * 8000)
+	audio := make([]byte, samples*2)
+	for i := 0; i < samples; i++ {
+		v := int16(0.3 * 32767 * math.Sin(2*math.Pi*frequency*float64(i)/8000))
+		binary.LittleEndian.PutUint16(audio[i*2:], uint16(v))
+	}
+	return audio
+}
+
+// speech is broadband noise, loud enough to be voice and without a single frequency
+func speech(d time.Duration) []byte {
+	rnd := rand.New(rand.NewSource(int64(d)))
+	samples := int(d.Seconds() * 8000)
+	audio := make([]byte, samples*2)
+	for i := 0; i < samples; i++ {
+		v := int16((rnd.Float64()*2 - 1) * 0.3 * 32767)
+		binary.LittleEndian.PutUint16(audio[i*2:], uint16(v))
+	}
+	return audio
+}
+
+func join(chunks ...[]byte) []byte {
+	var audio []byte
+	for _, chunk := range chunks {
+		audio = append(audio, chunk...)
+	}
+	return audio
+}
+
+func TestDetector(t *testing.T) {
+	tests := []struct {
+		name   string
+		audio  []byte
+		result Result
+		reason string
+	}{
+		{
+			name:   "short greeting",
+			audio:  join(silence(300*time.Millisecond), speech(500*time.Millisecond), silence(time.Second)),
+			result: Human,
+			reason: "short greeting",
+		},
+		{
+			name:   "two words",
+			audio:  join(speech(300*time.Millisecond), silence(200*time.Millisecond), speech(300*time.Millisecond), silence(time.Second)),
+			result: Human,
+			reason: "short greeting",
+		},
+		{
+			name:   "initial silence",
+			audio:  silence(3 * time.Second),
+			result: Machine,
+			reason: "initial silence",
+		},
+		{
+			name:   "long greeting",
+			audio:  join(speech(2*time.Second), silence(time.Second)),
+			result: Machine,
+			reason: "long greeting",
+		},
+		{
+			name: "too many words",
+			audio: join(
+				speech(200*time.Millisecond), silence(100*time.Millisecond),
+				speech(200*time.Millisecond), silence(100*time.Millisecond),
+				speech(200*time.Millisecond), silence(100*time.Millisecond),
+				speech(200*time.Millisecond), silence(time.Second),
+			),
+			result: Machine,
+			reason: "too many words",
+		},
+		{
+			name:   "noise only",
+			audio:  join(speech(60*time.Millisecond), silence(6*time.Second)),
+			result: Unknown,
+			reason: "not sure",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDetector(DefaultThresholds(), internal_audio.NewLinear8khzMonoAudioConfig())
+			var (
+				result Result
+				reason string
+				ok     bool
+			)
+			// chunks of 60ms as sent by the telephony streamers
+			for i := 0; i < len(tt.audio) && !ok; i += 960 {
+				result, reason, ok = d.Feed(tt.audio[i:min(i+960, len(tt.audio))])
+			}
+			assert.True(t, ok)
+			assert.Equal(t, tt.result, result)
+			assert.Equal(t, tt.reason, reason)
+
+			// decided only once
+			_, _, ok = d.Feed(speech(time.Second))
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestDetector_MuLaw(t *testing.T) {
+	d := NewDetector(DefaultThresholds(), internal_audio.NewMulaw8khzMonoAudioConfig())
+	result, reason, ok := d.Feed(g711.EncodeUlaw(join(speech(400*time.Millisecond), silence(time.Second))))
+	assert.True(t, ok)
+	assert.Equal(t, Human, result)
+	assert.Equal(t, "short greeting", reason)
+}
@@ -38,3 +38,20 @@ func NewLinear8khzMonoAudioConfig() *protos.AudioConfig {
 		Channels:    1,
 	}
 }
+
+// BytesPerSecond is the size of one second of raw audio in the config, zero without a config
+func BytesPerSecond(config *protos.AudioConfig) int {
+	if config == nil {
+		return 0
+	}
+	channels := int(config.GetChannels())
+	if channels == 0 {
+		channels = 1
+	}
+	switch config.GetAudioFormat() {
+	case protos.AudioConfig_MuLaw8:
+		return int(config.GetSampleRate()) * channels
+	default:
+		return int(config.GetSampleRate()) * channels * 2
+	}
+}
@@ -518,3 +518,23 @@ func TestRaceConditionOnConcurrentReads(t *testing.T) {
 
 	wg.Wait()
 }
+
+// TestBytesPerSecond validates the size of one second of audio per format
+func TestBytesPerSecond(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *protos.AudioConfig
+		want   int
+	}{
+		{name: "nil config", config: nil, want: 0},
+		{name: "mulaw 8khz mono", config: NewMulaw8khzMonoAudioConfig(), want: 8000},
+		{name: "linear 16khz mono", config: NewLinear16khzMonoAudioConfig(), want: 32000},
+		{name: "linear 24khz stereo", config: &protos.AudioConfig{SampleRate: 24000, AudioFormat: protos.AudioConfig_LINEAR16, Channels: 2}, want: 96000},
+		{name: "missing channels are mono", config: &protos.AudioConfig{SampleRate: 8000, AudioFormat: protos.AudioConfig_MuLaw8}, want: 8000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, BytesPerSecond(tt.config))
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
 	protos "github.com/rapidaai/protos"
 )
 
@@ -36,16 +37,32 @@ type audioRecorder struct {
 	userConfig       *protos.AudioConfig
 	systemConfig     *protos.AudioConfig
 	chunkIDCounter   int64 // Counter for unique chunk IDs
+	stereo           bool  // user on the left and system on the right channel
+	splitTracks      bool  // persist one file per speaker
+
+	// end of system audio which is no longer held in audioChunks, system
+	// chunks still held are played after it
+	playedUntil time.Time
 }
 
-func NewDefaultAudioRecorder(logger commons.Logger, userConfig, systemConfig *protos.AudioConfig) (internal_type.Recorder, error) {
-	return &audioRecorder{
+func NewDefaultAudioRecorder(logger commons.Logger, userConfig, systemConfig *protos.AudioConfig, opts utils.Option) (internal_type.Recorder, error) {
+	recorder := &audioRecorder{
 		logger:       logger,
 		audioChunks:  []AudioChunk{},
 		mu:           sync.Mutex{},
 		userConfig:   userConfig,
 		systemConfig: systemConfig,
-	}, nil
+	}
+	if channels, err := opts.GetUint32("recording.channels"); err == nil {
+		if channels > 2 {
+			return nil, fmt.Errorf("recording.channels must be 1 or 2, got %d", channels)
+		}
+		recorder.stereo = channels == 2
+	}
+	if split, err := opts.GetBool("recording.split_tracks"); err == nil {
+		recorder.splitTracks = split
+	}
+	return recorder, nil
 }
 
 func (r *audioRecorder) Record(ctx context.Context, p internal_type.Packet) error {
@@ -228,8 +245,8 @@ func (r *audioRecorder) trimAudioChunkData(data []byte, keepDuration time.Durati
 }
 
 func (r *audioRecorder) calculateSystemAudioPlayTime(targetChunk AudioChunk) time.Time {
-	var latestEndTime time.Time
-	isFirstSystemChunk := true
+	latestEndTime := r.playedUntil
+	isFirstSystemChunk := r.playedUntil.IsZero()
 
 	for _, chunk := range r.audioChunks {
 		if chunk.ID == targetChunk.ID {
@@ -254,7 +271,7 @@ func (r *audioRecorder) calculateSystemAudioPlayTime(targetChunk AudioChunk) tim
 	return playStartTime
 }
 
-func (r *audioRecorder) Persist() ([]byte, error) {
+func (r *audioRecorder) Persist() (*internal_type.Recording, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -274,6 +291,36 @@ func (r *audioRecorder) Persist() ([]byte, error) {
 		return nil, fmt.Errorf("no valid audio configuration found")
 	}
 
+	recording := &internal_type.Recording{Channels: 1}
+	if r.stereo || r.splitTracks {
+		userTrack, systemTrack, err := r.renderTracks(targetConfig)
+		if err != nil {
+			r.logger.Error("Failed to render speaker tracks", err)
+			return nil, err
+		}
+		if r.stereo {
+			recording.Channels = 2
+			recording.Mixed, err = r.createWAVFile(r.interleave(userTrack, systemTrack), r.trackConfig(targetConfig, 2))
+			if err != nil {
+				r.logger.Error("Failed to create stereo WAV file", err)
+				return nil, err
+			}
+		}
+		if r.splitTracks {
+			if recording.User, err = r.createWAVFile(r.toPCM(userTrack), r.trackConfig(targetConfig, 1)); err != nil {
+				r.logger.Error("Failed to create user WAV file", err)
+				return nil, err
+			}
+			if recording.Assistant, err = r.createWAVFile(r.toPCM(systemTrack), r.trackConfig(targetConfig, 1)); err != nil {
+				r.logger.Error("Failed to create assistant WAV file", err)
+				return nil, err
+			}
+		}
+	}
+	if recording.Mixed != nil {
+		return recording, nil
+	}
+
 	// Convert and merge all chunks
 	mergedAudio, err := r.mergeAudioChunks(targetConfig)
 	if err != nil {
@@ -289,7 +336,137 @@ func (r *audioRecorder) Persist() ([]byte, error) {
 	}
 
 	// r.logger.Info(fmt.Sprintf("Persisted audio with %d chunks", len(r.audioChunks)))
-	return wavData, nil
+	recording.Mixed = wavData
+	return recording, nil
+}
+
+// systemPlayTimes returns when every system chunk started playing, a chunk plays once the
+// previous one finished, the same rule the interruption trimming uses
+func (r *audioRecorder) systemPlayTimes() map[int64]time.Time {
+	playTimes := make(map[int64]time.Time)
+	latestEndTime := r.playedUntil
+	for _, chunk := range r.audioChunks {
+		if !chunk.IsSystem {
+			continue
+		}
+		playStartTime := chunk.Timestamp
+		if latestEndTime.After(playStartTime) {
+			playStartTime = latestEndTime
+		}
+		playTimes[chunk.ID] = playStartTime
+		latestEndTime = playStartTime.Add(r.calculateChunkDuration(chunk))
+	}
+	return playTimes
+}
+
+// renderTracks places the audio of each speaker on a shared timeline, user audio at the time it
+// was received and system audio at the time it was played, so both tracks stay aligned.
+func (r *audioRecorder) renderTracks(targetConfig *protos.AudioConfig) ([]int32, []int32, error) {
+	playTimes := r.systemPlayTimes()
+	startTime := r.audioChunks[0].Timestamp
+	var endTime time.Time
+	for _, chunk := range r.audioChunks {
+		if chunkEndTime := r.chunkStart(chunk, playTimes).Add(r.calculateChunkDuration(chunk)); chunkEndTime.After(endTime) {
+			endTime = chunkEndTime
+		}
+	}
+	totalFrames := r.frameAt(startTime, endTime, targetConfig)
+	if totalFrames <= 0 {
+		return nil, nil, fmt.Errorf("no audio to render")
+	}
+	return r.renderRange(startTime, 0, totalFrames, playTimes, targetConfig)
+}
+
+// chunkStart is when the chunk is heard, system chunks are queued behind each other
+func (r *audioRecorder) chunkStart(chunk AudioChunk, playTimes map[int64]time.Time) time.Time {
+	if chunk.IsSystem {
+		return playTimes[chunk.ID]
+	}
+	return chunk.Timestamp
+}
+
+// frameAt is the frame of the timeline starting at startTime which is played at t
+func (r *audioRecorder) frameAt(startTime, t time.Time, targetConfig *protos.AudioConfig) int {
+	return int(t.Sub(startTime).Seconds() * float64(targetConfig.SampleRate))
+}
+
+// renderRange renders frames [fromFrame, fromFrame+frames) of the timeline starting at startTime
+// into a mono track per speaker, chunks partially inside the range are clipped.
+func (r *audioRecorder) renderRange(startTime time.Time, fromFrame, frames int, playTimes map[int64]time.Time, targetConfig *protos.AudioConfig) ([]int32, []int32, error) {
+	userTrack := make([]int32, frames)
+	systemTrack := make([]int32, frames)
+	for _, chunk := range r.audioChunks {
+		if chunk.Config == nil {
+			return nil, nil, fmt.Errorf("chunk has no audio configuration")
+		}
+		offset := r.frameAt(startTime, r.chunkStart(chunk, playTimes), targetConfig) - fromFrame
+		if offset >= frames {
+			continue
+		}
+		samples, err := r.convertToSamples(chunk.Data, chunk.Config)
+		if err != nil {
+			return nil, nil, err
+		}
+		track := userTrack
+		if chunk.IsSystem {
+			track = systemTrack
+		}
+		channels := int(chunk.Config.GetChannels())
+		if channels == 0 {
+			channels = 1
+		}
+		// downmix the chunk to a single channel
+		for frame := 0; frame*channels < len(samples); frame++ {
+			idx := offset + frame
+			if idx < 0 {
+				continue
+			}
+			if idx >= len(track) {
+				break
+			}
+			var sum int32
+			n := 0
+			for c := 0; c < channels && frame*channels+c < len(samples); c++ {
+				sum += samples[frame*channels+c]
+				n++
+			}
+			track[idx] += sum / int32(n)
+		}
+	}
+	return userTrack, systemTrack, nil
+}
+
+// interleave builds stereo pcm with the user on the left and system on the right channel
+func (r *audioRecorder) interleave(left, right []int32) []byte {
+	stereo := make([]int32, len(left)*2)
+	for i := range left {
+		stereo[i*2] = left[i]
+		stereo[i*2+1] = right[i]
+	}
+	return r.toPCM(stereo)
+}
+
+// toPCM converts samples to 16-bit little endian pcm
+func (r *audioRecorder) toPCM(samples []int32) []byte {
+	pcmData := make([]byte, len(samples)*2)
+	for i, sample := range samples {
+		// Clamp to 16-bit range
+		if sample > 32767 {
+			sample = 32767
+		} else if sample < -32768 {
+			sample = -32768
+		}
+		binary.LittleEndian.PutUint16(pcmData[i*2:], uint16(sample))
+	}
+	return pcmData
+}
+
+func (r *audioRecorder) trackConfig(targetConfig *protos.AudioConfig, channels uint32) *protos.AudioConfig {
+	return &protos.AudioConfig{
+		SampleRate:  targetConfig.GetSampleRate(),
+		AudioFormat: protos.AudioConfig_LINEAR16,
+		Channels:    channels,
+	}
 }
 
 func (r *audioRecorder) getTargetAudioConfig() *protos.AudioConfig {
@@ -0,0 +1,128 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_recorder
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const wavHeaderSize = 44
+
+func testAudioConfig() *protos.AudioConfig {
+	return &protos.AudioConfig{SampleRate: 8000, AudioFormat: protos.AudioConfig_LINEAR16, Channels: 1}
+}
+
+// tone returns ms milliseconds of 8kHz 16-bit pcm holding a constant value
+func tone(value int16, ms int) []byte {
+	data := make([]byte, 8*ms*2)
+	for i := 0; i < len(data); i += 2 {
+		binary.LittleEndian.PutUint16(data[i:], uint16(value))
+	}
+	return data
+}
+
+func newTestRecorder(t *testing.T, opts utils.Option) *audioRecorder {
+	logger, _ := commons.NewApplicationLogger()
+	rc, err := NewDefaultAudioRecorder(logger, testAudioConfig(), testAudioConfig(), opts)
+	require.NoError(t, err)
+	return rc.(*audioRecorder)
+}
+
+func (r *audioRecorder) add(isSystem bool, at time.Time, data []byte) {
+	r.chunkIDCounter++
+	r.audioChunks = append(r.audioChunks, AudioChunk{Data: data, Timestamp: at, IsSystem: isSystem, Config: testAudioConfig(), ID: r.chunkIDCounter})
+}
+
+func sampleAt(wav []byte, channels, frame, channel int) int16 {
+	return int16(binary.LittleEndian.Uint16(wav[wavHeaderSize+(frame*channels+channel)*2:]))
+}
+
+func TestPersist_DefaultIsMono(t *testing.T) {
+	r := newTestRecorder(t, utils.Option{})
+	start := time.Now()
+	r.add(false, start, tone(1000, 100))
+	r.add(true, start.Add(50*time.Millisecond), tone(2000, 100))
+
+	recording, err := r.Persist()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), recording.Channels)
+	assert.Equal(t, uint16(1), binary.LittleEndian.Uint16(recording.Mixed[22:]))
+	assert.Nil(t, recording.User)
+	assert.Nil(t, recording.Assistant)
+}
+
+func TestPersist_StereoSeparatesSpeakers(t *testing.T) {
+	r := newTestRecorder(t, utils.Option{"recording.channels": "2"})
+	start := time.Now()
+	r.add(false, start, tone(1000, 100))
+	r.add(true, start.Add(50*time.Millisecond), tone(2000, 100))
+
+	recording, err := r.Persist()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), recording.Channels)
+	assert.Equal(t, uint16(2), binary.LittleEndian.Uint16(recording.Mixed[22:]))
+	// 150ms of audio on both channels
+	assert.Equal(t, 1200*2*2, len(recording.Mixed)-wavHeaderSize)
+
+	assert.Equal(t, int16(1000), sampleAt(recording.Mixed, 2, 0, 0))
+	assert.Equal(t, int16(0), sampleAt(recording.Mixed, 2, 0, 1))
+	// overlap keeps both speakers on their own channel
+	assert.Equal(t, int16(1000), sampleAt(recording.Mixed, 2, 500, 0))
+	assert.Equal(t, int16(2000), sampleAt(recording.Mixed, 2, 500, 1))
+	assert.Equal(t, int16(0), sampleAt(recording.Mixed, 2, 1000, 0))
+	assert.Equal(t, int16(2000), sampleAt(recording.Mixed, 2, 1000, 1))
+	assert.Nil(t, recording.User)
+}
+
+func TestPersist_SplitTracksAreAligned(t *testing.T) {
+	r := newTestRecorder(t, utils.Option{"recording.split_tracks": "true"})
+	start := time.Now()
+	// both system chunks arrive together, the second plays after the first
+	r.add(true, start, tone(2000, 100))
+	r.add(true, start, tone(3000, 100))
+	r.add(false, start.Add(150*time.Millisecond), tone(1000, 100))
+
+	recording, err := r.Persist()
+	require.NoError(t, err)
+	require.NotNil(t, recording.Mixed)
+	assert.Equal(t, uint32(1), recording.Channels)
+	require.Equal(t, len(recording.User), len(recording.Assistant))
+	assert.Equal(t, 2000*2, len(recording.User)-wavHeaderSize)
+
+	assert.Equal(t, int16(2000), sampleAt(recording.Assistant, 1, 0, 0))
+	assert.Equal(t, int16(3000), sampleAt(recording.Assistant, 1, 1200, 0))
+	assert.Equal(t, int16(0), sampleAt(recording.User, 1, 1100, 0))
+	assert.Equal(t, int16(1000), sampleAt(recording.User, 1, 1300, 0))
+	assert.Equal(t, int16(0), sampleAt(recording.Assistant, 1, 1700, 0))
+}
+
+func TestPersist_StereoKeepsInterruptionTrim(t *testing.T) {
+	r := newTestRecorder(t, utils.Option{"recording.channels": 2})
+	start := time.Now()
+	r.add(true, start, tone(2000, 200))
+	r.removeInterruptedSystemAudio(start.Add(100 * time.Millisecond))
+	r.add(false, start.Add(100*time.Millisecond), tone(1000, 100))
+
+	recording, err := r.Persist()
+	require.NoError(t, err)
+	assert.Equal(t, int16(2000), sampleAt(recording.Mixed, 2, 700, 1))
+	assert.Equal(t, int16(0), sampleAt(recording.Mixed, 2, 900, 1))
+	assert.Equal(t, int16(1000), sampleAt(recording.Mixed, 2, 900, 0))
+}
+
+func TestNewDefaultAudioRecorder_InvalidChannels(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	_, err := NewDefaultAudioRecorder(logger, testAudioConfig(), testAudioConfig(), utils.Option{"recording.channels": 6})
+	assert.Error(t, err)
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_recorder
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	protos "github.com/rapidaai/protos"
+)
+
+const defaultSegmentDuration = 10 * time.Second
+
+// streamingAudioRecorder writes the recording in fixed duration segments while the call is in
+// progress, only audio which has not been written yet is held in memory. Audio before the last
+// flush is never trimmed by an interruption since interruptions only affect audio played after them.
+type streamingAudioRecorder struct {
+	*audioRecorder
+	writer          internal_type.RecordingWriter
+	segmentDuration time.Duration
+
+	startTime    time.Time // start of the timeline, the first chunk
+	flushedUntil time.Time // timeline written so far
+	started      bool
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+func NewStreamingAudioRecorder(logger commons.Logger, userConfig, systemConfig *protos.AudioConfig, opts utils.Option, writer internal_type.RecordingWriter) (internal_type.Recorder, error) {
+	rc, err := NewDefaultAudioRecorder(logger, userConfig, systemConfig, opts)
+	if err != nil {
+		return nil, err
+	}
+	recorder := &streamingAudioRecorder{
+		audioRecorder:   rc.(*audioRecorder),
+		writer:          writer,
+		segmentDuration: defaultSegmentDuration,
+		stop:            make(chan struct{}),
+	}
+	if seconds, err := opts.GetFloat64("recording.segment_duration"); err == nil && seconds > 0 {
+		recorder.segmentDuration = time.Duration(seconds * float64(time.Second))
+	}
+
+	recorder.wg.Add(1)
+	utils.Go(context.Background(), func() {
+		defer recorder.wg.Done()
+		ticker := time.NewTicker(recorder.segmentDuration)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-recorder.stop:
+				return
+			case now := <-ticker.C:
+				if err := recorder.flush(context.Background(), now); err != nil {
+					recorder.logger.Errorf("unable to write recording segment %v", err)
+				}
+			}
+		}
+	})
+	return recorder, nil
+}
+
+// flush writes the timeline up to until and releases the audio that has been fully written
+func (r *streamingAudioRecorder) flush(ctx context.Context, until time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	targetConfig := r.getTargetAudioConfig()
+	if targetConfig == nil {
+		return nil
+	}
+	sort.Slice(r.audioChunks, func(i, j int) bool {
+		return r.audioChunks[i].Timestamp.Before(r.audioChunks[j].Timestamp)
+	})
+	if !r.started {
+		if len(r.audioChunks) == 0 {
+			return nil
+		}
+		r.startTime = r.audioChunks[0].Timestamp
+		r.flushedUntil = r.startTime
+		if err := r.writeHeaders(ctx, targetConfig); err != nil {
+			return err
+		}
+		r.started = true
+	}
+
+	fromFrame := r.frameAt(r.startTime, r.flushedUntil, targetConfig)
+	frames := r.frameAt(r.startTime, until, targetConfig) - fromFrame
+	if frames <= 0 {
+		return nil
+	}
+
+	playTimes := r.systemPlayTimes()
+	userTrack, systemTrack, err := r.renderRange(r.startTime, fromFrame, frames, playTimes, targetConfig)
+	if err != nil {
+		return err
+	}
+	if err := r.writeSegment(ctx, userTrack, systemTrack); err != nil {
+		return err
+	}
+	r.flushedUntil = until
+
+	// release chunks which are fully written
+	remaining := r.audioChunks[:0]
+	for _, chunk := range r.audioChunks {
+		end := r.chunkStart(chunk, playTimes).Add(r.calculateChunkDuration(chunk))
+		if end.After(until) {
+			remaining = append(remaining, chunk)
+			continue
+		}
+		if chunk.IsSystem && end.After(r.playedUntil) {
+			r.playedUntil = end
+		}
+	}
+	r.audioChunks = remaining
+	return nil
+}
+
+// writeHeaders starts every track with a wav header of unknown length, the sizes are set to
+// the maximum as done for streamed wav and the writer sets them once the upload completes.
+func (r *streamingAudioRecorder) writeHeaders(ctx context.Context, targetConfig *protos.AudioConfig) error {
+	channels := uint32(1)
+	if r.stereo {
+		channels = 2
+	}
+	if err := r.writer.Write(ctx, internal_type.RecordingTrackMixed, r.streamingHeader(r.trackConfig(targetConfig, channels))); err != nil {
+		return err
+	}
+	if r.splitTracks {
+		header := r.streamingHeader(r.trackConfig(targetConfig, 1))
+		if err := r.writer.Write(ctx, internal_type.RecordingTrackUser, header); err != nil {
+			return err
+		}
+		if err := r.writer.Write(ctx, internal_type.RecordingTrackAssistant, header); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *streamingAudioRecorder) streamingHeader(config *protos.AudioConfig) []byte {
+	header, _ := r.createWAVFile(nil, config)
+	binary.LittleEndian.PutUint32(header[4:], 0xFFFFFFFF)
+	binary.LittleEndian.PutUint32(header[40:], 0xFFFFFFFF-36)
+	return header
+}
+
+func (r *streamingAudioRecorder) writeSegment(ctx context.Context, userTrack, systemTrack []int32) error {
+	var mixed []byte
+	if r.stereo {
+		mixed = r.interleave(userTrack, systemTrack)
+	} else {
+		mono := make([]int32, len(userTrack))
+		for i := range mono {
+			mono[i] = userTrack[i] + systemTrack[i]
+		}
+		mixed = r.toPCM(mono)
+	}
+	if err := r.writer.Write(ctx, internal_type.RecordingTrackMixed, mixed); err != nil {
+		return err
+	}
+	if r.splitTracks {
+		if err := r.writer.Write(ctx, internal_type.RecordingTrackUser, r.toPCM(userTrack)); err != nil {
+			return err
+		}
+		if err := r.writer.Write(ctx, internal_type.RecordingTrackAssistant, r.toPCM(systemTrack)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// end of the audio still held, the point the timeline is written up to when the call ends
+func (r *streamingAudioRecorder) end() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	end := r.flushedUntil
+	playTimes := r.systemPlayTimes()
+	for _, chunk := range r.audioChunks {
+		if chunkEnd := r.chunkStart(chunk, playTimes).Add(r.calculateChunkDuration(chunk)); chunkEnd.After(end) {
+			end = chunkEnd
+		}
+	}
+	return end
+}
+
+// Persist writes the remaining audio and finalizes the recording in storage, it returns no
+// recording since nothing is left to be stored by the caller.
+func (r *streamingAudioRecorder) Persist() (*internal_type.Recording, error) {
+	r.stopOnce.Do(func() { close(r.stop) })
+	r.wg.Wait()
+
+	ctx := context.Background()
+	if err := r.flush(ctx, r.end()); err != nil {
+		r.logger.Errorf("unable to write last recording segment %v", err)
+		r.writer.Close(ctx)
+		return nil, err
+	}
+	if !r.started {
+		r.writer.Abort(ctx)
+		return nil, fmt.Errorf("empty chunk of audio")
+	}
+	if err := r.writer.Close(ctx); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_recorder
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryRecordingWriter struct {
+	tracks  map[internal_type.RecordingTrack][]byte
+	writes  int
+	closed  bool
+	aborted bool
+}
+
+func (w *memoryRecordingWriter) Write(ctx context.Context, track internal_type.RecordingTrack, segment []byte) error {
+	if w.tracks == nil {
+		w.tracks = map[internal_type.RecordingTrack][]byte{}
+	}
+	w.tracks[track] = append(w.tracks[track], segment...)
+	w.writes++
+	return nil
+}
+
+func (w *memoryRecordingWriter) Close(ctx context.Context) error {
+	w.closed = true
+	return nil
+}
+
+func (w *memoryRecordingWriter) Abort(ctx context.Context) error {
+	w.aborted = true
+	return nil
+}
+
+func newTestStreamingRecorder(t *testing.T, opts utils.Option, writer internal_type.RecordingWriter) *streamingAudioRecorder {
+	logger, _ := commons.NewApplicationLogger()
+	// segments are flushed by the test, not the ticker
+	opts["recording.segment_duration"] = 3600
+	rc, err := NewStreamingAudioRecorder(logger, testAudioConfig(), testAudioConfig(), opts, writer)
+	require.NoError(t, err)
+	return rc.(*streamingAudioRecorder)
+}
+
+func TestStreamingRecorder_MatchesBufferedRecording(t *testing.T) {
+	writer := &memoryRecordingWriter{}
+	streaming := newTestStreamingRecorder(t, utils.Option{"recording.channels": 2, "recording.split_tracks": true}, writer)
+	buffered := newTestRecorder(t, utils.Option{"recording.channels": 2, "recording.split_tracks": true})
+
+	start := time.Now()
+	chunks := []struct {
+		system bool
+		at     time.Duration
+		value  int16
+	}{
+		{false, 0, 1000},
+		{true, 50 * time.Millisecond, 2000},
+		{true, 50 * time.Millisecond, 3000},
+		{false, 300 * time.Millisecond, 1500},
+	}
+	for i, c := range chunks {
+		streaming.add(c.system, start.Add(c.at), tone(c.value, 100))
+		buffered.add(c.system, start.Add(c.at), tone(c.value, 100))
+		if i == 1 {
+			// the second system chunk is queued while the first one is half written
+			require.NoError(t, streaming.flush(context.Background(), start.Add(100*time.Millisecond)))
+		}
+	}
+	require.NoError(t, streaming.flush(context.Background(), start.Add(200*time.Millisecond)))
+
+	recording, err := streaming.Persist()
+	require.NoError(t, err)
+	assert.Nil(t, recording)
+	assert.True(t, writer.closed)
+
+	expected, err := buffered.Persist()
+	require.NoError(t, err)
+
+	for track, wav := range map[internal_type.RecordingTrack][]byte{
+		internal_type.RecordingTrackMixed:     expected.Mixed,
+		internal_type.RecordingTrackUser:      expected.User,
+		internal_type.RecordingTrackAssistant: expected.Assistant,
+	} {
+		streamed := writer.tracks[track]
+		require.Greater(t, len(streamed), wavHeaderSize, track)
+		assert.Equal(t, uint32(0xFFFFFFFF), binary.LittleEndian.Uint32(streamed[4:]), track)
+		assert.Equal(t, wav[:4], streamed[:4], track)
+		assert.Equal(t, wav[8:40], streamed[8:40], track)
+		assert.Equal(t, wav[wavHeaderSize:], streamed[wavHeaderSize:], track)
+	}
+}
+
+func TestStreamingRecorder_ReleasesWrittenAudio(t *testing.T) {
+	writer := &memoryRecordingWriter{}
+	r := newTestStreamingRecorder(t, utils.Option{}, writer)
+
+	start := time.Now()
+	r.add(false, start, tone(1000, 100))
+	r.add(true, start, tone(2000, 100))
+	r.add(true, start, tone(3000, 100))
+	require.NoError(t, r.flush(context.Background(), start.Add(150*time.Millisecond)))
+
+	// only the queued system chunk which is still playing is held
+	require.Len(t, r.audioChunks, 1)
+	assert.Equal(t, start.Add(100*time.Millisecond), r.playedUntil)
+	assert.Equal(t, start.Add(100*time.Millisecond), r.calculateSystemAudioPlayTime(r.audioChunks[0]))
+
+	mixed := writer.tracks[internal_type.RecordingTrackMixed]
+	assert.Equal(t, 1200*2, len(mixed)-wavHeaderSize)
+	assert.Equal(t, int16(3000), sampleAt(mixed, 1, 0, 0))
+	assert.Equal(t, int16(3000), sampleAt(mixed, 1, 1100, 0))
+}
+
+func TestStreamingRecorder_EmptyRecordingIsAborted(t *testing.T) {
+	writer := &memoryRecordingWriter{}
+	r := newTestStreamingRecorder(t, utils.Option{}, writer)
+
+	_, err := r.Persist()
+	assert.Error(t, err)
+	assert.True(t, writer.aborted)
+	assert.False(t, writer.closed)
+}
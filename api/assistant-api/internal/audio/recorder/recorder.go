@@ -9,10 +9,18 @@ import (
 	internal_recorder "github.com/rapidaai/api/assistant-api/internal/audio/recorder/internal"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
 )
 
 // logger, audioConfig, opts
-func GetRecorder(logger commons.Logger, intputAudio, outputAudio *protos.AudioConfig) (internal_type.Recorder, error) {
-	return internal_recorder.NewDefaultAudioRecorder(logger, intputAudio, outputAudio)
+//
+// recording.channels 2 records the user on the left and the assistant on the right channel,
+// recording.split_tracks additionally persists one file per speaker. With a writer the recording
+// is streamed to storage every recording.segment_duration seconds instead of held in memory.
+func GetRecorder(logger commons.Logger, intputAudio, outputAudio *protos.AudioConfig, opts utils.Option, writer internal_type.RecordingWriter) (internal_type.Recorder, error) {
+	if writer != nil {
+		return internal_recorder.NewStreamingAudioRecorder(logger, intputAudio, outputAudio, opts, writer)
+	}
+	return internal_recorder.NewDefaultAudioRecorder(logger, intputAudio, outputAudio, opts)
 }
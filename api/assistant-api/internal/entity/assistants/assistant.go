@@ -39,6 +39,7 @@ type Assistant struct {
 	AssistantDebuggerDeployment  *AssistantDebuggerDeployment                          `json:"debuggerDeployment"  gorm:"foreignKey:AssistantId"`
 	AssistantPhoneDeployment     *AssistantPhoneDeployment                             `json:"phoneDeployment"  gorm:"foreignKey:AssistantId"`
 	AssistantWhatsappDeployment  *AssistantWhatsappDeployment                          `json:"whatsappDeployment"  gorm:"foreignKey:AssistantId"`
+	AssistantSmsDeployment       *AssistantSmsDeployment                               `json:"smsDeployment"  gorm:"foreignKey:AssistantId"`
 	AssistantWebPluginDeployment *AssistantWebPluginDeployment                         `json:"webPluginDeployment"  gorm:"foreignKey:AssistantId"`
 	AssistantApiDeployment       *AssistantApiDeployment                               `json:"apiDeployment"  gorm:"foreignKey:AssistantId"`
 	AssistantConversations       []*internal_conversation_entity.AssistantConversation `json:"assistantConversations"  gorm:"foreignKey:AssistantId"`
@@ -60,6 +61,14 @@ func (a *Assistant) IsWebPluginDeploymentEnable() bool {
 	return a.AssistantWhatsappDeployment != nil
 }
 
+func (a *Assistant) IsWhatsappDeploymentEnable() bool {
+	return a.AssistantWhatsappDeployment != nil
+}
+
+func (a *Assistant) IsSmsDeploymentEnable() bool {
+	return a.AssistantSmsDeployment != nil
+}
+
 // AssistantTag represents a tag associated with an assistant in the database.
 // It extends the Audited model and includes fields for the assistant ID,
 // the tag itself (as a string array), and information about who created and updated the tag.
@@ -39,6 +39,14 @@ type AssistantDeploymentWhatsapp struct {
 	WhatsappOptions  []*AssistantDeploymentWhatsappOption `json:"whatsappOptions"  gorm:"foreignKey:AssistantDeploymentWhatsappId"`
 }
 
+func (a *AssistantDeploymentWhatsapp) GetOptions() utils.Option {
+	opts := make(map[string]interface{})
+	for _, v := range a.WhatsappOptions {
+		opts[v.Key] = v.Value
+	}
+	return opts
+}
+
 type AssistantDeploymentWhatsappOption struct {
 	gorm_model.Audited
 	gorm_model.Mutable
@@ -46,6 +54,36 @@ type AssistantDeploymentWhatsappOption struct {
 	AssistantDeploymentWhatsappId uint64 `json:"assistantDeploymentWhatsappId" gorm:"type:bigint;size:20"`
 }
 
+type AssistantDeploymentSms struct {
+	SmsProvider string                          `json:"smsProviderName" gorm:"type:string;size:50;not null;"`
+	SmsOptions  []*AssistantDeploymentSmsOption `json:"smsOptions"  gorm:"foreignKey:AssistantDeploymentSmsId"`
+}
+
+func (a *AssistantDeploymentSms) GetOptions() utils.Option {
+	opts := make(map[string]interface{})
+	for _, v := range a.SmsOptions {
+		opts[v.Key] = v.Value
+	}
+	return opts
+}
+
+type AssistantDeploymentSmsOption struct {
+	gorm_model.Audited
+	gorm_model.Mutable
+	gorm_model.Metadata
+	AssistantDeploymentSmsId uint64 `json:"assistantDeploymentSmsId" gorm:"type:bigint;size:20"`
+}
+
+// AssistantSmsOptOut is a number which opted out of the sms of the assistant, nothing is sent to
+// the number until it opts in again
+type AssistantSmsOptOut struct {
+	gorm_model.Audited
+	gorm_model.Mutable
+	AssistantId uint64 `json:"assistantId" gorm:"type:bigint;size:20;not null"`
+	PhoneNumber string `json:"phoneNumber" gorm:"type:string;size:50;not null"`
+	Keyword     string `json:"keyword" gorm:"type:string;size:50"`
+}
+
 // input audio later
 type AssistantDeploymentAudio struct {
 	gorm_model.Audited
@@ -120,6 +158,11 @@ type AssistantWhatsappDeployment struct {
 	AssistantDeploymentWhatsapp
 }
 
+type AssistantSmsDeployment struct {
+	AssistantDeploymentBehavior
+	AssistantDeploymentSms
+}
+
 /**
  */
 type AssistantApiDeployment struct {
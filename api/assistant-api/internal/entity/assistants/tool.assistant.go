@@ -37,6 +37,15 @@ func (a *AssistantTool) GetOptions() utils.Option {
 	return opts
 }
 
+// GetFillerMessage is spoken while the tool is executing, for example "let me look that up"
+func (a *AssistantTool) GetFillerMessage() string {
+	message, err := a.GetOptions().GetString("tool.filler_message")
+	if err != nil {
+		return ""
+	}
+	return message
+}
+
 type AssistantToolLog struct {
 	gorm_model.Audited
 	gorm_model.Mutable
@@ -0,0 +1,131 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_campaign_entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+)
+
+type CampaignStatus string
+
+const (
+	// waiting for the start of the campaign
+	CAMPAIGN_SCHEDULED CampaignStatus = "SCHEDULED"
+	CAMPAIGN_RUNNING   CampaignStatus = "RUNNING"
+	CAMPAIGN_PAUSED    CampaignStatus = "PAUSED"
+	CAMPAIGN_CANCELLED CampaignStatus = "CANCELLED"
+	// every contact was called until answered or out of attempts
+	CAMPAIGN_COMPLETED CampaignStatus = "COMPLETED"
+)
+
+func (s CampaignStatus) String() string {
+	return string(s)
+}
+
+// IsFinal reports whether the campaign does not place calls anymore
+func (s CampaignStatus) IsFinal() bool {
+	return s == CAMPAIGN_CANCELLED || s == CAMPAIGN_COMPLETED
+}
+
+// CampaignRetryPolicy tells how often a contact is called again after a call with the disposition
+type CampaignRetryPolicy struct {
+	Disposition       CallDisposition `json:"disposition"`
+	MaxAttempts       uint32          `json:"maxAttempts"`
+	RetryAfterSeconds uint32          `json:"retryAfterSeconds"`
+}
+
+type CampaignRetryPolicies []CampaignRetryPolicy
+
+func (a *CampaignRetryPolicies) Scan(value interface{}) error {
+	if value == nil {
+		*a = make(CampaignRetryPolicies, 0)
+		return nil
+	}
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, a)
+	case string:
+		return json.Unmarshal([]byte(v), a)
+	default:
+		return fmt.Errorf("unsupported type: %T", value)
+	}
+}
+
+func (a CampaignRetryPolicies) Value() (driver.Value, error) {
+	return json.Marshal(a)
+}
+
+// Get returns the policy of the disposition
+func (a CampaignRetryPolicies) Get(disposition CallDisposition) (CampaignRetryPolicy, bool) {
+	for _, policy := range a {
+		if policy.Disposition == disposition {
+			return policy, true
+		}
+	}
+	return CampaignRetryPolicy{}, false
+}
+
+// CampaignProgress counts the contacts of a campaign by status
+type CampaignProgress struct {
+	Total     uint64 `json:"total"`
+	Pending   uint64 `json:"pending"`
+	Calling   uint64 `json:"calling"`
+	Retrying  uint64 `json:"retrying"`
+	Completed uint64 `json:"completed"`
+	Failed    uint64 `json:"failed"`
+	Cancelled uint64 `json:"cancelled"`
+}
+
+// Add counts the contacts with the status
+func (p *CampaignProgress) Add(status ContactStatus, count uint64) {
+	p.Total += count
+	switch status {
+	case CONTACT_PENDING:
+		p.Pending += count
+	case CONTACT_CALLING:
+		p.Calling += count
+	case CONTACT_RETRYING:
+		p.Retrying += count
+	case CONTACT_COMPLETED:
+		p.Completed += count
+	case CONTACT_FAILED:
+		p.Failed += count
+	case CONTACT_CANCELLED:
+		p.Cancelled += count
+	}
+}
+
+// AssistantCampaign calls a list of contacts with the assistant. Calls are only placed within the
+// calling window in the time zone of the campaign and at most MaxConcurrentCalls calls are in
+// flight on the telephony credential at a time.
+type AssistantCampaign struct {
+	gorm_model.Audited
+	gorm_model.Mutable
+	gorm_model.Organizational
+	AssistantId        uint64                  `json:"assistantId" gorm:"type:bigint;not null"`
+	AssistantVersion   string                  `json:"assistantVersion" gorm:"type:string"`
+	Name               string                  `json:"name" gorm:"type:string;not null"`
+	CampaignStatus     CampaignStatus          `json:"status" gorm:"type:string;not null"`
+	FromNumber         string                  `json:"fromNumber" gorm:"type:string"`
+	Timezone           string                  `json:"timezone" gorm:"type:string;not null;default:UTC"`
+	WindowStart        string                  `json:"windowStart" gorm:"type:string"`
+	WindowEnd          string                  `json:"windowEnd" gorm:"type:string"`
+	WindowDays         gorm_types.IntArray     `json:"windowDays" gorm:"type:jsonb"`
+	StartAt            gorm_model.TimeWrapper  `json:"startAt" gorm:"type:timestamp;not null"`
+	MaxConcurrentCalls uint32                  `json:"maxConcurrentCalls" gorm:"type:integer;not null;default:1"`
+	RetryPolicies      CampaignRetryPolicies   `json:"retryPolicies" gorm:"type:jsonb"`
+	Options            gorm_types.InterfaceMap `json:"-" gorm:"type:jsonb"`
+
+	// telephony credential of the phone deployment, concurrent calls are counted per credential
+	CredentialId uint64 `json:"-" gorm:"type:bigint;not null"`
+
+	Progress *CampaignProgress `json:"progress" gorm:"-"`
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_campaign_entity
+
+import (
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+)
+
+type ContactStatus string
+
+const (
+	CONTACT_PENDING ContactStatus = "PENDING"
+	CONTACT_CALLING ContactStatus = "CALLING"
+	// waiting for the next attempt
+	CONTACT_RETRYING  ContactStatus = "RETRYING"
+	CONTACT_COMPLETED ContactStatus = "COMPLETED"
+	// out of attempts
+	CONTACT_FAILED    ContactStatus = "FAILED"
+	CONTACT_CANCELLED ContactStatus = "CANCELLED"
+)
+
+func (s ContactStatus) String() string {
+	return string(s)
+}
+
+// CallDisposition is the outcome of a call to a contact
+type CallDisposition string
+
+const (
+	DISPOSITION_ANSWERED  CallDisposition = "answered"
+	DISPOSITION_BUSY      CallDisposition = "busy"
+	DISPOSITION_NO_ANSWER CallDisposition = "no-answer"
+	DISPOSITION_VOICEMAIL CallDisposition = "voicemail"
+	DISPOSITION_FAILED    CallDisposition = "failed"
+)
+
+func (d CallDisposition) String() string {
+	return string(d)
+}
+
+// AssistantCampaignContact is a contact of a campaign, the args are passed to the assistant
+// when the contact is called
+type AssistantCampaignContact struct {
+	gorm_model.Audited
+	gorm_model.Mutable
+	gorm_model.Organizational
+	CampaignId              uint64                  `json:"campaignId" gorm:"type:bigint;not null"`
+	CredentialId            uint64                  `json:"-" gorm:"type:bigint;not null"`
+	ToNumber                string                  `json:"toNumber" gorm:"type:string;not null"`
+	Args                    gorm_types.InterfaceMap `json:"-" gorm:"type:jsonb"`
+	ContactStatus           ContactStatus           `json:"status" gorm:"type:string;not null"`
+	Disposition             CallDisposition         `json:"disposition" gorm:"type:string"`
+	Attempts                uint32                  `json:"attempts" gorm:"type:integer;not null;default:0"`
+	AssistantConversationId uint64                  `json:"assistantConversationId" gorm:"type:bigint"`
+	NextAttemptAt           gorm_model.TimeWrapper  `json:"nextAttemptAt" gorm:"type:timestamp;not null"`
+}
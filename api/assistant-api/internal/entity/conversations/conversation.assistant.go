@@ -7,6 +7,7 @@ package internal_conversation_entity
 
 import (
 	gorm_model "github.com/rapidaai/pkg/models/gorm"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
 	type_enums "github.com/rapidaai/pkg/types/enums"
 	"github.com/rapidaai/pkg/utils"
 )
@@ -68,4 +69,11 @@ type AssistantConversationRecording struct {
 	AssistantId             uint64 `json:"assistantId" gorm:"type:bigint;not null"`
 	AssistantConversationId uint64 `json:"assistantConversationId" gorm:"type:bigint;not null"`
 	RecordingUrl            string `json:"recordingUrl" gorm:"type:string;not null"`
+	Channels                uint32 `json:"channels" gorm:"type:integer;not null;default:1"`
+	UserRecordingUrl        string `json:"userRecordingUrl" gorm:"type:string"`
+	AssistantRecordingUrl   string `json:"assistantRecordingUrl" gorm:"type:string"`
+	Source                  string `json:"source" gorm:"type:string;not null;default:rapida"`
+
+	// multipart upload id of every track while the recording is streamed to storage
+	Uploads gorm_types.StringMap `json:"-" gorm:"type:jsonb"`
 }
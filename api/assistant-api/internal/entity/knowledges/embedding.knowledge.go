@@ -0,0 +1,34 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_knowledge_gorm
+
+import (
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+)
+
+// KnowledgeEmbeddingJob re-embeds every segment of a knowledge into a new storage namespace
+// and swaps the namespace of the knowledge once complete.
+//
+// Status follows the record state of the job, IN_PROGRESS while backfilling, COMPLETE once the
+// knowledge points to the new namespace, FAILED when the knowledge was left untouched and
+// INACTIVE when the job was rolled back to the previous namespace.
+type KnowledgeEmbeddingJob struct {
+	gorm_model.Audited
+	gorm_model.Mutable
+	gorm_model.Organizational
+	KnowledgeId                        uint64               `json:"knowledgeId" gorm:"type:bigint;not null"`
+	StorageNamespace                   string               `json:"storageNamespace" gorm:"type:string;not null"`
+	PreviousStorageNamespace           string               `json:"previousStorageNamespace" gorm:"type:string;not null"`
+	EmbeddingModelProviderName         string               `json:"embeddingModelProviderName" gorm:"type:string;not null"`
+	PreviousEmbeddingModelProviderName string               `json:"previousEmbeddingModelProviderName" gorm:"type:string"`
+	EmbeddingModelOptions              gorm_types.StringMap `json:"embeddingModelOptions" gorm:"type:string"`
+	PreviousEmbeddingModelOptions      gorm_types.StringMap `json:"previousEmbeddingModelOptions" gorm:"type:string"`
+	TotalSegments                      uint64               `json:"totalSegments" gorm:"type:bigint"`
+	ProcessedSegments                  uint64               `json:"processedSegments" gorm:"type:bigint"`
+	FailedSegments                     uint64               `json:"failedSegments" gorm:"type:bigint"`
+	Error                              string               `json:"error" gorm:"type:string"`
+}
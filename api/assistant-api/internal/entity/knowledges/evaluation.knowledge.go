@@ -0,0 +1,51 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_knowledge_gorm
+
+import (
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+)
+
+// KnowledgeEvaluation is a labeled question set used to measure the retrieval quality of a knowledge
+type KnowledgeEvaluation struct {
+	gorm_model.Audited
+	gorm_model.Mutable
+	gorm_model.Organizational
+	KnowledgeId uint64                         `json:"knowledgeId" gorm:"type:bigint;not null"`
+	Name        string                         `json:"name" gorm:"type:string;size:200;not null"`
+	Description string                         `json:"description" gorm:"type:string"`
+	Questions   []*KnowledgeEvaluationQuestion `json:"questions" gorm:"foreignKey:KnowledgeEvaluationId"`
+	Reports     []*KnowledgeEvaluationReport   `json:"reports" gorm:"foreignKey:KnowledgeEvaluationId"`
+}
+
+type KnowledgeEvaluationQuestion struct {
+	gorm_model.Audited
+	KnowledgeEvaluationId uint64                 `json:"knowledgeEvaluationId" gorm:"type:bigint;not null"`
+	Question              string                 `json:"question" gorm:"type:string;not null"`
+	ExpectedDocumentIds   gorm_types.StringArray `json:"expectedDocumentIds" gorm:"type:string"`
+	ExpectedSegmentIds    gorm_types.StringArray `json:"expectedSegmentIds" gorm:"type:string"`
+}
+
+// KnowledgeEvaluationReport is the result of one run of the question set with a retrieval configuration,
+// per question results are stored in the knowledge log referenced by KnowledgeLogId
+type KnowledgeEvaluationReport struct {
+	gorm_model.Audited
+	gorm_model.Mutable
+	gorm_model.Organizational
+	KnowledgeEvaluationId uint64  `json:"knowledgeEvaluationId" gorm:"type:bigint;not null"`
+	KnowledgeId           uint64  `json:"knowledgeId" gorm:"type:bigint;not null"`
+	RetrievalMethod       string  `json:"retrievalMethod" gorm:"type:string;size:50"`
+	Rerank                bool    `json:"rerank" gorm:"type:bool"`
+	TopK                  uint32  `json:"topK" gorm:"type:int"`
+	ScoreThreshold        float32 `json:"scoreThreshold" gorm:"type:float"`
+	QuestionCount         uint32  `json:"questionCount" gorm:"type:int"`
+	RecallAtK             float64 `json:"recallAtK" gorm:"type:float"`
+	Mrr                   float64 `json:"mrr" gorm:"type:float"`
+	AverageTimeTaken      int64   `json:"averageTimeTaken" gorm:"type:bigint"`
+	P95TimeTaken          int64   `json:"p95TimeTaken" gorm:"type:bigint"`
+	KnowledgeLogId        uint64  `json:"knowledgeLogId" gorm:"type:bigint"`
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_knowledge_gorm
+
+import (
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+)
+
+type SegmentRevisionAction string
+
+const (
+	SegmentRevisionUpdate SegmentRevisionAction = "update"
+	SegmentRevisionDelete SegmentRevisionAction = "delete"
+)
+
+// KnowledgeDocumentSegmentRevision keeps the state of a segment before a manual edit
+type KnowledgeDocumentSegmentRevision struct {
+	gorm_model.Audited
+	gorm_model.Mutable
+	gorm_model.Organizational
+	KnowledgeId      uint64                  `json:"knowledgeId" gorm:"type:bigint;not null"`
+	StorageNamespace string                  `json:"storageNamespace" gorm:"type:string;not null"`
+	DocumentId       string                  `json:"documentId" gorm:"type:string;not null"`
+	Action           SegmentRevisionAction   `json:"action" gorm:"type:string;size:50;not null"`
+	Text             string                  `json:"text" gorm:"type:string"`
+	DocumentName     string                  `json:"documentName" gorm:"type:string"`
+	Entities         gorm_types.InterfaceMap `json:"entities" gorm:"type:string"`
+	Reason           string                  `json:"reason" gorm:"type:string"`
+}
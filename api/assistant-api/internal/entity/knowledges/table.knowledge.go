@@ -0,0 +1,94 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_knowledge_gorm
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+)
+
+// document structure of csv and xlsx documents stored as rows instead of indexed segments
+const DOCUMENT_STRUCTURE_TABULAR = "tabular"
+
+type KnowledgeTableColumnType string
+
+const (
+	KnowledgeTableColumnText   KnowledgeTableColumnType = "text"
+	KnowledgeTableColumnNumber KnowledgeTableColumnType = "number"
+)
+
+// KnowledgeTableColumn is a column of an uploaded table, Key is the sanitized name rows are stored with
+type KnowledgeTableColumn struct {
+	Key  string                   `json:"key"`
+	Name string                   `json:"name"`
+	Type KnowledgeTableColumnType `json:"type"`
+}
+
+type KnowledgeTableColumns []*KnowledgeTableColumn
+
+// Scan converts JSON data into KnowledgeTableColumns
+func (a *KnowledgeTableColumns) Scan(value interface{}) error {
+	if value == nil {
+		*a = make(KnowledgeTableColumns, 0)
+		return nil
+	}
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, a)
+	case string:
+		return json.Unmarshal([]byte(v), a)
+	default:
+		return fmt.Errorf("unsupported type: %T", value)
+	}
+}
+
+// Value converts KnowledgeTableColumns into a format suitable for the database
+func (a KnowledgeTableColumns) Value() (driver.Value, error) {
+	data, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// KnowledgeTable is the tabular data of a knowledge document, rows are queried with exact filters
+// instead of being searched by similarity
+type KnowledgeTable struct {
+	gorm_model.Audited
+	gorm_model.Mutable
+	gorm_model.Organizational
+	KnowledgeId         uint64                `json:"knowledgeId" gorm:"type:bigint;not null"`
+	KnowledgeDocumentId uint64                `json:"knowledgeDocumentId" gorm:"type:bigint;not null"`
+	Name                string                `json:"name" gorm:"type:string;not null"`
+	Columns             KnowledgeTableColumns `json:"columns" gorm:"type:string;not null"`
+	RowCount            uint64                `json:"rowCount" gorm:"type:bigint"`
+}
+
+// Column returns the column matching the key or the display name
+func (t *KnowledgeTable) Column(name string) (*KnowledgeTableColumn, bool) {
+	for _, c := range t.Columns {
+		if c.Key == name {
+			return c, true
+		}
+	}
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+type KnowledgeTableRow struct {
+	gorm_model.Audited
+	KnowledgeTableId uint64                  `json:"knowledgeTableId" gorm:"type:bigint;not null"`
+	Position         uint64                  `json:"position" gorm:"type:bigint;not null"`
+	Data             gorm_types.InterfaceMap `json:"data" gorm:"type:jsonb;not null"`
+}
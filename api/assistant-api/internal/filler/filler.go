@@ -0,0 +1,225 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_filler
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+const (
+	FillerOptionsKeyEnable = "filler.enable"
+	FillerOptionsKeyClips  = "filler.clips"
+
+	// silence before a filler is played
+	defaultDelay = 1500 * time.Millisecond
+)
+
+// phrases played when nothing else is configured
+var DefaultFillerPhrases = []string{
+	"One moment.", "Let me check that.", "Just a second.",
+}
+
+// Filler is a short phrase or a pre-rendered clip played while the assistant is working. Audio is
+// set for clips and is already in the output audio format, otherwise the text is spoken.
+type Filler struct {
+	ContextID string
+	Text      string
+	Audio     []byte
+}
+
+// FillerPolicy fills dead air while the model or a tool is working. A wait is armed for an
+// utterance and a filler is played when no audio is sent to the user for the configured delay.
+// The wait ends as soon as the real response starts.
+type FillerPolicy interface {
+	// Wait arms the filler for the utterance, a non empty message is played instead of the configured
+	// fillers. Arming an utterance which is still waiting restarts the wait.
+	Wait(contextId, message string)
+
+	// Output tracks audio of an utterance sent to the user, fillers never talk over it
+	Output(contextId string, audio []byte)
+
+	// Cancel ends the wait of the utterance, the real response has started
+	Cancel(contextId string)
+
+	// Close ends any wait
+	Close()
+}
+
+type fillerPolicy struct {
+	mu  sync.Mutex
+	now func() time.Time
+
+	enabled bool
+	delay   time.Duration
+	fillers []Filler
+	next    int
+
+	bytesPerSecond int
+	playingUntil   time.Time
+
+	contextId string
+	message   string
+	wait      uint64
+	armedAt   time.Time
+	timer     *time.Timer
+	onFiller  func(Filler)
+}
+
+// NewFillerPolicy reads the policy from the options, clips are pre-rendered audio in the output
+// audio format which are played in turn with the configured phrases. onFiller is called from the
+// timer when a filler has to be played.
+func NewFillerPolicy(opts utils.Option, outputConfig *protos.AudioConfig, clips [][]byte, onFiller func(Filler)) FillerPolicy {
+	policy := &fillerPolicy{
+		now:            time.Now,
+		delay:          defaultDelay,
+		bytesPerSecond: internal_audio.BytesPerSecond(outputConfig),
+		onFiller:       onFiller,
+	}
+	if enabled, err := opts.GetBool(FillerOptionsKeyEnable); err == nil {
+		policy.enabled = enabled
+	}
+	if delay, err := opts.GetUint32("filler.delay"); err == nil && delay > 0 {
+		policy.delay = time.Duration(delay) * time.Millisecond
+	}
+
+	for _, clip := range clips {
+		if len(clip) > 0 {
+			policy.fillers = append(policy.fillers, Filler{Audio: clip})
+		}
+	}
+	// the default phrases are only played when no clip is available
+	phrases := DefaultFillerPhrases
+	if len(policy.fillers) > 0 {
+		phrases = nil
+	}
+	if configured, err := opts.GetString("filler.phrases"); err == nil && strings.TrimSpace(configured) != "" {
+		phrases = nil
+		for _, phrase := range strings.Split(configured, commons.SEPARATOR) {
+			if phrase = strings.TrimSpace(phrase); phrase != "" {
+				phrases = append(phrases, phrase)
+			}
+		}
+	}
+	for _, phrase := range phrases {
+		policy.fillers = append(policy.fillers, Filler{Text: phrase})
+	}
+	return policy
+}
+
+func (p *fillerPolicy) Wait(contextId, message string) {
+	if !p.enabled || p.onFiller == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	message = strings.TrimSpace(message)
+	// a tool without message keeps the message of a tool called with it
+	if message == "" && p.timer != nil && p.contextId == contextId {
+		message = p.message
+	}
+	p.stop()
+	p.contextId = contextId
+	p.message = message
+	p.armedAt = p.now()
+	p.schedule(p.remaining())
+}
+
+// remaining is the time until the user has heard nothing for the delay since the wait was armed
+func (p *fillerPolicy) remaining() time.Duration {
+	silentSince := p.armedAt
+	if p.playingUntil.After(silentSince) {
+		silentSince = p.playingUntil
+	}
+	return silentSince.Add(p.delay).Sub(p.now())
+}
+
+func (p *fillerPolicy) schedule(after time.Duration) {
+	wait := p.wait
+	p.timer = time.AfterFunc(after, func() {
+		p.fire(wait)
+	})
+}
+
+func (p *fillerPolicy) fire(wait uint64) {
+	p.mu.Lock()
+	// the wait was cancelled or armed again
+	if p.wait != wait || p.timer == nil {
+		p.mu.Unlock()
+		return
+	}
+	// audio was sent after the wait was armed
+	if remaining := p.remaining(); remaining > 0 {
+		p.schedule(remaining)
+		p.mu.Unlock()
+		return
+	}
+	filler, ok := p.pick()
+	// a single filler is played per wait
+	p.timer = nil
+	p.mu.Unlock()
+
+	if ok {
+		p.onFiller(filler)
+	}
+}
+
+// pick prefers the message of the wait, otherwise the configured fillers are played in turn
+func (p *fillerPolicy) pick() (Filler, bool) {
+	if p.message != "" {
+		return Filler{ContextID: p.contextId, Text: p.message}, true
+	}
+	if len(p.fillers) == 0 {
+		return Filler{}, false
+	}
+	filler := p.fillers[p.next%len(p.fillers)]
+	p.next++
+	filler.ContextID = p.contextId
+	return filler, true
+}
+
+func (p *fillerPolicy) Output(contextId string, audio []byte) {
+	if p.bytesPerSecond == 0 || len(audio) == 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	if p.playingUntil.Before(now) {
+		p.playingUntil = now
+	}
+	p.playingUntil = p.playingUntil.Add(time.Duration(len(audio)) * time.Second / time.Duration(p.bytesPerSecond))
+}
+
+func (p *fillerPolicy) Cancel(contextId string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.contextId != contextId {
+		return
+	}
+	p.stop()
+}
+
+func (p *fillerPolicy) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.stop()
+	p.playingUntil = time.Time{}
+}
+
+func (p *fillerPolicy) stop() {
+	p.wait++
+	if p.timer != nil {
+		p.timer.Stop()
+		p.timer = nil
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_filler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDelay = 20 * time.Millisecond
+
+// 8kHz 16-bit mono, 16000 bytes is a second of audio
+func testOutputConfig() *protos.AudioConfig {
+	return &protos.AudioConfig{SampleRate: 8000, AudioFormat: protos.AudioConfig_LINEAR16, Channels: 1}
+}
+
+func testOptions(opts utils.Option) utils.Option {
+	merged := utils.Option{FillerOptionsKeyEnable: "true", "filler.delay": "20"}
+	for k, v := range opts {
+		merged[k] = v
+	}
+	return merged
+}
+
+func newTestPolicy(opts utils.Option, clips [][]byte) (FillerPolicy, chan Filler) {
+	played := make(chan Filler, 10)
+	policy := NewFillerPolicy(opts, testOutputConfig(), clips, func(f Filler) {
+		played <- f
+	})
+	return policy, played
+}
+
+func receive(t *testing.T, played chan Filler) Filler {
+	t.Helper()
+	select {
+	case f := <-played:
+		return f
+	case <-time.After(time.Second):
+		require.FailNow(t, "filler was not played")
+		return Filler{}
+	}
+}
+
+func nothing(t *testing.T, played chan Filler) {
+	t.Helper()
+	select {
+	case f := <-played:
+		assert.Failf(t, "unexpected filler", "%+v", f)
+	case <-time.After(5 * testDelay):
+	}
+}
+
+func TestFillerPolicy_Selection(t *testing.T) {
+	clip := []byte{1, 2, 3}
+	tests := []struct {
+		name    string
+		opts    utils.Option
+		clips   [][]byte
+		message string
+		want    []Filler
+	}{
+		{
+			name: "default phrases in turn",
+			opts: utils.Option{},
+			want: []Filler{{Text: "One moment."}, {Text: "Let me check that."}, {Text: "Just a second."}, {Text: "One moment."}},
+		},
+		{
+			name: "configured phrases",
+			opts: utils.Option{"filler.phrases": "Hang on." + commons.SEPARATOR + " " + commons.SEPARATOR + "Checking."},
+			want: []Filler{{Text: "Hang on."}, {Text: "Checking."}, {Text: "Hang on."}},
+		},
+		{
+			name:  "clips replace the default phrases",
+			opts:  utils.Option{},
+			clips: [][]byte{clip, nil},
+			want:  []Filler{{Audio: clip}, {Audio: clip}},
+		},
+		{
+			name:  "clips are played with configured phrases",
+			opts:  utils.Option{"filler.phrases": "Hang on."},
+			clips: [][]byte{clip},
+			want:  []Filler{{Audio: clip}, {Text: "Hang on."}, {Audio: clip}},
+		},
+		{
+			name:    "tool message is preferred",
+			opts:    utils.Option{"filler.phrases": "Hang on."},
+			clips:   [][]byte{clip},
+			message: " Let me look that up. ",
+			want:    []Filler{{Text: "Let me look that up."}, {Text: "Let me look that up."}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, played := newTestPolicy(testOptions(tt.opts), tt.clips)
+			defer policy.Close()
+			for _, want := range tt.want {
+				policy.Wait("utterance", tt.message)
+				got := receive(t, played)
+				want.ContextID = "utterance"
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestFillerPolicy_DisabledByDefault(t *testing.T) {
+	policy, played := newTestPolicy(utils.Option{"filler.delay": "20"}, nil)
+	policy.Wait("utterance", "")
+	nothing(t, played)
+}
+
+func TestFillerPolicy_Cancel(t *testing.T) {
+	policy, played := newTestPolicy(testOptions(utils.Option{}), nil)
+
+	policy.Wait("utterance", "")
+	policy.Cancel("utterance")
+	nothing(t, played)
+
+	// only the wait of the same utterance is cancelled
+	policy.Wait("utterance", "")
+	policy.Cancel("previous")
+	assert.Equal(t, "utterance", receive(t, played).ContextID)
+
+	policy.Wait("utterance", "")
+	policy.Close()
+	nothing(t, played)
+}
+
+func TestFillerPolicy_SingleFillerPerWait(t *testing.T) {
+	policy, played := newTestPolicy(testOptions(utils.Option{}), nil)
+	defer policy.Close()
+
+	policy.Wait("utterance", "")
+	// arming again replaces the wait
+	policy.Wait("utterance", "Let me look that up.")
+	assert.Equal(t, "Let me look that up.", receive(t, played).Text)
+	nothing(t, played)
+
+	// tools called together keep the message of the tool which has one
+	policy.Wait("utterance", "Let me look that up.")
+	policy.Wait("utterance", "")
+	assert.Equal(t, "Let me look that up.", receive(t, played).Text)
+
+	// a finished wait does not leak its message
+	policy.Wait("utterance", "")
+	assert.Equal(t, "One moment.", receive(t, played).Text)
+}
+
+func TestFillerPolicy_WaitsForOutput(t *testing.T) {
+	policy, played := newTestPolicy(testOptions(utils.Option{}), nil)
+	defer policy.Close()
+
+	start := time.Now()
+	// 200ms of audio is still playing
+	policy.Output("previous", make([]byte, 3200))
+	policy.Wait("utterance", "")
+	receive(t, played)
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond+testDelay)
+
+	// audio sent while waiting moves the filler
+	start = time.Now()
+	policy.Wait("utterance", "")
+	policy.Output("utterance", make([]byte, 1600))
+	receive(t, played)
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond+testDelay)
+}
@@ -0,0 +1,313 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_interruption
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+type InterruptionMode string
+
+const (
+	// voice activity and transcribed words both interrupt
+	DefaultInterruption InterruptionMode = "default"
+	// only voice activity interrupts
+	VadInterruption InterruptionMode = "vad"
+	// only transcribed words interrupt
+	WordInterruption InterruptionMode = "word"
+	// the assistant can not be interrupted while speaking
+	DisabledInterruption InterruptionMode = "disabled"
+
+	InterruptionOptionsKeyMode = "interruption.mode"
+
+	// voice activity starting before this many seconds is treated as noise
+	defaultGracePeriod = 3.0
+)
+
+// words which acknowledge the assistant without asking it to stop
+var DefaultBackchannelWords = []string{
+	"uh-huh", "uh huh", "mhm", "mm-hmm", "hmm", "um", "uh", "ok", "okay", "yeah", "yep", "yes", "right", "sure", "got it", "i see",
+}
+
+type Decision int
+
+const (
+	// the packet is not a barge-in, the assistant is not speaking
+	Pass Decision = iota
+	// the packet interrupts the assistant
+	Interrupt
+	// the packet is dropped and the assistant keeps speaking
+	Ignore
+)
+
+func (d Decision) String() string {
+	switch d {
+	case Pass:
+		return "pass"
+	case Interrupt:
+		return "interrupt"
+	case Ignore:
+		return "ignore"
+	default:
+		return "unknown"
+	}
+}
+
+// InterruptionPolicy decides whether the user barges in while the assistant is speaking. It
+// follows the audio sent to the user to know when the assistant is speaking and which utterance
+// is playing.
+type InterruptionPolicy interface {
+	// Evaluate decides what happens to an interruption, speech to text or user text packet
+	Evaluate(pkt internal_type.Packet) Decision
+
+	// Speak tracks audio of an utterance sent to the user
+	Speak(contextId string, audio []byte)
+
+	// Observe checks text of an utterance for phrases which must not be interrupted
+	Observe(contextId, text string)
+
+	// Greeting marks the greeting utterance, it is protected when configured
+	Greeting(contextId string)
+
+	// Interrupted clears the speaking state once the assistant has been interrupted
+	Interrupted()
+}
+
+type interruptionPolicy struct {
+	mu  sync.Mutex
+	now func() time.Time
+
+	mode             InterruptionMode
+	gracePeriod      float64
+	minDuration      float64
+	minWords         int
+	backchannel      map[string]struct{}
+	protectGreeting  bool
+	protectedPhrases []string
+
+	bytesPerSecond int
+	speakingId     string
+	speakingUntil  time.Time
+	protected      map[string]struct{}
+}
+
+// NewInterruptionPolicy reads the policy from the options, the output audio config is used to
+// know how long the audio sent to the user plays. Without options the assistant is interrupted
+// by any voice activity after the grace period and by any transcribed word.
+func NewInterruptionPolicy(opts utils.Option, outputConfig *protos.AudioConfig) (InterruptionPolicy, error) {
+	policy := &interruptionPolicy{
+		now:            time.Now,
+		mode:           DefaultInterruption,
+		gracePeriod:    defaultGracePeriod,
+		bytesPerSecond: internal_audio.BytesPerSecond(outputConfig),
+		protected:      make(map[string]struct{}),
+	}
+	if mode, err := opts.GetString(InterruptionOptionsKeyMode); err == nil && mode != "" {
+		switch InterruptionMode(mode) {
+		case DefaultInterruption, VadInterruption, WordInterruption, DisabledInterruption:
+			policy.mode = InterruptionMode(mode)
+		default:
+			return nil, fmt.Errorf("illegal interruption mode %s", mode)
+		}
+	}
+	if gracePeriod, err := opts.GetFloat64("interruption.grace_period"); err == nil && gracePeriod >= 0 {
+		policy.gracePeriod = gracePeriod
+	}
+	if minDuration, err := opts.GetFloat64("interruption.min_duration"); err == nil && minDuration > 0 {
+		policy.minDuration = minDuration
+	}
+	if minWords, err := opts.GetUint32("interruption.min_words"); err == nil {
+		policy.minWords = int(minWords)
+	}
+	if ignore, err := opts.GetBool("interruption.ignore_backchannel"); err == nil && ignore {
+		words := DefaultBackchannelWords
+		if configured, err := opts.GetString("interruption.backchannel_words"); err == nil && strings.TrimSpace(configured) != "" {
+			words = strings.Split(configured, commons.SEPARATOR)
+		}
+		policy.backchannel = make(map[string]struct{}, len(words))
+		for _, word := range words {
+			if normalized := normalize(word); normalized != "" {
+				policy.backchannel[normalized] = struct{}{}
+			}
+		}
+	}
+	if protect, err := opts.GetBool("interruption.protect_greeting"); err == nil {
+		policy.protectGreeting = protect
+	}
+	if phrases, err := opts.GetString("interruption.protected_phrases"); err == nil {
+		for _, phrase := range strings.Split(phrases, commons.SEPARATOR) {
+			if normalized := normalize(phrase); normalized != "" {
+				policy.protectedPhrases = append(policy.protectedPhrases, normalized)
+			}
+		}
+	}
+	return policy, nil
+}
+
+func (p *interruptionPolicy) Evaluate(pkt internal_type.Packet) Decision {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	switch vl := pkt.(type) {
+	case internal_type.InterruptionPacket:
+		// might be noise at first
+		if vl.Source == internal_type.InterruptionSourceVad && vl.StartAt < p.gracePeriod {
+			return Ignore
+		}
+		// outside of speech an interruption starts the next turn of the user
+		if !p.speaking() {
+			return Interrupt
+		}
+		if p.mode == DisabledInterruption || p.isProtected() {
+			return Ignore
+		}
+		switch vl.Source {
+		case internal_type.InterruptionSourceVad:
+			// the words decide when they have to be checked
+			if p.mode == WordInterruption || p.requiresTranscript() {
+				return Ignore
+			}
+			if vl.EndAt-vl.StartAt < p.minDuration {
+				return Ignore
+			}
+			return Interrupt
+		default:
+			// a word interruption carries no transcript, the speech to text packet decides
+			if p.mode == VadInterruption || p.requiresTranscript() {
+				return Ignore
+			}
+			return Interrupt
+		}
+	case internal_type.SpeechToTextPacket:
+		if !p.speaking() {
+			return Pass
+		}
+		if p.mode == DisabledInterruption || p.mode == VadInterruption || p.isProtected() {
+			return Ignore
+		}
+		if !p.qualifies(vl.Script) {
+			return Ignore
+		}
+		return Interrupt
+	case internal_type.UserTextPacket:
+		// typed text is a new message, never a barge-in
+		return Interrupt
+	default:
+		return Pass
+	}
+}
+
+// requiresTranscript reports whether only the transcript can tell if the user barges in
+func (p *interruptionPolicy) requiresTranscript() bool {
+	return p.minWords > 0 || len(p.backchannel) > 0
+}
+
+// qualifies checks the transcript against the minimum words and the backchannel words
+func (p *interruptionPolicy) qualifies(transcript string) bool {
+	words := strings.Fields(normalize(transcript))
+	if len(words) == 0 {
+		return false
+	}
+	if len(p.backchannel) > 0 && p.backchannelOnly(words) {
+		return false
+	}
+	return len(words) >= p.minWords
+}
+
+// backchannelOnly matches the words against backchannel phrases of up to three words
+func (p *interruptionPolicy) backchannelOnly(words []string) bool {
+	for i := 0; i < len(words); {
+		matched := false
+		for n := 3; n > 0; n-- {
+			if i+n > len(words) {
+				continue
+			}
+			if _, ok := p.backchannel[strings.Join(words[i:i+n], " ")]; ok {
+				i += n
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *interruptionPolicy) speaking() bool {
+	return p.now().Before(p.speakingUntil)
+}
+
+func (p *interruptionPolicy) isProtected() bool {
+	_, ok := p.protected[p.speakingId]
+	return ok
+}
+
+func (p *interruptionPolicy) Speak(contextId string, audio []byte) {
+	if p.bytesPerSecond == 0 || len(audio) == 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	if p.speakingUntil.Before(now) {
+		p.speakingUntil = now
+	}
+	p.speakingId = contextId
+	p.speakingUntil = p.speakingUntil.Add(time.Duration(len(audio)) * time.Second / time.Duration(p.bytesPerSecond))
+}
+
+func (p *interruptionPolicy) Observe(contextId, text string) {
+	if len(p.protectedPhrases) == 0 {
+		return
+	}
+	normalized := normalize(text)
+	for _, phrase := range p.protectedPhrases {
+		if strings.Contains(normalized, phrase) {
+			p.mu.Lock()
+			p.protected[contextId] = struct{}{}
+			p.mu.Unlock()
+			return
+		}
+	}
+}
+
+func (p *interruptionPolicy) Greeting(contextId string) {
+	if !p.protectGreeting {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.protected[contextId] = struct{}{}
+}
+
+func (p *interruptionPolicy) Interrupted() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.speakingUntil = time.Time{}
+	p.speakingId = ""
+}
+
+// normalize lower cases the text and drops punctuation so transcripts compare by words
+func normalize(text string) string {
+	return strings.Join(strings.Fields(strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '\'' {
+			return unicode.ToLower(r)
+		}
+		return ' '
+	}, text)), " ")
+}
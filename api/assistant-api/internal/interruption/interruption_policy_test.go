@@ -0,0 +1,238 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_interruption
+
+import (
+	"testing"
+	"time"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 8kHz 16-bit mono, 16000 bytes is a second of audio
+func testOutputConfig() *protos.AudioConfig {
+	return &protos.AudioConfig{SampleRate: 8000, AudioFormat: protos.AudioConfig_LINEAR16, Channels: 1}
+}
+
+func newTestPolicy(t *testing.T, opts utils.Option, now *time.Time) *interruptionPolicy {
+	policy, err := NewInterruptionPolicy(opts, testOutputConfig())
+	require.NoError(t, err)
+	p := policy.(*interruptionPolicy)
+	p.now = func() time.Time { return *now }
+	return p
+}
+
+func vad(startAt, endAt float64) internal_type.InterruptionPacket {
+	return internal_type.InterruptionPacket{Source: internal_type.InterruptionSourceVad, StartAt: startAt, EndAt: endAt}
+}
+
+func word() internal_type.InterruptionPacket {
+	return internal_type.InterruptionPacket{Source: internal_type.InterruptionSourceWord}
+}
+
+func transcript(script string) internal_type.SpeechToTextPacket {
+	return internal_type.SpeechToTextPacket{Script: script}
+}
+
+func TestInterruptionPolicy_Evaluate(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     utils.Option
+		speaking bool
+		greeting bool
+		phrase   string
+		packets  []internal_type.Packet
+		want     []Decision
+	}{
+		{
+			name:    "vad within grace period is noise",
+			opts:    utils.Option{},
+			packets: []internal_type.Packet{vad(1, 2), vad(4, 5)},
+			want:    []Decision{Ignore, Interrupt},
+		},
+		{
+			name:     "default interrupts on vad and words while speaking",
+			opts:     utils.Option{},
+			speaking: true,
+			packets:  []internal_type.Packet{vad(4, 4.1), word(), transcript("uh-huh")},
+			want:     []Decision{Interrupt, Interrupt, Interrupt},
+		},
+		{
+			name:    "transcript outside of speech passes",
+			opts:    utils.Option{"interruption.mode": "disabled"},
+			packets: []internal_type.Packet{transcript("hello"), vad(4, 5), word()},
+			want:    []Decision{Pass, Interrupt, Interrupt},
+		},
+		{
+			name:     "disabled ignores everything while speaking",
+			opts:     utils.Option{"interruption.mode": "disabled"},
+			speaking: true,
+			packets:  []internal_type.Packet{vad(4, 6), word(), transcript("stop right there")},
+			want:     []Decision{Ignore, Ignore, Ignore},
+		},
+		{
+			name:     "vad mode ignores words",
+			opts:     utils.Option{"interruption.mode": "vad"},
+			speaking: true,
+			packets:  []internal_type.Packet{word(), transcript("wait a second"), vad(4, 5)},
+			want:     []Decision{Ignore, Ignore, Interrupt},
+		},
+		{
+			name:     "word mode ignores vad",
+			opts:     utils.Option{"interruption.mode": "word"},
+			speaking: true,
+			packets:  []internal_type.Packet{vad(4, 5), word(), transcript("wait")},
+			want:     []Decision{Ignore, Interrupt, Interrupt},
+		},
+		{
+			name:     "min duration",
+			opts:     utils.Option{"interruption.min_duration": "0.5"},
+			speaking: true,
+			packets:  []internal_type.Packet{vad(4, 4.2), vad(4, 4.6)},
+			want:     []Decision{Ignore, Interrupt},
+		},
+		{
+			name:     "min words defers to the transcript",
+			opts:     utils.Option{"interruption.min_words": "3"},
+			speaking: true,
+			packets:  []internal_type.Packet{vad(4, 6), word(), transcript("wait"), transcript("wait, that is wrong")},
+			want:     []Decision{Ignore, Ignore, Ignore, Interrupt},
+		},
+		{
+			name:     "backchannel is ignored",
+			opts:     utils.Option{"interruption.ignore_backchannel": "true"},
+			speaking: true,
+			packets: []internal_type.Packet{
+				vad(4, 6), word(), transcript("Uh-huh."), transcript("okay, got it"), transcript("mm-hmm yeah"), transcript("okay but what about tomorrow"),
+			},
+			want: []Decision{Ignore, Ignore, Ignore, Ignore, Ignore, Interrupt},
+		},
+		{
+			name:     "configured backchannel words replace the defaults",
+			opts:     utils.Option{"interruption.ignore_backchannel": true, "interruption.backchannel_words": "d'accord" + commons.SEPARATOR + "oui"},
+			speaking: true,
+			packets:  []internal_type.Packet{transcript("oui"), transcript("D'accord!"), transcript("okay")},
+			want:     []Decision{Ignore, Ignore, Interrupt},
+		},
+		{
+			name:     "empty transcript does not interrupt",
+			opts:     utils.Option{"interruption.mode": "word"},
+			speaking: true,
+			packets:  []internal_type.Packet{transcript(""), transcript("...")},
+			want:     []Decision{Ignore, Ignore},
+		},
+		{
+			name:     "protected greeting",
+			opts:     utils.Option{"interruption.protect_greeting": "true"},
+			speaking: true,
+			greeting: true,
+			packets:  []internal_type.Packet{vad(4, 6), word(), transcript("hello there")},
+			want:     []Decision{Ignore, Ignore, Ignore},
+		},
+		{
+			name:     "greeting is interruptible unless protected",
+			opts:     utils.Option{},
+			speaking: true,
+			greeting: true,
+			packets:  []internal_type.Packet{vad(4, 6)},
+			want:     []Decision{Interrupt},
+		},
+		{
+			name:     "protected disclaimer",
+			opts:     utils.Option{"interruption.protected_phrases": "this call may be recorded" + commons.SEPARATOR + "terms apply"},
+			speaking: true,
+			phrase:   "Please note, This call may be recorded for quality purposes.",
+			packets:  []internal_type.Packet{vad(4, 6), transcript("stop")},
+			want:     []Decision{Ignore, Ignore},
+		},
+		{
+			name:     "user text always interrupts",
+			opts:     utils.Option{"interruption.mode": "disabled", "interruption.protect_greeting": true},
+			speaking: true,
+			greeting: true,
+			packets:  []internal_type.Packet{internal_type.UserTextPacket{Text: "hi"}},
+			want:     []Decision{Interrupt},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Len(t, tt.want, len(tt.packets))
+			now := time.Now()
+			p := newTestPolicy(t, tt.opts, &now)
+			if tt.greeting {
+				p.Greeting("utterance")
+			}
+			if tt.phrase != "" {
+				p.Observe("utterance", tt.phrase)
+			}
+			if tt.speaking {
+				p.Speak("utterance", make([]byte, 16000*10))
+			}
+			for i, pkt := range tt.packets {
+				assert.Equal(t, tt.want[i].String(), p.Evaluate(pkt).String(), "packet %d %+v", i, pkt)
+			}
+		})
+	}
+}
+
+func TestInterruptionPolicy_SpeakingFollowsAudio(t *testing.T) {
+	now := time.Now()
+	p := newTestPolicy(t, utils.Option{"interruption.mode": "disabled"}, &now)
+
+	// two chunks of half a second queue up to a second of speech
+	p.Speak("utterance", make([]byte, 8000))
+	p.Speak("utterance", make([]byte, 8000))
+
+	now = now.Add(900 * time.Millisecond)
+	assert.Equal(t, Ignore, p.Evaluate(vad(4, 5)))
+
+	now = now.Add(200 * time.Millisecond)
+	assert.Equal(t, Interrupt, p.Evaluate(vad(4, 5)))
+	assert.Equal(t, Pass, p.Evaluate(transcript("hello")))
+}
+
+func TestInterruptionPolicy_InterruptedStopsSpeaking(t *testing.T) {
+	now := time.Now()
+	p := newTestPolicy(t, utils.Option{"interruption.min_words": 2}, &now)
+	p.Speak("utterance", make([]byte, 16000))
+
+	assert.Equal(t, Interrupt, p.Evaluate(transcript("hold on")))
+	p.Interrupted()
+	// the rest of the same utterance is a normal turn
+	assert.Equal(t, Pass, p.Evaluate(transcript("hold on please")))
+	assert.Equal(t, Interrupt, p.Evaluate(word()))
+}
+
+func TestInterruptionPolicy_ProtectionEndsWithUtterance(t *testing.T) {
+	now := time.Now()
+	p := newTestPolicy(t, utils.Option{"interruption.protect_greeting": true}, &now)
+	p.Greeting("greeting")
+	p.Speak("greeting", make([]byte, 16000))
+	assert.Equal(t, Ignore, p.Evaluate(vad(4, 5)))
+
+	p.Speak("answer", make([]byte, 16000))
+	assert.Equal(t, Interrupt, p.Evaluate(vad(4, 5)))
+}
+
+func TestInterruptionPolicy_WithoutOutputAudio(t *testing.T) {
+	policy, err := NewInterruptionPolicy(utils.Option{"interruption.mode": "disabled"}, nil)
+	require.NoError(t, err)
+	policy.Speak("utterance", make([]byte, 16000))
+	// speech can not be followed, nothing is a barge-in
+	assert.Equal(t, Interrupt, policy.Evaluate(vad(4, 5)))
+	assert.Equal(t, Pass, policy.Evaluate(transcript("hello")))
+}
+
+func TestNewInterruptionPolicy_InvalidMode(t *testing.T) {
+	_, err := NewInterruptionPolicy(utils.Option{"interruption.mode": "sometimes"}, testOutputConfig())
+	assert.Error(t, err)
+}
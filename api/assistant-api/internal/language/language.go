@@ -0,0 +1,166 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_language
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+)
+
+const (
+	LanguageOptionsKeyAuto = "speaker.language.auto"
+	// languages the assistant switches to, as language=voice pairs. The voice may be empty when
+	// the voice of the deployment speaks the language.
+	LanguageOptionsKeyVoices = "speaker.language.voices"
+
+	// prompt variable which tells the model the language the user speaks
+	LanguagePromptVariable = "language"
+
+	// a single transcript in another language is often a misdetection
+	defaultMinUtterances = 2
+)
+
+// Language is a language the assistant switches to, options replace the options of the text to
+// speech transformer
+type Language struct {
+	Code    string
+	Options utils.Option
+}
+
+// LanguagePolicy detects a sustained change of the language the user speaks from the language of
+// completed transcripts
+type LanguagePolicy interface {
+	// Enabled reports whether the assistant switches language during the conversation
+	Enabled() bool
+
+	// Current returns the language the assistant speaks
+	Current() string
+
+	// Observe records the language of a completed transcript and returns the language to switch to
+	// once the user keeps speaking it
+	Observe(language string) (Language, bool)
+}
+
+type languagePolicy struct {
+	mu sync.Mutex
+
+	enabled       bool
+	minUtterances int
+	voices        map[string]string
+
+	current   string
+	candidate string
+	count     int
+}
+
+// NewLanguagePolicy reads the policy from the text to speech options, the language of the
+// deployment is the language the conversation starts in
+func NewLanguagePolicy(opts utils.Option) (LanguagePolicy, error) {
+	policy := &languagePolicy{
+		minUtterances: defaultMinUtterances,
+		voices:        make(map[string]string),
+	}
+	if language, err := opts.GetString("speaker.language"); err == nil {
+		policy.current = normalize(language)
+	}
+	if enabled, err := opts.GetBool(LanguageOptionsKeyAuto); err == nil {
+		policy.enabled = enabled
+	}
+	if minUtterances, err := opts.GetUint32("speaker.language.min_utterances"); err == nil && minUtterances > 0 {
+		policy.minUtterances = int(minUtterances)
+	}
+	if voices, err := opts.GetString(LanguageOptionsKeyVoices); err == nil {
+		for _, pair := range strings.Split(voices, commons.SEPARATOR) {
+			if strings.TrimSpace(pair) == "" {
+				continue
+			}
+			language, voice, ok := strings.Cut(pair, "=")
+			if !ok || normalize(language) == "" {
+				return nil, fmt.Errorf("illegal language voice %q, expected language=voice", pair)
+			}
+			policy.voices[normalize(language)] = strings.TrimSpace(voice)
+		}
+	}
+	// the conversation can always switch back to the language and voice of the deployment
+	if _, ok := policy.voices[policy.current]; !ok && policy.current != "" && len(policy.voices) > 0 {
+		policy.voices[policy.current] = ""
+	}
+	return policy, nil
+}
+
+// normalize lower cases the language, providers report languages with or without region
+func normalize(language string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(language), "_", "-"))
+}
+
+// base strips the region of the language
+func base(language string) string {
+	code, _, _ := strings.Cut(language, "-")
+	return code
+}
+
+func (p *languagePolicy) Enabled() bool {
+	return p.enabled && len(p.voices) > 0
+}
+
+func (p *languagePolicy) Current() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.current
+}
+
+// lookup finds the configured language, a transcript in es-MX switches to es and the other way round
+func (p *languagePolicy) lookup(language string) (string, bool) {
+	if _, ok := p.voices[language]; ok {
+		return language, true
+	}
+	if _, ok := p.voices[base(language)]; ok {
+		return base(language), true
+	}
+	for configured := range p.voices {
+		if base(configured) == base(language) {
+			return configured, true
+		}
+	}
+	return "", false
+}
+
+func (p *languagePolicy) Observe(language string) (Language, bool) {
+	language = normalize(language)
+	if !p.Enabled() || language == "" {
+		return Language{}, false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if base(language) == base(p.current) {
+		p.candidate, p.count = "", 0
+		return Language{}, false
+	}
+	configured, ok := p.lookup(language)
+	if !ok {
+		p.candidate, p.count = "", 0
+		return Language{}, false
+	}
+	if configured != p.candidate {
+		p.candidate, p.count = configured, 0
+	}
+	p.count++
+	if p.count < p.minUtterances {
+		return Language{}, false
+	}
+
+	p.current, p.candidate, p.count = configured, "", 0
+	options := utils.Option{"speaker.language": configured, "speak.language": configured}
+	if voice := p.voices[configured]; voice != "" {
+		options["speak.voice.id"] = voice
+	}
+	return Language{Code: configured, Options: options}, true
+}
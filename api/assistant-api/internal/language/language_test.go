@@ -0,0 +1,107 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_language
+
+import (
+	"testing"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testOptions() utils.Option {
+	return utils.Option{
+		"speaker.language":       "en",
+		"speak.voice.id":         "voice-en",
+		LanguageOptionsKeyAuto:   "true",
+		LanguageOptionsKeyVoices: "es=voice-es" + commons.SEPARATOR + "fr-CA=" + commons.SEPARATOR,
+	}
+}
+
+func TestNewLanguagePolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        utils.Option
+		wantEnabled bool
+		wantErr     bool
+	}{
+		{name: "auto with voices", opts: testOptions(), wantEnabled: true},
+		{name: "without options", opts: utils.Option{}},
+		{name: "auto without voices", opts: utils.Option{LanguageOptionsKeyAuto: "true"}},
+		{name: "voices without auto", opts: utils.Option{LanguageOptionsKeyVoices: "es=voice-es"}},
+		{name: "illegal voice", opts: utils.Option{LanguageOptionsKeyVoices: "voice-es"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := NewLanguagePolicy(tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantEnabled, policy.Enabled())
+		})
+	}
+}
+
+func TestLanguagePolicy_SustainedChange(t *testing.T) {
+	policy, err := NewLanguagePolicy(testOptions())
+	require.NoError(t, err)
+	assert.Equal(t, "en", policy.Current())
+
+	// a single misdetected transcript does not switch
+	_, ok := policy.Observe("es")
+	assert.False(t, ok)
+	_, ok = policy.Observe("en-US")
+	assert.False(t, ok)
+	_, ok = policy.Observe("es-MX")
+	assert.False(t, ok)
+
+	language, ok := policy.Observe("es")
+	require.True(t, ok)
+	assert.Equal(t, "es", language.Code)
+	assert.Equal(t, utils.Option{"speaker.language": "es", "speak.language": "es", "speak.voice.id": "voice-es"}, language.Options)
+	assert.Equal(t, "es", policy.Current())
+
+	// transcripts without language keep the streak
+	_, ok = policy.Observe("en")
+	assert.False(t, ok)
+	_, ok = policy.Observe("")
+	assert.False(t, ok)
+	language, ok = policy.Observe("en")
+	require.True(t, ok)
+	assert.Equal(t, utils.Option{"speaker.language": "en", "speak.language": "en"}, language.Options, "the deployment voice is used again")
+}
+
+func TestLanguagePolicy_Observe(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      utils.Option
+		languages []string
+		want      string
+	}{
+		{name: "region of a configured language", opts: testOptions(), languages: []string{"fr", "FR_fr"}, want: "fr-ca"},
+		{name: "language without voice", opts: testOptions(), languages: []string{"de", "de"}},
+		{name: "alternating languages", opts: testOptions(), languages: []string{"es", "fr", "es", "fr"}},
+		{name: "disabled", opts: utils.Option{"speaker.language": "en", LanguageOptionsKeyVoices: "es=voice-es"}, languages: []string{"es", "es"}},
+		{name: "one utterance", opts: utils.Option{"speaker.language": "en", LanguageOptionsKeyAuto: "true", LanguageOptionsKeyVoices: "es=voice-es", "speaker.language.min_utterances": "1"}, languages: []string{"es"}, want: "es"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := NewLanguagePolicy(tt.opts)
+			require.NoError(t, err)
+			var switched string
+			for _, l := range tt.languages {
+				if language, ok := policy.Observe(l); ok {
+					switched = language.Code
+				}
+			}
+			assert.Equal(t, tt.want, switched)
+		})
+	}
+}
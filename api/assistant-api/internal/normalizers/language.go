@@ -0,0 +1,180 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_normalizers
+
+import (
+	"strconv"
+	"strings"
+
+	ntw "moul.io/number-to-words"
+)
+
+// Languages with localized normalizers, text in any other language is normalized as English.
+const (
+	LanguageEnglish       = "en"
+	LanguageIndianEnglish = "en-in"
+	LanguageHindi         = "hi"
+	LanguageSpanish       = "es"
+	LanguageGerman        = "de"
+	LanguageFrench        = "fr"
+)
+
+// NormalizerLanguage resolves the language of the speaker to a language with localized
+// normalizers, es-MX is normalized as Spanish and en-IN keeps Indian numbering.
+func NormalizerLanguage(language string) string {
+	language = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(language), "_", "-"))
+	if language == LanguageIndianEnglish {
+		return LanguageIndianEnglish
+	}
+	code, _, _ := strings.Cut(language, "-")
+	switch code {
+	case LanguageHindi, LanguageSpanish, LanguageGerman, LanguageFrench:
+		return code
+	default:
+		return LanguageEnglish
+	}
+}
+
+// numberFormat is how a language writes and reads numbers
+type numberFormat struct {
+	// spells a whole number
+	spell func(int) string
+	// the word for one before a noun, Spanish says un euro and not uno euro
+	one string
+
+	indian           bool
+	groupSeparator   byte
+	decimalSeparator byte
+	decimalWord      string
+}
+
+func getNumberFormat(language string) numberFormat {
+	switch NormalizerLanguage(language) {
+	case LanguageIndianEnglish:
+		return numberFormat{spell: integerToEnIn, one: "one", indian: true, groupSeparator: ',', decimalSeparator: '.', decimalWord: "point"}
+	case LanguageHindi:
+		return numberFormat{spell: integerToHiIn, one: "एक", indian: true, groupSeparator: ',', decimalSeparator: '.', decimalWord: "दशमलव"}
+	case LanguageSpanish:
+		return numberFormat{spell: integerToEsEs, one: "un", groupSeparator: '.', decimalSeparator: ',', decimalWord: "coma"}
+	case LanguageGerman:
+		return numberFormat{spell: ntw.IntegerToDeDe, one: "ein", groupSeparator: '.', decimalSeparator: ',', decimalWord: "Komma"}
+	case LanguageFrench:
+		return numberFormat{spell: ntw.IntegerToFrFr, one: "un", groupSeparator: '.', decimalSeparator: ',', decimalWord: "virgule"}
+	default:
+		return numberFormat{spell: ntw.IntegerToEnUs, one: "one", groupSeparator: ',', decimalSeparator: '.', decimalWord: "point"}
+	}
+}
+
+// digits spells every digit, used for the decimals and for codes and phone numbers
+func (f numberFormat) digits(s string) string {
+	words := make([]string, 0, len(s))
+	for _, c := range s {
+		words = append(words, f.spell(int(c-'0')))
+	}
+	return strings.Join(words, " ")
+}
+
+// parse splits a written number into its whole part and its decimals. Groups must be written
+// the way the language groups digits, 1.5 is not one thousand five hundred in German, and the
+// decimals are digits only, a group separator after the decimal separator is not a number.
+func (f numberFormat) parse(s string) (int, string, bool) {
+	whole, decimals := s, ""
+	if i := strings.LastIndexByte(s, f.decimalSeparator); i >= 0 {
+		whole, decimals = s[:i], s[i+1:]
+		if !isDigits(decimals) {
+			return 0, "", false
+		}
+	}
+	groups := strings.Split(whole, string(f.groupSeparator))
+	for i, group := range groups {
+		if group == "" || strings.IndexByte(group, f.decimalSeparator) >= 0 {
+			return 0, "", false
+		}
+		if len(groups) == 1 {
+			continue
+		}
+		switch {
+		case i == 0 && len(group) > 3:
+			return 0, "", false
+		case i == len(groups)-1 && len(group) != 3:
+			return 0, "", false
+		// Indian numbering groups digits above thousand by two
+		case i > 0 && i < len(groups)-1 && len(group) != 3 && !(f.indian && len(group) == 2):
+			return 0, "", false
+		}
+	}
+	n, err := strconv.Atoi(strings.Join(groups, ""))
+	if err != nil {
+		return 0, "", false
+	}
+	return n, decimals, true
+}
+
+// hindiNumbers are the words for 0 to 99, Hindi numbers below hundred are not composed
+var hindiNumbers = [100]string{
+	"शून्य", "एक", "दो", "तीन", "चार", "पाँच", "छह", "सात", "आठ", "नौ",
+	"दस", "ग्यारह", "बारह", "तेरह", "चौदह", "पंद्रह", "सोलह", "सत्रह", "अठारह", "उन्नीस",
+	"बीस", "इक्कीस", "बाईस", "तेईस", "चौबीस", "पच्चीस", "छब्बीस", "सत्ताईस", "अट्ठाईस", "उनतीस",
+	"तीस", "इकतीस", "बत्तीस", "तैंतीस", "चौंतीस", "पैंतीस", "छत्तीस", "सैंतीस", "अड़तीस", "उनतालीस",
+	"चालीस", "इकतालीस", "बयालीस", "तैंतालीस", "चवालीस", "पैंतालीस", "छियालीस", "सैंतालीस", "अड़तालीस", "उनचास",
+	"पचास", "इक्यावन", "बावन", "तिरेपन", "चौवन", "पचपन", "छप्पन", "सत्तावन", "अट्ठावन", "उनसठ",
+	"साठ", "इकसठ", "बासठ", "तिरसठ", "चौंसठ", "पैंसठ", "छियासठ", "सड़सठ", "अड़सठ", "उनहत्तर",
+	"सत्तर", "इकहत्तर", "बहत्तर", "तिहत्तर", "चौहत्तर", "पचहत्तर", "छिहत्तर", "सतहत्तर", "अठहत्तर", "उन्यासी",
+	"अस्सी", "इक्यासी", "बयासी", "तिरासी", "चौरासी", "पचासी", "छियासी", "सत्तासी", "अट्ठासी", "नवासी",
+	"नब्बे", "इक्यानबे", "बानबे", "तिरानबे", "चौरानबे", "पंचानबे", "छियानबे", "सत्तानबे", "अट्ठानबे", "निन्यानबे",
+}
+
+// indianScale are the words of the Indian numbering system, 1,50,00,000 is one crore fifty lakh
+type indianScale struct {
+	belowHundred func(int) string
+	hundred      string
+	thousand     string
+	lakh         string
+	crore        string
+}
+
+func (s indianScale) spell(n int) string {
+	if n < 0 {
+		return s.spell(-n)
+	}
+	if n < 100 {
+		return s.belowHundred(n)
+	}
+	var words []string
+	if crores := n / 10000000; crores > 0 {
+		words = append(words, s.spell(crores), s.crore)
+	}
+	if lakhs := n / 100000 % 100; lakhs > 0 {
+		words = append(words, s.belowHundred(lakhs), s.lakh)
+	}
+	if thousands := n / 1000 % 100; thousands > 0 {
+		words = append(words, s.belowHundred(thousands), s.thousand)
+	}
+	if hundreds := n / 100 % 10; hundreds > 0 {
+		words = append(words, s.belowHundred(hundreds), s.hundred)
+	}
+	if rest := n % 100; rest > 0 {
+		words = append(words, s.belowHundred(rest))
+	}
+	return strings.Join(words, " ")
+}
+
+func integerToEnIn(n int) string {
+	return indianScale{belowHundred: ntw.IntegerToEnUs, hundred: "hundred", thousand: "thousand", lakh: "lakh", crore: "crore"}.spell(n)
+}
+
+func integerToHiIn(n int) string {
+	return indianScale{belowHundred: func(n int) string { return hindiNumbers[n] }, hundred: "सौ", thousand: "हज़ार", lakh: "लाख", crore: "करोड़"}.spell(n)
+}
+
+// integerToEsEs spells Spanish numbers, a thousand is mil and not un mil
+func integerToEsEs(n int) string {
+	words := ntw.IntegerToEsEs(n)
+	if words == "un mil" || strings.HasPrefix(words, "un mil ") {
+		return strings.TrimPrefix(words, "un ")
+	}
+	return words
+}
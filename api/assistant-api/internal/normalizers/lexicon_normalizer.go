@@ -0,0 +1,199 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_normalizers
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rapidaai/pkg/commons"
+)
+
+// LexiconEntry tells how a brand name, acronym or word is pronounced. Providers with SSML
+// support speak the phonemes or the alias, the others speak the alias or the respelling.
+type LexiconEntry struct {
+	Grapheme string `json:"grapheme"`
+	// spoken instead of the grapheme, RAPIDA as rapida
+	Alias string `json:"alias,omitempty"`
+	// pronunciation in the alphabet, ipa unless told otherwise
+	Phoneme  string `json:"phoneme,omitempty"`
+	Alphabet string `json:"alphabet,omitempty"`
+	// spoken instead of the phonemes by providers without SSML support
+	Respelling string `json:"respelling,omitempty"`
+}
+
+// ParseLexicon reads the lexicon from its JSON form, a list of entries
+func ParseLexicon(raw string) ([]LexiconEntry, error) {
+	var entries []LexiconEntry
+	if err := json.Unmarshal([]byte(raw), &entries); err != nil {
+		return nil, fmt.Errorf("illegal pronunciation lexicon: %w", err)
+	}
+	valid := entries[:0]
+	for _, entry := range entries {
+		entry.Grapheme = strings.TrimSpace(entry.Grapheme)
+		if entry.Grapheme == "" || (entry.Alias == "" && entry.Phoneme == "" && entry.Respelling == "") {
+			continue
+		}
+		valid = append(valid, entry)
+	}
+	return valid, nil
+}
+
+type lexiconNormalizer struct {
+	logger commons.Logger
+	ssml   bool
+	re     *regexp.Regexp
+
+	// acronyms only match as written, US is not us
+	exact map[string]LexiconEntry
+	fold  map[string]LexiconEntry
+}
+
+// NewLexiconNormalizer applies the pronunciation lexicon. With ssml the text must already be
+// escaped for XML, entries are emitted as <phoneme> and <sub> elements and existing tags are
+// left untouched. Without ssml entries are replaced by their alias or respelling.
+func NewLexiconNormalizer(logger commons.Logger, entries []LexiconEntry, ssml bool) Normalizer {
+	ln := &lexiconNormalizer{
+		logger: logger,
+		ssml:   ssml,
+		exact:  make(map[string]LexiconEntry),
+		fold:   make(map[string]LexiconEntry),
+	}
+	graphemes := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		grapheme := entry.Grapheme
+		if ssml {
+			grapheme = escapeXML(grapheme)
+		}
+		if isAcronym(grapheme) {
+			ln.exact[grapheme] = entry
+		} else {
+			ln.fold[strings.ToLower(grapheme)] = entry
+		}
+		graphemes = append(graphemes, regexp.QuoteMeta(grapheme))
+	}
+	if len(graphemes) == 0 {
+		return ln
+	}
+	// longest first, so Rapida AI wins over Rapida
+	sort.Slice(graphemes, func(i, j int) bool { return len(graphemes[i]) > len(graphemes[j]) })
+	ln.re = regexp.MustCompile(`(?i)` + strings.Join(graphemes, "|"))
+	return ln
+}
+
+func (ln *lexiconNormalizer) Normalize(s string) string {
+	if ln.re == nil || s == "" {
+		return s
+	}
+	var tags [][]int
+	if ln.ssml {
+		tags = tagPattern.FindAllStringIndex(s, -1)
+	}
+
+	var builder strings.Builder
+	last := 0
+	for _, match := range ln.re.FindAllStringIndex(s, -1) {
+		start, end := match[0], match[1]
+		if !isWordBoundary(s, start, end) || insideTag(tags, start) {
+			continue
+		}
+		entry, ok := ln.lookup(s[start:end])
+		if !ok {
+			continue
+		}
+		builder.WriteString(s[last:start])
+		builder.WriteString(ln.pronounce(entry, s[start:end]))
+		last = end
+	}
+	builder.WriteString(s[last:])
+	return builder.String()
+}
+
+func (ln *lexiconNormalizer) lookup(word string) (LexiconEntry, bool) {
+	if entry, ok := ln.exact[word]; ok {
+		return entry, true
+	}
+	entry, ok := ln.fold[strings.ToLower(word)]
+	return entry, ok
+}
+
+func (ln *lexiconNormalizer) pronounce(entry LexiconEntry, word string) string {
+	if !ln.ssml {
+		switch {
+		case entry.Alias != "":
+			return entry.Alias
+		case entry.Respelling != "":
+			return entry.Respelling
+		default:
+			return word
+		}
+	}
+	if entry.Phoneme != "" {
+		alphabet := entry.Alphabet
+		if alphabet == "" {
+			alphabet = "ipa"
+		}
+		return fmt.Sprintf(`<phoneme alphabet="%s" ph="%s">%s</phoneme>`, escapeXML(alphabet), escapeXML(entry.Phoneme), word)
+	}
+	alias := entry.Alias
+	if alias == "" {
+		alias = entry.Respelling
+	}
+	return fmt.Sprintf(`<sub alias="%s">%s</sub>`, escapeXML(alias), word)
+}
+
+var tagPattern = regexp.MustCompile(`<[^<>]*>`)
+
+func insideTag(tags [][]int, pos int) bool {
+	for _, tag := range tags {
+		if pos > tag[0] && pos < tag[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// isWordBoundary reports whether the match is a whole word, C++ and AT&T are words as well
+func isWordBoundary(s string, start, end int) bool {
+	if before, _ := utf8.DecodeLastRuneInString(s[:start]); start > 0 && isWordRune(before) {
+		return false
+	}
+	if after, _ := utf8.DecodeRuneInString(s[end:]); end < len(s) && isWordRune(after) {
+		return false
+	}
+	return true
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)
+}
+
+// isAcronym reports whether the word is written in capitals only
+func isAcronym(word string) bool {
+	hasUpper := false
+	for _, r := range word {
+		if unicode.IsLower(r) {
+			return false
+		}
+		hasUpper = hasUpper || unicode.IsUpper(r)
+	}
+	return hasUpper
+}
+
+func escapeXML(text string) string {
+	return strings.NewReplacer(
+		"&", "&amp;",
+		"<", "&lt;",
+		">", "&gt;",
+		"\"", "&quot;",
+		"'", "&apos;",
+	).Replace(text)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_normalizers
+
+import (
+	"testing"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testLexicon = []LexiconEntry{
+	{Grapheme: "Rapida", Phoneme: "ræˈpiːdə", Respelling: "ra-pee-da"},
+	{Grapheme: "Rapida AI", Alias: "Rapida A.I."},
+	{Grapheme: "SQL", Alias: "sequel"},
+	{Grapheme: "AT&T", Alias: "A T and T"},
+	{Grapheme: "Nguyen", Phoneme: "w i n", Alphabet: "x-sampa", Respelling: "win"},
+}
+
+func TestParseLexicon(t *testing.T) {
+	entries, err := ParseLexicon(`[
+		{"grapheme": " SQL ", "alias": "sequel"},
+		{"grapheme": "", "alias": "nothing"},
+		{"grapheme": "Rapida"},
+		{"grapheme": "Rapida", "phoneme": "ræˈpiːdə", "alphabet": "ipa"}
+	]`)
+	require.NoError(t, err)
+	assert.Equal(t, []LexiconEntry{
+		{Grapheme: "SQL", Alias: "sequel"},
+		{Grapheme: "Rapida", Phoneme: "ræˈpiːdə", Alphabet: "ipa"},
+	}, entries)
+
+	_, err = ParseLexicon(`{"grapheme": "SQL"}`)
+	assert.Error(t, err)
+}
+
+func TestLexiconNormalizer(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+
+	tests := []struct {
+		name     string
+		ssml     bool
+		input    string
+		expected string
+	}{
+		{
+			name:     "respelling",
+			input:    "Welcome to Rapida.",
+			expected: "Welcome to ra-pee-da.",
+		},
+		{
+			name:     "longest entry wins",
+			input:    "Welcome to Rapida AI.",
+			expected: "Welcome to Rapida A.I..",
+		},
+		{
+			name:     "words match regardless of case",
+			input:    "rapida and NGUYEN",
+			expected: "ra-pee-da and win",
+		},
+		{
+			name:     "acronyms match as written",
+			input:    "SQL is not sql",
+			expected: "sequel is not sql",
+		},
+		{
+			name:     "whole words only",
+			input:    "Rapidas and MySQL",
+			expected: "Rapidas and MySQL",
+		},
+		{
+			name:     "ssml phoneme",
+			ssml:     true,
+			input:    "Welcome to Rapida.",
+			expected: `Welcome to <phoneme alphabet="ipa" ph="ræˈpiːdə">Rapida</phoneme>.`,
+		},
+		{
+			name:     "ssml phoneme alphabet",
+			ssml:     true,
+			input:    "Hello Nguyen",
+			expected: `Hello <phoneme alphabet="x-sampa" ph="w i n">Nguyen</phoneme>`,
+		},
+		{
+			name:     "ssml alias of escaped grapheme",
+			ssml:     true,
+			input:    "Call AT&amp;T about SQL",
+			expected: `Call <sub alias="A T and T">AT&amp;T</sub> about <sub alias="sequel">SQL</sub>`,
+		},
+		{
+			name:     "ssml tags untouched",
+			ssml:     true,
+			input:    `SQL<break time="300ms" name="SQL"/>`,
+			expected: `<sub alias="sequel">SQL</sub><break time="300ms" name="SQL"/>`,
+		},
+		{
+			name:     "empty",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := NewLexiconNormalizer(logger, testLexicon, tt.ssml)
+			assert.Equal(t, tt.expected, n.Normalize(tt.input))
+		})
+	}
+}
+
+func TestLexiconNormalizerWithoutEntries(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	n := NewLexiconNormalizer(logger, nil, true)
+	assert.Equal(t, "Rapida & SQL", n.Normalize("Rapida & SQL"))
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_normalizers
+
+import (
+	"strings"
+
+	"github.com/rapidaai/pkg/commons"
+)
+
+var generalAbbreviations = map[string]map[string]string{
+	LanguageHindi: {
+		"डॉ.":   "डॉक्टर",
+		"प्रो.": "प्रोफेसर",
+		"no.":   "नंबर",
+		"rs.":   "रुपये",
+	},
+	LanguageSpanish: {
+		"sr.":    "señor",
+		"sra.":   "señora",
+		"srta.":  "señorita",
+		"dr.":    "doctor",
+		"dra.":   "doctora",
+		"ud.":    "usted",
+		"uds.":   "ustedes",
+		"etc.":   "etcétera",
+		"núm.":   "número",
+		"pág.":   "página",
+		"tel.":   "teléfono",
+		"aprox.": "aproximadamente",
+		"p.ej.":  "por ejemplo",
+	},
+	LanguageGerman: {
+		"z.b.":  "zum Beispiel",
+		"usw.":  "und so weiter",
+		"bzw.":  "beziehungsweise",
+		"d.h.":  "das heißt",
+		"ca.":   "circa",
+		"hr.":   "Herr",
+		"fr.":   "Frau",
+		"dr.":   "Doktor",
+		"nr.":   "Nummer",
+		"tel.":  "Telefon",
+		"evtl.": "eventuell",
+		"ggf.":  "gegebenenfalls",
+		"inkl.": "inklusive",
+		"zzgl.": "zuzüglich",
+		"mwst.": "Mehrwertsteuer",
+	},
+	LanguageFrench: {
+		"m.":    "monsieur",
+		"mme":   "madame",
+		"mlle":  "mademoiselle",
+		"dr":    "docteur",
+		"dr.":   "docteur",
+		"etc.":  "et cetera",
+		"n°":    "numéro",
+		"tél.":  "téléphone",
+		"env.":  "environ",
+		"p.ex.": "par exemple",
+		"svp":   "s'il vous plaît",
+		"rdv":   "rendez-vous",
+	},
+}
+
+var addressAbbreviations = map[string]map[string]string{
+	LanguageSpanish: {
+		"av.":   "avenida",
+		"avda.": "avenida",
+		"c/":    "calle",
+		"pza.":  "plaza",
+		"ctra.": "carretera",
+	},
+	LanguageGerman: {
+		"str.": "Straße",
+		"pl.":  "Platz",
+	},
+	LanguageFrench: {
+		"av.": "avenue",
+		"bd":  "boulevard",
+		"bd.": "boulevard",
+		"pl.": "place",
+	},
+}
+
+type localizedAbbreviationNormalizer struct {
+	logger    commons.Logger
+	abbrevMap map[string]string
+}
+
+// NewLocalizedAbbreviationNormalizer expands the common abbreviations of the language
+func NewLocalizedAbbreviationNormalizer(logger commons.Logger, language string) Normalizer {
+	return &localizedAbbreviationNormalizer{
+		logger:    logger,
+		abbrevMap: generalAbbreviations[NormalizerLanguage(language)],
+	}
+}
+
+// NewLocalizedAddressNormalizer expands the street abbreviations of the language
+func NewLocalizedAddressNormalizer(logger commons.Logger, language string) Normalizer {
+	return &localizedAbbreviationNormalizer{
+		logger:    logger,
+		abbrevMap: addressAbbreviations[NormalizerLanguage(language)],
+	}
+}
+
+func (la *localizedAbbreviationNormalizer) Normalize(s string) string {
+	if len(la.abbrevMap) == 0 {
+		return s
+	}
+	words := strings.Fields(s)
+	for i, word := range words {
+		if expanded, ok := la.abbrevMap[strings.ToLower(word)]; ok {
+			words[i] = expanded
+		}
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_normalizers
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/rapidaai/pkg/commons"
+)
+
+// currencyWords are the singular and plural words of a currency and its hundredth
+type currencyWords struct {
+	one, many           string
+	minorOne, minorMany string
+}
+
+// currencyNames are the currencies read by the localized currency normalizer, keyed by language
+// and by the symbol or code written in the text
+var currencyNames = map[string]map[string]currencyWords{
+	LanguageIndianEnglish: {
+		"₹": {"rupee", "rupees", "paisa", "paise"},
+		"€": {"euro", "euros", "cent", "cents"},
+		"$": {"dollar", "dollars", "cent", "cents"},
+		"£": {"pound", "pounds", "penny", "pence"},
+	},
+	LanguageHindi: {
+		"₹": {"रुपया", "रुपये", "पैसा", "पैसे"},
+		"€": {"यूरो", "यूरो", "सेंट", "सेंट"},
+		"$": {"डॉलर", "डॉलर", "सेंट", "सेंट"},
+		"£": {"पाउंड", "पाउंड", "पेंस", "पेंस"},
+	},
+	LanguageSpanish: {
+		"₹": {"rupia", "rupias", "paisa", "paisas"},
+		"€": {"euro", "euros", "céntimo", "céntimos"},
+		"$": {"dólar", "dólares", "centavo", "centavos"},
+		"£": {"libra", "libras", "penique", "peniques"},
+	},
+	LanguageGerman: {
+		"₹": {"Rupie", "Rupien", "Paisa", "Paise"},
+		"€": {"Euro", "Euro", "Cent", "Cent"},
+		"$": {"Dollar", "Dollar", "Cent", "Cent"},
+		"£": {"Pfund", "Pfund", "Penny", "Pence"},
+	},
+	LanguageFrench: {
+		"₹": {"roupie", "roupies", "paisa", "paisas"},
+		"€": {"euro", "euros", "centime", "centimes"},
+		"$": {"dollar", "dollars", "cent", "cents"},
+		"£": {"livre", "livres", "penny", "pence"},
+	},
+	LanguageEnglish: {
+		"₹": {"rupee", "rupees", "paisa", "paise"},
+		"€": {"euro", "euros", "cent", "cents"},
+		"$": {"dollar", "dollars", "cent", "cents"},
+		"£": {"pound", "pounds", "penny", "pence"},
+	},
+}
+
+// currencyConjunctions joins the amount and its hundredths, ten euros and fifty cents
+var currencyConjunctions = map[string]string{
+	LanguageIndianEnglish: "and",
+	LanguageHindi:         "और",
+	LanguageSpanish:       "con",
+	LanguageGerman:        "und",
+	LanguageFrench:        "et",
+	LanguageEnglish:       "and",
+}
+
+// currencySymbols maps the ways a currency is written to its symbol
+var currencySymbols = map[string]string{
+	"₹": "₹", "rs": "₹", "rs.": "₹", "inr": "₹",
+	"€": "€", "eur": "€",
+	"$": "$", "usd": "$",
+	"£": "£", "gbp": "£",
+}
+
+type localizedCurrencyNormalizer struct {
+	logger      commons.Logger
+	format      numberFormat
+	names       map[string]currencyWords
+	conjunction string
+	re          *regexp.Regexp
+}
+
+// NewLocalizedCurrencyNormalizer reads rupee, euro, dollar and pound amounts in the language. The
+// currency may be written before or after the amount, as symbol or as code.
+func NewLocalizedCurrencyNormalizer(logger commons.Logger, language string) Normalizer {
+	language = NormalizerLanguage(language)
+	symbol := `(₹|€|\$|£|(?i:\bRs\.?|\bINR\b|\bEUR\b|\bUSD\b|\bGBP\b))`
+	amount := `(\d+(?:[.,]\d+)*)`
+	return &localizedCurrencyNormalizer{
+		logger:      logger,
+		format:      getNumberFormat(language),
+		names:       currencyNames[language],
+		conjunction: currencyConjunctions[language],
+		re:          regexp.MustCompile(symbol + `\s?` + amount + `|` + amount + `\s?` + symbol),
+	}
+}
+
+func (lc *localizedCurrencyNormalizer) Normalize(s string) string {
+	return lc.re.ReplaceAllStringFunc(s, func(match string) string {
+		parts := lc.re.FindStringSubmatch(match)
+		symbol, amount := parts[1], parts[2]
+		if symbol == "" {
+			amount, symbol = parts[3], parts[4]
+		}
+		words, ok := lc.names[currencySymbols[strings.ToLower(symbol)]]
+		if !ok {
+			return match
+		}
+		whole, decimals, ok := lc.format.parse(amount)
+		if !ok || len(decimals) > 2 {
+			lc.logger.Debugf("normalizer: unable to read amount %s", match)
+			return match
+		}
+		spoken := lc.amount(whole, words.one, words.many)
+		if decimals == "" {
+			return spoken
+		}
+		if len(decimals) == 1 {
+			decimals += "0"
+		}
+		minor := int(decimals[0]-'0')*10 + int(decimals[1]-'0')
+		if minor == 0 {
+			return spoken
+		}
+		return spoken + " " + lc.conjunction + " " + lc.amount(minor, words.minorOne, words.minorMany)
+	})
+}
+
+func (lc *localizedCurrencyNormalizer) amount(n int, one, many string) string {
+	if n == 1 {
+		return lc.format.one + " " + one
+	}
+	return lc.format.spell(n) + " " + many
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_normalizers
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/rapidaai/pkg/commons"
+)
+
+var monthNames = map[string][12]string{
+	LanguageIndianEnglish: {"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	LanguageHindi:         {"जनवरी", "फ़रवरी", "मार्च", "अप्रैल", "मई", "जून", "जुलाई", "अगस्त", "सितंबर", "अक्टूबर", "नवंबर", "दिसंबर"},
+	LanguageSpanish:       {"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	LanguageGerman:        {"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	LanguageFrench:        {"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	LanguageEnglish:       {"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+}
+
+type localizedDateNormalizer struct {
+	logger   commons.Logger
+	language string
+	re       *regexp.Regexp
+}
+
+// NewLocalizedDateNormalizer writes dates the way they are spoken in the language. Outside of
+// the United States dates are written day first, 05/03/2024 is the fifth of March.
+func NewLocalizedDateNormalizer(logger commons.Logger, language string) Normalizer {
+	return &localizedDateNormalizer{
+		logger:   logger,
+		language: NormalizerLanguage(language),
+		re: regexp.MustCompile(
+			`\b(\d{4}-\d{2}-\d{2})\b|` + // YYYY-MM-DD
+				`\b(\d{1,2}[/.-]\d{1,2}[/.-]\d{4})\b`, // DD/MM/YYYY, DD.MM.YYYY or DD-MM-YYYY
+		),
+	}
+}
+
+func (ld *localizedDateNormalizer) Normalize(s string) string {
+	return ld.re.ReplaceAllStringFunc(s, func(match string) string {
+		var date time.Time
+		var err error
+		for _, format := range []string{"2006-01-02", "2/1/2006", "2.1.2006", "2-1-2006"} {
+			date, err = time.Parse(format, match)
+			if err == nil {
+				break
+			}
+		}
+		if err != nil {
+			ld.logger.Debugf("normalizer: unable to read date %s", match)
+			return match
+		}
+		return ld.format(date)
+	})
+}
+
+func (ld *localizedDateNormalizer) format(date time.Time) string {
+	month := monthNames[ld.language][date.Month()-1]
+	switch ld.language {
+	case LanguageSpanish:
+		return fmt.Sprintf("%d de %s de %d", date.Day(), month, date.Year())
+	case LanguageGerman:
+		return fmt.Sprintf("%d. %s %d", date.Day(), month, date.Year())
+	case LanguageFrench:
+		if date.Day() == 1 {
+			return fmt.Sprintf("1er %s %d", month, date.Year())
+		}
+		return fmt.Sprintf("%d %s %d", date.Day(), month, date.Year())
+	case LanguageEnglish:
+		return fmt.Sprintf("%s %d, %d", month, date.Day(), date.Year())
+	default:
+		return fmt.Sprintf("%d %s %d", date.Day(), month, date.Year())
+	}
+}
@@ -0,0 +1,466 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_normalizers
+
+import (
+	"testing"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/stretchr/testify/assert"
+)
+
+// =============================================================================
+// Language Tests
+// =============================================================================
+
+func TestNormalizerLanguage(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "en", expected: LanguageEnglish},
+		{input: "en-US", expected: LanguageEnglish},
+		{input: "en-IN", expected: LanguageIndianEnglish},
+		{input: "en_in", expected: LanguageIndianEnglish},
+		{input: "hi-IN", expected: LanguageHindi},
+		{input: "es-MX", expected: LanguageSpanish},
+		{input: "de", expected: LanguageGerman},
+		{input: "fr-CA", expected: LanguageFrench},
+		{input: "ja-JP", expected: LanguageEnglish},
+		{input: "", expected: LanguageEnglish},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizerLanguage(tt.input))
+		})
+	}
+}
+
+func TestIndianNumbering(t *testing.T) {
+	tests := []struct {
+		input   int
+		english string
+		hindi   string
+	}{
+		{input: 0, english: "zero", hindi: "शून्य"},
+		{input: 45, english: "forty-five", hindi: "पैंतालीस"},
+		{input: 101, english: "one hundred one", hindi: "एक सौ एक"},
+		{input: 2500, english: "two thousand five hundred", hindi: "दो हज़ार पाँच सौ"},
+		{input: 150000, english: "one lakh fifty thousand", hindi: "एक लाख पचास हज़ार"},
+		{input: 12500000, english: "one crore twenty-five lakh", hindi: "एक करोड़ पच्चीस लाख"},
+		{input: 1230000000, english: "one hundred twenty-three crore", hindi: "एक सौ तेईस करोड़"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.english, func(t *testing.T) {
+			assert.Equal(t, tt.english, integerToEnIn(tt.input))
+			assert.Equal(t, tt.hindi, integerToHiIn(tt.input))
+		})
+	}
+}
+
+// =============================================================================
+// Localized Number Normalizer Tests
+// =============================================================================
+
+func TestLocalizedNumberNormalizer(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+
+	tests := []struct {
+		name     string
+		language string
+		input    string
+		expected string
+	}{
+		{
+			name:     "indian english lakh",
+			language: "en-IN",
+			input:    "The loan is 1,50,000 for 12 months",
+			expected: "The loan is one lakh fifty thousand for twelve months",
+		},
+		{
+			name:     "indian english crore",
+			language: "en-IN",
+			input:    "Turnover of 25000000",
+			expected: "Turnover of two crore fifty lakh",
+		},
+		{
+			name:     "indian english decimals",
+			language: "en-IN",
+			input:    "Rate is 7.25 percent",
+			expected: "Rate is seven point two five percent",
+		},
+		{
+			name:     "hindi",
+			language: "hi-IN",
+			input:    "कुल 2,35,000 लोग",
+			expected: "कुल दो लाख पैंतीस हज़ार लोग",
+		},
+		{
+			name:     "spanish grouping and decimals",
+			language: "es-ES",
+			input:    "Son 1.250 unidades a 3,5 metros",
+			expected: "Son mil doscientos cincuenta unidades a tres coma cinco metros",
+		},
+		{
+			name:     "german",
+			language: "de-DE",
+			input:    "Insgesamt 21 Tage",
+			expected: "Insgesamt einundzwanzig Tage",
+		},
+		{
+			name:     "french",
+			language: "fr-FR",
+			input:    "Il reste 80 places",
+			expected: "Il reste quatre-vingts places",
+		},
+		{
+			name:     "phone numbers are read digit by digit",
+			language: "en-IN",
+			input:    "Call 9876543210",
+			expected: "Call nine eight seven six five four three two one zero",
+		},
+		{
+			name:     "leading zero is read digit by digit",
+			language: "hi-IN",
+			input:    "कोड 042",
+			expected: "कोड शून्य चार दो",
+		},
+		{
+			name:     "grouping of another language is left alone",
+			language: "de-DE",
+			input:    "Version 1.5",
+			expected: "Version 1.5",
+		},
+		{
+			name:     "spanish separators in the wrong order are left alone",
+			language: "es-ES",
+			input:    "Valor 1,5.3",
+			expected: "Valor 1,5.3",
+		},
+		{
+			name:     "german separators in the wrong order are left alone",
+			language: "de-DE",
+			input:    "Wert 1,5.3",
+			expected: "Wert 1,5.3",
+		},
+		{
+			name:     "hindi separators in the wrong order are left alone",
+			language: "hi-IN",
+			input:    "मान 1.5,3",
+			expected: "मान 1.5,3",
+		},
+		{
+			name:     "indian english separators in the wrong order are left alone",
+			language: "en-IN",
+			input:    "Value 1.5,3",
+			expected: "Value 1.5,3",
+		},
+		{
+			name:     "no numbers",
+			language: "es",
+			input:    "Hola mundo",
+			expected: "Hola mundo",
+		},
+		{
+			name:     "empty string",
+			language: "hi",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalizer := NewLocalizedNumberNormalizer(logger, tt.language)
+			assert.Equal(t, tt.expected, normalizer.Normalize(tt.input))
+		})
+	}
+}
+
+// =============================================================================
+// Localized Currency Normalizer Tests
+// =============================================================================
+
+func TestLocalizedCurrencyNormalizer(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+
+	tests := []struct {
+		name     string
+		language string
+		input    string
+		expected string
+	}{
+		{
+			name:     "rupee symbol in indian english",
+			language: "en-IN",
+			input:    "Your bill is ₹1,50,000.50",
+			expected: "Your bill is one lakh fifty thousand rupees and fifty paise",
+		},
+		{
+			name:     "rs abbreviation",
+			language: "en-IN",
+			input:    "Pay Rs. 499 today",
+			expected: "Pay four hundred ninety-nine rupees today",
+		},
+		{
+			name:     "single rupee",
+			language: "en-IN",
+			input:    "Only ₹1",
+			expected: "Only one rupee",
+		},
+		{
+			name:     "rupee in hindi",
+			language: "hi-IN",
+			input:    "कुल ₹2,500 देय",
+			expected: "कुल दो हज़ार पाँच सौ रुपये देय",
+		},
+		{
+			name:     "rupee with paise in hindi",
+			language: "hi-IN",
+			input:    "₹10.25",
+			expected: "दस रुपये और पच्चीस पैसे",
+		},
+		{
+			name:     "euro after amount in spanish",
+			language: "es-ES",
+			input:    "Cuesta 25,50 €",
+			expected: "Cuesta veinticinco euros con cincuenta céntimos",
+		},
+		{
+			name:     "one euro in spanish",
+			language: "es-ES",
+			input:    "Solo 1 €",
+			expected: "Solo un euro",
+		},
+		{
+			name:     "euro in german",
+			language: "de-DE",
+			input:    "Preis: 1.299,99 €",
+			expected: "Preis: eintausendzweihundertneunundneunzig Euro und neunundneunzig Cent",
+		},
+		{
+			name:     "euro code in french",
+			language: "fr-FR",
+			input:    "Total EUR 40",
+			expected: "Total quarante euros",
+		},
+		{
+			name:     "one decimal is tenths",
+			language: "fr-FR",
+			input:    "€2,5",
+			expected: "deux euros et cinquante centimes",
+		},
+		{
+			name:     "zero cents are not read",
+			language: "en-IN",
+			input:    "$20.00",
+			expected: "twenty dollars",
+		},
+		{
+			name:     "no currency",
+			language: "de",
+			input:    "Hallo Welt",
+			expected: "Hallo Welt",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalizer := NewLocalizedCurrencyNormalizer(logger, tt.language)
+			assert.Equal(t, tt.expected, normalizer.Normalize(tt.input))
+		})
+	}
+}
+
+// =============================================================================
+// Localized Date Normalizer Tests
+// =============================================================================
+
+func TestLocalizedDateNormalizer(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+
+	tests := []struct {
+		name     string
+		language string
+		input    string
+		expected string
+	}{
+		{
+			name:     "indian english is day first",
+			language: "en-IN",
+			input:    "Due on 05/03/2024",
+			expected: "Due on 5 March 2024",
+		},
+		{
+			name:     "hindi",
+			language: "hi-IN",
+			input:    "तारीख 2024-08-15",
+			expected: "तारीख 15 अगस्त 2024",
+		},
+		{
+			name:     "spanish",
+			language: "es-ES",
+			input:    "El 25/12/2024",
+			expected: "El 25 de diciembre de 2024",
+		},
+		{
+			name:     "german dotted date",
+			language: "de-DE",
+			input:    "Am 3.10.2024",
+			expected: "Am 3. Oktober 2024",
+		},
+		{
+			name:     "french first of month",
+			language: "fr-FR",
+			input:    "Le 01-05-2024",
+			expected: "Le 1er mai 2024",
+		},
+		{
+			name:     "invalid date",
+			language: "es",
+			input:    "El 31/02/2024",
+			expected: "El 31/02/2024",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalizer := NewLocalizedDateNormalizer(logger, tt.language)
+			assert.Equal(t, tt.expected, normalizer.Normalize(tt.input))
+		})
+	}
+}
+
+// =============================================================================
+// Localized Time Normalizer Tests
+// =============================================================================
+
+func TestLocalizedTimeNormalizer(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+
+	tests := []struct {
+		name     string
+		language string
+		input    string
+		expected string
+	}{
+		{name: "indian english", language: "en-IN", input: "At 14:30", expected: "At 2:30 PM"},
+		{name: "hindi afternoon", language: "hi-IN", input: "14:30 पर", expected: "दोपहर 2 बजकर 30 मिनट पर"},
+		{name: "hindi morning on the hour", language: "hi-IN", input: "9:00", expected: "सुबह 9 बजे"},
+		{name: "spanish", language: "es-ES", input: "A las 15:45", expected: "A las 15 horas y 45 minutos"},
+		{name: "spanish one o'clock", language: "es-ES", input: "1:00", expected: "1 hora"},
+		{name: "german", language: "de-DE", input: "Um 18:05", expected: "Um 18 Uhr 5"},
+		{name: "german on the hour", language: "de-DE", input: "Um 8:00", expected: "Um 8 Uhr"},
+		{name: "french", language: "fr-FR", input: "À 20:15", expected: "À 20 heures 15"},
+		{name: "invalid time", language: "fr-FR", input: "25:99", expected: "25:99"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalizer := NewLocalizedTimeNormalizer(logger, tt.language)
+			assert.Equal(t, tt.expected, normalizer.Normalize(tt.input))
+		})
+	}
+}
+
+// =============================================================================
+// Localized Abbreviation Normalizer Tests
+// =============================================================================
+
+func TestLocalizedAbbreviationNormalizer(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+
+	tests := []struct {
+		name     string
+		language string
+		input    string
+		expected string
+	}{
+		{name: "spanish", language: "es", input: "El Sr. García y la Dra. López", expected: "El señor García y la doctora López"},
+		{name: "german", language: "de", input: "Bitte z.B. ggf. anrufen", expected: "Bitte zum Beispiel gegebenenfalls anrufen"},
+		{name: "french", language: "fr", input: "Mme Dupont, rdv demain", expected: "madame Dupont, rendez-vous demain"},
+		{name: "hindi", language: "hi", input: "डॉ. शर्मा", expected: "डॉक्टर शर्मा"},
+		{name: "english has no localized abbreviations", language: "en", input: "Dr. Smith", expected: "Dr. Smith"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalizer := NewLocalizedAbbreviationNormalizer(logger, tt.language)
+			assert.Equal(t, tt.expected, normalizer.Normalize(tt.input))
+		})
+	}
+}
+
+func TestLocalizedAddressNormalizer(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+
+	tests := []struct {
+		name     string
+		language string
+		input    string
+		expected string
+	}{
+		{name: "spanish", language: "es-ES", input: "Avda. de la Paz", expected: "avenida de la Paz"},
+		{name: "german", language: "de-DE", input: "Hauptstr. 5", expected: "Hauptstr. 5"},
+		{name: "german street", language: "de-DE", input: "Berliner Str. 5", expected: "Berliner Straße 5"},
+		{name: "french", language: "fr-FR", input: "12 bd Haussmann", expected: "12 boulevard Haussmann"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalizer := NewLocalizedAddressNormalizer(logger, tt.language)
+			assert.Equal(t, tt.expected, normalizer.Normalize(tt.input))
+		})
+	}
+}
+
+// =============================================================================
+// Localized Chain Tests
+// =============================================================================
+
+func TestLocalizedNormalizerChain(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+
+	tests := []struct {
+		name     string
+		language string
+		input    string
+		expected string
+	}{
+		{
+			name:     "hindi appointment",
+			language: "hi-IN",
+			input:    "आपकी बुकिंग 2024-08-15 को 14:30 पर ₹500 में है",
+			expected: "आपकी बुकिंग पंद्रह अगस्त दो हज़ार चौबीस को दोपहर दो बजकर तीस मिनट पर पाँच सौ रुपये में है",
+		},
+		{
+			name:     "spanish invoice",
+			language: "es-ES",
+			input:    "Factura del 05/03/2024 por 120 €",
+			expected: "Factura del cinco de marzo de dos mil veinticuatro por ciento veinte euros",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// dates, times and currencies are read before the remaining numbers are spelled
+			normalizers := []Normalizer{
+				NewLocalizedDateNormalizer(logger, tt.language),
+				NewLocalizedTimeNormalizer(logger, tt.language),
+				NewLocalizedCurrencyNormalizer(logger, tt.language),
+				NewLocalizedNumberNormalizer(logger, tt.language),
+			}
+			result := tt.input
+			for _, n := range normalizers {
+				result = n.Normalize(result)
+			}
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_normalizers
+
+import (
+	"regexp"
+
+	"github.com/rapidaai/pkg/commons"
+)
+
+// numbers this long are codes or phone numbers rather than amounts
+const maxSpelledDigits = 9
+
+type localizedNumberNormalizer struct {
+	logger commons.Logger
+	format numberFormat
+	re     *regexp.Regexp
+}
+
+// NewLocalizedNumberNormalizer spells numbers in the language, with the digit grouping and
+// decimal separator of the language. Hindi and Indian English count in lakh and crore.
+func NewLocalizedNumberNormalizer(logger commons.Logger, language string) Normalizer {
+	return &localizedNumberNormalizer{
+		logger: logger,
+		format: getNumberFormat(language),
+		re:     regexp.MustCompile(`\b\d+(?:[.,]\d+)*\b`),
+	}
+}
+
+func (ln *localizedNumberNormalizer) Normalize(s string) string {
+	return ln.re.ReplaceAllStringFunc(s, func(match string) string {
+		if isDigits(match) && (len(match) > maxSpelledDigits || (len(match) > 1 && match[0] == '0')) {
+			return ln.format.digits(match)
+		}
+		n, decimals, ok := ln.format.parse(match)
+		if !ok {
+			ln.logger.Debugf("normalizer: unable to read number %s", match)
+			return match
+		}
+		if decimals == "" {
+			return ln.format.spell(n)
+		}
+		return ln.format.spell(n) + " " + ln.format.decimalWord + " " + ln.format.digits(decimals)
+	})
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
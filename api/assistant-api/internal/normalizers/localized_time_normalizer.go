@@ -0,0 +1,94 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_normalizers
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/rapidaai/pkg/commons"
+)
+
+type localizedTimeNormalizer struct {
+	logger   commons.Logger
+	language string
+	re       *regexp.Regexp
+}
+
+// NewLocalizedTimeNormalizer writes times the way they are spoken in the language, Spanish, German
+// and French read the 24 hour clock while Hindi names the part of the day.
+func NewLocalizedTimeNormalizer(logger commons.Logger, language string) Normalizer {
+	return &localizedTimeNormalizer{
+		logger:   logger,
+		language: NormalizerLanguage(language),
+		re:       regexp.MustCompile(`\b\d{1,2}:\d{2}\b`),
+	}
+}
+
+func (lt *localizedTimeNormalizer) Normalize(s string) string {
+	return lt.re.ReplaceAllStringFunc(s, func(match string) string {
+		t, err := time.Parse("15:04", match)
+		if err != nil {
+			lt.logger.Debugf("normalizer: unable to read time %s", match)
+			return match
+		}
+		return lt.format(t.Hour(), t.Minute())
+	})
+}
+
+func (lt *localizedTimeNormalizer) format(hour, minute int) string {
+	switch lt.language {
+	case LanguageHindi:
+		clock := hour % 12
+		if clock == 0 {
+			clock = 12
+		}
+		if minute == 0 {
+			return fmt.Sprintf("%s %d बजे", hindiDayPart(hour), clock)
+		}
+		return fmt.Sprintf("%s %d बजकर %d मिनट", hindiDayPart(hour), clock, minute)
+	case LanguageSpanish:
+		hours := "horas"
+		if hour == 1 {
+			hours = "hora"
+		}
+		if minute == 0 {
+			return fmt.Sprintf("%d %s", hour, hours)
+		}
+		return fmt.Sprintf("%d %s y %d minutos", hour, hours, minute)
+	case LanguageGerman:
+		if minute == 0 {
+			return fmt.Sprintf("%d Uhr", hour)
+		}
+		return fmt.Sprintf("%d Uhr %d", hour, minute)
+	case LanguageFrench:
+		hours := "heures"
+		if hour <= 1 {
+			hours = "heure"
+		}
+		if minute == 0 {
+			return fmt.Sprintf("%d %s", hour, hours)
+		}
+		return fmt.Sprintf("%d %s %d", hour, hours, minute)
+	default:
+		return time.Date(0, 1, 1, hour, minute, 0, 0, time.UTC).Format("3:04 PM")
+	}
+}
+
+// hindiDayPart names the part of the day, Hindi says 3 in the afternoon rather than 3 PM
+func hindiDayPart(hour int) string {
+	switch {
+	case hour >= 4 && hour < 12:
+		return "सुबह"
+	case hour >= 12 && hour < 16:
+		return "दोपहर"
+	case hour >= 16 && hour < 20:
+		return "शाम"
+	default:
+		return "रात"
+	}
+}
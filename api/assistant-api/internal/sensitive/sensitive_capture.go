@@ -0,0 +1,212 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sensitive
+
+import (
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+const (
+	SensitiveCaptureStarted = "sensitive_capture.started"
+	SensitiveCaptureStopped = "sensitive_capture.stopped"
+
+	// sources which can toggle the capture
+	SourceTool = "tool"
+	SourceDtmf = "dtmf"
+
+	defaultPlaceholder = "[sensitive input redacted]"
+)
+
+// SensitiveCapture tracks whether a conversation is collecting sensitive information such as
+// card numbers. While it is active the user audio is recorded as silence, what the user says is
+// masked before it is stored and the model only receives a placeholder.
+type SensitiveCapture interface {
+	// Active reports whether sensitive capture is in progress
+	Active() bool
+
+	// Start begins sensitive capture, the returned event is nil when it was already active
+	Start(source string) *types.Event
+
+	// Stop ends sensitive capture, the returned event is nil when it was not active
+	Stop(source string) *types.Event
+
+	// Dtmf feeds a keypad digit, the capture toggles when the configured sequence is entered
+	Dtmf(digit string) *types.Event
+
+	// Redact masks the text when capture is active, it reports whether the text was masked
+	Redact(text string) (string, bool)
+
+	// MarkRedacted remembers that text of the message was masked
+	MarkRedacted(contextId string)
+
+	// Redacted reports whether any text of the message was masked
+	Redacted(contextId string) bool
+
+	// Placeholder is what the model receives instead of a redacted message
+	Placeholder() string
+
+	// Silence returns the audio unchanged or silence of the same length when capture is active
+	Silence(audio []byte) []byte
+}
+
+type sensitiveCapture struct {
+	mu          sync.Mutex
+	active      bool
+	source      string
+	startedAt   time.Time
+	toggle      string
+	digits      string
+	placeholder string
+	redacted    map[string]struct{}
+	audioConfig *protos.AudioConfig
+}
+
+// NewSensitiveCapture reads the dtmf toggle sequence and the placeholder from the options,
+// without a toggle sequence only tools can start and stop the capture. The audio config is
+// the format of the user audio which is replaced with silence.
+func NewSensitiveCapture(opts utils.Option, audioConfig *protos.AudioConfig) SensitiveCapture {
+	capture := &sensitiveCapture{
+		placeholder: defaultPlaceholder,
+		redacted:    make(map[string]struct{}),
+		audioConfig: audioConfig,
+	}
+	if toggle, err := opts.GetString("sensitive_capture.dtmf_toggle"); err == nil {
+		capture.toggle = strings.TrimSpace(toggle)
+	}
+	if placeholder, err := opts.GetString("sensitive_capture.placeholder"); err == nil && strings.TrimSpace(placeholder) != "" {
+		capture.placeholder = placeholder
+	}
+	return capture
+}
+
+func (c *sensitiveCapture) Active() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.active
+}
+
+func (c *sensitiveCapture) Start(source string) *types.Event {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.start(source)
+}
+
+func (c *sensitiveCapture) start(source string) *types.Event {
+	if c.active {
+		return nil
+	}
+	c.active = true
+	c.source = source
+	c.startedAt = time.Now()
+	return &types.Event{
+		EventType: SensitiveCaptureStarted,
+		Payload: map[string]interface{}{
+			"source":     source,
+			"started_at": c.startedAt.UTC().Format(time.RFC3339Nano),
+		},
+	}
+}
+
+func (c *sensitiveCapture) Stop(source string) *types.Event {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stop(source)
+}
+
+func (c *sensitiveCapture) stop(source string) *types.Event {
+	if !c.active {
+		return nil
+	}
+	endedAt := time.Now()
+	c.active = false
+	return &types.Event{
+		EventType: SensitiveCaptureStopped,
+		Payload: map[string]interface{}{
+			"source":         source,
+			"started_by":     c.source,
+			"started_at":     c.startedAt.UTC().Format(time.RFC3339Nano),
+			"ended_at":       endedAt.UTC().Format(time.RFC3339Nano),
+			"duration_in_ms": endedAt.Sub(c.startedAt).Milliseconds(),
+		},
+	}
+}
+
+func (c *sensitiveCapture) Dtmf(digit string) *types.Event {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.toggle == "" {
+		return nil
+	}
+	// only as many digits as the sequence are kept, entered card numbers are not held
+	c.digits += digit
+	if len(c.digits) > len(c.toggle) {
+		c.digits = c.digits[len(c.digits)-len(c.toggle):]
+	}
+	if c.digits != c.toggle {
+		return nil
+	}
+	c.digits = ""
+	if c.active {
+		return c.stop(SourceDtmf)
+	}
+	return c.start(SourceDtmf)
+}
+
+func (c *sensitiveCapture) Redact(text string) (string, bool) {
+	if !c.Active() {
+		return text, false
+	}
+	return Mask(text), true
+}
+
+func (c *sensitiveCapture) MarkRedacted(contextId string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.redacted[contextId] = struct{}{}
+}
+
+func (c *sensitiveCapture) Redacted(contextId string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.redacted[contextId]
+	return ok
+}
+
+func (c *sensitiveCapture) Placeholder() string {
+	return c.placeholder
+}
+
+func (c *sensitiveCapture) Silence(audio []byte) []byte {
+	if !c.Active() {
+		return audio
+	}
+	silence := make([]byte, len(audio))
+	// zero amplitude is encoded as 0xFF in mu-law
+	if c.audioConfig.GetAudioFormat() == protos.AudioConfig_MuLaw8 {
+		for i := range silence {
+			silence[i] = 0xFF
+		}
+	}
+	return silence
+}
+
+// Mask replaces every letter and digit with an asterisk, spacing and punctuation are kept
+// so the transcript still shows that something was said.
+func Mask(text string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return '*'
+		}
+		return r
+	}, text)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sensitive
+
+import (
+	"testing"
+
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"digits", "4111 1111 1111 1111", "**** **** **** ****"},
+		{"sentence", "my card is 4242, expiry 12/29", "** **** ** ****, ****** **/**"},
+		{"empty", "", ""},
+		{"unicode", "número 42", "****** **"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Mask(tt.in))
+		})
+	}
+}
+
+func TestSensitiveCapture_StartStop(t *testing.T) {
+	c := NewSensitiveCapture(utils.Option{}, nil)
+	assert.False(t, c.Active())
+	assert.Nil(t, c.Stop(SourceTool))
+
+	started := c.Start(SourceTool)
+	require.NotNil(t, started)
+	assert.Equal(t, SensitiveCaptureStarted, started.EventType)
+	assert.Equal(t, SourceTool, started.Payload["source"])
+	assert.True(t, c.Active())
+	// already active
+	assert.Nil(t, c.Start(SourceTool))
+
+	stopped := c.Stop(SourceTool)
+	require.NotNil(t, stopped)
+	assert.Equal(t, SensitiveCaptureStopped, stopped.EventType)
+	assert.Equal(t, started.Payload["started_at"], stopped.Payload["started_at"])
+	assert.Contains(t, stopped.Payload, "ended_at")
+	assert.False(t, c.Active())
+}
+
+func TestSensitiveCapture_DtmfToggle(t *testing.T) {
+	c := NewSensitiveCapture(utils.Option{"sensitive_capture.dtmf_toggle": "*9"}, nil)
+
+	for _, digit := range []string{"1", "*", "8", "*"} {
+		assert.Nil(t, c.Dtmf(digit))
+	}
+	started := c.Dtmf("9")
+	require.NotNil(t, started)
+	assert.Equal(t, SensitiveCaptureStarted, started.EventType)
+	assert.Equal(t, SourceDtmf, started.Payload["source"])
+
+	// card digits entered while active do not toggle
+	for _, digit := range []string{"4", "2", "4", "2", "9"} {
+		assert.Nil(t, c.Dtmf(digit))
+	}
+	assert.True(t, c.Active())
+
+	assert.Nil(t, c.Dtmf("*"))
+	stopped := c.Dtmf("9")
+	require.NotNil(t, stopped)
+	assert.Equal(t, SensitiveCaptureStopped, stopped.EventType)
+	assert.False(t, c.Active())
+}
+
+func TestSensitiveCapture_DtmfWithoutToggle(t *testing.T) {
+	c := NewSensitiveCapture(utils.Option{}, nil)
+	for _, digit := range []string{"*", "9", "#"} {
+		assert.Nil(t, c.Dtmf(digit))
+	}
+	assert.False(t, c.Active())
+}
+
+func TestSensitiveCapture_Redact(t *testing.T) {
+	c := NewSensitiveCapture(utils.Option{"sensitive_capture.placeholder": "[card details]"}, nil)
+	assert.Equal(t, "[card details]", c.Placeholder())
+
+	text, redacted := c.Redact("hello")
+	assert.Equal(t, "hello", text)
+	assert.False(t, redacted)
+
+	c.Start(SourceTool)
+	text, redacted = c.Redact("4242")
+	assert.Equal(t, "****", text)
+	assert.True(t, redacted)
+
+	c.MarkRedacted("message-1")
+	c.Stop(SourceTool)
+	// a message stays redacted after capture ended
+	assert.True(t, c.Redacted("message-1"))
+	assert.False(t, c.Redacted("message-2"))
+}
+
+func TestSensitiveCapture_Silence(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *protos.AudioConfig
+		want   byte
+	}{
+		{"linear16", &protos.AudioConfig{AudioFormat: protos.AudioConfig_LINEAR16}, 0x00},
+		{"mulaw", &protos.AudioConfig{AudioFormat: protos.AudioConfig_MuLaw8}, 0xFF},
+		{"unknown", nil, 0x00},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewSensitiveCapture(utils.Option{}, tt.config)
+			audio := []byte{0x12, 0x34, 0x56, 0x78}
+			assert.Equal(t, audio, c.Silence(audio))
+
+			c.Start(SourceTool)
+			silence := c.Silence(audio)
+			require.Len(t, silence, len(audio))
+			for _, b := range silence {
+				assert.Equal(t, tt.want, b)
+			}
+			// the input is not modified
+			assert.Equal(t, byte(0x12), audio[0])
+		})
+	}
+}
@@ -7,9 +7,11 @@ package internal_services
 
 import (
 	"context"
+	"time"
 
 	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
 	internal_message_gorm "github.com/rapidaai/api/assistant-api/internal/entity/messages"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/types"
 	type_enums "github.com/rapidaai/pkg/types/enums"
 	"github.com/rapidaai/pkg/utils"
@@ -298,9 +300,29 @@ type AssistantConversationService interface {
 		auth types.SimplePrinciple,
 		assistantId uint64,
 		assistantConversationId uint64,
-		body []byte,
+		recording *internal_type.Recording,
 	) (*internal_conversation_entity.AssistantConversationRecording, error)
 
+	// CreateConversationRecordingWriter starts a recording that is streamed to storage while
+	// the conversation is in progress, it fails when the storage can not write in parts.
+	CreateConversationRecordingWriter(
+		ctx context.Context,
+		auth types.SimplePrinciple,
+		assistantId uint64,
+		assistantConversationId uint64,
+		channels uint32,
+		splitTracks bool,
+	) (internal_type.RecordingWriter, error)
+
+	// GetByTelephonyUuid finds the conversation of a call of the telephony by its telephony.uuid, it
+	// is used by telephony which connect the audio of a call without any credential of the project.
+	// Conversations which completed or failed are not found.
+	GetByTelephonyUuid(ctx context.Context, provider, uuid string) (*internal_conversation_entity.AssistantConversation, error)
+
+	// RecoverConversationRecordings finalizes streamed recordings that have not been written
+	// to for staleAfter, which happens when a session ended without closing its writer.
+	RecoverConversationRecordings(ctx context.Context, staleAfter time.Duration) (int, error)
+
 	ApplyConversationTelephonyEvent(
 		ctx context.Context,
 		auth types.SimplePrinciple,
@@ -23,6 +23,7 @@ type GetAssistantOption struct {
 	InjectDebuggerDeployment     bool
 	InjectPhoneDeployment        bool
 	InjectWhatsappDeployment     bool
+	InjectSmsDeployment          bool
 	InjectTool                   bool
 	//
 	InjectConversations bool
@@ -41,6 +42,7 @@ func NewDefaultGetAssistantOption() *GetAssistantOption {
 		InjectDebuggerDeployment:     true,
 		InjectPhoneDeployment:        true,
 		InjectWhatsappDeployment:     true,
+		InjectSmsDeployment:          true,
 		InjectTool:                   true,
 		InjectConversations:          true,
 	}
@@ -151,6 +153,16 @@ type AssistantDeploymentService interface {
 		opts []*workflow_api.Metadata,
 	) (*internal_assistant_entity.AssistantWhatsappDeployment, error)
 
+	CreateSmsDeployment(
+		ctx context.Context,
+		auth types.SimplePrinciple,
+		assistantId uint64,
+		greeting, mistake *string,
+		idealTimeout *uint64, idealTimeoutBackoff *uint64, idealTimeoutMessage *string, maxSessionDuration *uint64,
+		smsProvider string,
+		opts []*workflow_api.Metadata,
+	) (*internal_assistant_entity.AssistantSmsDeployment, error)
+
 	CreatePhoneDeployment(
 		ctx context.Context,
 		auth types.SimplePrinciple,
@@ -197,4 +209,10 @@ type AssistantDeploymentService interface {
 	GetAssistantPhoneDeployment(ctx context.Context, auth types.SimplePrinciple, assistantId uint64) (*internal_assistant_entity.AssistantPhoneDeployment, error)
 	GetAssistantWebpluginDeployment(ctx context.Context, auth types.SimplePrinciple, assistantId uint64) (*internal_assistant_entity.AssistantWebPluginDeployment, error)
 	GetAssistantWhatsappDeployment(ctx context.Context, auth types.SimplePrinciple, assistantId uint64) (*internal_assistant_entity.AssistantWhatsappDeployment, error)
+	GetAssistantSmsDeployment(ctx context.Context, auth types.SimplePrinciple, assistantId uint64) (*internal_assistant_entity.AssistantSmsDeployment, error)
+
+	// opt-outs of the numbers the sms deployment of the assistant writes to
+	OptOutSms(ctx context.Context, auth types.SimplePrinciple, assistantId uint64, phoneNumber, keyword string) error
+	OptInSms(ctx context.Context, auth types.SimplePrinciple, assistantId uint64, phoneNumber string) error
+	IsSmsOptedOut(ctx context.Context, auth types.SimplePrinciple, assistantId uint64, phoneNumber string) (bool, error)
 }
@@ -305,6 +305,78 @@ func (eService assistantDeploymentService) CreateWhatsappDeployment(
 	return deployment, nil
 }
 
+func (eService assistantDeploymentService) CreateSmsDeployment(
+	ctx context.Context,
+	auth types.SimplePrinciple,
+	assistantId uint64,
+	greeting, mistake *string,
+	idealTimeout *uint64,
+	idealTimeoutBackoff *uint64,
+	idealTimeoutMessage *string, maxSessionDuration *uint64,
+	smsProvider string,
+	smsOptions []*protos.Metadata,
+) (*internal_assistant_entity.AssistantSmsDeployment, error) {
+	db := eService.postgres.DB(ctx)
+	deployment := &internal_assistant_entity.AssistantSmsDeployment{
+		AssistantDeploymentBehavior: internal_assistant_entity.AssistantDeploymentBehavior{
+			AssistantDeployment: internal_assistant_entity.AssistantDeployment{
+				Mutable: gorm_models.Mutable{
+					CreatedBy: *auth.GetUserId(),
+					Status:    type_enums.RECORD_ACTIVE,
+				},
+				AssistantId: assistantId,
+			},
+			Greeting:            greeting,
+			Mistake:             mistake,
+			IdealTimeout:        idealTimeout,
+			IdealTimeoutBackoff: idealTimeoutBackoff,
+			IdealTimeoutMessage: idealTimeoutMessage,
+			MaxSessionDuration:  maxSessionDuration,
+		},
+		AssistantDeploymentSms: internal_assistant_entity.AssistantDeploymentSms{
+			SmsProvider: smsProvider,
+		},
+	}
+
+	tx := db.Create(deployment)
+	if tx.Error != nil {
+		eService.logger.Errorf("unable to create sms deployment for assistant with error %v", tx.Error)
+		return nil, tx.Error
+	}
+
+	if len(smsOptions) == 0 {
+		return deployment, nil
+	}
+
+	smsOpts := make([]*internal_assistant_entity.AssistantDeploymentSmsOption, 0)
+	for _, v := range smsOptions {
+		smsOpts = append(smsOpts, &internal_assistant_entity.AssistantDeploymentSmsOption{
+			AssistantDeploymentSmsId: deployment.Id,
+			Mutable: gorm_models.Mutable{
+				CreatedBy: *auth.GetUserId(),
+				UpdatedBy: *auth.GetUserId(),
+				Status:    type_enums.RECORD_ACTIVE,
+			},
+			Metadata: gorm_models.Metadata{
+				Key:   v.GetKey(),
+				Value: v.GetValue(),
+			},
+		})
+	}
+	tx = db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "assistant_deployment_sms_id"}, {Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"value",
+			"updated_by"}),
+	}).Create(smsOpts)
+	if tx.Error != nil {
+		eService.logger.Errorf("unable to create sms options for assistant with error %v", tx.Error)
+		return nil, tx.Error
+	}
+	deployment.SmsOptions = smsOpts
+	return deployment, nil
+}
+
 func (eService assistantDeploymentService) CreatePhoneDeployment(
 	ctx context.Context,
 	auth types.SimplePrinciple,
@@ -508,3 +580,75 @@ func (eService assistantDeploymentService) GetAssistantWhatsappDeployment(ctx co
 	}
 	return whatsappDeployment, nil
 }
+
+func (eService assistantDeploymentService) GetAssistantSmsDeployment(ctx context.Context, auth types.SimplePrinciple, assistantId uint64) (*internal_assistant_entity.AssistantSmsDeployment, error) {
+	db := eService.postgres.DB(ctx)
+	var smsDeployment *internal_assistant_entity.AssistantSmsDeployment
+	qry := db.
+		Preload("SmsOptions").
+		Where("assistant_id = ?", assistantId)
+	tx := qry.Order(clause.OrderByColumn{
+		Column: clause.Column{Name: "created_date"},
+		Desc:   true,
+	}).First(&smsDeployment)
+
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if tx.Error != nil {
+		eService.logger.Errorf("not able to find sms deployment for the assistant %d  with error %v", assistantId, tx.Error)
+		return nil, tx.Error
+	}
+	return smsDeployment, nil
+}
+
+// OptOutSms stops the messages to the number, the keyword is the one the number replied with
+func (eService assistantDeploymentService) OptOutSms(ctx context.Context, auth types.SimplePrinciple, assistantId uint64, phoneNumber, keyword string) error {
+	db := eService.postgres.DB(ctx)
+	optOut := &internal_assistant_entity.AssistantSmsOptOut{
+		Mutable: gorm_models.Mutable{
+			Status: type_enums.RECORD_ACTIVE,
+		},
+		AssistantId: assistantId,
+		PhoneNumber: phoneNumber,
+		Keyword:     keyword,
+	}
+	// the numbers opt out by a reply, the webhook has no user
+	if auth.GetUserId() != nil {
+		optOut.CreatedBy = *auth.GetUserId()
+	}
+	tx := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "assistant_id"}, {Name: "phone_number"}},
+		DoUpdates: clause.AssignmentColumns([]string{"keyword", "updated_date"}),
+	}).Create(optOut)
+	if tx.Error != nil {
+		eService.logger.Errorf("unable to opt out %s from sms of the assistant %d with error %v", phoneNumber, assistantId, tx.Error)
+		return tx.Error
+	}
+	return nil
+}
+
+// OptInSms allows the messages to a number which opted out before
+func (eService assistantDeploymentService) OptInSms(ctx context.Context, auth types.SimplePrinciple, assistantId uint64, phoneNumber string) error {
+	db := eService.postgres.DB(ctx)
+	tx := db.Where("assistant_id = ? AND phone_number = ?", assistantId, phoneNumber).
+		Delete(&internal_assistant_entity.AssistantSmsOptOut{})
+	if tx.Error != nil {
+		eService.logger.Errorf("unable to opt in %s to sms of the assistant %d with error %v", phoneNumber, assistantId, tx.Error)
+		return tx.Error
+	}
+	return nil
+}
+
+func (eService assistantDeploymentService) IsSmsOptedOut(ctx context.Context, auth types.SimplePrinciple, assistantId uint64, phoneNumber string) (bool, error) {
+	db := eService.postgres.DB(ctx)
+	var cnt int64
+	tx := db.Model(&internal_assistant_entity.AssistantSmsOptOut{}).
+		Where("assistant_id = ? AND phone_number = ?", assistantId, phoneNumber).
+		Count(&cnt)
+	if tx.Error != nil {
+		eService.logger.Errorf("unable to check the sms opt out of %s for the assistant %d with error %v", phoneNumber, assistantId, tx.Error)
+		return false, tx.Error
+	}
+	return cnt > 0, nil
+}
@@ -313,6 +313,11 @@ func (eService *assistantService) Get(ctx context.Context,
 				defer wg.Done()
 				var deployment *internal_assistant_entity.AssistantWhatsappDeployment
 				tx := db.
+					Preload("WhatsappOptions").
+					Order(clause.OrderByColumn{
+						Column: clause.Column{Name: "created_date"},
+						Desc:   true,
+					}).
 					Where("assistant_id = ?", assistantId).First(&deployment)
 				if tx.Error != nil {
 					return
@@ -320,6 +325,25 @@ func (eService *assistantService) Get(ctx context.Context,
 				assistant.AssistantWhatsappDeployment = deployment
 			})
 	}
+	if opts.InjectSmsDeployment {
+		wg.Add(1)
+		utils.Go(ctx,
+			func() {
+				defer wg.Done()
+				var deployment *internal_assistant_entity.AssistantSmsDeployment
+				tx := db.
+					Preload("SmsOptions").
+					Order(clause.OrderByColumn{
+						Column: clause.Column{Name: "created_date"},
+						Desc:   true,
+					}).
+					Where("assistant_id = ?", assistantId).First(&deployment)
+				if tx.Error != nil {
+					return
+				}
+				assistant.AssistantSmsDeployment = deployment
+			})
+	}
 	if opts.InjectPhoneDeployment {
 		wg.Add(1)
 		utils.Go(ctx,
@@ -481,6 +505,11 @@ func (eService *assistantService) GetAll(ctx context.Context, auth types.SimpleP
 			return db.Order("updated_date DESC")
 		})
 	}
+	if opts.InjectSmsDeployment {
+		qry = qry.Preload("AssistantSmsDeployment", func(db *gorm.DB) *gorm.DB {
+			return db.Order("updated_date DESC")
+		})
+	}
 	if opts.InjectPhoneDeployment {
 		qry = qry.Preload("AssistantPhoneDeployment", func(db *gorm.DB) *gorm.DB {
 			return db.Order("updated_date DESC")
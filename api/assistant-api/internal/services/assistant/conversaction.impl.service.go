@@ -13,6 +13,7 @@ import (
 
 	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
 	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
 	gorm_models "github.com/rapidaai/pkg/models/gorm"
@@ -170,6 +171,16 @@ func (conversationService *assistantConversationService) Get(
 						continue
 					}
 					recording.RecordingUrl = *pUrl
+					if recording.UserRecordingUrl != "" {
+						if pUrl, err := conversationService.GetRecordingPublicUrl(ctx, recording.UserRecordingUrl); err == nil {
+							recording.UserRecordingUrl = *pUrl
+						}
+					}
+					if recording.AssistantRecordingUrl != "" {
+						if pUrl, err := conversationService.GetRecordingPublicUrl(ctx, recording.AssistantRecordingUrl); err == nil {
+							recording.AssistantRecordingUrl = *pUrl
+						}
+					}
 					assistantConversation.Recordings = append(assistantConversation.Recordings, recording)
 				}
 			})
@@ -227,6 +238,36 @@ func (conversationService *assistantConversationService) GetConversation(
 	return assistantConversation, nil
 }
 
+func (conversationService *assistantConversationService) GetByTelephonyUuid(ctx context.Context, provider, uuid string) (*internal_conversation_entity.AssistantConversation, error) {
+	start := time.Now()
+	db := conversationService.postgres.DB(ctx)
+	var assistantConversation *internal_conversation_entity.AssistantConversation
+	tx := db.
+		Where("id = (?)", db.
+			Model(&internal_conversation_entity.AssistantConversationMetadata{}).
+			Select("assistant_conversation_id").
+			Where("key = ? AND value = ?", "telephony.uuid", uuid).
+			Order("id DESC").
+			Limit(1)).
+		Where("id IN (?)", db.
+			Model(&internal_conversation_entity.AssistantConversationMetadata{}).
+			Select("assistant_conversation_id").
+			Where("key = ? AND value = ?", "telephony.provider", provider)).
+		Where("id NOT IN (?)", db.
+			Model(&internal_conversation_entity.AssistantConversationMetric{}).
+			Select("assistant_conversation_id").
+			Where("name = ? AND value IN ?", type_enums.STATUS.String(), []string{type_enums.RECORD_COMPLETE.String(), type_enums.RECORD_FAILED.String()})).
+		Preload("Metadatas").
+		First(&assistantConversation)
+	if tx.Error != nil {
+		conversationService.logger.Benchmark("conversationService.GetByTelephonyUuid", time.Since(start))
+		conversationService.logger.Errorf("not able to find conversation with telephony uuid %s with error %v", uuid, tx.Error)
+		return nil, tx.Error
+	}
+	conversationService.logger.Benchmark("conversationService.GetByTelephonyUuid", time.Since(start))
+	return assistantConversation, nil
+}
+
 func (conversationService *assistantConversationService) CreateConversation(
 	ctx context.Context,
 	auth types.SimplePrinciple,
@@ -531,7 +572,7 @@ func (conversationService *assistantConversationService) CreateConversationRecor
 	auth types.SimplePrinciple,
 	assistantId,
 	assistantConversationId uint64,
-	body []byte,
+	recording *internal_type.Recording,
 ) (*internal_conversation_entity.AssistantConversationRecording, error) {
 	start := time.Now()
 	db := conversationService.postgres.DB(ctx)
@@ -539,8 +580,31 @@ func (conversationService *assistantConversationService) CreateConversationRecor
 	s3Prefix := conversationService.ObjectPrefix(*auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId())
 	recordingId := gorm_generator.ID()
 
-	key := conversationService.ObjectKey(s3Prefix, recordingId, fmt.Sprintf("recording-%d.wav", assistantConversationId))
-	conversationService.storage.Store(ctx, key, body)
+	// the recording of a telephony provider is kept as the provider made it
+	name, format, source := fmt.Sprintf("recording-%d", assistantConversationId), "wav", "rapida"
+	if recording.Source != "" {
+		name, source = fmt.Sprintf("recording-%d-%s", assistantConversationId, recording.Source), recording.Source
+	}
+	if recording.Format != "" {
+		format = recording.Format
+	}
+	key := conversationService.ObjectKey(s3Prefix, recordingId, fmt.Sprintf("%s.%s", name, format))
+	conversationService.storage.Store(ctx, key, recording.Mixed)
+
+	// per speaker tracks are stored next to the mixed recording
+	var userKey, assistantKey string
+	if len(recording.User) > 0 {
+		userKey = conversationService.ObjectKey(s3Prefix, recordingId, fmt.Sprintf("recording-%d-user.wav", assistantConversationId))
+		conversationService.storage.Store(ctx, userKey, recording.User)
+	}
+	if len(recording.Assistant) > 0 {
+		assistantKey = conversationService.ObjectKey(s3Prefix, recordingId, fmt.Sprintf("recording-%d-assistant.wav", assistantConversationId))
+		conversationService.storage.Store(ctx, assistantKey, recording.Assistant)
+	}
+	channels := recording.Channels
+	if channels == 0 {
+		channels = 1
+	}
 
 	conversationRecording := &internal_conversation_entity.AssistantConversationRecording{
 		Audited: gorm_models.Audited{
@@ -553,6 +617,10 @@ func (conversationService *assistantConversationService) CreateConversationRecor
 		AssistantId:             assistantId,
 		AssistantConversationId: assistantConversationId,
 		RecordingUrl:            key,
+		Channels:                channels,
+		UserRecordingUrl:        userKey,
+		AssistantRecordingUrl:   assistantKey,
+		Source:                  source,
 	}
 	if auth.GetUserId() != nil {
 		conversationRecording.Mutable.CreatedBy = *auth.GetUserId()
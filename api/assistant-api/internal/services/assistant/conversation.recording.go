@@ -0,0 +1,322 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_assistant_service
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	gorm_models "github.com/rapidaai/pkg/models/gorm"
+	gorm_generator "github.com/rapidaai/pkg/models/gorm/generators"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+	"github.com/rapidaai/pkg/storages"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+)
+
+var errStreamingNotSupported = errors.New("storage does not support streaming recordings")
+
+// how often a streamed recording marks itself as alive, recovery only picks up recordings
+// which have not been marked for much longer than this
+const recordingHeartbeatInterval = time.Minute
+
+// recordingUpload is the multipart upload of a single track
+type recordingUpload struct {
+	key      string
+	uploadId string
+	parts    int64
+	pending  []byte
+	// the first part carries the wav header, it is written again with the length of the
+	// recording once it is closed
+	first []byte
+	size  int64
+}
+
+// conversationRecordingWriter streams the tracks of a recording as multipart uploads, segments
+// are buffered until they reach the minimum part size of the storage.
+type conversationRecordingWriter struct {
+	logger    commons.Logger
+	postgres  connectors.PostgresConnector
+	storage   storages.MultipartStorage
+	recording *internal_conversation_entity.AssistantConversationRecording
+
+	mu        sync.Mutex
+	uploads   map[internal_type.RecordingTrack]*recordingUpload
+	done      bool
+	heartbeat time.Time
+}
+
+func (conversationService *assistantConversationService) CreateConversationRecordingWriter(
+	ctx context.Context,
+	auth types.SimplePrinciple,
+	assistantId,
+	assistantConversationId uint64,
+	channels uint32,
+	splitTracks bool,
+) (internal_type.RecordingWriter, error) {
+	start := time.Now()
+	storage, ok := conversationService.storage.(storages.MultipartStorage)
+	if !ok {
+		return nil, errStreamingNotSupported
+	}
+	if channels == 0 {
+		channels = 1
+	}
+
+	s3Prefix := conversationService.ObjectPrefix(*auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId())
+	recordingId := gorm_generator.ID()
+	keys := map[internal_type.RecordingTrack]string{
+		internal_type.RecordingTrackMixed: conversationService.ObjectKey(s3Prefix, recordingId, fmt.Sprintf("recording-%d.wav", assistantConversationId)),
+	}
+	if splitTracks {
+		keys[internal_type.RecordingTrackUser] = conversationService.ObjectKey(s3Prefix, recordingId, fmt.Sprintf("recording-%d-user.wav", assistantConversationId))
+		keys[internal_type.RecordingTrackAssistant] = conversationService.ObjectKey(s3Prefix, recordingId, fmt.Sprintf("recording-%d-assistant.wav", assistantConversationId))
+	}
+
+	writer := &conversationRecordingWriter{
+		logger:   conversationService.logger,
+		postgres: conversationService.postgres,
+		storage:  storage,
+		uploads:  make(map[internal_type.RecordingTrack]*recordingUpload, len(keys)),
+	}
+	uploads := gorm_types.StringMap{}
+	for track, key := range keys {
+		uploadId, err := storage.CreateMultipart(ctx, key)
+		if err != nil {
+			conversationService.logger.Errorf("unable to start streaming %s recording %v", track, err)
+			writer.Abort(ctx)
+			return nil, err
+		}
+		writer.uploads[track] = &recordingUpload{key: key, uploadId: uploadId}
+		uploads[string(track)] = uploadId
+	}
+
+	recording := &internal_conversation_entity.AssistantConversationRecording{
+		Audited: gorm_models.Audited{
+			Id: recordingId,
+		},
+		Mutable: gorm_models.Mutable{
+			Status: type_enums.RECORD_IN_PROGRESS,
+		},
+		Organizational: gorm_models.Organizational{
+			ProjectId:      *auth.GetCurrentProjectId(),
+			OrganizationId: *auth.GetCurrentOrganizationId(),
+		},
+		AssistantId:             assistantId,
+		AssistantConversationId: assistantConversationId,
+		RecordingUrl:            keys[internal_type.RecordingTrackMixed],
+		Channels:                channels,
+		UserRecordingUrl:        keys[internal_type.RecordingTrackUser],
+		AssistantRecordingUrl:   keys[internal_type.RecordingTrackAssistant],
+		Uploads:                 uploads,
+	}
+	if auth.GetUserId() != nil {
+		recording.Mutable.CreatedBy = *auth.GetUserId()
+	}
+	if tx := conversationService.postgres.DB(ctx).Create(recording); tx.Error != nil {
+		conversationService.logger.Errorf("error while creating streamed conversation recording %v", tx.Error)
+		writer.Abort(ctx)
+		return nil, tx.Error
+	}
+	writer.recording = recording
+	writer.heartbeat = time.Now()
+	conversationService.logger.Benchmark("conversationService.CreateConversationRecordingWriter", time.Since(start))
+	return writer, nil
+}
+
+func (w *conversationRecordingWriter) Write(ctx context.Context, track internal_type.RecordingTrack, segment []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.done {
+		return errors.New("recording is already closed")
+	}
+	upload, ok := w.uploads[track]
+	if !ok {
+		return fmt.Errorf("recording does not have a %s track", track)
+	}
+	upload.pending = append(upload.pending, segment...)
+	upload.size += int64(len(segment))
+
+	// updated date tells recovery the recording is still being written
+	if time.Since(w.heartbeat) >= recordingHeartbeatInterval {
+		w.heartbeat = time.Now()
+		if tx := w.postgres.DB(ctx).
+			Model(&internal_conversation_entity.AssistantConversationRecording{}).
+			Where("id = ?", w.recording.Id).
+			Update("updated_date", w.heartbeat); tx.Error != nil {
+			w.logger.Warnf("unable to update streamed recording %d %v", w.recording.Id, tx.Error)
+		}
+	}
+
+	if len(upload.pending) == 0 || len(upload.pending) < w.storage.MinPartSize() {
+		return nil
+	}
+	return w.flush(ctx, upload)
+}
+
+func (w *conversationRecordingWriter) flush(ctx context.Context, upload *recordingUpload) error {
+	if err := w.storage.UploadPart(ctx, upload.key, upload.uploadId, upload.parts+1, upload.pending); err != nil {
+		return err
+	}
+	upload.parts++
+	if upload.parts == 1 {
+		upload.first = upload.pending
+	}
+	upload.pending = nil
+	return nil
+}
+
+func (w *conversationRecordingWriter) Close(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.done {
+		return nil
+	}
+	w.done = true
+
+	var lastErr error
+	for track, upload := range w.uploads {
+		// the header is completed in the part which is written last
+		if upload.parts == 0 {
+			setWavLength(upload.pending, upload.size)
+		} else if setWavLength(upload.first, upload.size) {
+			if err := w.storage.UploadPart(ctx, upload.key, upload.uploadId, 1, upload.first); err != nil {
+				w.logger.Warnf("unable to set the length of %s recording %v", track, err)
+			}
+		}
+		if len(upload.pending) > 0 {
+			if err := w.flush(ctx, upload); err != nil {
+				w.logger.Errorf("unable to write last part of %s recording %v", track, err)
+				lastErr = err
+			}
+		}
+		if upload.parts == 0 {
+			w.storage.AbortMultipart(ctx, upload.key, upload.uploadId)
+			continue
+		}
+		if output := w.storage.CompleteMultipart(ctx, upload.key, upload.uploadId); output.Error != nil {
+			w.logger.Errorf("unable to complete %s recording %v", track, output.Error)
+			lastErr = output.Error
+		}
+	}
+
+	status := type_enums.RECORD_ACTIVE
+	if upload := w.uploads[internal_type.RecordingTrackMixed]; upload == nil || upload.parts == 0 || lastErr != nil {
+		status = type_enums.RECORD_FAILED
+	}
+	if tx := w.postgres.DB(ctx).
+		Model(&internal_conversation_entity.AssistantConversationRecording{}).
+		Where("id = ?", w.recording.Id).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"uploads":      nil,
+			"updated_date": time.Now(),
+		}); tx.Error != nil {
+		w.logger.Errorf("unable to update streamed recording %d %v", w.recording.Id, tx.Error)
+		return tx.Error
+	}
+	return lastErr
+}
+
+func (w *conversationRecordingWriter) Abort(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.done {
+		return nil
+	}
+	w.done = true
+	for _, upload := range w.uploads {
+		w.storage.AbortMultipart(ctx, upload.key, upload.uploadId)
+	}
+	if w.recording == nil {
+		return nil
+	}
+	return w.postgres.DB(ctx).
+		Model(&internal_conversation_entity.AssistantConversationRecording{}).
+		Where("id = ?", w.recording.Id).
+		Updates(map[string]interface{}{
+			"status":       type_enums.RECORD_FAILED,
+			"uploads":      nil,
+			"updated_date": time.Now(),
+		}).Error
+}
+
+func (conversationService *assistantConversationService) RecoverConversationRecordings(ctx context.Context, staleAfter time.Duration) (int, error) {
+	start := time.Now()
+	storage, ok := conversationService.storage.(storages.MultipartStorage)
+	if !ok {
+		return 0, nil
+	}
+	var recordings []*internal_conversation_entity.AssistantConversationRecording
+	tx := conversationService.postgres.DB(ctx).
+		Where("status = ? AND COALESCE(updated_date, created_date) < ?", type_enums.RECORD_IN_PROGRESS.String(), time.Now().Add(-staleAfter)).
+		Find(&recordings)
+	if tx.Error != nil {
+		conversationService.logger.Errorf("unable to find streamed recordings to recover %v", tx.Error)
+		return 0, tx.Error
+	}
+
+	recovered := 0
+	for _, recording := range recordings {
+		keys := map[string]string{
+			string(internal_type.RecordingTrackMixed):     recording.RecordingUrl,
+			string(internal_type.RecordingTrackUser):      recording.UserRecordingUrl,
+			string(internal_type.RecordingTrackAssistant): recording.AssistantRecordingUrl,
+		}
+		status := type_enums.RECORD_ACTIVE
+		for track, uploadId := range recording.Uploads {
+			// parts that were buffered in memory are lost, everything uploaded is kept. The wav
+			// keeps the header of a stream without length, players read it to its end.
+			if output := storage.CompleteMultipart(ctx, keys[track], uploadId); output.Error != nil {
+				conversationService.logger.Warnf("unable to recover %s track of recording %d %v", track, recording.Id, output.Error)
+				storage.AbortMultipart(ctx, keys[track], uploadId)
+				if track == string(internal_type.RecordingTrackMixed) {
+					status = type_enums.RECORD_FAILED
+				}
+			}
+		}
+		if tx := conversationService.postgres.DB(ctx).
+			Model(&internal_conversation_entity.AssistantConversationRecording{}).
+			Where("id = ?", recording.Id).
+			Updates(map[string]interface{}{
+				"status":       status,
+				"uploads":      nil,
+				"updated_date": time.Now(),
+			}); tx.Error != nil {
+			conversationService.logger.Errorf("unable to update recovered recording %d %v", recording.Id, tx.Error)
+			continue
+		}
+		if status == type_enums.RECORD_ACTIVE {
+			recovered++
+		}
+	}
+	conversationService.logger.Benchmark("conversationService.RecoverConversationRecordings", time.Since(start))
+	return recovered, nil
+}
+
+// setWavLength sets the riff and data sizes of a streamed wav once its length is known, the
+// recorder writes them as the maximum since the length is unknown while the call is running.
+// It reports whether the header was set.
+func setWavLength(content []byte, size int64) bool {
+	if len(content) < 44 || !bytes.Equal(content[0:4], []byte("RIFF")) || !bytes.Equal(content[36:40], []byte("data")) {
+		return false
+	}
+	if binary.LittleEndian.Uint32(content[4:]) != 0xFFFFFFFF || size > 0xFFFFFFFF {
+		return false
+	}
+	binary.LittleEndian.PutUint32(content[4:], uint32(size-8))
+	binary.LittleEndian.PutUint32(content[40:], uint32(size-44))
+	return true
+}
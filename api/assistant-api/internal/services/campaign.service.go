@@ -0,0 +1,69 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_services
+
+import (
+	"context"
+	"time"
+
+	internal_campaign_entity "github.com/rapidaai/api/assistant-api/internal/entity/campaigns"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/protos"
+)
+
+type CampaignService interface {
+	// Create validates the campaign and stores it with its contacts, the calls are placed by
+	// the dialer once the campaign starts
+	Create(ctx context.Context,
+		auth types.SimplePrinciple,
+		campaign *internal_campaign_entity.AssistantCampaign,
+		contacts []*internal_campaign_entity.AssistantCampaignContact,
+	) (*internal_campaign_entity.AssistantCampaign, error)
+
+	Get(ctx context.Context, auth types.SimplePrinciple, campaignId uint64) (*internal_campaign_entity.AssistantCampaign, error)
+
+	GetAll(ctx context.Context,
+		auth types.SimplePrinciple,
+		criterias []*protos.Criteria,
+		paginate *protos.Paginate) (int64, []*internal_campaign_entity.AssistantCampaign, error)
+
+	// UpdateStatus pauses, resumes or cancels the campaign, contacts which were not called yet
+	// are cancelled with the campaign
+	UpdateStatus(ctx context.Context,
+		auth types.SimplePrinciple,
+		campaignId uint64,
+		status internal_campaign_entity.CampaignStatus) (*internal_campaign_entity.AssistantCampaign, error)
+
+	GetAllContact(ctx context.Context,
+		auth types.SimplePrinciple,
+		campaignId uint64,
+		criterias []*protos.Criteria,
+		paginate *protos.Paginate) (int64, []*internal_campaign_entity.AssistantCampaignContact, error)
+
+	// GetDialable returns the started campaigns whose calling window is open at now
+	GetDialable(ctx context.Context, now time.Time) ([]*internal_campaign_entity.AssistantCampaign, error)
+
+	// Claim marks the contacts due at now as calling, at most as many as the telephony
+	// credential of the campaign has capacity for
+	Claim(ctx context.Context, campaign *internal_campaign_entity.AssistantCampaign, now time.Time) ([]*internal_campaign_entity.AssistantCampaignContact, error)
+
+	// AttachConversation links the conversation of the call to the contact, status callbacks of
+	// the call are matched to the contact by it
+	AttachConversation(ctx context.Context, contactId, assistantConversationId uint64) error
+
+	// ApplyCallStatus applies the status reported by the telephony provider to the contact
+	// called in the conversation, calls which ended are retried following the retry policy
+	ApplyCallStatus(ctx context.Context, assistantConversationId uint64, status, answeredBy string) error
+
+	// ApplyDisposition ends the call to the contact with the disposition
+	ApplyDisposition(ctx context.Context, contactId uint64, disposition internal_campaign_entity.CallDisposition) error
+
+	// TimeoutCalls fails the calls without status callback for staleAfter
+	TimeoutCalls(ctx context.Context, staleAfter time.Duration) (int, error)
+
+	// Complete completes the running campaigns without any contact left to call
+	Complete(ctx context.Context) (int, error)
+}
@@ -0,0 +1,484 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_campaign_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	internal_campaign_entity "github.com/rapidaai/api/assistant-api/internal/entity/campaigns"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	gorm_models "github.com/rapidaai/pkg/models/gorm"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/protos"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// contacts inserted per statement when a campaign is created
+const contactBatchSize = 500
+
+type campaignService struct {
+	logger   commons.Logger
+	postgres connectors.PostgresConnector
+}
+
+func NewCampaignService(logger commons.Logger, postgres connectors.PostgresConnector) internal_services.CampaignService {
+	return &campaignService{
+		logger:   logger,
+		postgres: postgres,
+	}
+}
+
+func (cService *campaignService) Create(ctx context.Context,
+	auth types.SimplePrinciple,
+	campaign *internal_campaign_entity.AssistantCampaign,
+	contacts []*internal_campaign_entity.AssistantCampaignContact,
+) (*internal_campaign_entity.AssistantCampaign, error) {
+	start := time.Now()
+	if err := validate(campaign); err != nil {
+		return nil, err
+	}
+	if len(contacts) == 0 {
+		return nil, errors.New("campaign needs at least one contact")
+	}
+	campaign.Organizational = gorm_models.Organizational{
+		ProjectId:      *auth.GetCurrentProjectId(),
+		OrganizationId: *auth.GetCurrentOrganizationId(),
+	}
+	if auth.GetUserId() != nil {
+		campaign.CreatedBy = *auth.GetUserId()
+	}
+	if time.Time(campaign.StartAt).IsZero() {
+		campaign.StartAt = gorm_models.TimeWrapper(time.Now())
+	}
+	campaign.CampaignStatus = internal_campaign_entity.CAMPAIGN_SCHEDULED
+
+	err := cService.postgres.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(campaign).Error; err != nil {
+			return err
+		}
+		for _, contact := range contacts {
+			contact.Organizational = campaign.Organizational
+			contact.CreatedBy = campaign.CreatedBy
+			contact.CampaignId = campaign.Id
+			contact.CredentialId = campaign.CredentialId
+			contact.ContactStatus = internal_campaign_entity.CONTACT_PENDING
+			contact.NextAttemptAt = campaign.StartAt
+		}
+		return tx.CreateInBatches(contacts, contactBatchSize).Error
+	})
+	cService.logger.Benchmark("campaignService.Create", time.Since(start))
+	if err != nil {
+		cService.logger.Errorf("unable to create campaign %v", err)
+		return nil, err
+	}
+	campaign.Progress = &internal_campaign_entity.CampaignProgress{}
+	campaign.Progress.Add(internal_campaign_entity.CONTACT_PENDING, uint64(len(contacts)))
+	return campaign, nil
+}
+
+func (cService *campaignService) Get(ctx context.Context, auth types.SimplePrinciple, campaignId uint64) (*internal_campaign_entity.AssistantCampaign, error) {
+	var campaign *internal_campaign_entity.AssistantCampaign
+	tx := cService.postgres.DB(ctx).
+		Where("id = ? AND organization_id = ? AND project_id = ?", campaignId, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId()).
+		First(&campaign)
+	if tx.Error != nil {
+		cService.logger.Errorf("not able to find campaign %v", tx.Error)
+		return nil, tx.Error
+	}
+	if err := cService.progress(ctx, campaign); err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+func (cService *campaignService) GetAll(ctx context.Context,
+	auth types.SimplePrinciple,
+	criterias []*protos.Criteria,
+	paginate *protos.Paginate) (int64, []*internal_campaign_entity.AssistantCampaign, error) {
+	db := cService.postgres.DB(ctx)
+	var (
+		campaigns []*internal_campaign_entity.AssistantCampaign
+		cnt       int64
+	)
+	qry := db.Model(internal_campaign_entity.AssistantCampaign{}).
+		Where("organization_id = ? AND project_id = ?", *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId())
+	for _, ct := range criterias {
+		qry.Where(fmt.Sprintf("%s %s ?", ct.GetKey(), ct.GetLogic()), ct.GetValue())
+	}
+	tx := qry.
+		Scopes(gorm_models.
+			Paginate(gorm_models.
+				NewPaginated(
+					int(paginate.GetPage()),
+					int(paginate.GetPageSize()),
+					&cnt,
+					qry))).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "created_date"},
+			Desc:   true,
+		}).Find(&campaigns)
+	if tx.Error != nil {
+		cService.logger.Errorf("not able to find any campaign %v", tx.Error)
+		return cnt, nil, tx.Error
+	}
+	if err := cService.progress(ctx, campaigns...); err != nil {
+		return cnt, nil, err
+	}
+	return cnt, campaigns, nil
+}
+
+// progress counts the contacts of the campaigns by status
+func (cService *campaignService) progress(ctx context.Context, campaigns ...*internal_campaign_entity.AssistantCampaign) error {
+	if len(campaigns) == 0 {
+		return nil
+	}
+	ids := make([]uint64, 0, len(campaigns))
+	byId := make(map[uint64]*internal_campaign_entity.AssistantCampaign, len(campaigns))
+	for _, campaign := range campaigns {
+		campaign.Progress = &internal_campaign_entity.CampaignProgress{}
+		ids = append(ids, campaign.Id)
+		byId[campaign.Id] = campaign
+	}
+	var counts []struct {
+		CampaignId    uint64
+		ContactStatus internal_campaign_entity.ContactStatus
+		Count         uint64
+	}
+	tx := cService.postgres.DB(ctx).
+		Model(internal_campaign_entity.AssistantCampaignContact{}).
+		Select("campaign_id, contact_status, count(*) AS count").
+		Where("campaign_id IN ?", ids).
+		Group("campaign_id, contact_status").
+		Scan(&counts)
+	if tx.Error != nil {
+		cService.logger.Errorf("unable to count contacts of campaigns %v", tx.Error)
+		return tx.Error
+	}
+	for _, count := range counts {
+		byId[count.CampaignId].Progress.Add(count.ContactStatus, count.Count)
+	}
+	return nil
+}
+
+func (cService *campaignService) UpdateStatus(ctx context.Context,
+	auth types.SimplePrinciple,
+	campaignId uint64,
+	status internal_campaign_entity.CampaignStatus) (*internal_campaign_entity.AssistantCampaign, error) {
+	campaign, err := cService.Get(ctx, auth, campaignId)
+	if err != nil {
+		return nil, err
+	}
+	current := campaign.CampaignStatus
+	switch status {
+	case internal_campaign_entity.CAMPAIGN_PAUSED:
+		if current != internal_campaign_entity.CAMPAIGN_SCHEDULED && current != internal_campaign_entity.CAMPAIGN_RUNNING {
+			return nil, fmt.Errorf("campaign is %s, only a scheduled or running campaign can be paused", current)
+		}
+	case internal_campaign_entity.CAMPAIGN_RUNNING:
+		if current != internal_campaign_entity.CAMPAIGN_PAUSED {
+			return nil, fmt.Errorf("campaign is %s, only a paused campaign can be resumed", current)
+		}
+		if time.Time(campaign.StartAt).After(time.Now()) {
+			status = internal_campaign_entity.CAMPAIGN_SCHEDULED
+		}
+	case internal_campaign_entity.CAMPAIGN_CANCELLED:
+		if current.IsFinal() {
+			return nil, fmt.Errorf("campaign is already %s", current)
+		}
+	default:
+		return nil, fmt.Errorf("illegal campaign status %s", status)
+	}
+
+	updates := map[string]interface{}{
+		"campaign_status": status,
+		"updated_date":    time.Now(),
+	}
+	if auth.GetUserId() != nil {
+		updates["updated_by"] = *auth.GetUserId()
+	}
+	err = cService.postgres.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&internal_campaign_entity.AssistantCampaign{}).
+			Where("id = ? AND campaign_status = ?", campaign.Id, current).
+			Updates(updates).Error; err != nil {
+			return err
+		}
+		if status != internal_campaign_entity.CAMPAIGN_CANCELLED {
+			return nil
+		}
+		// calls in flight end as usual, they are not retried anymore
+		return tx.Model(&internal_campaign_entity.AssistantCampaignContact{}).
+			Where("campaign_id = ? AND contact_status IN ?", campaign.Id, []internal_campaign_entity.ContactStatus{
+				internal_campaign_entity.CONTACT_PENDING,
+				internal_campaign_entity.CONTACT_RETRYING,
+			}).
+			Updates(map[string]interface{}{
+				"contact_status": internal_campaign_entity.CONTACT_CANCELLED,
+				"updated_date":   time.Now(),
+			}).Error
+	})
+	if err != nil {
+		cService.logger.Errorf("unable to update status of campaign %d %v", campaign.Id, err)
+		return nil, err
+	}
+	return cService.Get(ctx, auth, campaign.Id)
+}
+
+func (cService *campaignService) GetAllContact(ctx context.Context,
+	auth types.SimplePrinciple,
+	campaignId uint64,
+	criterias []*protos.Criteria,
+	paginate *protos.Paginate) (int64, []*internal_campaign_entity.AssistantCampaignContact, error) {
+	db := cService.postgres.DB(ctx)
+	var (
+		contacts []*internal_campaign_entity.AssistantCampaignContact
+		cnt      int64
+	)
+	qry := db.Model(internal_campaign_entity.AssistantCampaignContact{}).
+		Where("campaign_id = ? AND organization_id = ? AND project_id = ?", campaignId, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId())
+	for _, ct := range criterias {
+		qry.Where(fmt.Sprintf("%s %s ?", ct.GetKey(), ct.GetLogic()), ct.GetValue())
+	}
+	tx := qry.
+		Scopes(gorm_models.
+			Paginate(gorm_models.
+				NewPaginated(
+					int(paginate.GetPage()),
+					int(paginate.GetPageSize()),
+					&cnt,
+					qry))).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "id"},
+		}).Find(&contacts)
+	if tx.Error != nil {
+		cService.logger.Errorf("not able to find any campaign contact %v", tx.Error)
+		return cnt, nil, tx.Error
+	}
+	return cnt, contacts, nil
+}
+
+func (cService *campaignService) GetDialable(ctx context.Context, now time.Time) ([]*internal_campaign_entity.AssistantCampaign, error) {
+	var campaigns []*internal_campaign_entity.AssistantCampaign
+	tx := cService.postgres.DB(ctx).
+		Where("campaign_status IN ? AND start_at <= ?", []internal_campaign_entity.CampaignStatus{
+			internal_campaign_entity.CAMPAIGN_SCHEDULED,
+			internal_campaign_entity.CAMPAIGN_RUNNING,
+		}, now).
+		Find(&campaigns)
+	if tx.Error != nil {
+		cService.logger.Errorf("unable to find campaigns to dial %v", tx.Error)
+		return nil, tx.Error
+	}
+
+	dialable := make([]*internal_campaign_entity.AssistantCampaign, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		if campaign.CampaignStatus == internal_campaign_entity.CAMPAIGN_SCHEDULED {
+			if tx := cService.postgres.DB(ctx).
+				Model(&internal_campaign_entity.AssistantCampaign{}).
+				Where("id = ? AND campaign_status = ?", campaign.Id, internal_campaign_entity.CAMPAIGN_SCHEDULED).
+				Updates(map[string]interface{}{
+					"campaign_status": internal_campaign_entity.CAMPAIGN_RUNNING,
+					"updated_date":    time.Now(),
+				}); tx.Error != nil {
+				cService.logger.Errorf("unable to start campaign %d %v", campaign.Id, tx.Error)
+				continue
+			}
+			campaign.CampaignStatus = internal_campaign_entity.CAMPAIGN_RUNNING
+		}
+		if inWindow(campaign, now) {
+			dialable = append(dialable, campaign)
+		}
+	}
+	return dialable, nil
+}
+
+func (cService *campaignService) Claim(ctx context.Context, campaign *internal_campaign_entity.AssistantCampaign, now time.Time) ([]*internal_campaign_entity.AssistantCampaignContact, error) {
+	var contacts []*internal_campaign_entity.AssistantCampaignContact
+	err := cService.postgres.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// calls in flight are counted per credential, campaigns sharing the credential are
+		// claimed one after the other
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", int64(campaign.CredentialId)).Error; err != nil {
+			return err
+		}
+		var calling int64
+		if err := tx.Model(&internal_campaign_entity.AssistantCampaignContact{}).
+			Where("credential_id = ? AND contact_status = ?", campaign.CredentialId, internal_campaign_entity.CONTACT_CALLING).
+			Count(&calling).Error; err != nil {
+			return err
+		}
+		capacity := int(campaign.MaxConcurrentCalls) - int(calling)
+		if capacity <= 0 {
+			return nil
+		}
+		if err := tx.
+			Where("campaign_id = ? AND contact_status IN ? AND next_attempt_at <= ?", campaign.Id, []internal_campaign_entity.ContactStatus{
+				internal_campaign_entity.CONTACT_PENDING,
+				internal_campaign_entity.CONTACT_RETRYING,
+			}, now).
+			Order("next_attempt_at, id").
+			Limit(capacity).
+			Find(&contacts).Error; err != nil {
+			return err
+		}
+		if len(contacts) == 0 {
+			return nil
+		}
+		ids := make([]uint64, 0, len(contacts))
+		for _, contact := range contacts {
+			ids = append(ids, contact.Id)
+			contact.ContactStatus = internal_campaign_entity.CONTACT_CALLING
+			contact.Attempts++
+		}
+		return tx.Model(&internal_campaign_entity.AssistantCampaignContact{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{
+				"contact_status": internal_campaign_entity.CONTACT_CALLING,
+				"attempts":       gorm.Expr("attempts + 1"),
+				"updated_date":   time.Now(),
+			}).Error
+	})
+	if err != nil {
+		cService.logger.Errorf("unable to claim contacts of campaign %d %v", campaign.Id, err)
+		return nil, err
+	}
+	return contacts, nil
+}
+
+func (cService *campaignService) AttachConversation(ctx context.Context, contactId, assistantConversationId uint64) error {
+	tx := cService.postgres.DB(ctx).
+		Model(&internal_campaign_entity.AssistantCampaignContact{}).
+		Where("id = ?", contactId).
+		Updates(map[string]interface{}{
+			"assistant_conversation_id": assistantConversationId,
+			"updated_date":              time.Now(),
+		})
+	if tx.Error != nil {
+		cService.logger.Errorf("unable to attach conversation to campaign contact %d %v", contactId, tx.Error)
+	}
+	return tx.Error
+}
+
+func (cService *campaignService) ApplyCallStatus(ctx context.Context, assistantConversationId uint64, status, answeredBy string) error {
+	var contact *internal_campaign_entity.AssistantCampaignContact
+	tx := cService.postgres.DB(ctx).
+		Where("assistant_conversation_id = ? AND contact_status = ?", assistantConversationId, internal_campaign_entity.CONTACT_CALLING).
+		Limit(1).
+		Find(&contact)
+	if tx.Error != nil {
+		cService.logger.Errorf("unable to find campaign contact of conversation %d %v", assistantConversationId, tx.Error)
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		// not a campaign call
+		return nil
+	}
+
+	disposition, ended := dispositionOf(status, answeredBy)
+	if !ended {
+		// the call is alive, a machine detected while ringing is kept for the end of the call
+		updates := map[string]interface{}{"updated_date": time.Now()}
+		if disposition != "" {
+			updates["disposition"] = disposition
+		}
+		return cService.postgres.DB(ctx).
+			Model(&internal_campaign_entity.AssistantCampaignContact{}).
+			Where("id = ?", contact.Id).
+			Updates(updates).Error
+	}
+	if disposition == internal_campaign_entity.DISPOSITION_ANSWERED && contact.Disposition == internal_campaign_entity.DISPOSITION_VOICEMAIL {
+		disposition = internal_campaign_entity.DISPOSITION_VOICEMAIL
+	}
+	return cService.end(ctx, contact, disposition)
+}
+
+func (cService *campaignService) ApplyDisposition(ctx context.Context, contactId uint64, disposition internal_campaign_entity.CallDisposition) error {
+	var contact *internal_campaign_entity.AssistantCampaignContact
+	tx := cService.postgres.DB(ctx).
+		Where("id = ? AND contact_status = ?", contactId, internal_campaign_entity.CONTACT_CALLING).
+		First(&contact)
+	if tx.Error != nil {
+		cService.logger.Errorf("unable to find calling campaign contact %d %v", contactId, tx.Error)
+		return tx.Error
+	}
+	return cService.end(ctx, contact, disposition)
+}
+
+// end applies the retry policy of the campaign to the contact whose call ended with the
+// disposition, status callbacks received more than once are applied once
+func (cService *campaignService) end(ctx context.Context, contact *internal_campaign_entity.AssistantCampaignContact, disposition internal_campaign_entity.CallDisposition) error {
+	var campaign *internal_campaign_entity.AssistantCampaign
+	if tx := cService.postgres.DB(ctx).Where("id = ?", contact.CampaignId).First(&campaign); tx.Error != nil {
+		cService.logger.Errorf("unable to find campaign %d of contact %d %v", contact.CampaignId, contact.Id, tx.Error)
+		return tx.Error
+	}
+
+	status, next := nextAttempt(campaign.RetryPolicies, contact.Attempts, disposition, time.Now())
+	if status == internal_campaign_entity.CONTACT_RETRYING && campaign.CampaignStatus == internal_campaign_entity.CAMPAIGN_CANCELLED {
+		status = internal_campaign_entity.CONTACT_CANCELLED
+	}
+	tx := cService.postgres.DB(ctx).
+		Model(&internal_campaign_entity.AssistantCampaignContact{}).
+		Where("id = ? AND contact_status = ?", contact.Id, internal_campaign_entity.CONTACT_CALLING).
+		Updates(map[string]interface{}{
+			"contact_status":  status,
+			"disposition":     disposition,
+			"next_attempt_at": next,
+			"updated_date":    time.Now(),
+		})
+	if tx.Error != nil {
+		cService.logger.Errorf("unable to update campaign contact %d %v", contact.Id, tx.Error)
+		return tx.Error
+	}
+	cService.logger.Debugf("campaign %d contact %d ended with %s after %d attempts, contact is %s", campaign.Id, contact.Id, disposition, contact.Attempts, status)
+	return nil
+}
+
+func (cService *campaignService) TimeoutCalls(ctx context.Context, staleAfter time.Duration) (int, error) {
+	var contacts []*internal_campaign_entity.AssistantCampaignContact
+	tx := cService.postgres.DB(ctx).
+		Where("contact_status = ? AND COALESCE(updated_date, created_date) < ?", internal_campaign_entity.CONTACT_CALLING, time.Now().Add(-staleAfter)).
+		Find(&contacts)
+	if tx.Error != nil {
+		cService.logger.Errorf("unable to find stale campaign calls %v", tx.Error)
+		return 0, tx.Error
+	}
+	timedOut := 0
+	for _, contact := range contacts {
+		if err := cService.end(ctx, contact, internal_campaign_entity.DISPOSITION_FAILED); err != nil {
+			continue
+		}
+		timedOut++
+	}
+	return timedOut, nil
+}
+
+func (cService *campaignService) Complete(ctx context.Context) (int, error) {
+	tx := cService.postgres.DB(ctx).
+		Model(&internal_campaign_entity.AssistantCampaign{}).
+		Where("campaign_status = ?", internal_campaign_entity.CAMPAIGN_RUNNING).
+		Where("NOT EXISTS (?)", cService.postgres.DB(ctx).
+			Model(&internal_campaign_entity.AssistantCampaignContact{}).
+			Select("1").
+			Where("assistant_campaign_contacts.campaign_id = assistant_campaigns.id AND assistant_campaign_contacts.contact_status IN ?", []internal_campaign_entity.ContactStatus{
+				internal_campaign_entity.CONTACT_PENDING,
+				internal_campaign_entity.CONTACT_CALLING,
+				internal_campaign_entity.CONTACT_RETRYING,
+			})).
+		Updates(map[string]interface{}{
+			"campaign_status": internal_campaign_entity.CAMPAIGN_COMPLETED,
+			"updated_date":    time.Now(),
+		})
+	if tx.Error != nil {
+		cService.logger.Errorf("unable to complete campaigns %v", tx.Error)
+		return 0, tx.Error
+	}
+	return int(tx.RowsAffected), nil
+}
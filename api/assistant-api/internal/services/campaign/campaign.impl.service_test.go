@@ -0,0 +1,190 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_campaign_service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	internal_campaign_entity "github.com/rapidaai/api/assistant-api/internal/entity/campaigns"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// mockPostgres serves the queries of the service from sqlmock
+type mockPostgres struct {
+	db *gorm.DB
+}
+
+func (m *mockPostgres) Connect(ctx context.Context) error                             { return nil }
+func (m *mockPostgres) Name() string                                                  { return "mock" }
+func (m *mockPostgres) IsConnected(ctx context.Context) bool                          { return true }
+func (m *mockPostgres) Disconnect(ctx context.Context) error                          { return nil }
+func (m *mockPostgres) Query(ctx context.Context, qry string, dest interface{}) error { return nil }
+func (m *mockPostgres) DB(ctx context.Context) *gorm.DB                               { return m.db.WithContext(ctx) }
+
+func newMockService(t *testing.T) (*campaignService, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+	logger, _ := commons.NewApplicationLogger()
+	return &campaignService{logger: logger, postgres: &mockPostgres{db: db}}, mock
+}
+
+func TestClaim(t *testing.T) {
+	campaign := &internal_campaign_entity.AssistantCampaign{MaxConcurrentCalls: 3, CredentialId: 7}
+	campaign.Id = 11
+	now := time.Now()
+
+	t.Run("credential at capacity claims nothing", func(t *testing.T) {
+		service, mock := newMockService(t)
+		mock.ExpectBegin()
+		mock.ExpectExec(`pg_advisory_xact_lock`).WithArgs(int64(7)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SELECT count\(\*\) FROM "assistant_campaign_contacts"`).
+			WithArgs(uint64(7), internal_campaign_entity.CONTACT_CALLING).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+		mock.ExpectCommit()
+
+		contacts, err := service.Claim(context.Background(), campaign, now)
+		require.NoError(t, err)
+		assert.Empty(t, contacts)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("contacts are claimed up to the free capacity of the credential", func(t *testing.T) {
+		service, mock := newMockService(t)
+		mock.ExpectBegin()
+		mock.ExpectExec(`pg_advisory_xact_lock`).WithArgs(int64(7)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SELECT count\(\*\) FROM "assistant_campaign_contacts"`).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(`SELECT \* FROM "assistant_campaign_contacts" WHERE .* ORDER BY next_attempt_at, id LIMIT \$5`).
+			WithArgs(uint64(11), internal_campaign_entity.CONTACT_PENDING, internal_campaign_entity.CONTACT_RETRYING, now, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "campaign_id", "contact_status", "attempts"}).
+				AddRow(21, 11, internal_campaign_entity.CONTACT_PENDING, 0).
+				AddRow(22, 11, internal_campaign_entity.CONTACT_RETRYING, 1))
+		mock.ExpectExec(`UPDATE "assistant_campaign_contacts" SET "attempts"=attempts \+ 1,"contact_status"=\$1,"updated_date"=\$2 WHERE id IN \(\$3,\$4\)`).
+			WithArgs(internal_campaign_entity.CONTACT_CALLING, sqlmock.AnyArg(), uint64(21), uint64(22)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		contacts, err := service.Claim(context.Background(), campaign, now)
+		require.NoError(t, err)
+		require.Len(t, contacts, 2)
+		for i, attempts := range []uint32{1, 2} {
+			assert.Equal(t, internal_campaign_entity.CONTACT_CALLING, contacts[i].ContactStatus)
+			assert.Equal(t, attempts, contacts[i].Attempts)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestApplyCallStatus(t *testing.T) {
+	policies := `[{"disposition":"busy","maxAttempts":3,"retryAfterSeconds":600}]`
+	tests := []struct {
+		name        string
+		status      string
+		answeredBy  string
+		previous    internal_campaign_entity.CallDisposition
+		attempts    uint32
+		campaign    internal_campaign_entity.CampaignStatus
+		disposition internal_campaign_entity.CallDisposition
+		contact     internal_campaign_entity.ContactStatus
+	}{
+		{
+			name:        "answered call completes the contact",
+			status:      "completed",
+			attempts:    1,
+			campaign:    internal_campaign_entity.CAMPAIGN_RUNNING,
+			disposition: internal_campaign_entity.DISPOSITION_ANSWERED,
+			contact:     internal_campaign_entity.CONTACT_COMPLETED,
+		},
+		{
+			name:        "machine detected while ringing is kept at the end of the call",
+			status:      "completed",
+			previous:    internal_campaign_entity.DISPOSITION_VOICEMAIL,
+			attempts:    1,
+			campaign:    internal_campaign_entity.CAMPAIGN_RUNNING,
+			disposition: internal_campaign_entity.DISPOSITION_VOICEMAIL,
+			contact:     internal_campaign_entity.CONTACT_FAILED,
+		},
+		{
+			name:        "busy contact is retried",
+			status:      "busy",
+			attempts:    1,
+			campaign:    internal_campaign_entity.CAMPAIGN_RUNNING,
+			disposition: internal_campaign_entity.DISPOSITION_BUSY,
+			contact:     internal_campaign_entity.CONTACT_RETRYING,
+		},
+		{
+			name:        "busy contact out of attempts fails",
+			status:      "busy",
+			attempts:    3,
+			campaign:    internal_campaign_entity.CAMPAIGN_RUNNING,
+			disposition: internal_campaign_entity.DISPOSITION_BUSY,
+			contact:     internal_campaign_entity.CONTACT_FAILED,
+		},
+		{
+			name:        "contact of a cancelled campaign is not retried",
+			status:      "busy",
+			attempts:    1,
+			campaign:    internal_campaign_entity.CAMPAIGN_CANCELLED,
+			disposition: internal_campaign_entity.DISPOSITION_BUSY,
+			contact:     internal_campaign_entity.CONTACT_CANCELLED,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mock := newMockService(t)
+			mock.ExpectQuery(`SELECT \* FROM "assistant_campaign_contacts" WHERE assistant_conversation_id = \$1 AND contact_status = \$2`).
+				WithArgs(uint64(31), internal_campaign_entity.CONTACT_CALLING, 1).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "campaign_id", "contact_status", "disposition", "attempts"}).
+					AddRow(21, 11, internal_campaign_entity.CONTACT_CALLING, tt.previous, tt.attempts))
+			mock.ExpectQuery(`SELECT \* FROM "assistant_campaigns" WHERE id = \$1`).
+				WithArgs(uint64(11), 1).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "campaign_status", "retry_policies"}).
+					AddRow(11, tt.campaign, policies))
+			mock.ExpectBegin()
+			mock.ExpectExec(`UPDATE "assistant_campaign_contacts" SET "contact_status"=\$1,"disposition"=\$2,"next_attempt_at"=\$3,"updated_date"=\$4 WHERE id = \$5 AND contact_status = \$6`).
+				WithArgs(tt.contact, tt.disposition, sqlmock.AnyArg(), sqlmock.AnyArg(), uint64(21), internal_campaign_entity.CONTACT_CALLING).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			require.NoError(t, service.ApplyCallStatus(context.Background(), 31, tt.status, tt.answeredBy))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+
+	t.Run("call of a conversation outside campaigns is ignored", func(t *testing.T) {
+		service, mock := newMockService(t)
+		mock.ExpectQuery(`SELECT \* FROM "assistant_campaign_contacts"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		require.NoError(t, service.ApplyCallStatus(context.Background(), 31, "completed", ""))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("machine detected while ringing does not end the call", func(t *testing.T) {
+		service, mock := newMockService(t)
+		mock.ExpectQuery(`SELECT \* FROM "assistant_campaign_contacts"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "campaign_id", "contact_status"}).
+				AddRow(21, 11, internal_campaign_entity.CONTACT_CALLING))
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE "assistant_campaign_contacts" SET "disposition"=\$1,"updated_date"=\$2 WHERE id = \$3`).
+			WithArgs(internal_campaign_entity.DISPOSITION_VOICEMAIL, sqlmock.AnyArg(), uint64(21)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, service.ApplyCallStatus(context.Background(), 31, "", "machine_start"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
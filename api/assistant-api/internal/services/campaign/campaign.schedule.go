@@ -0,0 +1,157 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_campaign_service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	internal_campaign_entity "github.com/rapidaai/api/assistant-api/internal/entity/campaigns"
+)
+
+// retry policies of a campaign created without any
+var defaultRetryPolicies = internal_campaign_entity.CampaignRetryPolicies{
+	{Disposition: internal_campaign_entity.DISPOSITION_BUSY, MaxAttempts: 3, RetryAfterSeconds: 600},
+	{Disposition: internal_campaign_entity.DISPOSITION_NO_ANSWER, MaxAttempts: 3, RetryAfterSeconds: 1800},
+	{Disposition: internal_campaign_entity.DISPOSITION_VOICEMAIL, MaxAttempts: 2, RetryAfterSeconds: 3600},
+	{Disposition: internal_campaign_entity.DISPOSITION_FAILED, MaxAttempts: 2, RetryAfterSeconds: 300},
+}
+
+// validate checks the schedule of the campaign and fills in the defaults
+func validate(campaign *internal_campaign_entity.AssistantCampaign) error {
+	if campaign.Timezone == "" {
+		campaign.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(campaign.Timezone); err != nil {
+		return fmt.Errorf("illegal timezone %s", campaign.Timezone)
+	}
+	if (campaign.WindowStart == "") != (campaign.WindowEnd == "") {
+		return fmt.Errorf("calling window needs both start and end")
+	}
+	if campaign.WindowStart != "" {
+		if _, err := parseClock(campaign.WindowStart); err != nil {
+			return err
+		}
+		if _, err := parseClock(campaign.WindowEnd); err != nil {
+			return err
+		}
+	}
+	for _, day := range campaign.WindowDays {
+		if day > 6 {
+			return fmt.Errorf("illegal calling day %d, days are 0 (sunday) to 6", day)
+		}
+	}
+	if campaign.MaxConcurrentCalls == 0 {
+		campaign.MaxConcurrentCalls = 1
+	}
+	if len(campaign.RetryPolicies) == 0 {
+		campaign.RetryPolicies = defaultRetryPolicies
+	}
+	for _, policy := range campaign.RetryPolicies {
+		switch policy.Disposition {
+		case internal_campaign_entity.DISPOSITION_BUSY,
+			internal_campaign_entity.DISPOSITION_NO_ANSWER,
+			internal_campaign_entity.DISPOSITION_VOICEMAIL,
+			internal_campaign_entity.DISPOSITION_FAILED:
+		default:
+			return fmt.Errorf("illegal retry disposition %s", policy.Disposition)
+		}
+	}
+	return nil
+}
+
+// parseClock returns the minutes since midnight of HH:MM
+func parseClock(clock string) (int, error) {
+	var hour, minute int
+	if _, err := fmt.Sscanf(clock, "%d:%d", &hour, &minute); err != nil || len(clock) != 5 || hour > 23 || minute > 59 || hour < 0 || minute < 0 {
+		return 0, fmt.Errorf("illegal time %s, expected HH:MM", clock)
+	}
+	return hour*60 + minute, nil
+}
+
+// inWindow reports whether now is within the calling window of the campaign. A window ending
+// before it starts runs over midnight and belongs to the day it starts on.
+func inWindow(campaign *internal_campaign_entity.AssistantCampaign, now time.Time) bool {
+	location, err := time.LoadLocation(campaign.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	local := now.In(location)
+	day := local.Weekday()
+
+	if campaign.WindowStart != "" && campaign.WindowEnd != "" {
+		start, err := parseClock(campaign.WindowStart)
+		if err != nil {
+			return false
+		}
+		end, err := parseClock(campaign.WindowEnd)
+		if err != nil {
+			return false
+		}
+		minute := local.Hour()*60 + local.Minute()
+		switch {
+		case start < end:
+			if minute < start || minute >= end {
+				return false
+			}
+		case start > end:
+			if minute < end {
+				day = (day + 6) % 7
+			} else if minute < start {
+				return false
+			}
+		}
+	}
+
+	if len(campaign.WindowDays) == 0 {
+		return true
+	}
+	for _, d := range campaign.WindowDays {
+		if time.Weekday(d) == day {
+			return true
+		}
+	}
+	return false
+}
+
+// dispositionOf maps the call status of the telephony provider to the disposition of the call,
+// ended is false while the call is still going on
+func dispositionOf(status, answeredBy string) (disposition internal_campaign_entity.CallDisposition, ended bool) {
+	if strings.HasPrefix(strings.ToLower(answeredBy), "machine") {
+		disposition = internal_campaign_entity.DISPOSITION_VOICEMAIL
+	}
+	switch strings.ToLower(status) {
+	case "machine":
+		return internal_campaign_entity.DISPOSITION_VOICEMAIL, false
+	case "completed":
+		if disposition == "" {
+			disposition = internal_campaign_entity.DISPOSITION_ANSWERED
+		}
+		return disposition, true
+	case "busy":
+		return internal_campaign_entity.DISPOSITION_BUSY, true
+	case "no-answer", "unanswered", "timeout":
+		return internal_campaign_entity.DISPOSITION_NO_ANSWER, true
+	case "failed", "canceled", "cancelled", "rejected":
+		return internal_campaign_entity.DISPOSITION_FAILED, true
+	default:
+		return disposition, false
+	}
+}
+
+// nextAttempt returns the status of the contact after the call with the disposition, retried
+// contacts are called again at the returned time
+func nextAttempt(policies internal_campaign_entity.CampaignRetryPolicies, attempts uint32, disposition internal_campaign_entity.CallDisposition, now time.Time) (internal_campaign_entity.ContactStatus, time.Time) {
+	if disposition == internal_campaign_entity.DISPOSITION_ANSWERED {
+		return internal_campaign_entity.CONTACT_COMPLETED, now
+	}
+	policy, ok := policies.Get(disposition)
+	if !ok || attempts >= policy.MaxAttempts {
+		return internal_campaign_entity.CONTACT_FAILED, now
+	}
+	return internal_campaign_entity.CONTACT_RETRYING, now.Add(time.Duration(policy.RetryAfterSeconds) * time.Second)
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_campaign_service
+
+import (
+	"testing"
+	"time"
+
+	internal_campaign_entity "github.com/rapidaai/api/assistant-api/internal/entity/campaigns"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	campaign := &internal_campaign_entity.AssistantCampaign{}
+	require.NoError(t, validate(campaign))
+	assert.Equal(t, "UTC", campaign.Timezone)
+	assert.Equal(t, uint32(1), campaign.MaxConcurrentCalls)
+	assert.Equal(t, defaultRetryPolicies, campaign.RetryPolicies)
+
+	tests := []struct {
+		name     string
+		campaign internal_campaign_entity.AssistantCampaign
+	}{
+		{name: "unknown timezone", campaign: internal_campaign_entity.AssistantCampaign{Timezone: "Mars/Olympus"}},
+		{name: "window without end", campaign: internal_campaign_entity.AssistantCampaign{WindowStart: "09:00"}},
+		{name: "illegal window", campaign: internal_campaign_entity.AssistantCampaign{WindowStart: "9am", WindowEnd: "17:00"}},
+		{name: "illegal hour", campaign: internal_campaign_entity.AssistantCampaign{WindowStart: "09:00", WindowEnd: "24:00"}},
+		{name: "illegal day", campaign: internal_campaign_entity.AssistantCampaign{WindowDays: gorm_types.IntArray{7}}},
+		{name: "illegal disposition", campaign: internal_campaign_entity.AssistantCampaign{RetryPolicies: internal_campaign_entity.CampaignRetryPolicies{{Disposition: "answered", MaxAttempts: 2}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, validate(&tt.campaign))
+		})
+	}
+}
+
+func TestInWindow(t *testing.T) {
+	// monday 2025-03-03 in UTC
+	at := func(clock string) time.Time {
+		now, err := time.Parse(time.RFC3339, "2025-03-03T"+clock+":00Z")
+		require.NoError(t, err)
+		return now
+	}
+
+	tests := []struct {
+		name     string
+		campaign internal_campaign_entity.AssistantCampaign
+		now      time.Time
+		expected bool
+	}{
+		{
+			name:     "no window",
+			campaign: internal_campaign_entity.AssistantCampaign{Timezone: "UTC"},
+			now:      at("03:00"),
+			expected: true,
+		},
+		{
+			name:     "within window",
+			campaign: internal_campaign_entity.AssistantCampaign{Timezone: "UTC", WindowStart: "09:00", WindowEnd: "17:00"},
+			now:      at("09:00"),
+			expected: true,
+		},
+		{
+			name:     "window end is excluded",
+			campaign: internal_campaign_entity.AssistantCampaign{Timezone: "UTC", WindowStart: "09:00", WindowEnd: "17:00"},
+			now:      at("17:00"),
+			expected: false,
+		},
+		{
+			name:     "window in time zone of campaign",
+			campaign: internal_campaign_entity.AssistantCampaign{Timezone: "Asia/Kolkata", WindowStart: "09:00", WindowEnd: "17:00"},
+			// 14:30 in Kolkata
+			now:      at("09:00"),
+			expected: true,
+		},
+		{
+			name:     "outside window in time zone of campaign",
+			campaign: internal_campaign_entity.AssistantCampaign{Timezone: "America/New_York", WindowStart: "09:00", WindowEnd: "17:00"},
+			// 04:00 in New York
+			now:      at("09:00"),
+			expected: false,
+		},
+		{
+			name:     "day of the week",
+			campaign: internal_campaign_entity.AssistantCampaign{Timezone: "UTC", WindowDays: gorm_types.IntArray{1, 2, 3, 4, 5}},
+			now:      at("12:00"),
+			expected: true,
+		},
+		{
+			name:     "day of the week in time zone of campaign",
+			campaign: internal_campaign_entity.AssistantCampaign{Timezone: "America/Los_Angeles", WindowDays: gorm_types.IntArray{1, 2, 3, 4, 5}},
+			// sunday evening in Los Angeles
+			now:      at("02:00"),
+			expected: false,
+		},
+		{
+			name:     "window over midnight",
+			campaign: internal_campaign_entity.AssistantCampaign{Timezone: "UTC", WindowStart: "22:00", WindowEnd: "02:00", WindowDays: gorm_types.IntArray{0}},
+			// belongs to the window started on sunday
+			now:      at("01:00"),
+			expected: true,
+		},
+		{
+			name:     "window over midnight of another day",
+			campaign: internal_campaign_entity.AssistantCampaign{Timezone: "UTC", WindowStart: "22:00", WindowEnd: "02:00", WindowDays: gorm_types.IntArray{1}},
+			now:      at("01:00"),
+			expected: false,
+		},
+		{
+			name:     "outside window over midnight",
+			campaign: internal_campaign_entity.AssistantCampaign{Timezone: "UTC", WindowStart: "22:00", WindowEnd: "02:00"},
+			now:      at("12:00"),
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, inWindow(&tt.campaign, tt.now))
+		})
+	}
+}
+
+func TestDispositionOf(t *testing.T) {
+	tests := []struct {
+		status      string
+		answeredBy  string
+		disposition internal_campaign_entity.CallDisposition
+		ended       bool
+	}{
+		{status: "ringing"},
+		{status: "in-progress"},
+		{status: "stream-started"},
+		{status: "completed", disposition: internal_campaign_entity.DISPOSITION_ANSWERED, ended: true},
+		{status: "completed", answeredBy: "human", disposition: internal_campaign_entity.DISPOSITION_ANSWERED, ended: true},
+		{status: "completed", answeredBy: "machine_end_beep", disposition: internal_campaign_entity.DISPOSITION_VOICEMAIL, ended: true},
+		{status: "in-progress", answeredBy: "machine_start", disposition: internal_campaign_entity.DISPOSITION_VOICEMAIL},
+		{status: "machine", disposition: internal_campaign_entity.DISPOSITION_VOICEMAIL},
+		{status: "busy", disposition: internal_campaign_entity.DISPOSITION_BUSY, ended: true},
+		{status: "no-answer", disposition: internal_campaign_entity.DISPOSITION_NO_ANSWER, ended: true},
+		{status: "unanswered", disposition: internal_campaign_entity.DISPOSITION_NO_ANSWER, ended: true},
+		{status: "timeout", disposition: internal_campaign_entity.DISPOSITION_NO_ANSWER, ended: true},
+		{status: "failed", disposition: internal_campaign_entity.DISPOSITION_FAILED, ended: true},
+		{status: "rejected", disposition: internal_campaign_entity.DISPOSITION_FAILED, ended: true},
+		{status: "canceled", disposition: internal_campaign_entity.DISPOSITION_FAILED, ended: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status+"/"+tt.answeredBy, func(t *testing.T) {
+			disposition, ended := dispositionOf(tt.status, tt.answeredBy)
+			assert.Equal(t, tt.disposition, disposition)
+			assert.Equal(t, tt.ended, ended)
+		})
+	}
+}
+
+func TestNextAttempt(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name        string
+		attempts    uint32
+		disposition internal_campaign_entity.CallDisposition
+		status      internal_campaign_entity.ContactStatus
+		next        time.Time
+	}{
+		{name: "answered", attempts: 1, disposition: internal_campaign_entity.DISPOSITION_ANSWERED, status: internal_campaign_entity.CONTACT_COMPLETED, next: now},
+		{name: "busy is retried", attempts: 1, disposition: internal_campaign_entity.DISPOSITION_BUSY, status: internal_campaign_entity.CONTACT_RETRYING, next: now.Add(10 * time.Minute)},
+		{name: "busy out of attempts", attempts: 3, disposition: internal_campaign_entity.DISPOSITION_BUSY, status: internal_campaign_entity.CONTACT_FAILED, next: now},
+		{name: "voicemail is retried", attempts: 1, disposition: internal_campaign_entity.DISPOSITION_VOICEMAIL, status: internal_campaign_entity.CONTACT_RETRYING, next: now.Add(time.Hour)},
+		{name: "voicemail out of attempts", attempts: 2, disposition: internal_campaign_entity.DISPOSITION_VOICEMAIL, status: internal_campaign_entity.CONTACT_FAILED, next: now},
+		{name: "no policy", attempts: 1, disposition: "unknown", status: internal_campaign_entity.CONTACT_FAILED, next: now},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, next := nextAttempt(defaultRetryPolicies, tt.attempts, tt.disposition, now)
+			assert.Equal(t, tt.status, status)
+			assert.Equal(t, tt.next, next)
+		})
+	}
+}
+
+func TestCampaignProgress(t *testing.T) {
+	progress := &internal_campaign_entity.CampaignProgress{}
+	progress.Add(internal_campaign_entity.CONTACT_PENDING, 3)
+	progress.Add(internal_campaign_entity.CONTACT_CALLING, 2)
+	progress.Add(internal_campaign_entity.CONTACT_COMPLETED, 5)
+	assert.Equal(t, &internal_campaign_entity.CampaignProgress{Total: 10, Pending: 3, Calling: 2, Completed: 5}, progress)
+}
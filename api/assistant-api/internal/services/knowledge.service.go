@@ -9,6 +9,7 @@ import (
 	"context"
 
 	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/types"
 	type_enums "github.com/rapidaai/pkg/types/enums"
 	workflow_api "github.com/rapidaai/protos"
@@ -112,6 +113,8 @@ type KnowledgeDocumentService interface {
 		quantities []string,
 		locations []string,
 		industries []string,
+		text string,
+		reason string,
 	) (*workflow_api.KnowledgeDocumentSegment, error)
 
 	DeleteDocumentSegment(
@@ -121,4 +124,85 @@ type KnowledgeDocumentService interface {
 		documentId string,
 		reason string,
 	) (*workflow_api.KnowledgeDocumentSegment, error)
+
+	GetAllDocumentSegmentRevision(
+		ctx context.Context,
+		auth types.SimplePrinciple,
+		knowledgeId uint64,
+		documentId string,
+		paginate *workflow_api.Paginate) (int64, []*internal_knowledge_gorm.KnowledgeDocumentSegmentRevision, error)
+}
+
+type KnowledgeEmbeddingService interface {
+	// Reindex starts a job that re-embeds every segment of the knowledge with the given embedding model
+	// into a new storage namespace, the knowledge is switched to the new namespace once backfilled.
+	Reindex(ctx context.Context,
+		auth types.SimplePrinciple,
+		knowledge *internal_knowledge_gorm.Knowledge,
+		embeddingModelProviderName string,
+		embeddingModelOptions []*workflow_api.Metadata,
+	) (*internal_knowledge_gorm.KnowledgeEmbeddingJob, error)
+
+	GetJob(ctx context.Context, auth types.SimplePrinciple, jobId uint64) (*internal_knowledge_gorm.KnowledgeEmbeddingJob, error)
+
+	GetAllJob(ctx context.Context,
+		auth types.SimplePrinciple,
+		knowledgeId uint64,
+		criterias []*workflow_api.Criteria,
+		paginate *workflow_api.Paginate) (int64, []*internal_knowledge_gorm.KnowledgeEmbeddingJob, error)
+
+	// Rollback switches the knowledge back to the namespace and embedding model it used before the job
+	Rollback(ctx context.Context, auth types.SimplePrinciple, jobId uint64) (*internal_knowledge_gorm.KnowledgeEmbeddingJob, error)
+}
+
+// KnowledgeTableService stores csv and xlsx documents as rows and answers exact queries over them
+type KnowledgeTableService interface {
+	Create(ctx context.Context,
+		auth types.SimplePrinciple,
+		document *internal_knowledge_gorm.KnowledgeDocument,
+		mimeType string,
+		content []byte,
+	) (*internal_knowledge_gorm.KnowledgeTable, error)
+
+	Get(ctx context.Context, auth types.SimplePrinciple, knowledgeId uint64, knowledgeDocumentId uint64) (*internal_knowledge_gorm.KnowledgeTable, error)
+
+	GetAll(ctx context.Context, auth types.SimplePrinciple, knowledgeId uint64) ([]*internal_knowledge_gorm.KnowledgeTable, error)
+
+	// Query returns the rows matching the query keyed by column name, maxLimit bounds the number of rows
+	Query(ctx context.Context,
+		auth types.SimplePrinciple,
+		table *internal_knowledge_gorm.KnowledgeTable,
+		query *internal_type.KnowledgeTableQuery,
+		maxLimit uint32,
+	) ([]map[string]interface{}, error)
+}
+
+type KnowledgeEvaluationService interface {
+	Create(ctx context.Context,
+		auth types.SimplePrinciple,
+		knowledgeId uint64,
+		name, description string,
+		questions []*workflow_api.KnowledgeEvaluationQuestion,
+	) (*internal_knowledge_gorm.KnowledgeEvaluation, error)
+
+	Get(ctx context.Context, auth types.SimplePrinciple, evaluationId uint64) (*internal_knowledge_gorm.KnowledgeEvaluation, error)
+
+	GetAll(ctx context.Context,
+		auth types.SimplePrinciple,
+		knowledgeId uint64,
+		criterias []*workflow_api.Criteria,
+		paginate *workflow_api.Paginate) (int64, []*internal_knowledge_gorm.KnowledgeEvaluation, error)
+
+	// Run creates a report for every retrieval method (and reranked variant when a reranker is given)
+	// and evaluates the question set in background, reports are completed as the runs finish.
+	Run(ctx context.Context,
+		auth types.SimplePrinciple,
+		knowledge *internal_knowledge_gorm.Knowledge,
+		evaluation *internal_knowledge_gorm.KnowledgeEvaluation,
+		retrievalMethods []string,
+		topK uint32,
+		scoreThreshold float32,
+		rerankerModelProviderName string,
+		rerankerModelOptions []*workflow_api.Metadata,
+	) ([]*internal_knowledge_gorm.KnowledgeEvaluationReport, error)
 }
@@ -16,9 +16,11 @@ import (
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/rapidaai/api/assistant-api/config"
+	internal_agent_embeddings "github.com/rapidaai/api/assistant-api/internal/agent/embedding"
 	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
 	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
 	"github.com/rapidaai/pkg/ciphers"
+	web_client "github.com/rapidaai/pkg/clients/web"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
 	gorm_models "github.com/rapidaai/pkg/models/gorm"
@@ -37,19 +39,24 @@ type knowledgeDocumentService struct {
 	postgres   connectors.PostgresConnector
 	opensearch connectors.OpenSearchConnector
 	storage    storages.Storage
+
+	queryEmbedder internal_agent_embeddings.QueryEmbedding
+	vaultClient   web_client.VaultClient
 }
 
 var (
 	KNOWLEDGE_DOCUMENT_PREFIX = "knowledge-document__"
 )
 
-func NewKnowledgeDocumentService(config *config.AssistantConfig, logger commons.Logger, postgres connectors.PostgresConnector, opensearch connectors.OpenSearchConnector) internal_services.KnowledgeDocumentService {
+func NewKnowledgeDocumentService(config *config.AssistantConfig, logger commons.Logger, postgres connectors.PostgresConnector, redis connectors.RedisConnector, opensearch connectors.OpenSearchConnector) internal_services.KnowledgeDocumentService {
 	return &knowledgeDocumentService{
-		config:     config,
-		logger:     logger,
-		postgres:   postgres,
-		opensearch: opensearch,
-		storage:    storage_files.NewStorage(config.AssetStoreConfig, logger),
+		config:        config,
+		logger:        logger,
+		postgres:      postgres,
+		opensearch:    opensearch,
+		storage:       storage_files.NewStorage(config.AssetStoreConfig, logger),
+		queryEmbedder: internal_agent_embeddings.NewQueryEmbedding(logger, config, redis),
+		vaultClient:   web_client.NewVaultClientGRPC(&config.AppConfig, logger, redis),
 	}
 }
 
@@ -396,7 +403,15 @@ func (knowledge *knowledgeDocumentService) UpdateDocumentSegment(
 	quantities []string,
 	locations []string,
 	industries []string,
+	text string,
+	reason string,
 ) (*protos.KnowledgeDocumentSegment, error) {
+	segment, _knowledge, err := knowledge.getDocumentSegment(ctx, auth, index, documentId)
+	if err != nil {
+		knowledge.logger.Errorf("unable to find document segment %s in %s: %v", documentId, index, err)
+		return nil, err
+	}
+
 	// Construct the update query
 	updateQuery := map[string]interface{}{
 		"doc": map[string]interface{}{},
@@ -446,6 +461,17 @@ func (knowledge *knowledgeDocumentService) UpdateDocumentSegment(
 		updateQuery["doc"].(map[string]interface{})["entities"] = entities
 	}
 
+	// edited text is embedded with the embedding model of the knowledge so the vector stays in sync
+	if previous, _ := segment["text"].(string); text != "" && text != previous {
+		embeddings, err := knowledge.embedText(ctx, auth, _knowledge, text)
+		if err != nil {
+			knowledge.logger.Errorf("unable to embed edited text of segment %s: %v", documentId, err)
+			return nil, err
+		}
+		updateQuery["doc"].(map[string]interface{})["text"] = text
+		updateQuery["doc"].(map[string]interface{})["vector"] = embeddings
+	}
+
 	updateBodyJSON, err := json.Marshal(updateQuery)
 	if err != nil {
 		knowledge.logger.Errorf("Error marshaling update body: %s", err)
@@ -457,6 +483,7 @@ func (knowledge *knowledgeDocumentService) UpdateDocumentSegment(
 		knowledge.logger.Errorf("Error updating document segment: %s", err)
 		return nil, err
 	}
+	knowledge.createSegmentRevision(ctx, auth, _knowledge.Id, index, documentId, internal_knowledge_gorm.SegmentRevisionUpdate, segment, reason)
 	return nil, nil
 }
 
@@ -467,6 +494,11 @@ func (knowledge *knowledgeDocumentService) DeleteDocumentSegment(
 	documentId string,
 	reason string,
 ) (*protos.KnowledgeDocumentSegment, error) {
+	segment, _knowledge, err := knowledge.getDocumentSegment(ctx, auth, index, documentId)
+	if err != nil {
+		knowledge.logger.Errorf("unable to find document segment %s in %s: %v", documentId, index, err)
+		return nil, err
+	}
 	// Update the document status directly
 	updateBody := map[string]interface{}{
 		"doc": map[string]interface{}{
@@ -485,5 +517,6 @@ func (knowledge *knowledgeDocumentService) DeleteDocumentSegment(
 		knowledge.logger.Errorf("Error updating document segment: %s", err)
 		return nil, err
 	}
+	knowledge.createSegmentRevision(ctx, auth, _knowledge.Id, index, documentId, internal_knowledge_gorm.SegmentRevisionDelete, segment, reason)
 	return nil, nil
 }
@@ -0,0 +1,681 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_knowledge_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	integration_client "github.com/rapidaai/pkg/clients/integration"
+	integration_client_builders "github.com/rapidaai/pkg/clients/integration/builders"
+	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	gorm_models "github.com/rapidaai/pkg/models/gorm"
+	gorm_generator "github.com/rapidaai/pkg/models/gorm/generators"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// segments embedded per request to the embedding provider
+const reindexBatchSize = 100
+
+// a job which did not report progress for this long was left behind by a crash of the process
+// running it, it no longer holds back a new re-embedding of the knowledge
+const reindexStaleAfter = 30 * time.Minute
+
+var errReindexRunning = errors.New("knowledge already has a re-embedding in progress")
+
+type knowledgeEmbeddingService struct {
+	logger            commons.Logger
+	config            *config.AssistantConfig
+	postgres          connectors.PostgresConnector
+	opensearch        connectors.OpenSearchConnector
+	integrationClient integration_client.IntegrationServiceClient
+	inputBuilder      integration_client_builders.InputEmbeddingBuilder
+	vaultClient       web_client.VaultClient
+}
+
+func NewKnowledgeEmbeddingService(config *config.AssistantConfig,
+	logger commons.Logger,
+	postgres connectors.PostgresConnector,
+	redis connectors.RedisConnector,
+	opensearch connectors.OpenSearchConnector) internal_services.KnowledgeEmbeddingService {
+	return &knowledgeEmbeddingService{
+		logger:            logger,
+		config:            config,
+		postgres:          postgres,
+		opensearch:        opensearch,
+		integrationClient: integration_client.NewIntegrationServiceClientGRPC(&config.AppConfig, logger, redis),
+		inputBuilder:      integration_client_builders.NewEmbeddingInputBuilder(logger),
+		vaultClient:       web_client.NewVaultClientGRPC(&config.AppConfig, logger, redis),
+	}
+}
+
+// embeddingModel is the provider and options segments are embedded with
+type embeddingModel struct {
+	providerName string
+	options      utils.Option
+	credential   *protos.VaultCredential
+}
+
+func (eService *knowledgeEmbeddingService) Reindex(ctx context.Context,
+	auth types.SimplePrinciple,
+	knowledge *internal_knowledge_gorm.Knowledge,
+	embeddingModelProviderName string,
+	embeddingModelOptions []*protos.Metadata,
+) (*internal_knowledge_gorm.KnowledgeEmbeddingJob, error) {
+	if embeddingModelProviderName == "" {
+		return nil, errors.New("embedding model provider is required")
+	}
+	if knowledge.StorageNamespace == "" {
+		return nil, errors.New("knowledge does not have any indexed segment")
+	}
+
+	options := gorm_types.StringMap{}
+	modelOptions := utils.Option{}
+	for _, v := range embeddingModelOptions {
+		options[v.GetKey()] = v.GetValue()
+		modelOptions[v.GetKey()] = v.GetValue()
+	}
+	// the credential of the knowledge is kept when the new model is of the same provider
+	if _, ok := options["rapida.credential_id"]; !ok {
+		if credentialId, ok := knowledge.GetOptions()["rapida.credential_id"]; ok {
+			options["rapida.credential_id"] = fmt.Sprintf("%v", credentialId)
+			modelOptions["rapida.credential_id"] = credentialId
+		}
+	}
+	credential, err := eService.credential(ctx, auth, modelOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	previousOptions := gorm_types.StringMap{}
+	for _, v := range knowledge.KnowledgeEmbeddingModelOptions {
+		previousOptions[v.Key] = v.Value
+	}
+	jobId := gorm_generator.ID()
+	job := &internal_knowledge_gorm.KnowledgeEmbeddingJob{
+		Audited: gorm_models.Audited{
+			Id: jobId,
+		},
+		Mutable: gorm_models.Mutable{
+			CreatedBy: *auth.GetUserId(),
+			Status:    type_enums.RECORD_IN_PROGRESS,
+		},
+		Organizational: gorm_models.Organizational{
+			ProjectId:      *auth.GetCurrentProjectId(),
+			OrganizationId: *auth.GetCurrentOrganizationId(),
+		},
+		KnowledgeId:                        knowledge.Id,
+		StorageNamespace:                   reindexNamespace(knowledge.StorageNamespace, jobId),
+		PreviousStorageNamespace:           knowledge.StorageNamespace,
+		EmbeddingModelProviderName:         embeddingModelProviderName,
+		PreviousEmbeddingModelProviderName: knowledge.EmbeddingModelProviderName,
+		EmbeddingModelOptions:              options,
+		PreviousEmbeddingModelOptions:      previousOptions,
+	}
+	err = eService.postgres.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// requests to re-embed the knowledge wait for each other until the job is created
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", int64(knowledge.Id)).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(internal_knowledge_gorm.KnowledgeEmbeddingJob{}).
+			Where("knowledge_id = ? AND status = ? AND COALESCE(updated_date, created_date) < ?", knowledge.Id, type_enums.RECORD_IN_PROGRESS.String(), time.Now().Add(-reindexStaleAfter)).
+			Updates(map[string]interface{}{
+				"status":       type_enums.RECORD_FAILED.String(),
+				"error":        "re-embedding stopped without finishing",
+				"updated_date": time.Now(),
+			}).Error; err != nil {
+			return err
+		}
+		var running int64
+		if err := tx.Model(internal_knowledge_gorm.KnowledgeEmbeddingJob{}).
+			Where("knowledge_id = ? AND status = ?", knowledge.Id, type_enums.RECORD_IN_PROGRESS.String()).
+			Count(&running).Error; err != nil {
+			return err
+		}
+		if running > 0 {
+			return errReindexRunning
+		}
+		return tx.Create(job).Error
+	})
+	if err != nil {
+		eService.logger.Errorf("unable to create knowledge embedding job %v", err)
+		return nil, err
+	}
+
+	model := &embeddingModel{
+		providerName: embeddingModelProviderName,
+		options:      modelOptions,
+		credential:   credential,
+	}
+	utils.Go(context.Background(), func() {
+		eService.backfill(context.Background(), auth, job, model)
+	})
+	return job, nil
+}
+
+func (eService *knowledgeEmbeddingService) credential(ctx context.Context, auth types.SimplePrinciple, options utils.Option) (*protos.VaultCredential, error) {
+	credentialId, err := options.GetUint64("rapida.credential_id")
+	if err != nil {
+		return nil, fmt.Errorf("embedding model does not have credential: %w", err)
+	}
+	return eService.vaultClient.GetCredential(ctx, auth, credentialId)
+}
+
+// backfill copies every segment of the previous namespace into the new namespace with the new embedding,
+// then copies again the segments changed while backfilling and switches the knowledge to the new namespace.
+// the segments written to the previous namespace till the switch are copied once more after it.
+func (eService *knowledgeEmbeddingService) backfill(ctx context.Context,
+	auth types.SimplePrinciple,
+	job *internal_knowledge_gorm.KnowledgeEmbeddingJob,
+	model *embeddingModel) {
+	start := time.Now()
+	started := time.Time(job.CreatedDate)
+	if started.IsZero() {
+		started = start
+	}
+
+	total := eService.opensearch.SearchWithCount(ctx, []string{job.PreviousStorageNamespace}, `{"size":0,"query":{"match_all":{}}}`)
+	if total.Error() != nil {
+		eService.fail(ctx, job, fmt.Errorf("unable to count segments: %w", total.Error()))
+		return
+	}
+	eService.progress(ctx, job, map[string]interface{}{"total_segments": total.Hits.Total})
+
+	created := false
+	_, failed, err := eService.copy(ctx, auth, job.Id, job.PreviousStorageNamespace, job.StorageNamespace, model, nil, &created)
+	if err != nil {
+		eService.fail(ctx, job, err)
+		return
+	}
+
+	// segments edited and documents indexed since the job started are copied again
+	delta := time.Now()
+	changed, err := eService.changedSegmentsQuery(ctx, job.KnowledgeId, job.PreviousStorageNamespace, started)
+	if err != nil {
+		eService.fail(ctx, job, err)
+		return
+	}
+	if changed != nil {
+		_, f, err := eService.copy(ctx, auth, job.Id, job.PreviousStorageNamespace, job.StorageNamespace, model, changed, &created)
+		if err == nil {
+			err = eService.prune(ctx, job.PreviousStorageNamespace, job.StorageNamespace, changed)
+		}
+		if err != nil {
+			eService.fail(ctx, job, err)
+			return
+		}
+		failed += f
+	}
+	if failed > 0 {
+		eService.fail(ctx, job, fmt.Errorf("%d segments could not be embedded", failed))
+		return
+	}
+	if !created {
+		eService.fail(ctx, job, errors.New("knowledge does not have any segment to re-embed"))
+		return
+	}
+
+	previous := eService.opensearch.SearchWithCount(ctx, []string{job.PreviousStorageNamespace}, `{"size":0,"query":{"match_all":{}}}`)
+	current := eService.opensearch.SearchWithCount(ctx, []string{job.StorageNamespace}, `{"size":0,"query":{"match_all":{}}}`)
+	if previous.Error() != nil || current.Error() != nil {
+		eService.fail(ctx, job, errors.New("unable to verify segments of the new namespace"))
+		return
+	}
+	if previous.Hits.Total != current.Hits.Total {
+		eService.fail(ctx, job, fmt.Errorf("new namespace has %d segments, expected %d", current.Hits.Total, previous.Hits.Total))
+		return
+	}
+
+	err = eService.postgres.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// a job taken for stale while it was stuck does not switch the knowledge any more
+		result := tx.Model(internal_knowledge_gorm.KnowledgeEmbeddingJob{}).
+			Where("id = ? AND status = ?", job.Id, type_enums.RECORD_IN_PROGRESS.String()).
+			Update("updated_date", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("job %d is no longer in progress", job.Id)
+		}
+		return eService.swap(tx, auth, job.KnowledgeId, job.PreviousStorageNamespace, job.StorageNamespace, job.EmbeddingModelProviderName, job.EmbeddingModelOptions)
+	})
+	if err != nil {
+		eService.fail(ctx, job, err)
+		return
+	}
+
+	// the knowledge writes to the new namespace from now on, what was written before the switch is copied
+	changed, err = eService.changedSegmentsQuery(ctx, job.KnowledgeId, job.PreviousStorageNamespace, delta)
+	if err == nil && changed != nil {
+		_, failed, err = eService.copy(ctx, auth, job.Id, job.PreviousStorageNamespace, job.StorageNamespace, model, changed, &created)
+		if err == nil {
+			err = eService.prune(ctx, job.PreviousStorageNamespace, job.StorageNamespace, changed)
+		}
+	}
+	if err != nil || failed > 0 {
+		eService.logger.Errorf("unable to copy segments written during the switch of job %d, failed %d: %v", job.Id, failed, err)
+	}
+	eService.progress(ctx, job, map[string]interface{}{"status": type_enums.RECORD_COMPLETE.String()})
+	eService.cleanup(ctx, job)
+	eService.logger.Benchmark("knowledgeEmbeddingService.backfill", time.Since(start))
+}
+
+// cleanup deletes the namespaces of the earlier jobs of the knowledge, only the previous namespace of
+// the latest job is kept as it is the one the knowledge can be rolled back to
+func (eService *knowledgeEmbeddingService) cleanup(ctx context.Context, job *internal_knowledge_gorm.KnowledgeEmbeddingJob) {
+	var superseded []*internal_knowledge_gorm.KnowledgeEmbeddingJob
+	tx := eService.postgres.DB(ctx).
+		Where("knowledge_id = ? AND status = ? AND id <> ?", job.KnowledgeId, type_enums.RECORD_COMPLETE.String(), job.Id).
+		Find(&superseded)
+	if tx.Error != nil {
+		eService.logger.Errorf("unable to find superseded jobs of knowledge %d: %v", job.KnowledgeId, tx.Error)
+		return
+	}
+	for _, previous := range superseded {
+		if previous.PreviousStorageNamespace == job.PreviousStorageNamespace || previous.PreviousStorageNamespace == job.StorageNamespace {
+			continue
+		}
+		if err := eService.opensearch.DeleteIndex(ctx, previous.PreviousStorageNamespace); err != nil {
+			eService.logger.Errorf("unable to delete namespace %s of job %d: %v", previous.PreviousStorageNamespace, previous.Id, err)
+			continue
+		}
+		eService.progress(ctx, previous, map[string]interface{}{"status": type_enums.RECORD_INACTIVE.String()})
+	}
+}
+
+// copy pages through the segments of the source index matching the query and indexes them
+// into the target with the embedding of the model, the target index is created with the first batch.
+func (eService *knowledgeEmbeddingService) copy(ctx context.Context,
+	auth types.SimplePrinciple,
+	jobId uint64,
+	source, target string,
+	model *embeddingModel,
+	query map[string]interface{},
+	created *bool) (processed, failed uint64, err error) {
+	var after []interface{}
+	for {
+		body, err := segmentPageQuery(query, reindexBatchSize, after)
+		if err != nil {
+			return processed, failed, err
+		}
+		page := eService.opensearch.Search(ctx, []string{source}, body)
+		if page.Error() != nil {
+			return processed, failed, page.Error()
+		}
+		hits := page.Hits.Hits
+		if len(hits) == 0 {
+			return processed, failed, nil
+		}
+
+		var written, skipped uint64
+		vectors, err := eService.embed(ctx, auth, jobId, model, hits)
+		if err != nil {
+			eService.logger.Errorf("unable to embed segments of %s: %v", source, err)
+			skipped = uint64(len(hits))
+		} else {
+			if !*created {
+				if err := eService.createIndex(ctx, target, vectors); err != nil {
+					return processed, failed, err
+				}
+				*created = true
+			}
+			bulk, missing, err := segmentBulkBody(target, hits, vectors)
+			if err != nil {
+				return processed, failed, err
+			}
+			if err := eService.opensearch.Bulk(ctx, bulk); err != nil {
+				return processed, failed, err
+			}
+			written, skipped = uint64(len(hits)-len(missing)), uint64(len(missing))
+		}
+		processed, failed = processed+written, failed+skipped
+		eService.postgres.DB(ctx).
+			Model(internal_knowledge_gorm.KnowledgeEmbeddingJob{}).
+			Where("id = ?", jobId).
+			Updates(map[string]interface{}{
+				"processed_segments": gorm.Expr("processed_segments + ?", written),
+				"failed_segments":    gorm.Expr("failed_segments + ?", skipped),
+				"updated_date":       time.Now(),
+			})
+
+		after, _ = hits[len(hits)-1]["sort"].([]interface{})
+		if len(hits) < reindexBatchSize || len(after) == 0 {
+			return processed, failed, nil
+		}
+	}
+}
+
+// prune deletes the segments of the target matching the query which are no longer in the source,
+// segments deleted while the job runs are not copied and would otherwise stay in the target.
+func (eService *knowledgeEmbeddingService) prune(ctx context.Context, source, target string, query map[string]interface{}) error {
+	var after []interface{}
+	for {
+		body, err := segmentPageQuery(query, reindexBatchSize, after)
+		if err != nil {
+			return err
+		}
+		page := eService.opensearch.Search(ctx, []string{target}, body)
+		if page.Error() != nil {
+			return page.Error()
+		}
+		hits := page.Hits.Hits
+		if len(hits) == 0 {
+			return nil
+		}
+		ids := make([]string, 0, len(hits))
+		for _, hit := range hits {
+			if id, ok := hit["_id"].(string); ok {
+				ids = append(ids, id)
+			}
+		}
+		existing, err := segmentIdsQuery(ids)
+		if err != nil {
+			return err
+		}
+		kept := eService.opensearch.Search(ctx, []string{source}, existing)
+		if kept.Error() != nil {
+			return kept.Error()
+		}
+		bulk, err := segmentDeleteBody(target, ids, kept.Hits.Hits)
+		if err != nil {
+			return err
+		}
+		if bulk != "" {
+			if err := eService.opensearch.Bulk(ctx, bulk); err != nil {
+				return err
+			}
+		}
+
+		after, _ = hits[len(hits)-1]["sort"].([]interface{})
+		if len(hits) < reindexBatchSize || len(after) == 0 {
+			return nil
+		}
+	}
+}
+
+func (eService *knowledgeEmbeddingService) embed(ctx context.Context,
+	auth types.SimplePrinciple,
+	jobId uint64,
+	model *embeddingModel,
+	hits []map[string]interface{}) (map[int32][]float64, error) {
+	contents := make(map[int32]string, len(hits))
+	for idx, hit := range hits {
+		source, _ := hit["_source"].(map[string]interface{})
+		if text, ok := source["text"].(string); ok && text != "" {
+			contents[int32(idx)] = text
+		}
+	}
+	if len(contents) == 0 {
+		return map[int32][]float64{}, nil
+	}
+	res, err := eService.integrationClient.Embedding(ctx,
+		auth,
+		model.providerName,
+		eService.inputBuilder.Embedding(
+			eService.inputBuilder.Credential(model.credential.GetId(), model.credential.GetValue()),
+			eService.inputBuilder.Options(model.options, nil),
+			map[string]string{
+				"knowledge_embedding_job_id": fmt.Sprintf("%d", jobId),
+			},
+			contents,
+		))
+	if err != nil {
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		return nil, fmt.Errorf("embedding provider returned error: %s", res.GetError().GetHumanMessage())
+	}
+	vectors := make(map[int32][]float64, len(res.GetData()))
+	for _, d := range res.GetData() {
+		vectors[d.GetIndex()] = d.GetEmbedding()
+	}
+	return vectors, nil
+}
+
+func (eService *knowledgeEmbeddingService) createIndex(ctx context.Context, index string, vectors map[int32][]float64) error {
+	for _, v := range vectors {
+		if len(v) == 0 {
+			continue
+		}
+		body, err := segmentIndexBody(len(v))
+		if err != nil {
+			return err
+		}
+		return eService.opensearch.CreateIndex(ctx, index, body)
+	}
+	return errors.New("embedding provider returned empty vectors")
+}
+
+// changedSegmentsQuery matches the segments edited or indexed since the given time, nil when nothing changed
+func (eService *knowledgeEmbeddingService) changedSegmentsQuery(ctx context.Context, knowledgeId uint64, namespace string, since time.Time) (map[string]interface{}, error) {
+	var segmentIds []string
+	tx := eService.postgres.DB(ctx).
+		Model(internal_knowledge_gorm.KnowledgeDocumentSegmentRevision{}).
+		Where("knowledge_id = ? AND storage_namespace = ? AND created_date >= ?", knowledgeId, namespace, since).
+		Distinct().
+		Pluck("document_id", &segmentIds)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	var documentIds []uint64
+	tx = eService.postgres.DB(ctx).
+		Model(internal_knowledge_gorm.KnowledgeDocument{}).
+		Where("knowledge_id = ? AND created_date >= ?", knowledgeId, since).
+		Pluck("id", &documentIds)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	if len(segmentIds) == 0 && len(documentIds) == 0 {
+		return nil, nil
+	}
+	knowledgeDocumentIds := make([]string, 0, len(documentIds))
+	for _, id := range documentIds {
+		knowledgeDocumentIds = append(knowledgeDocumentIds, fmt.Sprintf("%d", id))
+	}
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"should": []interface{}{
+				map[string]interface{}{"ids": map[string]interface{}{"values": segmentIds}},
+				map[string]interface{}{"terms": map[string]interface{}{"metadata.knowledge_document_id": knowledgeDocumentIds}},
+			},
+			"minimum_should_match": 1,
+		},
+	}, nil
+}
+
+// swap points the knowledge to the namespace and embedding model, the knowledge is only
+// updated when it still points to the expected namespace.
+func (eService *knowledgeEmbeddingService) swap(tx *gorm.DB,
+	auth types.SimplePrinciple,
+	knowledgeId uint64,
+	from, to string,
+	providerName string,
+	options gorm_types.StringMap) error {
+	result := tx.Model(internal_knowledge_gorm.Knowledge{}).
+		Where("id = ? AND storage_namespace = ?", knowledgeId, from).
+		Updates(map[string]interface{}{
+			"storage_namespace":             to,
+			"embedding_model_provider_name": providerName,
+			"updated_by":                    *auth.GetUserId(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("knowledge %d no longer uses namespace %s", knowledgeId, from)
+	}
+	if err := tx.Where("knowledge_id = ?", knowledgeId).Delete(&internal_knowledge_gorm.KnowledgeEmbeddingModelOption{}).Error; err != nil {
+		return err
+	}
+	if len(options) == 0 {
+		return nil
+	}
+	modelOptions := make([]*internal_knowledge_gorm.KnowledgeEmbeddingModelOption, 0, len(options))
+	for k, v := range options {
+		modelOptions = append(modelOptions, &internal_knowledge_gorm.KnowledgeEmbeddingModelOption{
+			KnowledgeId: knowledgeId,
+			Mutable: gorm_models.Mutable{
+				CreatedBy: *auth.GetUserId(),
+				UpdatedBy: *auth.GetUserId(),
+				Status:    type_enums.RECORD_ACTIVE,
+			},
+			Metadata: gorm_models.Metadata{
+				Key:   k,
+				Value: v,
+			},
+		})
+	}
+	return tx.Create(modelOptions).Error
+}
+
+func (eService *knowledgeEmbeddingService) progress(ctx context.Context, job *internal_knowledge_gorm.KnowledgeEmbeddingJob, updates map[string]interface{}) {
+	tx := eService.postgres.DB(ctx).
+		Model(internal_knowledge_gorm.KnowledgeEmbeddingJob{}).
+		Where("id = ?", job.Id).
+		Updates(updates)
+	if tx.Error != nil {
+		eService.logger.Errorf("unable to update knowledge embedding job %d: %v", job.Id, tx.Error)
+	}
+}
+
+// fail marks the job as failed, the knowledge keeps using the previous namespace
+func (eService *knowledgeEmbeddingService) fail(ctx context.Context, job *internal_knowledge_gorm.KnowledgeEmbeddingJob, err error) {
+	eService.logger.Errorf("knowledge embedding job %d failed: %v", job.Id, err)
+	eService.progress(ctx, job, map[string]interface{}{
+		"status": type_enums.RECORD_FAILED.String(),
+		"error":  err.Error(),
+	})
+}
+
+func (eService *knowledgeEmbeddingService) GetJob(ctx context.Context, auth types.SimplePrinciple, jobId uint64) (*internal_knowledge_gorm.KnowledgeEmbeddingJob, error) {
+	var job *internal_knowledge_gorm.KnowledgeEmbeddingJob
+	tx := eService.postgres.DB(ctx).
+		Where("id = ? AND organization_id = ? AND project_id = ?", jobId, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId()).
+		First(&job)
+	if tx.Error != nil {
+		eService.logger.Errorf("not able to find knowledge embedding job %v", tx.Error)
+		return nil, tx.Error
+	}
+	return job, nil
+}
+
+func (eService *knowledgeEmbeddingService) GetAllJob(ctx context.Context,
+	auth types.SimplePrinciple,
+	knowledgeId uint64,
+	criterias []*protos.Criteria,
+	paginate *protos.Paginate) (int64, []*internal_knowledge_gorm.KnowledgeEmbeddingJob, error) {
+	db := eService.postgres.DB(ctx)
+	var (
+		jobs []*internal_knowledge_gorm.KnowledgeEmbeddingJob
+		cnt  int64
+	)
+	qry := db.Model(internal_knowledge_gorm.KnowledgeEmbeddingJob{}).
+		Where("knowledge_id = ? AND organization_id = ? AND project_id = ?", knowledgeId, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId())
+	for _, ct := range criterias {
+		qry.Where(fmt.Sprintf("%s %s ?", ct.GetKey(), ct.GetLogic()), ct.GetValue())
+	}
+	tx := qry.
+		Scopes(gorm_models.
+			Paginate(gorm_models.
+				NewPaginated(
+					int(paginate.GetPage()),
+					int(paginate.GetPageSize()),
+					&cnt,
+					qry))).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "created_date"},
+			Desc:   true,
+		}).Find(&jobs)
+	if tx.Error != nil {
+		eService.logger.Errorf("not able to find any knowledge embedding job %v", tx.Error)
+		return cnt, nil, tx.Error
+	}
+	return cnt, jobs, nil
+}
+
+func (eService *knowledgeEmbeddingService) Rollback(ctx context.Context, auth types.SimplePrinciple, jobId uint64) (*internal_knowledge_gorm.KnowledgeEmbeddingJob, error) {
+	job, err := eService.GetJob(ctx, auth, jobId)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != type_enums.RECORD_COMPLETE {
+		return nil, fmt.Errorf("only a complete job can be rolled back, job is %s", job.Status.String())
+	}
+	completed := time.Time(job.UpdatedDate)
+
+	err = eService.postgres.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := eService.swap(tx, auth, job.KnowledgeId, job.StorageNamespace, job.PreviousStorageNamespace, job.PreviousEmbeddingModelProviderName, job.PreviousEmbeddingModelOptions); err != nil {
+			return err
+		}
+		return tx.Model(internal_knowledge_gorm.KnowledgeEmbeddingJob{}).
+			Where("id = ?", job.Id).
+			Updates(map[string]interface{}{
+				"status":     type_enums.RECORD_INACTIVE.String(),
+				"updated_by": *auth.GetUserId(),
+			}).Error
+	})
+	if err != nil {
+		eService.logger.Errorf("unable to rollback knowledge embedding job %d: %v", job.Id, err)
+		return nil, err
+	}
+	job.Status = type_enums.RECORD_INACTIVE
+
+	// segments edited after the switch only exist in the new namespace, they are copied back
+	// into the previous namespace with the previous embedding model
+	utils.Go(context.Background(), func() {
+		ctx := context.Background()
+		changed, err := eService.changedSegmentsQuery(ctx, job.KnowledgeId, job.StorageNamespace, completed)
+		if err != nil {
+			eService.logger.Errorf("unable to find edited segments of job %d: %v", job.Id, err)
+			return
+		}
+		if changed != nil && !eService.restore(ctx, auth, job, changed) {
+			return
+		}
+		// nothing points to the namespace of the job anymore
+		if err := eService.opensearch.DeleteIndex(ctx, job.StorageNamespace); err != nil {
+			eService.logger.Errorf("unable to delete namespace %s of job %d: %v", job.StorageNamespace, job.Id, err)
+		}
+	})
+	return job, nil
+}
+
+// restore copies the segments edited after the switch back into the previous namespace with the
+// previous embedding model
+func (eService *knowledgeEmbeddingService) restore(ctx context.Context, auth types.SimplePrinciple, job *internal_knowledge_gorm.KnowledgeEmbeddingJob, changed map[string]interface{}) bool {
+	options := utils.Option{}
+	for k, v := range job.PreviousEmbeddingModelOptions {
+		options[k] = v
+	}
+	credential, err := eService.credential(ctx, auth, options)
+	if err != nil {
+		eService.logger.Errorf("unable to restore edited segments of job %d: %v", job.Id, err)
+		return false
+	}
+	created := true
+	if _, failed, err := eService.copy(ctx, auth, job.Id, job.StorageNamespace, job.PreviousStorageNamespace, &embeddingModel{
+		providerName: job.PreviousEmbeddingModelProviderName,
+		options:      options,
+		credential:   credential,
+	}, changed, &created); err != nil || failed > 0 {
+		eService.logger.Errorf("unable to restore edited segments of job %d, failed %d: %v", job.Id, failed, err)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_knowledge_service
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// reindexNamespace returns the storage namespace of a re-embedding job, the suffix of a
+// previous job is replaced so namespaces do not grow with every re-embedding.
+func reindexNamespace(namespace string, jobId uint64) string {
+	base := namespace
+	if idx := strings.LastIndex(base, "__"); idx > 0 {
+		if _, err := strconv.ParseUint(base[idx+2:], 10, 64); err == nil {
+			base = base[:idx]
+		}
+	}
+	return strings.ToLower(fmt.Sprintf("%s__%d", base, jobId))
+}
+
+// segmentIndexBody is the settings and mapping of a segment index, it must stay in line
+// with the collection created by the document indexer.
+func segmentIndexBody(dimension int) (string, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"settings": map[string]interface{}{
+			"number_of_shards":   1,
+			"number_of_replicas": 1,
+			"index":              map[string]interface{}{"knn": true},
+		},
+		"mappings": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"text":          map[string]interface{}{"type": "text"},
+				"document_id":   map[string]interface{}{"type": "keyword"},
+				"document_hash": map[string]interface{}{"type": "keyword"},
+				"vector": map[string]interface{}{
+					"type":      "knn_vector",
+					"dimension": dimension,
+					"method": map[string]interface{}{
+						"name":       "hnsw",
+						"space_type": "l2",
+						"engine":     "faiss",
+						"parameters": map[string]interface{}{"ef_construction": 64, "m": 8},
+					},
+				},
+				"entities": map[string]interface{}{
+					"type":    "object",
+					"dynamic": true,
+				},
+				"metadata": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"knowledge_document_id": map[string]interface{}{"type": "keyword"},
+						"knowledge_id":          map[string]interface{}{"type": "keyword"},
+						"project_id":            map[string]interface{}{"type": "keyword"},
+						"organization_id":       map[string]interface{}{"type": "keyword"},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// segmentPageQuery pages through the segments matching the query, ordered on the document hash
+// so the next page starts after the last hash of the previous one.
+func segmentPageQuery(query map[string]interface{}, size int, after []interface{}) (string, error) {
+	if query == nil {
+		query = map[string]interface{}{"match_all": map[string]interface{}{}}
+	}
+	body := map[string]interface{}{
+		"size":    size,
+		"query":   query,
+		"sort":    []interface{}{map[string]interface{}{"document_hash": "asc"}},
+		"_source": map[string]interface{}{"excludes": []string{"vector"}},
+	}
+	if len(after) > 0 {
+		body["search_after"] = after
+	}
+	out, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// segmentIdsQuery finds which of the segments exist, only their ids are returned
+func segmentIdsQuery(ids []string) (string, error) {
+	out, err := json.Marshal(map[string]interface{}{
+		"size":    len(ids),
+		"query":   map[string]interface{}{"ids": map[string]interface{}{"values": ids}},
+		"_source": false,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// segmentDeleteBody builds the ndjson bulk body deleting the segments of the index which are
+// not among the existing hits, it is empty when every segment exists.
+func segmentDeleteBody(index string, ids []string, existing []map[string]interface{}) (string, error) {
+	found := make(map[string]bool, len(existing))
+	for _, hit := range existing {
+		if id, ok := hit["_id"].(string); ok {
+			found[id] = true
+		}
+	}
+	var builder strings.Builder
+	for _, id := range ids {
+		if found[id] {
+			continue
+		}
+		action, err := json.Marshal(map[string]interface{}{
+			"delete": map[string]interface{}{"_index": index, "_id": id},
+		})
+		if err != nil {
+			return "", err
+		}
+		builder.Write(action)
+		builder.WriteByte('\n')
+	}
+	return builder.String(), nil
+}
+
+// segmentBulkBody builds the ndjson bulk body indexing the hits into the index with the new vectors,
+// vectors are keyed on the position of the hit. Hits without a vector are skipped and returned.
+func segmentBulkBody(index string, hits []map[string]interface{}, vectors map[int32][]float64) (string, []string, error) {
+	var (
+		builder strings.Builder
+		skipped []string
+	)
+	for idx, hit := range hits {
+		id, _ := hit["_id"].(string)
+		vector, ok := vectors[int32(idx)]
+		if !ok || len(vector) == 0 {
+			skipped = append(skipped, id)
+			continue
+		}
+		source := map[string]interface{}{}
+		if src, ok := hit["_source"].(map[string]interface{}); ok {
+			for k, v := range src {
+				source[k] = v
+			}
+		}
+		source["vector"] = vector
+		action, err := json.Marshal(map[string]interface{}{
+			"index": map[string]interface{}{"_index": index, "_id": id},
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		doc, err := json.Marshal(source)
+		if err != nil {
+			return "", nil, err
+		}
+		builder.Write(action)
+		builder.WriteByte('\n')
+		builder.Write(doc)
+		builder.WriteByte('\n')
+	}
+	return builder.String(), skipped, nil
+}
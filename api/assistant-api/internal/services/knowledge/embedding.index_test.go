@@ -0,0 +1,93 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_knowledge_service
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReindexNamespace(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		jobId     uint64
+		want      string
+	}{
+		{"first job", "Knowledge_Abc", 12, "knowledge_abc__12"},
+		{"replaces previous job", "knowledge_abc__12", 34, "knowledge_abc__34"},
+		{"keeps non numeric suffix", "knowledge__abc", 5, "knowledge__abc__5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, reindexNamespace(tt.namespace, tt.jobId))
+		})
+	}
+}
+
+func TestSegmentIndexBody(t *testing.T) {
+	body, err := segmentIndexBody(1536)
+	require.NoError(t, err)
+	var parsed map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(body), &parsed))
+	vector := parsed["mappings"].(map[string]interface{})["properties"].(map[string]interface{})["vector"].(map[string]interface{})
+	assert.Equal(t, "knn_vector", vector["type"])
+	assert.Equal(t, float64(1536), vector["dimension"])
+}
+
+func TestSegmentPageQuery(t *testing.T) {
+	body, err := segmentPageQuery(nil, 50, []interface{}{"hash-1"})
+	require.NoError(t, err)
+	var parsed map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(body), &parsed))
+	assert.Equal(t, float64(50), parsed["size"])
+	assert.Contains(t, parsed["query"], "match_all")
+	assert.Equal(t, []interface{}{"hash-1"}, parsed["search_after"])
+
+	body, err = segmentPageQuery(nil, 50, nil)
+	require.NoError(t, err)
+	assert.NotContains(t, body, "search_after")
+}
+
+func TestSegmentIdsQuery(t *testing.T) {
+	body, err := segmentIdsQuery([]string{"a", "b"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"size":2,"query":{"ids":{"values":["a","b"]}},"_source":false}`, body)
+}
+
+func TestSegmentDeleteBody(t *testing.T) {
+	body, err := segmentDeleteBody("target", []string{"a", "b", "c"}, []map[string]interface{}{{"_id": "b"}})
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(body), "\n")
+	require.Len(t, lines, 2)
+	assert.JSONEq(t, `{"delete":{"_index":"target","_id":"a"}}`, lines[0])
+	assert.JSONEq(t, `{"delete":{"_index":"target","_id":"c"}}`, lines[1])
+
+	body, err = segmentDeleteBody("target", []string{"a"}, []map[string]interface{}{{"_id": "a"}})
+	require.NoError(t, err)
+	assert.Empty(t, body)
+}
+
+func TestSegmentBulkBody(t *testing.T) {
+	hits := []map[string]interface{}{
+		{"_id": "a", "_source": map[string]interface{}{"text": "first", "vector": []float64{9}}},
+		{"_id": "b", "_source": map[string]interface{}{"text": "second"}},
+	}
+	body, skipped, err := segmentBulkBody("target", hits, map[int32][]float64{0: {0.1, 0.2}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, skipped)
+
+	lines := strings.Split(strings.TrimSpace(body), "\n")
+	require.Len(t, lines, 2)
+	assert.JSONEq(t, `{"index":{"_index":"target","_id":"a"}}`, lines[0])
+	assert.JSONEq(t, `{"text":"first","vector":[0.1,0.2]}`, lines[1])
+	// the source of the hit is not modified
+	assert.Equal(t, []float64{9}, hits[0]["_source"].(map[string]interface{})["vector"])
+}
@@ -0,0 +1,431 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_knowledge_service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_agent_embeddings "github.com/rapidaai/api/assistant-api/internal/agent/embedding"
+	internal_agent_rerankers "github.com/rapidaai/api/assistant-api/internal/agent/reranker"
+	internal_agent_retriever "github.com/rapidaai/api/assistant-api/internal/agent/retriever"
+	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	gorm_models "github.com/rapidaai/pkg/models/gorm"
+	gorm_generator "github.com/rapidaai/pkg/models/gorm/generators"
+	"github.com/rapidaai/pkg/storages"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// candidates retrieved per requested result when the run is reranked
+	rerankCandidateFactor = 3
+	defaultEvaluationTopK = 4
+)
+
+var defaultEvaluationRetrievalMethods = []string{"text-search", "semantic-search", "hybrid-search"}
+
+type knowledgeEvaluationService struct {
+	logger           commons.Logger
+	config           *config.AssistantConfig
+	postgres         connectors.PostgresConnector
+	knowledgeService internal_services.KnowledgeService
+	retriever        internal_agent_retriever.KnowledgeRetriever
+	reranker         internal_agent_rerankers.Reranking[string]
+	vaultClient      web_client.VaultClient
+}
+
+func NewKnowledgeEvaluationService(config *config.AssistantConfig,
+	logger commons.Logger,
+	postgres connectors.PostgresConnector,
+	redis connectors.RedisConnector,
+	opensearch connectors.OpenSearchConnector,
+	storage storages.Storage) internal_services.KnowledgeEvaluationService {
+	return &knowledgeEvaluationService{
+		logger:           logger,
+		config:           config,
+		postgres:         postgres,
+		knowledgeService: NewKnowledgeService(config, logger, postgres, storage),
+		retriever:        internal_agent_retriever.NewKnowledgeRetriever(logger, opensearch, internal_agent_embeddings.NewQueryEmbedding(logger, config, redis)),
+		reranker:         internal_agent_rerankers.NewTextReranker(logger, config, redis),
+		vaultClient:      web_client.NewVaultClientGRPC(&config.AppConfig, logger, redis),
+	}
+}
+
+func (eService *knowledgeEvaluationService) Create(ctx context.Context,
+	auth types.SimplePrinciple,
+	knowledgeId uint64,
+	name, description string,
+	questions []*protos.KnowledgeEvaluationQuestion,
+) (*internal_knowledge_gorm.KnowledgeEvaluation, error) {
+	if len(questions) == 0 {
+		return nil, errors.New("evaluation requires at least one question")
+	}
+	evaluation := &internal_knowledge_gorm.KnowledgeEvaluation{
+		Audited: gorm_models.Audited{
+			Id: gorm_generator.ID(),
+		},
+		Mutable: gorm_models.Mutable{
+			CreatedBy: *auth.GetUserId(),
+			Status:    type_enums.RECORD_ACTIVE,
+		},
+		Organizational: gorm_models.Organizational{
+			ProjectId:      *auth.GetCurrentProjectId(),
+			OrganizationId: *auth.GetCurrentOrganizationId(),
+		},
+		KnowledgeId: knowledgeId,
+		Name:        name,
+		Description: description,
+	}
+	evaluationQuestions := make([]*internal_knowledge_gorm.KnowledgeEvaluationQuestion, 0, len(questions))
+	for _, q := range questions {
+		if strings.TrimSpace(q.GetQuestion()) == "" {
+			return nil, errors.New("evaluation question can not be empty")
+		}
+		if len(q.GetExpectedDocumentIds()) == 0 && len(q.GetExpectedSegmentIds()) == 0 {
+			return nil, fmt.Errorf("evaluation question %q has no expected document or segment", q.GetQuestion())
+		}
+		evaluationQuestions = append(evaluationQuestions, &internal_knowledge_gorm.KnowledgeEvaluationQuestion{
+			Audited: gorm_models.Audited{
+				Id: gorm_generator.ID(),
+			},
+			KnowledgeEvaluationId: evaluation.Id,
+			Question:              q.GetQuestion(),
+			ExpectedDocumentIds:   q.GetExpectedDocumentIds(),
+			ExpectedSegmentIds:    q.GetExpectedSegmentIds(),
+		})
+	}
+
+	err := eService.postgres.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(evaluation).Error; err != nil {
+			return err
+		}
+		return tx.Create(evaluationQuestions).Error
+	})
+	if err != nil {
+		eService.logger.Errorf("unable to create knowledge evaluation with error %v", err)
+		return nil, err
+	}
+	evaluation.Questions = evaluationQuestions
+	return evaluation, nil
+}
+
+func (eService *knowledgeEvaluationService) Get(ctx context.Context, auth types.SimplePrinciple, evaluationId uint64) (*internal_knowledge_gorm.KnowledgeEvaluation, error) {
+	db := eService.postgres.DB(ctx)
+	var evaluation *internal_knowledge_gorm.KnowledgeEvaluation
+	tx := db.
+		Preload("Questions").
+		Preload("Reports", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_date DESC")
+		}).
+		Where("id = ? AND status = ? AND project_id = ? AND organization_id = ?", evaluationId, type_enums.RECORD_ACTIVE.String(), *auth.GetCurrentProjectId(), *auth.GetCurrentOrganizationId()).
+		First(&evaluation)
+	if tx.Error != nil {
+		eService.logger.Errorf("not able to find knowledge evaluation %v", tx.Error)
+		return nil, tx.Error
+	}
+	return evaluation, nil
+}
+
+func (eService *knowledgeEvaluationService) GetAll(ctx context.Context,
+	auth types.SimplePrinciple,
+	knowledgeId uint64,
+	criterias []*protos.Criteria,
+	paginate *protos.Paginate) (int64, []*internal_knowledge_gorm.KnowledgeEvaluation, error) {
+	db := eService.postgres.DB(ctx)
+	var (
+		evaluations []*internal_knowledge_gorm.KnowledgeEvaluation
+		cnt         int64
+	)
+	qry := db.Model(internal_knowledge_gorm.KnowledgeEvaluation{}).
+		Where("knowledge_id = ? AND organization_id = ? AND project_id = ? AND status = ?", knowledgeId, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId(), type_enums.RECORD_ACTIVE.String())
+	for _, ct := range criterias {
+		qry.Where(fmt.Sprintf("%s %s ?", ct.GetKey(), ct.GetLogic()), ct.GetValue())
+	}
+	tx := qry.
+		Preload("Reports", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_date DESC")
+		}).
+		Scopes(gorm_models.
+			Paginate(gorm_models.
+				NewPaginated(
+					int(paginate.GetPage()),
+					int(paginate.GetPageSize()),
+					&cnt,
+					qry))).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "created_date"},
+			Desc:   true,
+		}).Find(&evaluations)
+	if tx.Error != nil {
+		eService.logger.Errorf("not able to find any knowledge evaluation %v", tx.Error)
+		return cnt, nil, tx.Error
+	}
+	return cnt, evaluations, nil
+}
+
+func (eService *knowledgeEvaluationService) Run(ctx context.Context,
+	auth types.SimplePrinciple,
+	knowledge *internal_knowledge_gorm.Knowledge,
+	evaluation *internal_knowledge_gorm.KnowledgeEvaluation,
+	retrievalMethods []string,
+	topK uint32,
+	scoreThreshold float32,
+	rerankerModelProviderName string,
+	rerankerModelOptions []*protos.Metadata,
+) ([]*internal_knowledge_gorm.KnowledgeEvaluationReport, error) {
+	if len(evaluation.Questions) == 0 {
+		return nil, errors.New("evaluation does not have any question")
+	}
+	if len(retrievalMethods) == 0 {
+		retrievalMethods = defaultEvaluationRetrievalMethods
+	}
+	if topK == 0 {
+		topK = defaultEvaluationTopK
+	}
+
+	credentialId, err := knowledge.GetOptions().GetUint64("rapida.credential_id")
+	if err != nil {
+		eService.logger.Errorf("error while getting knowledge credentials for knowledge %d, check the setup %v", knowledge.Id, err)
+		return nil, err
+	}
+	embeddingCredential, err := eService.vaultClient.GetCredential(ctx, auth, credentialId)
+	if err != nil {
+		eService.logger.Errorf("error while getting embedding credentials for knowledge %d: %v", knowledge.Id, err)
+		return nil, err
+	}
+
+	var rerankOption *internal_agent_rerankers.RerankingOption
+	if rerankerModelProviderName != "" {
+		options := make(map[string]interface{}, len(rerankerModelOptions))
+		for _, opt := range rerankerModelOptions {
+			options[opt.GetKey()] = opt.GetValue()
+		}
+		rerankCredentialId, err := utils.Option(options).GetUint64("rapida.credential_id")
+		if err != nil {
+			eService.logger.Errorf("error while getting reranker credentials, check the setup %v", err)
+			return nil, err
+		}
+		rerankCredential, err := eService.vaultClient.GetCredential(ctx, auth, rerankCredentialId)
+		if err != nil {
+			eService.logger.Errorf("error while getting reranker credentials: %v", err)
+			return nil, err
+		}
+		rerankOption = &internal_agent_rerankers.RerankingOption{
+			ProviderCredential: rerankCredential,
+			ModelProviderName:  rerankerModelProviderName,
+			Options:            options,
+		}
+	}
+
+	reports := make([]*internal_knowledge_gorm.KnowledgeEvaluationReport, 0)
+	for _, method := range retrievalMethods {
+		reranks := []bool{false}
+		if rerankOption != nil {
+			reranks = append(reranks, true)
+		}
+		for _, rerank := range reranks {
+			reports = append(reports, &internal_knowledge_gorm.KnowledgeEvaluationReport{
+				Audited: gorm_models.Audited{
+					Id: gorm_generator.ID(),
+				},
+				Mutable: gorm_models.Mutable{
+					CreatedBy: *auth.GetUserId(),
+					Status:    type_enums.RECORD_IN_PROGRESS,
+				},
+				Organizational: gorm_models.Organizational{
+					ProjectId:      *auth.GetCurrentProjectId(),
+					OrganizationId: *auth.GetCurrentOrganizationId(),
+				},
+				KnowledgeEvaluationId: evaluation.Id,
+				KnowledgeId:           knowledge.Id,
+				RetrievalMethod:       method,
+				Rerank:                rerank,
+				TopK:                  topK,
+				ScoreThreshold:        scoreThreshold,
+				QuestionCount:         uint32(len(evaluation.Questions)),
+			})
+		}
+	}
+	if err := eService.postgres.DB(ctx).Create(reports).Error; err != nil {
+		eService.logger.Errorf("unable to create knowledge evaluation reports %v", err)
+		return nil, err
+	}
+
+	// the run outlives the request, every report is evaluated in sequence to keep the load on
+	// the vector store and the embedding provider close to a single conversation
+	utils.Go(context.Background(), func() {
+		for _, report := range reports {
+			eService.evaluate(context.Background(), auth, knowledge, evaluation.Questions, report, embeddingCredential, rerankOption)
+		}
+	})
+	return reports, nil
+}
+
+// evaluate runs every question with the configuration of the report, per question results are
+// stored as a knowledge log so they can be inspected with the existing log apis
+func (eService *knowledgeEvaluationService) evaluate(ctx context.Context,
+	auth types.SimplePrinciple,
+	knowledge *internal_knowledge_gorm.Knowledge,
+	questions []*internal_knowledge_gorm.KnowledgeEvaluationQuestion,
+	report *internal_knowledge_gorm.KnowledgeEvaluationReport,
+	embeddingCredential *protos.VaultCredential,
+	rerankOption *internal_agent_rerankers.RerankingOption,
+) {
+	start := time.Now()
+	results := make([]evaluationResult, 0, len(questions))
+	recalls := make([]float64, 0, len(questions))
+	ranks := make([]int, 0, len(questions))
+	latencies := make([]int64, 0, len(questions))
+	failed := 0
+	for _, question := range questions {
+		qStart := time.Now()
+		retrieved, err := eService.retrieve(ctx, auth, knowledge, question.Question, report, embeddingCredential, rerankOption)
+		result := evaluationResult{Question: question.Question, TimeTaken: int64(time.Since(qStart))}
+		if err != nil {
+			failed++
+			result.Error = err.Error()
+		}
+		expected := expectedIds(question)
+		result.Recall = recallAtK(retrieved, expected, int(report.TopK))
+		result.Rank = firstRelevantRank(retrieved, expected)
+		for _, r := range retrieved {
+			result.Retrieved = append(result.Retrieved, r.ID)
+		}
+		recalls = append(recalls, result.Recall)
+		ranks = append(ranks, result.Rank)
+		latencies = append(latencies, result.TimeTaken)
+		results = append(results, result)
+	}
+
+	var sum int64
+	for _, l := range latencies {
+		sum += l
+	}
+	report.RecallAtK = mean(recalls)
+	report.Mrr = meanReciprocalRank(ranks)
+	report.AverageTimeTaken = sum / int64(len(latencies))
+	report.P95TimeTaken = percentile(latencies, 0.95)
+	report.Status = type_enums.RECORD_COMPLETE
+	if failed == len(questions) {
+		report.Status = type_enums.RECORD_FAILED
+	}
+
+	request, _ := json.Marshal(map[string]interface{}{
+		"retrievalMethod": report.RetrievalMethod,
+		"rerank":          report.Rerank,
+		"topK":            report.TopK,
+		"scoreThreshold":  report.ScoreThreshold,
+		"questions":       questions,
+	})
+	response, _ := json.Marshal(map[string]interface{}{
+		"recallAtK": report.RecallAtK,
+		"mrr":       report.Mrr,
+		"result":    results,
+	})
+	knowledgeLog, err := eService.knowledgeService.CreateLog(ctx, auth,
+		knowledge.Id,
+		report.RetrievalMethod,
+		report.TopK,
+		report.ScoreThreshold,
+		len(questions),
+		int64(time.Since(start)),
+		map[string]string{
+			"source":                      "evaluation",
+			"knowledgeEvaluationId":       fmt.Sprintf("%d", report.KnowledgeEvaluationId),
+			"knowledgeEvaluationReportId": fmt.Sprintf("%d", report.Id),
+			"rerank":                      fmt.Sprintf("%t", report.Rerank),
+		},
+		report.Status,
+		request, response,
+	)
+	if err == nil {
+		report.KnowledgeLogId = knowledgeLog.Id
+	}
+
+	tx := eService.postgres.DB(ctx).
+		Where("id = ?", report.Id).
+		Updates(&internal_knowledge_gorm.KnowledgeEvaluationReport{
+			Mutable: gorm_models.Mutable{
+				Status:    report.Status,
+				UpdatedBy: *auth.GetUserId(),
+			},
+			RecallAtK:        report.RecallAtK,
+			Mrr:              report.Mrr,
+			AverageTimeTaken: report.AverageTimeTaken,
+			P95TimeTaken:     report.P95TimeTaken,
+			KnowledgeLogId:   report.KnowledgeLogId,
+		})
+	if tx.Error != nil {
+		eService.logger.Errorf("unable to update knowledge evaluation report %d: %v", report.Id, tx.Error)
+	}
+	eService.logger.Benchmark("knowledgeEvaluationService.evaluate", time.Since(start))
+}
+
+// retrieve runs the question through the shared retriever, a reranked run retrieves
+// more candidates and keeps the top k after reranking
+func (eService *knowledgeEvaluationService) retrieve(ctx context.Context,
+	auth types.SimplePrinciple,
+	knowledge *internal_knowledge_gorm.Knowledge,
+	query string,
+	report *internal_knowledge_gorm.KnowledgeEvaluationReport,
+	embeddingCredential *protos.VaultCredential,
+	rerankOption *internal_agent_rerankers.RerankingOption,
+) ([]internal_type.KnowledgeContextResult, error) {
+	topK := report.TopK
+	if report.Rerank {
+		topK = topK * rerankCandidateFactor
+	}
+	results, err := eService.retriever.Retrieve(ctx, auth, knowledge, query, nil, &internal_type.KnowledgeRetrieveOption{
+		EmbeddingProviderCredential: embeddingCredential,
+		RetrievalMethod:             report.RetrievalMethod,
+		TopK:                        topK,
+		ScoreThreshold:              report.ScoreThreshold,
+	})
+	if err != nil || !report.Rerank || len(results) == 0 {
+		return results, err
+	}
+
+	contents := make([]string, len(results))
+	for idx, r := range results {
+		contents[idx] = r.Content
+	}
+	reranked, err := eService.reranker.Rerank(ctx, auth, rerankOption, contents, query, map[string]string{
+		"knowledge_id": fmt.Sprintf("%d", knowledge.Id),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rerankedResults(results, reranked, int(report.TopK)), nil
+}
+
+// rerankedResults orders the results as the reranker did, positions the provider made up are dropped
+func rerankedResults(results []internal_type.KnowledgeContextResult, reranked []internal_agent_rerankers.RerankingResult[string], topK int) []internal_type.KnowledgeContextResult {
+	output := make([]internal_type.KnowledgeContextResult, 0, topK)
+	for _, rk := range reranked {
+		if rk.Index < 0 || int(rk.Index) >= len(results) || len(output) == topK {
+			continue
+		}
+		result := results[rk.Index]
+		result.Score = rk.Score
+		output = append(output, result)
+	}
+	return output
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_knowledge_service
+
+import (
+	"testing"
+
+	internal_agent_rerankers "github.com/rapidaai/api/assistant-api/internal/agent/reranker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRerankedResults(t *testing.T) {
+	tests := []struct {
+		name     string
+		reranked []internal_agent_rerankers.RerankingResult[string]
+		topK     int
+		want     []string
+	}{
+		{
+			name:     "ordered by the reranker",
+			reranked: []internal_agent_rerankers.RerankingResult[string]{{Index: 2, Score: 0.9}, {Index: 0, Score: 0.5}},
+			topK:     3,
+			want:     []string{"3", "1"},
+		},
+		{
+			name:     "out of range positions are dropped",
+			reranked: []internal_agent_rerankers.RerankingResult[string]{{Index: -1, Score: 0.9}, {Index: 3, Score: 0.8}, {Index: 1, Score: 0.7}},
+			topK:     3,
+			want:     []string{"2"},
+		},
+		{
+			name:     "limited to top k",
+			reranked: []internal_agent_rerankers.RerankingResult[string]{{Index: 1}, {Index: 0}, {Index: 2}},
+			topK:     2,
+			want:     []string{"2", "1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := []string{}
+			for _, r := range rerankedResults(results("1", "2", "3"), tt.reranked, tt.topK) {
+				ids = append(ids, r.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
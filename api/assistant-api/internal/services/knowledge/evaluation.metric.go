@@ -0,0 +1,139 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_knowledge_service
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+)
+
+// evaluationResult is the outcome of a single question of the evaluation
+type evaluationResult struct {
+	Question  string   `json:"question"`
+	Retrieved []string `json:"retrieved"`
+	Recall    float64  `json:"recall"`
+	Rank      int      `json:"rank"`
+	TimeTaken int64    `json:"timeTaken"`
+	Error     string   `json:"error,omitempty"`
+}
+
+// expectedIds returns the labeled ids of the question, segments and documents share
+// the same id space as a result is matched on either of them.
+func expectedIds(question *internal_knowledge_gorm.KnowledgeEvaluationQuestion) []string {
+	ids := make([]string, 0, len(question.ExpectedSegmentIds)+len(question.ExpectedDocumentIds))
+	ids = append(ids, question.ExpectedSegmentIds...)
+	ids = append(ids, question.ExpectedDocumentIds...)
+	return ids
+}
+
+// resultIds returns the ids a retrieved segment can be matched with, the segment id,
+// the document hash id and the knowledge document id from the metadata.
+func resultIds(result internal_type.KnowledgeContextResult) []string {
+	ids := []string{result.ID, result.DocumentID}
+	switch v := result.Metadata["knowledge_document_id"].(type) {
+	case string:
+		ids = append(ids, v)
+	case float64:
+		ids = append(ids, strconv.FormatFloat(v, 'f', -1, 64))
+	case nil:
+	default:
+		ids = append(ids, fmt.Sprintf("%v", v))
+	}
+	return ids
+}
+
+func matches(result internal_type.KnowledgeContextResult, expected map[string]struct{}) []string {
+	matched := make([]string, 0)
+	for _, id := range resultIds(result) {
+		if _, ok := expected[id]; ok && id != "" {
+			matched = append(matched, id)
+		}
+	}
+	return matched
+}
+
+// recallAtK is the fraction of expected ids found in the top k results
+func recallAtK(results []internal_type.KnowledgeContextResult, expected []string, k int) float64 {
+	if len(expected) == 0 {
+		return 0
+	}
+	want := make(map[string]struct{}, len(expected))
+	for _, id := range expected {
+		want[id] = struct{}{}
+	}
+	found := make(map[string]struct{}, len(expected))
+	for idx, result := range results {
+		if idx == k {
+			break
+		}
+		for _, id := range matches(result, want) {
+			found[id] = struct{}{}
+		}
+	}
+	return float64(len(found)) / float64(len(want))
+}
+
+// firstRelevantRank is the 1 based rank of the first result matching an expected id, 0 when none matches
+func firstRelevantRank(results []internal_type.KnowledgeContextResult, expected []string) int {
+	want := make(map[string]struct{}, len(expected))
+	for _, id := range expected {
+		want[id] = struct{}{}
+	}
+	for idx, result := range results {
+		if len(matches(result, want)) > 0 {
+			return idx + 1
+		}
+	}
+	return 0
+}
+
+// meanReciprocalRank averages 1/rank over all questions, questions without a relevant result count as 0
+func meanReciprocalRank(ranks []int) float64 {
+	if len(ranks) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, rank := range ranks {
+		if rank > 0 {
+			sum += 1 / float64(rank)
+		}
+	}
+	return sum / float64(len(ranks))
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// percentile returns the nearest rank percentile of the latencies
+func percentile(latencies []int64, p float64) int64 {
+	if len(latencies) == 0 {
+		return 0
+	}
+	sorted := make([]int64, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	idx := int(math.Ceil(float64(len(sorted))*p)) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_knowledge_service
+
+import (
+	"testing"
+
+	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/stretchr/testify/assert"
+)
+
+func results(ids ...string) []internal_type.KnowledgeContextResult {
+	out := make([]internal_type.KnowledgeContextResult, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, internal_type.KnowledgeContextResult{ID: id, DocumentID: "doc-" + id})
+	}
+	return out
+}
+
+func TestRecallAtK(t *testing.T) {
+	tests := []struct {
+		name     string
+		results  []internal_type.KnowledgeContextResult
+		expected []string
+		k        int
+		want     float64
+	}{
+		{"all found", results("a", "b", "c"), []string{"a", "c"}, 3, 1},
+		{"half found", results("a", "b", "c"), []string{"a", "z"}, 3, 0.5},
+		{"outside k", results("a", "b", "c"), []string{"c"}, 2, 0},
+		{"matched on document", results("a", "b"), []string{"doc-b"}, 2, 1},
+		{"no expectation", results("a"), nil, 1, 0},
+		{"no results", nil, []string{"a"}, 4, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, recallAtK(tt.results, tt.expected, tt.k), 1e-9)
+		})
+	}
+}
+
+func TestFirstRelevantRank(t *testing.T) {
+	assert.Equal(t, 1, firstRelevantRank(results("a", "b"), []string{"a"}))
+	assert.Equal(t, 3, firstRelevantRank(results("a", "b", "c"), []string{"c", "z"}))
+	assert.Equal(t, 0, firstRelevantRank(results("a", "b"), []string{"z"}))
+}
+
+func TestResultIds_KnowledgeDocumentId(t *testing.T) {
+	result := internal_type.KnowledgeContextResult{
+		ID:         "segment",
+		DocumentID: "hash",
+		Metadata:   map[string]interface{}{"knowledge_document_id": float64(2048)},
+	}
+	assert.Equal(t, []string{"segment", "hash", "2048"}, resultIds(result))
+}
+
+func TestMeanReciprocalRank(t *testing.T) {
+	assert.InDelta(t, (1+0.5+0)/3.0, meanReciprocalRank([]int{1, 2, 0}), 1e-9)
+	assert.Equal(t, float64(0), meanReciprocalRank(nil))
+}
+
+func TestPercentile(t *testing.T) {
+	latencies := []int64{10, 20, 30, 40, 50, 60, 70, 80, 90, 100}
+	assert.Equal(t, int64(100), percentile(latencies, 0.95))
+	assert.Equal(t, int64(50), percentile(latencies, 0.5))
+	assert.Equal(t, int64(7), percentile([]int64{7}, 0.95))
+	assert.Equal(t, int64(0), percentile(nil, 0.95))
+}
+
+func TestExpectedIds(t *testing.T) {
+	question := &internal_knowledge_gorm.KnowledgeEvaluationQuestion{
+		ExpectedSegmentIds:  []string{"s1"},
+		ExpectedDocumentIds: []string{"d1", "d2"},
+	}
+	assert.Equal(t, []string{"s1", "d1", "d2"}, expectedIds(question))
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_knowledge_service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	internal_agent_embeddings "github.com/rapidaai/api/assistant-api/internal/agent/embedding"
+	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
+	gorm_models "github.com/rapidaai/pkg/models/gorm"
+	gorm_generator "github.com/rapidaai/pkg/models/gorm/generators"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	protos "github.com/rapidaai/protos"
+	"gorm.io/gorm/clause"
+)
+
+// getDocumentSegment returns the stored segment and the active knowledge owning the index,
+// segments of a namespace that is no longer active (after a re-embedding) can not be edited
+func (knowledge *knowledgeDocumentService) getDocumentSegment(ctx context.Context,
+	auth types.SimplePrinciple,
+	index string,
+	documentId string) (map[string]interface{}, *internal_knowledge_gorm.Knowledge, error) {
+	var _knowledge internal_knowledge_gorm.Knowledge
+	tx := knowledge.postgres.DB(ctx).
+		Preload("KnowledgeEmbeddingModelOptions").
+		Where("storage_namespace = ? AND status = ? AND project_id = ? AND organization_id = ?", index, type_enums.RECORD_ACTIVE.String(), *auth.GetCurrentProjectId(), *auth.GetCurrentOrganizationId()).
+		First(&_knowledge)
+	if tx.Error != nil {
+		return nil, nil, fmt.Errorf("no active knowledge for index %s: %w", index, tx.Error)
+	}
+
+	query, err := json.Marshal(map[string]interface{}{
+		"query": map[string]interface{}{
+			"ids": map[string]interface{}{
+				"values": []string{documentId},
+			},
+		},
+		"_source": map[string]interface{}{
+			"excludes": []string{"vector"},
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	result := knowledge.opensearch.Search(ctx, []string{index}, string(query))
+	if result.Error() != nil {
+		return nil, nil, result.Error()
+	}
+	if len(result.Hits.Hits) == 0 {
+		return nil, nil, fmt.Errorf("document segment %s not found", documentId)
+	}
+	source, _ := result.Hits.Hits[0]["_source"].(map[string]interface{})
+	return source, &_knowledge, nil
+}
+
+func (knowledge *knowledgeDocumentService) embedText(ctx context.Context,
+	auth types.SimplePrinciple,
+	_knowledge *internal_knowledge_gorm.Knowledge,
+	text string) ([]float64, error) {
+	credentialId, err := _knowledge.GetOptions().GetUint64("rapida.credential_id")
+	if err != nil {
+		return nil, fmt.Errorf("knowledge %d does not have embedding credential: %w", _knowledge.Id, err)
+	}
+	credential, err := knowledge.vaultClient.GetCredential(ctx, auth, credentialId)
+	if err != nil {
+		return nil, err
+	}
+	res, err := knowledge.queryEmbedder.TextQueryEmbedding(ctx, auth, text, &internal_agent_embeddings.TextEmbeddingOption{
+		ProviderCredential: credential,
+		ModelProviderName:  _knowledge.EmbeddingModelProviderName,
+		Options:            _knowledge.GetOptions(),
+		AdditionalData: map[string]string{
+			"knowledge_id": fmt.Sprintf("%d", _knowledge.Id),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.GetData()) == 0 {
+		return nil, fmt.Errorf("empty embedding for knowledge %d", _knowledge.Id)
+	}
+	return res.GetData()[len(res.GetData())-1].GetEmbedding(), nil
+}
+
+// createSegmentRevision keeps the state of the segment before the edit, failures are only logged
+// as the edit itself is already applied
+func (knowledge *knowledgeDocumentService) createSegmentRevision(ctx context.Context,
+	auth types.SimplePrinciple,
+	knowledgeId uint64,
+	index string,
+	documentId string,
+	action internal_knowledge_gorm.SegmentRevisionAction,
+	segment map[string]interface{},
+	reason string) {
+	revision := &internal_knowledge_gorm.KnowledgeDocumentSegmentRevision{
+		Audited: gorm_models.Audited{
+			Id: gorm_generator.ID(),
+		},
+		Mutable: gorm_models.Mutable{
+			CreatedBy: *auth.GetUserId(),
+			Status:    type_enums.RECORD_ACTIVE,
+		},
+		Organizational: gorm_models.Organizational{
+			ProjectId:      *auth.GetCurrentProjectId(),
+			OrganizationId: *auth.GetCurrentOrganizationId(),
+		},
+		KnowledgeId:      knowledgeId,
+		StorageNamespace: index,
+		DocumentId:       documentId,
+		Action:           action,
+		Reason:           reason,
+	}
+	revision.Text, _ = segment["text"].(string)
+	if metadata, ok := segment["metadata"].(map[string]interface{}); ok {
+		revision.DocumentName, _ = metadata["document_name"].(string)
+	}
+	if entities, ok := segment["entities"].(map[string]interface{}); ok {
+		revision.Entities = entities
+	}
+	if err := knowledge.postgres.DB(ctx).Create(revision).Error; err != nil {
+		knowledge.logger.Errorf("unable to create revision for document segment %s: %v", documentId, err)
+	}
+}
+
+func (knowledge *knowledgeDocumentService) GetAllDocumentSegmentRevision(
+	ctx context.Context,
+	auth types.SimplePrinciple,
+	knowledgeId uint64,
+	documentId string,
+	paginate *protos.Paginate) (int64, []*internal_knowledge_gorm.KnowledgeDocumentSegmentRevision, error) {
+	db := knowledge.postgres.DB(ctx)
+	var (
+		revisions []*internal_knowledge_gorm.KnowledgeDocumentSegmentRevision
+		cnt       int64
+	)
+	qry := db.Model(internal_knowledge_gorm.KnowledgeDocumentSegmentRevision{}).
+		Where("knowledge_id = ? AND organization_id = ? AND project_id = ?", knowledgeId, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId())
+	if documentId != "" {
+		qry.Where("document_id = ?", documentId)
+	}
+	tx := qry.
+		Scopes(gorm_models.
+			Paginate(gorm_models.
+				NewPaginated(
+					int(paginate.GetPage()),
+					int(paginate.GetPageSize()),
+					&cnt,
+					qry))).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "created_date"},
+			Desc:   true,
+		}).Find(&revisions)
+	if tx.Error != nil {
+		knowledge.logger.Errorf("not able to find any segment revision %v", tx.Error)
+		return cnt, nil, tx.Error
+	}
+	return cnt, revisions, nil
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_knowledge_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	gorm_models "github.com/rapidaai/pkg/models/gorm"
+	gorm_generator "github.com/rapidaai/pkg/models/gorm/generators"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"gorm.io/gorm"
+)
+
+// rows inserted per statement while importing a table
+const tableRowBatchSize = 500
+
+type knowledgeTableService struct {
+	logger   commons.Logger
+	config   *config.AssistantConfig
+	postgres connectors.PostgresConnector
+}
+
+func NewKnowledgeTableService(config *config.AssistantConfig,
+	logger commons.Logger,
+	postgres connectors.PostgresConnector) internal_services.KnowledgeTableService {
+	return &knowledgeTableService{
+		logger:   logger,
+		config:   config,
+		postgres: postgres,
+	}
+}
+
+func (tService *knowledgeTableService) Create(ctx context.Context,
+	auth types.SimplePrinciple,
+	document *internal_knowledge_gorm.KnowledgeDocument,
+	mimeType string,
+	content []byte,
+) (*internal_knowledge_gorm.KnowledgeTable, error) {
+	start := time.Now()
+	records, err := parseTable(document.Name, mimeType, content)
+	if err != nil {
+		tService.logger.Errorf("unable to parse table document %d: %v", document.Id, err)
+		tService.failDocument(ctx, document, err)
+		return nil, err
+	}
+	columns, data, err := tableFromRecords(records)
+	if err != nil {
+		tService.logger.Errorf("unable to read table document %d: %v", document.Id, err)
+		tService.failDocument(ctx, document, err)
+		return nil, err
+	}
+
+	table := &internal_knowledge_gorm.KnowledgeTable{
+		Audited: gorm_models.Audited{
+			Id: gorm_generator.ID(),
+		},
+		Mutable: gorm_models.Mutable{
+			CreatedBy: *auth.GetUserId(),
+			Status:    type_enums.RECORD_ACTIVE,
+		},
+		Organizational: gorm_models.Organizational{
+			ProjectId:      *auth.GetCurrentProjectId(),
+			OrganizationId: *auth.GetCurrentOrganizationId(),
+		},
+		KnowledgeId:         document.KnowledgeId,
+		KnowledgeDocumentId: document.Id,
+		Name:                document.Name,
+		Columns:             columns,
+		RowCount:            uint64(len(data)),
+	}
+	rows := make([]*internal_knowledge_gorm.KnowledgeTableRow, 0, len(data))
+	for idx, row := range data {
+		rows = append(rows, &internal_knowledge_gorm.KnowledgeTableRow{
+			Audited: gorm_models.Audited{
+				Id: gorm_generator.ID(),
+			},
+			KnowledgeTableId: table.Id,
+			Position:         uint64(idx + 1),
+			Data:             row,
+		})
+	}
+
+	err = tService.postgres.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(table).Error; err != nil {
+			return err
+		}
+		if err := tx.CreateInBatches(rows, tableRowBatchSize).Error; err != nil {
+			return err
+		}
+		return tx.Model(internal_knowledge_gorm.KnowledgeDocument{}).
+			Where("id = ?", document.Id).
+			Updates(map[string]interface{}{
+				"index_status":  "completed",
+				"document_size": len(content),
+				"completed_at":  time.Now(),
+			}).Error
+	})
+	if err != nil {
+		tService.logger.Errorf("unable to store table document %d: %v", document.Id, err)
+		tService.failDocument(ctx, document, err)
+		return nil, err
+	}
+	tService.logger.Benchmark("knowledgeTableService.Create", time.Since(start))
+	return table, nil
+}
+
+// failDocument marks the document as errored so the failure is visible on the knowledge
+func (tService *knowledgeTableService) failDocument(ctx context.Context, document *internal_knowledge_gorm.KnowledgeDocument, err error) {
+	tx := tService.postgres.DB(ctx).
+		Model(internal_knowledge_gorm.KnowledgeDocument{}).
+		Where("id = ?", document.Id).
+		Updates(map[string]interface{}{
+			"index_status": "error",
+			"error":        err.Error(),
+		})
+	if tx.Error != nil {
+		tService.logger.Errorf("unable to update status of table document %d: %v", document.Id, tx.Error)
+	}
+}
+
+func (tService *knowledgeTableService) Get(ctx context.Context, auth types.SimplePrinciple, knowledgeId uint64, knowledgeDocumentId uint64) (*internal_knowledge_gorm.KnowledgeTable, error) {
+	var table *internal_knowledge_gorm.KnowledgeTable
+	tx := tService.postgres.DB(ctx).
+		Where("knowledge_id = ? AND knowledge_document_id = ? AND organization_id = ? AND project_id = ? AND status = ?",
+			knowledgeId, knowledgeDocumentId, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId(), type_enums.RECORD_ACTIVE.String()).
+		First(&table)
+	if tx.Error != nil {
+		tService.logger.Errorf("not able to find knowledge table %v", tx.Error)
+		return nil, tx.Error
+	}
+	return table, nil
+}
+
+func (tService *knowledgeTableService) GetAll(ctx context.Context, auth types.SimplePrinciple, knowledgeId uint64) ([]*internal_knowledge_gorm.KnowledgeTable, error) {
+	var tables []*internal_knowledge_gorm.KnowledgeTable
+	tx := tService.postgres.DB(ctx).
+		Where("knowledge_id = ? AND organization_id = ? AND project_id = ? AND status = ?",
+			knowledgeId, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId(), type_enums.RECORD_ACTIVE.String()).
+		Order("created_date").
+		Find(&tables)
+	if tx.Error != nil {
+		tService.logger.Errorf("not able to find knowledge tables %v", tx.Error)
+		return nil, tx.Error
+	}
+	return tables, nil
+}
+
+func (tService *knowledgeTableService) Query(ctx context.Context,
+	auth types.SimplePrinciple,
+	table *internal_knowledge_gorm.KnowledgeTable,
+	query *internal_type.KnowledgeTableQuery,
+	maxLimit uint32,
+) ([]map[string]interface{}, error) {
+	start := time.Now()
+	sql, args, err := buildTableQuery(table, query, maxLimit)
+	if err != nil {
+		return nil, err
+	}
+	var rows []struct {
+		Data gorm_types.InterfaceMap
+	}
+	if err := tService.postgres.DB(ctx).Raw(sql, args...).Scan(&rows).Error; err != nil {
+		tService.logger.Errorf("unable to query knowledge table %d: %v", table.Id, err)
+		return nil, err
+	}
+	out := make([]map[string]interface{}, 0, len(rows))
+	for _, row := range rows {
+		projected, err := projectRow(table, row.Data, query.Columns)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, projected)
+	}
+	tService.logger.Benchmark("knowledgeTableService.Query", time.Since(start))
+	return out, nil
+}
@@ -0,0 +1,362 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_knowledge_service
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+)
+
+const (
+	maxTableRows    = 50000
+	maxTableColumns = 100
+	// decompressed size of a part of an xlsx archive, a small archive may inflate to gigabytes
+	maxXLSXPartSize = 64 << 20
+)
+
+var (
+	nonKeyCharacters  = regexp.MustCompile(`[^a-z0-9]+`)
+	groupedNumber     = regexp.MustCompile(`^-?\d{1,3}(,\d{3})+(\.\d+)?$`)
+	currencyPrefixes  = []string{"$", "€", "£", "₹", "¥"}
+	errEmptyTableFile = errors.New("table does not have any row")
+)
+
+// parseTable reads the records of a csv or xlsx file, the first record is the header
+func parseTable(name, mimeType string, content []byte) ([][]string, error) {
+	switch {
+	case strings.EqualFold(path.Ext(name), ".xlsx") || strings.Contains(mimeType, "spreadsheetml"):
+		return parseXLSX(content)
+	case strings.EqualFold(path.Ext(name), ".csv") || strings.EqualFold(path.Ext(name), ".tsv") || strings.HasPrefix(mimeType, "text/"):
+		return parseCSV(content)
+	default:
+		return nil, fmt.Errorf("unsupported table format %s, only csv and xlsx are supported", mimeType)
+	}
+}
+
+func parseCSV(content []byte) ([][]string, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	firstLine := content
+	if idx := bytes.IndexByte(content, '\n'); idx >= 0 {
+		firstLine = content[:idx]
+	}
+	delimiter, best := ',', bytes.Count(firstLine, []byte(","))
+	for _, d := range []rune{';', '\t', '|'} {
+		if c := bytes.Count(firstLine, []byte(string(d))); c > best {
+			delimiter, best = d, c
+		}
+	}
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.Comma = delimiter
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	records := make([][]string, 0)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if isEmptyRecord(record) {
+			continue
+		}
+		records = append(records, record)
+		if len(records) > maxTableRows+1 {
+			return nil, fmt.Errorf("table has more than %d rows", maxTableRows)
+		}
+	}
+	return records, nil
+}
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+		Id   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		Id     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxText struct {
+	T string `xml:"t"`
+	R []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.R) == 0 {
+		return t.T
+	}
+	var builder strings.Builder
+	for _, r := range t.R {
+		builder.WriteString(r.T)
+	}
+	return builder.String()
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		Cells []struct {
+			Ref    string   `xml:"r,attr"`
+			Type   string   `xml:"t,attr"`
+			Value  string   `xml:"v"`
+			Inline xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// parseXLSX reads the first worksheet of the workbook
+func parseXLSX(content []byte) ([][]string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid xlsx file: %w", err)
+	}
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, f := range archive.File {
+		files[f.Name] = f
+	}
+
+	sheetPath := "xl/worksheets/sheet1.xml"
+	var workbook xlsxWorkbook
+	var relationships xlsxRelationships
+	if decodeZipXML(files["xl/workbook.xml"], &workbook) == nil && len(workbook.Sheets) > 0 &&
+		decodeZipXML(files["xl/_rels/workbook.xml.rels"], &relationships) == nil {
+		for _, rel := range relationships.Relationships {
+			if rel.Id == workbook.Sheets[0].Id {
+				if strings.HasPrefix(rel.Target, "/") {
+					sheetPath = strings.TrimPrefix(rel.Target, "/")
+				} else {
+					sheetPath = path.Join("xl", rel.Target)
+				}
+			}
+		}
+	}
+
+	var shared xlsxSharedStrings
+	if f, ok := files["xl/sharedStrings.xml"]; ok {
+		if err := decodeZipXML(f, &shared); err != nil {
+			return nil, err
+		}
+	}
+	var sheet xlsxWorksheet
+	if err := decodeZipXML(files[sheetPath], &sheet); err != nil {
+		return nil, err
+	}
+
+	records := make([][]string, 0, len(sheet.Rows))
+	for _, row := range sheet.Rows {
+		record := make([]string, 0, len(row.Cells))
+		for idx, cell := range row.Cells {
+			column := idx
+			if c, ok := xlsxColumnIndex(cell.Ref); ok {
+				column = c
+			}
+			// a cell reference like ZZZZ1 would pad the record up to its column
+			if column >= maxTableColumns {
+				return nil, fmt.Errorf("table has more than %d columns", maxTableColumns)
+			}
+			for len(record) < column {
+				record = append(record, "")
+			}
+			var value string
+			switch cell.Type {
+			case "s":
+				i, err := strconv.Atoi(cell.Value)
+				if err == nil && i >= 0 && i < len(shared.Items) {
+					value = shared.Items[i].String()
+				}
+			case "inlineStr":
+				value = cell.Inline.String()
+			case "b":
+				value = map[string]string{"1": "true", "0": "false"}[cell.Value]
+			default:
+				value = cell.Value
+			}
+			if column < len(record) {
+				record[column] = value
+			} else {
+				record = append(record, value)
+			}
+		}
+		if isEmptyRecord(record) {
+			continue
+		}
+		records = append(records, record)
+		if len(records) > maxTableRows+1 {
+			return nil, fmt.Errorf("table has more than %d rows", maxTableRows)
+		}
+	}
+	return records, nil
+}
+
+func decodeZipXML(f *zip.File, out interface{}) error {
+	if f == nil {
+		return errors.New("missing file in xlsx archive")
+	}
+	if f.UncompressedSize64 > maxXLSXPartSize {
+		return fmt.Errorf("%s of xlsx archive is larger than %d bytes", f.Name, maxXLSXPartSize)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	// the declared size can not be trusted, the part is cut at the limit and fails to decode
+	return xml.NewDecoder(io.LimitReader(rc, maxXLSXPartSize)).Decode(out)
+}
+
+// xlsxColumnIndex returns the zero based column of a cell reference like "AB12"
+func xlsxColumnIndex(ref string) (int, bool) {
+	column := 0
+	letters := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		// columns after the limit are rejected, they are not counted any further
+		if column <= maxTableColumns {
+			column = column*26 + int(r-'A'+1)
+		}
+		letters++
+	}
+	if letters == 0 {
+		return 0, false
+	}
+	return column - 1, true
+}
+
+func isEmptyRecord(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// parseNumber parses plain, grouped and currency prefixed numbers as found in price lists
+func parseNumber(value string) (float64, bool) {
+	value = strings.TrimSpace(value)
+	for _, prefix := range currencyPrefixes {
+		value = strings.TrimPrefix(value, prefix)
+	}
+	value = strings.TrimSpace(value)
+	if groupedNumber.MatchString(value) {
+		value = strings.ReplaceAll(value, ",", "")
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false
+	}
+	return f, true
+}
+
+// columnKey sanitizes a header into the key the values are stored with
+func columnKey(name string, idx int) string {
+	key := strings.Trim(nonKeyCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if key == "" {
+		key = fmt.Sprintf("column_%d", idx+1)
+	}
+	return key
+}
+
+// tableFromRecords derives the columns from the header and types every column as number
+// when all of its non empty values are numbers.
+func tableFromRecords(records [][]string) (internal_knowledge_gorm.KnowledgeTableColumns, []gorm_types.InterfaceMap, error) {
+	if len(records) < 2 {
+		return nil, nil, errEmptyTableFile
+	}
+	header := records[0]
+	if len(header) > maxTableColumns {
+		return nil, nil, fmt.Errorf("table has more than %d columns", maxTableColumns)
+	}
+
+	columns := make(internal_knowledge_gorm.KnowledgeTableColumns, 0, len(header))
+	seen := make(map[string]int, len(header))
+	for idx, name := range header {
+		key := columnKey(name, idx)
+		if n, ok := seen[key]; ok {
+			seen[key] = n + 1
+			key = fmt.Sprintf("%s_%d", key, n+1)
+		} else {
+			seen[key] = 1
+		}
+		display := strings.TrimSpace(name)
+		if display == "" {
+			display = key
+		}
+		columns = append(columns, &internal_knowledge_gorm.KnowledgeTableColumn{
+			Key:  key,
+			Name: display,
+			Type: internal_knowledge_gorm.KnowledgeTableColumnNumber,
+		})
+	}
+
+	body := records[1:]
+	for idx, column := range columns {
+		hasValue := false
+		for _, record := range body {
+			if idx >= len(record) || strings.TrimSpace(record[idx]) == "" {
+				continue
+			}
+			hasValue = true
+			if _, ok := parseNumber(record[idx]); !ok {
+				column.Type = internal_knowledge_gorm.KnowledgeTableColumnText
+				break
+			}
+		}
+		if !hasValue {
+			column.Type = internal_knowledge_gorm.KnowledgeTableColumnText
+		}
+	}
+
+	rows := make([]gorm_types.InterfaceMap, 0, len(body))
+	for _, record := range body {
+		row := make(gorm_types.InterfaceMap, len(columns))
+		for idx, column := range columns {
+			if idx >= len(record) {
+				break
+			}
+			value := strings.TrimSpace(record[idx])
+			if value == "" {
+				continue
+			}
+			if column.Type == internal_knowledge_gorm.KnowledgeTableColumnNumber {
+				n, _ := parseNumber(value)
+				row[column.Key] = n
+				continue
+			}
+			row[column.Key] = value
+		}
+		rows = append(rows, row)
+	}
+	return columns, rows, nil
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_knowledge_service
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCSV_Delimiter(t *testing.T) {
+	records, err := parseTable("prices.csv", "", []byte("\xef\xbb\xbfProduct;Price\nWidget;10\n;\nGadget;\"1,299.00\"\n"))
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"Product", "Price"}, {"Widget", "10"}, {"Gadget", "1,299.00"}}, records)
+}
+
+// xlsxArchive is a workbook of the single sheet
+func xlsxArchive(t *testing.T, sheet string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	files := map[string]string{
+		"xl/workbook.xml":            `<workbook xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Stock" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships><Relationship Id="rId1" Target="worksheets/stock.xml"/></Relationships>`,
+		"xl/sharedStrings.xml":       `<sst><si><t>Item</t></si><si><t>Qty</t></si><si><r><t>Blue </t></r><r><t>Pen</t></r></si></sst>`,
+		"xl/worksheets/stock.xml":    sheet,
+	}
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestParseXLSX(t *testing.T) {
+	records, err := parseTable("stock.xlsx", "", xlsxArchive(t, `<worksheet><sheetData>`+
+		`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>`+
+		`<row r="2"><c r="A2" t="s"><v>2</v></c><c r="C2"><v>4</v></c></row>`+
+		`<row r="3"><c r="A3" t="inlineStr"><is><t>Pencil</t></is></c><c r="B3"><v>7</v></c></row>`+
+		`</sheetData></worksheet>`))
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"Item", "Qty"}, {"Blue Pen", "", "4"}, {"Pencil", "7"}}, records)
+}
+
+func TestParseXLSX_ColumnLimit(t *testing.T) {
+	tests := []struct {
+		name string
+		ref  string
+	}{
+		{name: "column after the limit", ref: "CW1"},
+		{name: "last column of a sheet", ref: "XFD1"},
+		{name: "column after any sheet", ref: "ZZZZZZZZ1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseTable("stock.xlsx", "", xlsxArchive(t, `<worksheet><sheetData><row r="1">`+
+				`<c r="A1" t="s"><v>0</v></c><c r="`+tt.ref+`"><v>1</v></c>`+
+				`</row></sheetData></worksheet>`))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseXLSX_PartSize(t *testing.T) {
+	sheet := `<worksheet><sheetData>` + strings.Repeat(" ", maxXLSXPartSize) + `</sheetData></worksheet>`
+	_, err := parseTable("stock.xlsx", "", xlsxArchive(t, sheet))
+	assert.Error(t, err)
+}
+
+func TestParseTable_Unsupported(t *testing.T) {
+	_, err := parseTable("notes.pdf", "application/pdf", []byte("%PDF"))
+	assert.Error(t, err)
+}
+
+func TestTableFromRecords(t *testing.T) {
+	columns, rows, err := tableFromRecords([][]string{
+		{"Product Name", "Price ($)", "SKU", "Product Name", ""},
+		{"Widget", "$10", "001", "w", ""},
+		{"Gadget", "1,299.50", "A02"},
+	})
+	require.NoError(t, err)
+	require.Len(t, columns, 5)
+	assert.Equal(t, "product_name", columns[0].Key)
+	assert.Equal(t, "price", columns[1].Key)
+	assert.Equal(t, internal_knowledge_gorm.KnowledgeTableColumnNumber, columns[1].Type)
+	assert.Equal(t, internal_knowledge_gorm.KnowledgeTableColumnText, columns[2].Type)
+	assert.Equal(t, "product_name_2", columns[3].Key)
+	assert.Equal(t, "column_5", columns[4].Key)
+	assert.Equal(t, internal_knowledge_gorm.KnowledgeTableColumnText, columns[4].Type)
+
+	require.Len(t, rows, 2)
+	assert.Equal(t, 10.0, rows[0]["price"])
+	assert.Equal(t, "001", rows[0]["sku"])
+	assert.Equal(t, 1299.5, rows[1]["price"])
+	assert.NotContains(t, rows[1], "product_name_2")
+
+	_, _, err = tableFromRecords([][]string{{"only header"}})
+	assert.Error(t, err)
+}
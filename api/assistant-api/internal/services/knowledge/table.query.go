@@ -0,0 +1,182 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_knowledge_service
+
+import (
+	"fmt"
+	"strings"
+
+	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+)
+
+const (
+	defaultTableQueryLimit = 10
+	maxTableQueryLimit     = 100
+)
+
+var comparisonOperators = map[string]string{
+	"eq":  "=",
+	"neq": "<>",
+	"gt":  ">",
+	"gte": ">=",
+	"lt":  "<",
+	"lte": "<=",
+}
+
+// buildTableQuery translates the query into a parameterized sql over the rows of the table,
+// column names and operators are never interpolated, columns must exist in the table and
+// operators must be one of the known operators. The limit is bounded by maxLimit.
+func buildTableQuery(table *internal_knowledge_gorm.KnowledgeTable, query *internal_type.KnowledgeTableQuery, maxLimit uint32) (string, []interface{}, error) {
+	var builder strings.Builder
+	args := []interface{}{table.Id}
+	builder.WriteString("SELECT data FROM knowledge_table_rows WHERE knowledge_table_id = ?")
+
+	for _, filter := range query.Filters {
+		column, ok := table.Column(filter.Column)
+		if !ok {
+			return "", nil, fmt.Errorf("unknown column %q", filter.Column)
+		}
+		condition, values, err := filterCondition(column, filter)
+		if err != nil {
+			return "", nil, err
+		}
+		builder.WriteString(" AND ")
+		builder.WriteString(condition)
+		args = append(args, values...)
+	}
+
+	if query.OrderBy != "" {
+		column, ok := table.Column(query.OrderBy)
+		if !ok {
+			return "", nil, fmt.Errorf("unknown column %q", query.OrderBy)
+		}
+		direction := "ASC"
+		if query.Descending {
+			direction = "DESC"
+		}
+		if column.Type == internal_knowledge_gorm.KnowledgeTableColumnNumber {
+			builder.WriteString(" ORDER BY (data->>?)::numeric " + direction + " NULLS LAST, position")
+		} else {
+			builder.WriteString(" ORDER BY data->>? " + direction + " NULLS LAST, position")
+		}
+		args = append(args, column.Key)
+	} else {
+		builder.WriteString(" ORDER BY position")
+	}
+
+	builder.WriteString(" LIMIT ?")
+	args = append(args, tableQueryLimit(query.Limit, maxLimit))
+	return builder.String(), args, nil
+}
+
+func tableQueryLimit(limit, maxLimit uint32) uint32 {
+	if maxLimit == 0 || maxLimit > maxTableQueryLimit {
+		maxLimit = maxTableQueryLimit
+	}
+	if limit == 0 {
+		limit = defaultTableQueryLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	return limit
+}
+
+func filterCondition(column *internal_knowledge_gorm.KnowledgeTableColumn, filter internal_type.KnowledgeTableFilter) (string, []interface{}, error) {
+	number := column.Type == internal_knowledge_gorm.KnowledgeTableColumnNumber
+	switch filter.Operator {
+	case "eq", "neq", "gt", "gte", "lt", "lte":
+		op := comparisonOperators[filter.Operator]
+		if number {
+			value, err := toNumber(filter.Value)
+			if err != nil {
+				return "", nil, fmt.Errorf("column %q expects a number: %w", column.Name, err)
+			}
+			return "(data->>?)::numeric " + op + " ?", []interface{}{column.Key, value}, nil
+		}
+		value := fmt.Sprintf("%v", filter.Value)
+		if filter.Operator == "eq" || filter.Operator == "neq" {
+			return "lower(data->>?) " + op + " lower(?)", []interface{}{column.Key, value}, nil
+		}
+		return "data->>? " + op + " ?", []interface{}{column.Key, value}, nil
+	case "contains":
+		if number {
+			return "", nil, fmt.Errorf("contains is not supported on number column %q", column.Name)
+		}
+		return "data->>? ILIKE ?", []interface{}{column.Key, "%" + escapeLike(fmt.Sprintf("%v", filter.Value)) + "%"}, nil
+	case "in":
+		items, ok := filter.Value.([]interface{})
+		if !ok {
+			items = []interface{}{filter.Value}
+		}
+		if len(items) == 0 {
+			return "", nil, fmt.Errorf("in on column %q requires at least one value", column.Name)
+		}
+		if number {
+			values := make([]float64, 0, len(items))
+			for _, item := range items {
+				value, err := toNumber(item)
+				if err != nil {
+					return "", nil, fmt.Errorf("column %q expects numbers: %w", column.Name, err)
+				}
+				values = append(values, value)
+			}
+			return "(data->>?)::numeric IN ?", []interface{}{column.Key, values}, nil
+		}
+		values := make([]string, 0, len(items))
+		for _, item := range items {
+			values = append(values, strings.ToLower(fmt.Sprintf("%v", item)))
+		}
+		return "lower(data->>?) IN ?", []interface{}{column.Key, values}, nil
+	default:
+		return "", nil, fmt.Errorf("unsupported operator %q", filter.Operator)
+	}
+}
+
+func toNumber(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case string:
+		if n, ok := parseNumber(v); ok {
+			return n, nil
+		}
+	}
+	return 0, fmt.Errorf("%v is not a number", value)
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+// projectRow keeps the requested columns of the row keyed by their display name, all columns when none requested
+func projectRow(table *internal_knowledge_gorm.KnowledgeTable, row map[string]interface{}, columns []string) (map[string]interface{}, error) {
+	selected := table.Columns
+	if len(columns) > 0 {
+		selected = make(internal_knowledge_gorm.KnowledgeTableColumns, 0, len(columns))
+		for _, name := range columns {
+			column, ok := table.Column(name)
+			if !ok {
+				return nil, fmt.Errorf("unknown column %q", name)
+			}
+			selected = append(selected, column)
+		}
+	}
+	out := make(map[string]interface{}, len(selected))
+	for _, column := range selected {
+		if v, ok := row[column.Key]; ok {
+			out[column.Name] = v
+		}
+	}
+	return out, nil
+}
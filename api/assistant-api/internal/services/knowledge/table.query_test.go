@@ -0,0 +1,123 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_knowledge_service
+
+import (
+	"testing"
+
+	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func priceTable() *internal_knowledge_gorm.KnowledgeTable {
+	return &internal_knowledge_gorm.KnowledgeTable{
+		Columns: internal_knowledge_gorm.KnowledgeTableColumns{
+			{Key: "product", Name: "Product", Type: internal_knowledge_gorm.KnowledgeTableColumnText},
+			{Key: "price", Name: "Price", Type: internal_knowledge_gorm.KnowledgeTableColumnNumber},
+		},
+	}
+}
+
+func TestBuildTableQuery(t *testing.T) {
+	table := priceTable()
+	table.Id = 7
+	tests := []struct {
+		name     string
+		query    internal_type.KnowledgeTableQuery
+		maxLimit uint32
+		sql      string
+		args     []interface{}
+	}{
+		{
+			name:  "default limit",
+			query: internal_type.KnowledgeTableQuery{},
+			sql:   "SELECT data FROM knowledge_table_rows WHERE knowledge_table_id = ? ORDER BY position LIMIT ?",
+			args:  []interface{}{uint64(7), uint32(defaultTableQueryLimit)},
+		},
+		{
+			name: "text equality is case insensitive",
+			query: internal_type.KnowledgeTableQuery{
+				Filters: []internal_type.KnowledgeTableFilter{{Column: "Product", Operator: "eq", Value: "Widget"}},
+			},
+			sql:  "SELECT data FROM knowledge_table_rows WHERE knowledge_table_id = ? AND lower(data->>?) = lower(?) ORDER BY position LIMIT ?",
+			args: []interface{}{uint64(7), "product", "Widget", uint32(defaultTableQueryLimit)},
+		},
+		{
+			name: "number comparison with order and bounded limit",
+			query: internal_type.KnowledgeTableQuery{
+				Filters:    []internal_type.KnowledgeTableFilter{{Column: "price", Operator: "lte", Value: "20"}},
+				OrderBy:    "price",
+				Descending: true,
+				Limit:      50,
+			},
+			maxLimit: 5,
+			sql:      "SELECT data FROM knowledge_table_rows WHERE knowledge_table_id = ? AND (data->>?)::numeric <= ? ORDER BY (data->>?)::numeric DESC NULLS LAST, position LIMIT ?",
+			args:     []interface{}{uint64(7), "price", 20.0, "price", uint32(5)},
+		},
+		{
+			name: "contains escapes wildcards",
+			query: internal_type.KnowledgeTableQuery{
+				Filters: []internal_type.KnowledgeTableFilter{{Column: "product", Operator: "contains", Value: "50%_off"}},
+			},
+			sql:  "SELECT data FROM knowledge_table_rows WHERE knowledge_table_id = ? AND data->>? ILIKE ? ORDER BY position LIMIT ?",
+			args: []interface{}{uint64(7), "product", `%50\%\_off%`, uint32(defaultTableQueryLimit)},
+		},
+		{
+			name: "in on text",
+			query: internal_type.KnowledgeTableQuery{
+				Filters: []internal_type.KnowledgeTableFilter{{Column: "product", Operator: "in", Value: []interface{}{"A", "b"}}},
+			},
+			sql:  "SELECT data FROM knowledge_table_rows WHERE knowledge_table_id = ? AND lower(data->>?) IN ? ORDER BY position LIMIT ?",
+			args: []interface{}{uint64(7), "product", []string{"a", "b"}, uint32(defaultTableQueryLimit)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := buildTableQuery(table, &tt.query, tt.maxLimit)
+			require.NoError(t, err)
+			assert.Equal(t, tt.sql, sql)
+			assert.Equal(t, tt.args, args)
+		})
+	}
+}
+
+func TestBuildTableQuery_Rejects(t *testing.T) {
+	table := priceTable()
+	tests := []struct {
+		name  string
+		query internal_type.KnowledgeTableQuery
+	}{
+		{"unknown column", internal_type.KnowledgeTableQuery{Filters: []internal_type.KnowledgeTableFilter{{Column: "price; DROP TABLE x", Operator: "eq", Value: 1}}}},
+		{"unknown operator", internal_type.KnowledgeTableQuery{Filters: []internal_type.KnowledgeTableFilter{{Column: "price", Operator: "= 1 OR 1", Value: 1}}}},
+		{"number expected", internal_type.KnowledgeTableQuery{Filters: []internal_type.KnowledgeTableFilter{{Column: "price", Operator: "gt", Value: "cheap"}}}},
+		{"contains on number", internal_type.KnowledgeTableQuery{Filters: []internal_type.KnowledgeTableFilter{{Column: "price", Operator: "contains", Value: "1"}}}},
+		{"unknown order", internal_type.KnowledgeTableQuery{OrderBy: "random()"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := buildTableQuery(table, &tt.query, 0)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestProjectRow(t *testing.T) {
+	table := priceTable()
+	row := map[string]interface{}{"product": "Widget", "price": 10.0}
+
+	out, err := projectRow(table, row, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"Product": "Widget", "Price": 10.0}, out)
+
+	out, err = projectRow(table, row, []string{"price"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"Price": 10.0}, out)
+
+	_, err = projectRow(table, row, []string{"cost"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_twilio_sms
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+	twilio_client "github.com/twilio/twilio-go/client"
+)
+
+// api of twilio, it is replaced in tests
+var apiUrl = "https://api.twilio.com/2010-04-01"
+
+type twilioSms struct {
+	appCfg *config.AssistantConfig
+	logger commons.Logger
+}
+
+// NewTwilioSms receives the messages of a phone number of twilio, the incoming message webhook
+// of the number posts them and the replies are created with the messages api.
+func NewTwilioSms(config *config.AssistantConfig, logger commons.Logger) (internal_type.Sms, error) {
+	return &twilioSms{
+		appCfg: config,
+		logger: logger,
+	}, nil
+}
+
+func credentialOf(vaultCredential *protos.VaultCredential) (string, string, error) {
+	accountSid, ok := vaultCredential.GetValue().AsMap()["account_sid"].(string)
+	if !ok || accountSid == "" {
+		return "", "", fmt.Errorf("illegal vault config account_sid is not found")
+	}
+	authToken, ok := vaultCredential.GetValue().AsMap()["account_token"].(string)
+	if !ok || authToken == "" {
+		return "", "", fmt.Errorf("illegal vault config account_token not found")
+	}
+	return accountSid, authToken, nil
+}
+
+// VerifyWebhook checks the X-Twilio-Signature of the request, twilio signs the url it requested
+// and the posted parameters with the auth token of the account
+func (ts *twilioSms) VerifyWebhook(c *gin.Context, vaultCredential *protos.VaultCredential) error {
+	_, authToken, err := credentialOf(vaultCredential)
+	if err != nil {
+		return err
+	}
+	signature := c.GetHeader("X-Twilio-Signature")
+	if signature == "" {
+		return fmt.Errorf("missing twilio signature")
+	}
+	if err := c.Request.ParseForm(); err != nil {
+		return fmt.Errorf("failed to parse form: %w", err)
+	}
+	params := make(map[string]string)
+	for key, values := range c.Request.PostForm {
+		if len(values) > 0 {
+			params[key] = values[0]
+		}
+	}
+	validator := twilio_client.NewRequestValidator(authToken)
+	if !validator.Validate(fmt.Sprintf("https://%s%s", ts.appCfg.PublicAssistantHost, c.Request.URL.RequestURI()), params, signature) {
+		return fmt.Errorf("illegal twilio signature")
+	}
+	return nil
+}
+
+// ReceiveMessage reads the message posted by twilio, media of a mms are not given to the
+// assistant
+func (ts *twilioSms) ReceiveMessage(c *gin.Context) ([]*internal_type.SmsMessage, error) {
+	if err := c.Request.ParseForm(); err != nil {
+		return nil, fmt.Errorf("failed to parse form: %w", err)
+	}
+	form := c.Request.PostForm
+	if form.Get("MessageSid") == "" {
+		return nil, fmt.Errorf("missing message sid")
+	}
+	// status of a sent message when the status callback is the incoming message url
+	if form.Get("MessageStatus") != "" || strings.TrimSpace(form.Get("Body")) == "" {
+		return nil, nil
+	}
+	return []*internal_type.SmsMessage{{
+		Id:   form.Get("MessageSid"),
+		From: form.Get("From"),
+		To:   form.Get("To"),
+		Text: form.Get("Body"),
+		Time: time.Now(),
+	}}, nil
+}
+
+// Acknowledge answers with empty twiml, the reply is created with the messages api once the
+// assistant responded
+func (ts *twilioSms) Acknowledge(c *gin.Context) {
+	c.Data(http.StatusOK, "text/xml", []byte("<Response></Response>"))
+}
+
+// SendMessage creates the message with the messages api of the account
+func (ts *twilioSms) SendMessage(ctx context.Context, vaultCredential *protos.VaultCredential, from, to, text string) error {
+	accountSid, authToken, err := credentialOf(vaultCredential)
+	if err != nil {
+		return err
+	}
+	form := url.Values{}
+	form.Set("From", from)
+	form.Set("To", to)
+	form.Set("Body", text)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/Accounts/%s/Messages.json", apiUrl, url.PathEscape(accountSid)), strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(accountSid, authToken)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	client := &http.Client{Timeout: 60 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("status code %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
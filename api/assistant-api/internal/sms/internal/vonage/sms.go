@@ -0,0 +1,186 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_vonage_sms
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+	vonage_jwt "github.com/vonage/vonage-go-sdk/jwt"
+)
+
+// messages api of vonage, it is replaced in tests
+var apiUrl = "https://api.nexmo.com/v1/messages"
+
+// webhooks signed earlier are taken as replayed
+const signatureTolerance = 5 * time.Minute
+
+type vonageSms struct {
+	appCfg *config.AssistantConfig
+	logger commons.Logger
+}
+
+// NewVonageSms receives the messages of a number linked to a vonage application, the inbound
+// webhook of the messages api posts them and the replies are sent with the messages api.
+func NewVonageSms(config *config.AssistantConfig, logger commons.Logger) (internal_type.Sms, error) {
+	return &vonageSms{
+		appCfg: config,
+		logger: logger,
+	}, nil
+}
+
+// inboundMessage is the inbound webhook of the messages api, delivery statuses carry a status
+type inboundMessage struct {
+	Channel     string `json:"channel"`
+	MessageUuid string `json:"message_uuid"`
+	To          string `json:"to"`
+	From        string `json:"from"`
+	Timestamp   string `json:"timestamp"`
+	Text        string `json:"text"`
+	Status      string `json:"status"`
+}
+
+// VerifyWebhook checks the signed webhook of vonage, the bearer token is signed with the signature
+// secret of the account and carries the sha256 of the body it was sent with. Credentials created
+// before the signature secret was asked for are rejected unless unverified webhooks are accepted.
+func (vs *vonageSms) VerifyWebhook(c *gin.Context, vaultCredential *protos.VaultCredential) error {
+	signatureSecret, ok := vaultCredential.GetValue().AsMap()["signature_secret"].(string)
+	if !ok || signatureSecret == "" {
+		if vs.appCfg != nil && vs.appCfg.UnverifiedWebhooks {
+			vs.logger.Warnf("vonage sms webhook is not verified, signature_secret is missing in the vault credential")
+			return nil
+		}
+		return fmt.Errorf("illegal vault config signature_secret not found")
+	}
+	tokenString, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if !ok || tokenString == "" {
+		return fmt.Errorf("missing vonage signature")
+	}
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(signatureSecret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()})); err != nil {
+		return fmt.Errorf("illegal vonage signature: %w", err)
+	}
+	signedAt, err := claims.GetIssuedAt()
+	if err != nil || signedAt == nil {
+		return fmt.Errorf("missing vonage signature time")
+	}
+	if time.Since(signedAt.Time).Abs() > signatureTolerance {
+		return fmt.Errorf("vonage signature has expired")
+	}
+	if applicationId, ok := vaultCredential.GetValue().AsMap()["application_id"].(string); ok {
+		if claimed, ok := claims["application_id"].(string); ok && claimed != applicationId {
+			return fmt.Errorf("illegal vonage signature of application %s", claimed)
+		}
+	}
+
+	// messages are always posted, a token without payload hash does not sign the message
+	payloadHash, ok := claims["payload_hash"].(string)
+	if !ok {
+		return fmt.Errorf("missing vonage payload hash")
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read request body: %w", err)
+	}
+	hash := sha256.Sum256(body)
+	if !strings.EqualFold(hex.EncodeToString(hash[:]), payloadHash) {
+		return fmt.Errorf("illegal vonage payload hash")
+	}
+	return nil
+}
+
+// ReceiveMessage reads the inbound sms of the webhook, statuses of sent messages and messages of
+// other channels are not messages of the deployment
+func (vs *vonageSms) ReceiveMessage(c *gin.Context) ([]*internal_type.SmsMessage, error) {
+	var inbound inboundMessage
+	if err := c.ShouldBindJSON(&inbound); err != nil {
+		return nil, fmt.Errorf("failed to parse inbound message: %w", err)
+	}
+	if inbound.MessageUuid == "" {
+		return nil, fmt.Errorf("missing message uuid")
+	}
+	if inbound.Status != "" || inbound.Channel != "sms" || strings.TrimSpace(inbound.Text) == "" {
+		return nil, nil
+	}
+	sent, err := time.Parse(time.RFC3339, inbound.Timestamp)
+	if err != nil {
+		sent = time.Now()
+	}
+	return []*internal_type.SmsMessage{{
+		Id:   inbound.MessageUuid,
+		From: inbound.From,
+		To:   inbound.To,
+		Text: inbound.Text,
+		Time: sent,
+	}}, nil
+}
+
+// Acknowledge answers the webhook, vonage retries messages which are not answered with 200
+func (vs *vonageSms) Acknowledge(c *gin.Context) {
+	c.Status(http.StatusOK)
+}
+
+// SendMessage sends the text with the messages api, the request is authorized with a token of
+// the application
+func (vs *vonageSms) SendMessage(ctx context.Context, vaultCredential *protos.VaultCredential, from, to, text string) error {
+	privateKey, ok := vaultCredential.GetValue().AsMap()["private_key"].(string)
+	if !ok || privateKey == "" {
+		return fmt.Errorf("illegal vault config private_key is not found")
+	}
+	applicationId, ok := vaultCredential.GetValue().AsMap()["application_id"].(string)
+	if !ok || applicationId == "" {
+		return fmt.Errorf("illegal vault config application_id is not found")
+	}
+	token, err := vonage_jwt.NewGenerator(applicationId, []byte(privateKey)).GenerateToken()
+	if err != nil {
+		return fmt.Errorf("unable to sign vonage token: %w", err)
+	}
+	body, err := json.Marshal(map[string]string{
+		"channel":      "sms",
+		"message_type": "text",
+		"from":         strings.TrimPrefix(from, "+"),
+		"to":           strings.TrimPrefix(to, "+"),
+		"text":         text,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiUrl, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{Timeout: 60 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("status code %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
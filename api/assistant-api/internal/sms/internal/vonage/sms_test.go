@@ -0,0 +1,231 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_vonage_sms
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func credential(t *testing.T, value map[string]interface{}) *protos.VaultCredential {
+	t.Helper()
+	v, err := structpb.NewStruct(value)
+	require.NoError(t, err)
+	return &protos.VaultCredential{Value: v}
+}
+
+func newSms() *vonageSms {
+	logger, _ := commons.NewApplicationLogger()
+	return &vonageSms{appCfg: &config.AssistantConfig{}, logger: logger}
+}
+
+func sign(t *testing.T, secret string, claims jwt.MapClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	require.NoError(t, err)
+	return token
+}
+
+func TestVerifyWebhook(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	body := `{"channel":"sms","message_uuid":"aaaaaaaa-bbbb-cccc-dddd-0123456789ab","to":"447700900000","from":"447700900001","text":"Hello"}`
+	hash := sha256.Sum256([]byte(body))
+	payloadHash := hex.EncodeToString(hash[:])
+	vlt := map[string]interface{}{"application_id": "app", "signature_secret": "secret"}
+	tests := []struct {
+		name          string
+		body          string
+		authorization string
+		credential    map[string]interface{}
+		unverified    bool
+		wantErr       bool
+	}{
+		{
+			name:          "signed message",
+			body:          body,
+			authorization: "Bearer " + sign(t, "secret", jwt.MapClaims{"iat": time.Now().Unix(), "application_id": "app", "payload_hash": payloadHash}),
+		},
+		{
+			name:          "forged message",
+			body:          strings.Replace(body, "Hello", "STOP", 1),
+			authorization: "Bearer " + sign(t, "secret", jwt.MapClaims{"iat": time.Now().Unix(), "application_id": "app", "payload_hash": payloadHash}),
+			wantErr:       true,
+		},
+		{
+			name:          "token without payload hash",
+			body:          body,
+			authorization: "Bearer " + sign(t, "secret", jwt.MapClaims{"iat": time.Now().Unix(), "application_id": "app"}),
+			wantErr:       true,
+		},
+		{
+			name:          "signed with another secret",
+			body:          body,
+			authorization: "Bearer " + sign(t, "other", jwt.MapClaims{"iat": time.Now().Unix(), "application_id": "app", "payload_hash": payloadHash}),
+			wantErr:       true,
+		},
+		{
+			name:          "signed for another application",
+			body:          body,
+			authorization: "Bearer " + sign(t, "secret", jwt.MapClaims{"iat": time.Now().Unix(), "application_id": "other", "payload_hash": payloadHash}),
+			wantErr:       true,
+		},
+		{
+			name:          "replayed signature",
+			body:          body,
+			authorization: "Bearer " + sign(t, "secret", jwt.MapClaims{"iat": time.Now().Add(-time.Hour).Unix(), "application_id": "app", "payload_hash": payloadHash}),
+			wantErr:       true,
+		},
+		{
+			name:    "missing signature",
+			body:    body,
+			wantErr: true,
+		},
+		{
+			name:       "credential without signature secret",
+			body:       body,
+			credential: map[string]interface{}{"application_id": "app"},
+			wantErr:    true,
+		},
+		{
+			name:       "credential without signature secret while unverified webhooks are accepted",
+			body:       body,
+			credential: map[string]interface{}{"application_id": "app"},
+			unverified: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/talk/sms/vonage/prj/1/key", strings.NewReader(tt.body))
+			if tt.authorization != "" {
+				c.Request.Header.Set("Authorization", tt.authorization)
+			}
+			if tt.credential == nil {
+				tt.credential = vlt
+			}
+			sms := newSms()
+			sms.appCfg.UnverifiedWebhooks = tt.unverified
+			err := sms.VerifyWebhook(c, credential(t, tt.credential))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestReceiveMessage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name    string
+		body    string
+		want    []*internal_type.SmsMessage
+		wantErr bool
+	}{
+		{
+			name: "inbound sms",
+			body: `{"channel":"sms","message_uuid":"uuid-1","to":"447700900000","from":"447700900001","timestamp":"2025-02-03T12:14:25Z","text":"Hello","message_type":"text"}`,
+			want: []*internal_type.SmsMessage{{Id: "uuid-1", From: "447700900001", To: "447700900000", Text: "Hello", Time: time.Date(2025, 2, 3, 12, 14, 25, 0, time.UTC)}},
+		},
+		{
+			name: "status of a sent message",
+			body: `{"channel":"sms","message_uuid":"uuid-2","to":"447700900001","from":"447700900000","timestamp":"2025-02-03T12:14:25Z","status":"delivered"}`,
+			want: nil,
+		},
+		{
+			name: "message of another channel",
+			body: `{"channel":"whatsapp","message_uuid":"uuid-3","to":"447700900000","from":"447700900001","timestamp":"2025-02-03T12:14:25Z","text":"Hello"}`,
+			want: nil,
+		},
+		{
+			name:    "missing message uuid",
+			body:    `{"channel":"sms","text":"Hello"}`,
+			wantErr: true,
+		},
+		{
+			name:    "malformed message",
+			body:    `{"channel":`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/talk/sms/vonage/prj/1/key", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			messages, err := newSms().ReceiveMessage(c)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, messages)
+		})
+	}
+}
+
+func TestSendMessage(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	privateKey := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+
+	var received map[string]interface{}
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &received)
+		if received["text"] == "fail" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	defer func(url string) { apiUrl = url }(apiUrl)
+	apiUrl = server.URL
+
+	sms := newSms()
+	vlt := credential(t, map[string]interface{}{"application_id": "app", "private_key": privateKey})
+	require.NoError(t, sms.SendMessage(context.Background(), vlt, "+447700900000", "+447700900001", "Your booking link"))
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	require.True(t, ok)
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		return &key.PublicKey, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "app", claims["application_id"])
+	assert.Equal(t, map[string]interface{}{"channel": "sms", "message_type": "text", "from": "447700900000", "to": "447700900001", "text": "Your booking link"}, received)
+
+	assert.Error(t, sms.SendMessage(context.Background(), vlt, "+447700900000", "+447700900001", "fail"))
+	assert.Error(t, sms.SendMessage(context.Background(), credential(t, map[string]interface{}{"application_id": "app"}), "+447700900000", "+447700900001", "hello"))
+}
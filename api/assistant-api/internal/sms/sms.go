@@ -0,0 +1,110 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_sms_factory
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_twilio_sms "github.com/rapidaai/api/assistant-api/internal/sms/internal/twilio"
+	internal_vonage_sms "github.com/rapidaai/api/assistant-api/internal/sms/internal/vonage"
+	internal_text_session "github.com/rapidaai/api/assistant-api/internal/text_session"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+)
+
+type Sms string
+
+const (
+	Twilio Sms = "twilio"
+	Vonage Sms = "vonage"
+)
+
+// ConversationWindow is how long the conversation of a user is kept, the messages of the user
+// within the window since the last message resume it
+const ConversationWindow = 24 * time.Hour
+
+// TextChannel is the text session of sms users, the providers send at most 1600 characters as one
+// concatenated sms
+var TextChannel = internal_text_session.Channel{Name: "sms", MaxMessageLength: 1600}
+
+func (s Sms) String() string {
+	return string(s)
+}
+
+func GetSms(s Sms, cfg *config.AssistantConfig, logger commons.Logger) (internal_type.Sms, error) {
+	switch s {
+	case Twilio:
+		return internal_twilio_sms.NewTwilioSms(cfg, logger)
+	case Vonage:
+		return internal_vonage_sms.NewVonageSms(cfg, logger)
+	default:
+		return nil, errors.New("illegal sms provider")
+	}
+}
+
+// Key is where the conversation of a sms user is kept for the conversation window, the messages
+// of the user within the window resume it
+func Key(assistantId uint64, identifier string) string {
+	return fmt.Sprintf("sms::%d::%s", assistantId, identifier)
+}
+
+// keywords of the carriers, a user replying with one of them stops or allows the messages again
+var (
+	optOutKeywords = []string{"STOP", "STOPALL", "UNSUBSCRIBE", "CANCEL", "END", "QUIT"}
+	optInKeywords  = []string{"START", "UNSTOP"}
+)
+
+// OptOutKeyword returns the opt-out keyword the message consists of
+func OptOutKeyword(text string) (string, bool) {
+	return keyword(text, optOutKeywords)
+}
+
+// OptInKeyword returns the opt-in keyword the message consists of
+func OptInKeyword(text string) (string, bool) {
+	return keyword(text, optInKeywords)
+}
+
+// keyword matches the whole message, a keyword within a sentence is not an instruction
+func keyword(text string, keywords []string) (string, bool) {
+	word := strings.ToUpper(strings.Trim(strings.TrimSpace(text), ".!"))
+	for _, k := range keywords {
+		if word == k {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// PhoneNumber is the number in E.164 the opt-outs are kept by, the providers and the callers write
+// the same number with or without the plus, the international prefix, spaces and dashes. Sender
+// ids which are not a number are kept as they are.
+func PhoneNumber(number string) string {
+	number = strings.TrimSpace(number)
+	international := strings.HasPrefix(number, "+")
+	digits := make([]rune, 0, len(number))
+	for _, r := range strings.TrimPrefix(number, "+") {
+		switch {
+		case r >= '0' && r <= '9':
+			digits = append(digits, r)
+		case r == ' ' || r == '-' || r == '.' || r == '(' || r == ')':
+		default:
+			return number
+		}
+	}
+	if len(digits) == 0 {
+		return number
+	}
+	e164 := string(digits)
+	if !international {
+		e164 = strings.TrimPrefix(e164, "00")
+	}
+	return "+" + e164
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_sms_factory
+
+import (
+	"testing"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSms(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	for _, provider := range []Sms{Twilio, Vonage} {
+		sms, err := GetSms(provider, nil, logger)
+		require.NoError(t, err)
+		assert.NotNil(t, sms)
+	}
+	_, err := GetSms("plivo", nil, logger)
+	assert.Error(t, err)
+}
+
+func TestKey(t *testing.T) {
+	assert.Equal(t, "sms::12::twilio-sms-production-+15551234567-1-2", Key(12, "twilio-sms-production-+15551234567-1-2"))
+}
+
+func TestKeyword(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		wantOptOut string
+		wantOptIn  string
+	}{
+		{name: "stop", text: "STOP", wantOptOut: "STOP"},
+		{name: "lower case with spaces", text: "  unsubscribe ", wantOptOut: "UNSUBSCRIBE"},
+		{name: "with punctuation", text: "Stop!", wantOptOut: "STOP"},
+		{name: "start", text: "start", wantOptIn: "START"},
+		{name: "unstop", text: "UNSTOP", wantOptIn: "UNSTOP"},
+		{name: "keyword within a sentence", text: "please do not stop"},
+		{name: "message", text: "when do you open?"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			optOut, isOptOut := OptOutKeyword(tt.text)
+			assert.Equal(t, tt.wantOptOut, optOut)
+			assert.Equal(t, tt.wantOptOut != "", isOptOut)
+			optIn, isOptIn := OptInKeyword(tt.text)
+			assert.Equal(t, tt.wantOptIn, optIn)
+			assert.Equal(t, tt.wantOptIn != "", isOptIn)
+		})
+	}
+}
+
+func TestPhoneNumber(t *testing.T) {
+	tests := []struct {
+		name   string
+		number string
+		want   string
+	}{
+		{name: "e164", number: "+15551234567", want: "+15551234567"},
+		{name: "without plus", number: "15551234567", want: "+15551234567"},
+		{name: "formatted", number: " +1 (555) 123-4567 ", want: "+15551234567"},
+		{name: "international prefix", number: "0044 20 7946 0958", want: "+442079460958"},
+		{name: "sender id", number: "RAPIDA", want: "RAPIDA"},
+		{name: "empty", number: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, PhoneNumber(tt.number))
+		})
+	}
+}
@@ -0,0 +1,277 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_speech_cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/storages"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+const (
+	SpeechCacheOptionsKeyEnable = "tts_cache.enable"
+
+	// sentences synthesized this often are rendered into the cache
+	defaultMinRepeat = 3
+	// longer sentences are unlikely to repeat
+	defaultMaxLength = 200
+
+	// the index expires so rendered audio which is not used any more is not looked up forever
+	indexTTL = 7 * 24 * time.Hour
+	countTTL = 24 * time.Hour
+)
+
+// Renderer synthesizes text outside of a conversation and returns the complete audio
+type Renderer func(ctx context.Context, text string) ([]byte, error)
+
+// Voice is everything which changes the audio synthesized for a text
+type Voice struct {
+	Provider    string
+	Options     utils.Option
+	AudioConfig *protos.AudioConfig
+}
+
+// SpeechCache keeps synthesized audio of greetings, behavior messages and frequently repeated
+// sentences so they are not synthesized by the provider on every call. The audio is kept in
+// storage, redis indexes what has been rendered and counts how often a sentence is synthesized.
+type SpeechCache interface {
+	// Lookup returns the cached audio of text spoken in the utterance. Cached audio is only returned
+	// while nothing of the utterance was sent to the provider, it would overtake the synthesized audio.
+	Lookup(ctx context.Context, contextId, text string) ([]byte, bool)
+
+	// Synthesized records text of the utterance sent to the provider, text repeated often enough is
+	// rendered into the cache in background
+	Synthesized(ctx context.Context, contextId, text string)
+
+	// Repeated counts a static message spoken by the assistant, messages repeated often enough are
+	// rendered into the cache in background. Messages rendered from templates are rarely the same.
+	// The text of the utterance synthesized afterwards is not counted again.
+	Repeated(ctx context.Context, contextId, text string)
+
+	// CachedOnly reports whether the audio of the utterance came from the cache only, the provider
+	// never completes an utterance it has not synthesized
+	CachedOnly(contextId string) bool
+
+	// Warm renders the text into the cache unless it is cached already
+	Warm(ctx context.Context, text string) error
+}
+
+type speechCache struct {
+	logger  commons.Logger
+	storage storages.Storage
+	redis   connectors.RedisConnector
+	render  Renderer
+
+	scope     string
+	voice     string
+	enabled   bool
+	minRepeat int64
+	maxLength int
+
+	mu           sync.Mutex
+	synthesizing string
+	played       string
+	// utterance of the static message counted as a whole
+	static string
+}
+
+// NewSpeechCache creates the cache of a voice, scope separates the audio of projects. The options
+// of the voice configure the cache, it is enabled unless disabled explicitly.
+func NewSpeechCache(logger commons.Logger, storage storages.Storage, redis connectors.RedisConnector, scope string, voice Voice, render Renderer) SpeechCache {
+	cache := &speechCache{
+		logger:    logger,
+		storage:   storage,
+		redis:     redis,
+		render:    render,
+		scope:     scope,
+		voice:     voice.fingerprint(),
+		enabled:   storage != nil && redis != nil && render != nil,
+		minRepeat: defaultMinRepeat,
+		maxLength: defaultMaxLength,
+	}
+	if enabled, err := voice.Options.GetBool(SpeechCacheOptionsKeyEnable); err == nil && !enabled {
+		cache.enabled = false
+	}
+	if minRepeat, err := voice.Options.GetUint32("tts_cache.min_repeat"); err == nil {
+		cache.minRepeat = int64(minRepeat)
+	}
+	if maxLength, err := voice.Options.GetUint32("tts_cache.max_length"); err == nil && maxLength > 0 {
+		cache.maxLength = int(maxLength)
+	}
+	return cache
+}
+
+// fingerprint describes the voice, credentials and options of the cache itself do not change the audio
+func (v Voice) fingerprint() string {
+	keys := make([]string, 0, len(v.Options))
+	for k := range v.Options {
+		if strings.HasPrefix(k, "rapida.") || strings.HasPrefix(k, "tts_cache.") {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var builder strings.Builder
+	builder.WriteString(v.Provider)
+	for _, k := range keys {
+		fmt.Fprintf(&builder, "\x00%s=%v", k, v.Options[k])
+	}
+	fmt.Fprintf(&builder, "\x00%d\x00%s\x00%d",
+		v.AudioConfig.GetSampleRate(), v.AudioConfig.GetAudioFormat().String(), v.AudioConfig.GetChannels())
+	return builder.String()
+}
+
+// normalize collapses whitespace, text which only differs by spacing sounds the same
+func normalize(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func (c *speechCache) key(text string) string {
+	sum := sha256.Sum256([]byte(c.voice + "\x00" + text))
+	return hex.EncodeToString(sum[:])
+}
+
+func (c *speechCache) path(key string) string {
+	return fmt.Sprintf("tts-cache/%s/%s", c.scope, key)
+}
+
+func (c *speechCache) indexKey(key string) string {
+	return fmt.Sprintf("TTS_CACHE::%s::%s", c.scope, key)
+}
+
+func (c *speechCache) countKey(key string) string {
+	return fmt.Sprintf("TTS_CACHE_COUNT::%s::%s", c.scope, key)
+}
+
+func (c *speechCache) cached(ctx context.Context, key string) bool {
+	res := c.redis.Cmd(ctx, "EXISTS", []string{c.indexKey(key)})
+	if res == nil || res.HasError() {
+		return false
+	}
+	exists, ok := res.Result.(int64)
+	return ok && exists > 0
+}
+
+func (c *speechCache) Lookup(ctx context.Context, contextId, text string) ([]byte, bool) {
+	text = normalize(text)
+	if !c.enabled || text == "" {
+		return nil, false
+	}
+	c.mu.Lock()
+	synthesizing := c.synthesizing == contextId
+	c.mu.Unlock()
+	if synthesizing {
+		return nil, false
+	}
+
+	// the index avoids a storage round trip for text which was never rendered
+	key := c.key(text)
+	if !c.cached(ctx, key) {
+		return nil, false
+	}
+	audio := c.storage.Get(ctx, c.path(key))
+	if audio.Error != nil || len(audio.Data) == 0 {
+		c.logger.Warnf("speech cache: unable to read cached audio %s %v", key, audio.Error)
+		return nil, false
+	}
+	c.mu.Lock()
+	c.played = contextId
+	c.mu.Unlock()
+	return audio.Data, true
+}
+
+func (c *speechCache) CachedOnly(contextId string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.played == contextId && c.synthesizing != contextId
+}
+
+func (c *speechCache) Synthesized(ctx context.Context, contextId, text string) {
+	c.mu.Lock()
+	c.synthesizing = contextId
+	static := c.static == contextId
+	c.mu.Unlock()
+
+	text = normalize(text)
+	if static || len(text) > c.maxLength {
+		return
+	}
+	c.repeated(ctx, text)
+}
+
+func (c *speechCache) Repeated(ctx context.Context, contextId, text string) {
+	c.mu.Lock()
+	c.static = contextId
+	c.mu.Unlock()
+	c.repeated(ctx, normalize(text))
+}
+
+// repeated counts how often the text is spoken, it is rendered once when it repeats often enough
+func (c *speechCache) repeated(ctx context.Context, text string) {
+	if !c.enabled || c.minRepeat == 0 || text == "" {
+		return
+	}
+	// rendering completes even when the conversation ends meanwhile
+	ctx = context.WithoutCancel(ctx)
+	utils.Go(ctx, func() {
+		key := c.key(text)
+		res := c.redis.Cmd(ctx, "INCR", []string{c.countKey(key)})
+		if res == nil || res.HasError() {
+			return
+		}
+		count, ok := res.Result.(int64)
+		if !ok {
+			return
+		}
+		if count == 1 {
+			c.redis.Cmd(ctx, "EXPIRE", []string{c.countKey(key), fmt.Sprintf("%d", int64(countTTL.Seconds()))})
+		}
+		if count != c.minRepeat {
+			return
+		}
+		if err := c.Warm(ctx, text); err != nil {
+			c.logger.Warnf("speech cache: unable to render repeated text %v", err)
+		}
+	})
+}
+
+func (c *speechCache) Warm(ctx context.Context, text string) error {
+	text = normalize(text)
+	if !c.enabled || text == "" {
+		return nil
+	}
+	key := c.key(text)
+	if c.cached(ctx, key) {
+		return nil
+	}
+	audio, err := c.render(ctx, text)
+	if err != nil {
+		return err
+	}
+	if len(audio) == 0 {
+		return errors.New("speech cache: no audio rendered")
+	}
+	if out := c.storage.Store(ctx, c.path(key), audio); out.Error != nil {
+		return out.Error
+	}
+	if res := c.redis.Cmd(ctx, "SET", []string{c.indexKey(key), c.path(key), "EX", fmt.Sprintf("%d", int64(indexTTL.Seconds()))}); res == nil || res.HasError() {
+		return errors.New("speech cache: unable to index rendered audio")
+	}
+	return nil
+}
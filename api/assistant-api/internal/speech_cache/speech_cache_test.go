@@ -0,0 +1,238 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_speech_cache
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/storages"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStorage struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	gets    int
+}
+
+func (s *memoryStorage) Name() string { return "memory" }
+
+func (s *memoryStorage) Store(ctx context.Context, key string, fileContent []byte) storages.StorageOutput {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = fileContent
+	return storages.StorageOutput{CompletePath: key}
+}
+
+func (s *memoryStorage) Get(ctx context.Context, key string) storages.GetStorageOutput {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gets++
+	data, ok := s.objects[key]
+	if !ok {
+		return storages.GetStorageOutput{Error: errors.New("not found")}
+	}
+	return storages.GetStorageOutput{Data: data}
+}
+
+func (s *memoryStorage) GetUrl(ctx context.Context, key string) storages.StorageOutput {
+	return storages.StorageOutput{CompletePath: key}
+}
+
+type memoryRedis struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func (r *memoryRedis) Connect(ctx context.Context) error    { return nil }
+func (r *memoryRedis) Name() string                         { return "memory" }
+func (r *memoryRedis) IsConnected(ctx context.Context) bool { return true }
+func (r *memoryRedis) Disconnect(ctx context.Context) error { return nil }
+func (r *memoryRedis) Cmds(ctx context.Context, cmd string, args *[]string) *connectors.RedisResponse {
+	return &connectors.RedisResponse{Err: errors.New("not supported")}
+}
+
+func (r *memoryRedis) Cmd(ctx context.Context, cmd string, args []string) *connectors.RedisResponse {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	switch cmd {
+	case "EXISTS":
+		if _, ok := r.values[args[0]]; ok {
+			return &connectors.RedisResponse{Result: int64(1)}
+		}
+		return &connectors.RedisResponse{Result: int64(0)}
+	case "INCR":
+		count, _ := strconv.ParseInt(r.values[args[0]], 10, 64)
+		count++
+		r.values[args[0]] = strconv.FormatInt(count, 10)
+		return &connectors.RedisResponse{Result: count}
+	case "SET":
+		r.values[args[0]] = args[1]
+		return &connectors.RedisResponse{Result: "OK"}
+	case "EXPIRE":
+		return &connectors.RedisResponse{Result: int64(1)}
+	}
+	return &connectors.RedisResponse{Err: errors.New("not supported")}
+}
+
+type testRenderer struct {
+	mu       sync.Mutex
+	rendered []string
+}
+
+func (r *testRenderer) render(ctx context.Context, text string) ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rendered = append(r.rendered, text)
+	return []byte("audio:" + text), nil
+}
+
+func (r *testRenderer) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.rendered)
+}
+
+func testVoice(opts utils.Option) Voice {
+	return Voice{
+		Provider:    "cartesia",
+		Options:     opts,
+		AudioConfig: &protos.AudioConfig{SampleRate: 8000, AudioFormat: protos.AudioConfig_MuLaw8, Channels: 1},
+	}
+}
+
+func newTestCache(opts utils.Option) (*speechCache, *memoryStorage, *testRenderer) {
+	logger, _ := commons.NewApplicationLogger()
+	storage := &memoryStorage{objects: make(map[string][]byte)}
+	renderer := &testRenderer{}
+	cache := NewSpeechCache(logger, storage, &memoryRedis{values: make(map[string]string)}, "1", testVoice(opts), renderer.render)
+	return cache.(*speechCache), storage, renderer
+}
+
+func TestSpeechCache_WarmAndLookup(t *testing.T) {
+	cache, storage, renderer := newTestCache(utils.Option{})
+	ctx := context.Background()
+
+	_, ok := cache.Lookup(ctx, "greeting", "Hello, how can I help?")
+	assert.False(t, ok)
+	assert.Equal(t, 0, storage.gets, "text which was never rendered is not read from storage")
+
+	require.NoError(t, cache.Warm(ctx, "Hello,  how can I help? "))
+	require.NoError(t, cache.Warm(ctx, "Hello, how can I help?"))
+	assert.Equal(t, 1, renderer.count(), "cached text is not rendered again")
+
+	audio, ok := cache.Lookup(ctx, "greeting", " Hello, how can I help?")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("audio:Hello, how can I help?"), audio)
+}
+
+func TestSpeechCache_NotOvertakingSynthesizedAudio(t *testing.T) {
+	cache, _, _ := newTestCache(utils.Option{"tts_cache.min_repeat": "0"})
+	ctx := context.Background()
+	require.NoError(t, cache.Warm(ctx, "Sure."))
+
+	cache.Synthesized(ctx, "answer", "Let me explain.")
+	_, ok := cache.Lookup(ctx, "answer", "Sure.")
+	assert.False(t, ok)
+	assert.False(t, cache.CachedOnly("answer"))
+
+	_, ok = cache.Lookup(ctx, "next", "Sure.")
+	assert.True(t, ok)
+	assert.True(t, cache.CachedOnly("next"))
+
+	cache.Synthesized(ctx, "next", "Let me explain.")
+	assert.False(t, cache.CachedOnly("next"))
+}
+
+func TestSpeechCache_RepeatedSentences(t *testing.T) {
+	cache, _, renderer := newTestCache(utils.Option{"tts_cache.min_repeat": "2", "tts_cache.max_length": "20"})
+	ctx := context.Background()
+
+	cache.Synthesized(ctx, "1", "One moment please.")
+	cache.Synthesized(ctx, "2", "This sentence is far too long to be cached.")
+	cache.Synthesized(ctx, "3", "This sentence is far too long to be cached.")
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 0, renderer.count())
+
+	cache.Synthesized(ctx, "4", "One moment  please.")
+	assert.Eventually(t, func() bool { return renderer.count() == 1 }, time.Second, 10*time.Millisecond)
+
+	_, ok := cache.Lookup(ctx, "5", "One moment please.")
+	assert.True(t, ok)
+}
+
+func TestSpeechCache_RepeatedStaticMessages(t *testing.T) {
+	cache, _, renderer := newTestCache(utils.Option{"tts_cache.min_repeat": "2", "tts_cache.max_length": "5"})
+	ctx := context.Background()
+
+	// personalized greetings never repeat
+	cache.Repeated(ctx, "1", "Hello Jane, thank you for calling.")
+	cache.Repeated(ctx, "2", "Hello John, thank you for calling.")
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 0, renderer.count())
+
+	// static messages are not limited in length
+	cache.Repeated(ctx, "3", "Hello Jane, thank you for calling.")
+	assert.Eventually(t, func() bool { return renderer.count() == 1 }, time.Second, 10*time.Millisecond)
+}
+
+func TestSpeechCache_StaticMessageCountedOnce(t *testing.T) {
+	cache, _, renderer := newTestCache(utils.Option{"tts_cache.min_repeat": "2"})
+	ctx := context.Background()
+
+	// the static message missed the cache and is synthesized by the provider
+	cache.Repeated(ctx, "greeting", "Hello.")
+	cache.Synthesized(ctx, "greeting", "Hello.")
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 0, renderer.count())
+
+	cache.Repeated(ctx, "greeting-2", "Hello.")
+	cache.Synthesized(ctx, "greeting-2", "Hello.")
+	assert.Eventually(t, func() bool { return renderer.count() == 1 }, time.Second, 10*time.Millisecond)
+}
+
+func TestSpeechCache_KeyFollowsVoice(t *testing.T) {
+	base, _, _ := newTestCache(utils.Option{"speak.voice.id": "a", "rapida.credential_id": "1"})
+	tests := []struct {
+		name  string
+		voice Voice
+		same  bool
+	}{
+		{name: "credential and cache options do not change the audio", voice: testVoice(utils.Option{"speak.voice.id": "a", "rapida.credential_id": "2", "tts_cache.min_repeat": "5"}), same: true},
+		{name: "voice", voice: testVoice(utils.Option{"speak.voice.id": "b"})},
+		{name: "provider", voice: Voice{Provider: "elevenlabs", Options: utils.Option{"speak.voice.id": "a"}, AudioConfig: testVoice(nil).AudioConfig}},
+		{name: "audio config", voice: Voice{Provider: "cartesia", Options: utils.Option{"speak.voice.id": "a"}, AudioConfig: &protos.AudioConfig{SampleRate: 16000, AudioFormat: protos.AudioConfig_LINEAR16, Channels: 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			other := NewSpeechCache(base.logger, base.storage, base.redis, "1", tt.voice, base.render).(*speechCache)
+			assert.Equal(t, tt.same, base.key("Hello") == other.key("Hello"))
+		})
+	}
+}
+
+func TestSpeechCache_Disabled(t *testing.T) {
+	cache, _, renderer := newTestCache(utils.Option{SpeechCacheOptionsKeyEnable: "false"})
+	ctx := context.Background()
+	require.NoError(t, cache.Warm(ctx, "Hello"))
+	_, ok := cache.Lookup(ctx, "greeting", "Hello")
+	assert.False(t, ok)
+	assert.Equal(t, 0, renderer.count())
+
+	withoutRedis := NewSpeechCache(cache.logger, cache.storage, nil, "1", testVoice(utils.Option{}), renderer.render)
+	require.NoError(t, withoutRedis.Warm(ctx, "Hello"))
+	assert.Equal(t, 0, renderer.count())
+}
@@ -0,0 +1,306 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_asterisk_telephony
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_asterisk "github.com/rapidaai/api/assistant-api/internal/telephony/internal/asterisk/internal"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+)
+
+const (
+	// asterisk plays audio as it arrives, it is sent as 20ms frames of 8khz 16 bit audio
+	framePeriod = 20 * time.Millisecond
+	frameSize   = 320
+	// asterisk sends the uuid right after it connected
+	uuidTimeout = 5 * time.Second
+	// frames waiting for the streamer, older audio is dropped when it does not keep up
+	frameQueueSize = 256
+)
+
+// the audio socket server of the process, streamers of the telephony find their connection in it
+var (
+	running   *audioSocketServer
+	runningMu sync.RWMutex
+)
+
+func current() (*audioSocketServer, error) {
+	runningMu.RLock()
+	defer runningMu.RUnlock()
+	if running == nil {
+		return nil, errors.New("audio socket server is not listening")
+	}
+	return running, nil
+}
+
+// Listen accepts the audio sockets of asterisk, AudioSocket() of the dialplan or external media
+// of ari with audiosocket encapsulation, and hands them to the handler until the context is done.
+func Listen(ctx context.Context, cfg *config.AssistantConfig, logger commons.Logger, handler internal_type.AudioSocketHandler) error {
+	server, err := newAudioSocketServer(ctx, cfg.AudioSocketConfig, logger, handler)
+	if err != nil {
+		return err
+	}
+	runningMu.Lock()
+	running = server
+	runningMu.Unlock()
+
+	logger.Infof("audio socket server listening on %s", server.listener.Addr())
+	utils.Go(ctx, server.serve)
+	utils.Go(ctx, func() {
+		<-ctx.Done()
+		runningMu.Lock()
+		if running == server {
+			running = nil
+		}
+		runningMu.Unlock()
+		server.close()
+	})
+	return nil
+}
+
+type audioSocketServer struct {
+	ctx      context.Context
+	logger   commons.Logger
+	handler  internal_type.AudioSocketHandler
+	listener net.Listener
+
+	mu          sync.Mutex
+	connections map[string]*connection
+}
+
+func newAudioSocketServer(ctx context.Context, cfg config.AudioSocketConfig, logger commons.Logger, handler internal_type.AudioSocketHandler) (*audioSocketServer, error) {
+	if cfg.Listen == "" {
+		return nil, errors.New("audio socket listen address is not configured")
+	}
+	listener, err := net.Listen("tcp", cfg.Listen)
+	if err != nil {
+		return nil, err
+	}
+	return &audioSocketServer{
+		ctx:         ctx,
+		logger:      logger,
+		handler:     handler,
+		listener:    listener,
+		connections: map[string]*connection{},
+	}, nil
+}
+
+func (s *audioSocketServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			s.logger.Warnf("unable to accept audio socket: %v", err)
+			continue
+		}
+		utils.Go(s.ctx, func() {
+			s.accept(conn)
+		})
+	}
+}
+
+// accept reads the uuid of the call and runs the handler for it, the socket is closed once the
+// handler returns
+func (s *audioSocketServer) accept(conn net.Conn) {
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(uuidTimeout))
+	msg, err := internal_asterisk.ReadMessage(conn)
+	if err != nil {
+		s.logger.Warnf("audio socket from %s closed before its uuid: %v", conn.RemoteAddr(), err)
+		return
+	}
+	id, err := msg.Uuid()
+	if err != nil {
+		s.logger.Warnf("illegal audio socket from %s: %v", conn.RemoteAddr(), err)
+		return
+	}
+	conn.SetReadDeadline(time.Time{})
+
+	c := newConnection(s.logger, id, conn)
+	s.mu.Lock()
+	if _, ok := s.connections[id]; ok {
+		s.mu.Unlock()
+		s.logger.Warnf("audio socket %s is already connected", id)
+		return
+	}
+	s.connections[id] = c
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.connections, id)
+		s.mu.Unlock()
+		c.Close()
+	}()
+
+	go c.receive()
+	go c.send()
+	s.handler.Connected(s.ctx, id, conn.RemoteAddr())
+}
+
+func (s *audioSocketServer) connection(id string) *connection {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.connections[id]
+}
+
+func (s *audioSocketServer) close() {
+	s.listener.Close()
+	s.mu.Lock()
+	connections := make([]*connection, 0, len(s.connections))
+	for _, c := range s.connections {
+		connections = append(connections, c)
+	}
+	s.mu.Unlock()
+	for _, c := range connections {
+		c.Hangup()
+	}
+}
+
+// frame is audio or a keypad digit of the caller
+type frame struct {
+	audio []byte
+	digit string
+}
+
+// connection is the audio socket of a call
+type connection struct {
+	logger commons.Logger
+	id     string
+	conn   net.Conn
+
+	mu     sync.Mutex
+	output bytes.Buffer
+	// writes of audio and hangup are not interleaved
+	writeMu sync.Mutex
+
+	frames    chan frame
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newConnection(logger commons.Logger, id string, conn net.Conn) *connection {
+	return &connection{
+		logger: logger,
+		id:     id,
+		conn:   conn,
+		frames: make(chan frame, frameQueueSize),
+		done:   make(chan struct{}),
+	}
+}
+
+func (c *connection) receive() {
+	defer c.Close()
+	for {
+		msg, err := internal_asterisk.ReadMessage(c.conn)
+		if err != nil {
+			return
+		}
+		switch msg.Kind {
+		case internal_asterisk.KindAudio:
+			c.push(frame{audio: msg.Payload})
+		case internal_asterisk.KindDtmf:
+			if len(msg.Payload) > 0 {
+				c.push(frame{digit: string(msg.Payload[:1])})
+			}
+		case internal_asterisk.KindHangup:
+			return
+		case internal_asterisk.KindError:
+			c.logger.Warnf("asterisk reported error %x on audio socket %s", msg.Payload, c.id)
+			return
+		}
+	}
+}
+
+func (c *connection) push(f frame) {
+	select {
+	case c.frames <- f:
+	case <-c.done:
+	default:
+		c.logger.Debugf("audio socket queue is full, dropping audio of the caller")
+	}
+}
+
+// send paces the audio of the assistant, asterisk does not buffer what it receives
+func (c *connection) send() {
+	ticker := time.NewTicker(framePeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+		}
+		c.mu.Lock()
+		if c.output.Len() == 0 {
+			c.mu.Unlock()
+			continue
+		}
+		audio := append([]byte(nil), c.output.Next(frameSize)...)
+		c.mu.Unlock()
+		if err := c.write(internal_asterisk.NewAudioMessage(audio)); err != nil {
+			c.logger.Debugf("unable to send audio to audio socket %s: %v", c.id, err)
+		}
+	}
+}
+
+func (c *connection) write(msg *internal_asterisk.Message) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_, err := c.conn.Write(msg.Marshal())
+	return err
+}
+
+// Write queues 8khz 16 bit audio to play to the caller
+func (c *connection) Write(audio []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.output.Write(audio)
+}
+
+// Clear drops the audio which has not been played yet
+func (c *connection) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.output.Reset()
+}
+
+// Hangup asks asterisk to end the call and closes the socket
+func (c *connection) Hangup() error {
+	select {
+	case <-c.done:
+		return nil
+	default:
+	}
+	err := c.write(internal_asterisk.NewHangupMessage())
+	c.Close()
+	return err
+}
+
+func (c *connection) Frames() <-chan frame {
+	return c.frames
+}
+
+func (c *connection) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *connection) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		c.conn.Close()
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_asterisk_telephony
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_asterisk "github.com/rapidaai/api/assistant-api/internal/telephony/internal/asterisk/internal"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testHandler echoes the audio of the caller and hangs up on the first digit
+type testHandler struct {
+	server    *audioSocketServer
+	connected chan string
+	ended     chan string
+}
+
+func newTestHandler() *testHandler {
+	return &testHandler{connected: make(chan string, 1), ended: make(chan string, 1)}
+}
+
+func (h *testHandler) Connected(ctx context.Context, id string, source net.Addr) {
+	h.connected <- id
+	defer func() { h.ended <- id }()
+	c := h.server.connection(id)
+	if c == nil {
+		return
+	}
+	for {
+		select {
+		case <-c.Done():
+			return
+		case f := <-c.Frames():
+			if f.digit != "" {
+				c.Hangup()
+				return
+			}
+			c.Write(f.audio)
+		}
+	}
+}
+
+func newTestServer(t *testing.T, handler *testHandler) *audioSocketServer {
+	t.Helper()
+	logger, _ := commons.NewApplicationLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	server, err := newAudioSocketServer(ctx, config.AudioSocketConfig{Listen: "127.0.0.1:0"}, logger, handler)
+	require.NoError(t, err)
+	handler.server = server
+	go server.serve()
+	t.Cleanup(func() {
+		cancel()
+		server.close()
+	})
+	return server
+}
+
+func dial(t *testing.T, server *audioSocketServer) net.Conn {
+	t.Helper()
+	conn, err := net.Dial("tcp", server.listener.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	return conn
+}
+
+func TestAudioSocket(t *testing.T) {
+	handler := newTestHandler()
+	server := newTestServer(t, handler)
+	conn := dial(t, server)
+
+	id := uuid.New()
+	_, err := conn.Write(internal_asterisk.NewUuidMessage(id).Marshal())
+	require.NoError(t, err)
+	assert.Equal(t, id.String(), <-handler.connected)
+
+	// the audio of the caller is played back as 20ms frames
+	audio := make([]byte, 2*frameSize)
+	for i := range audio {
+		audio[i] = byte(i)
+	}
+	_, err = conn.Write(internal_asterisk.NewAudioMessage(audio).Marshal())
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		msg, err := internal_asterisk.ReadMessage(conn)
+		require.NoError(t, err)
+		assert.Equal(t, internal_asterisk.KindAudio, msg.Kind)
+		assert.Equal(t, audio[i*frameSize:(i+1)*frameSize], msg.Payload)
+	}
+
+	// the digit hangs up the call
+	_, err = conn.Write((&internal_asterisk.Message{Kind: internal_asterisk.KindDtmf, Payload: []byte("1")}).Marshal())
+	require.NoError(t, err)
+	msg, err := internal_asterisk.ReadMessage(conn)
+	require.NoError(t, err)
+	assert.Equal(t, internal_asterisk.KindHangup, msg.Kind)
+	assert.Equal(t, id.String(), <-handler.ended)
+	assert.Eventually(t, func() bool { return server.connection(id.String()) == nil }, time.Second, 10*time.Millisecond)
+}
+
+func TestAudioSocketHangupByCaller(t *testing.T) {
+	handler := newTestHandler()
+	server := newTestServer(t, handler)
+	conn := dial(t, server)
+
+	id := uuid.New()
+	_, err := conn.Write(internal_asterisk.NewUuidMessage(id).Marshal())
+	require.NoError(t, err)
+	<-handler.connected
+	require.NotNil(t, server.connection(id.String()))
+
+	_, err = conn.Write(internal_asterisk.NewHangupMessage().Marshal())
+	require.NoError(t, err)
+	assert.Equal(t, id.String(), <-handler.ended)
+}
+
+func TestAudioSocketWithoutUuid(t *testing.T) {
+	handler := newTestHandler()
+	server := newTestServer(t, handler)
+	conn := dial(t, server)
+
+	_, err := conn.Write(internal_asterisk.NewAudioMessage(make([]byte, frameSize)).Marshal())
+	require.NoError(t, err)
+
+	// the socket is closed without running the handler
+	_, err = internal_asterisk.ReadMessage(conn)
+	assert.Error(t, err)
+	assert.Empty(t, handler.connected)
+}
+
+func TestListenWithoutAddress(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	err := Listen(context.Background(), &config.AssistantConfig{}, logger, newTestHandler())
+	assert.Error(t, err)
+	_, err = current()
+	assert.Error(t, err)
+}
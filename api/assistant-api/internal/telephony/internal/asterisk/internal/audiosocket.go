@@ -0,0 +1,85 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_asterisk
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+)
+
+// Kind is the type of an audio socket message, every message is the kind, the length of the
+// payload as 16 bit big endian and the payload
+type Kind byte
+
+const (
+	KindHangup Kind = 0x00
+	KindUuid   Kind = 0x01
+	KindDtmf   Kind = 0x03
+	// signed linear 16 bit little endian, 8khz mono
+	KindAudio Kind = 0x10
+	KindError Kind = 0xff
+)
+
+const headerSize = 3
+
+type Message struct {
+	Kind    Kind
+	Payload []byte
+}
+
+// ReadMessage reads the next message of the audio socket
+func ReadMessage(r io.Reader) (*Message, error) {
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	msg := &Message{Kind: Kind(header[0])}
+	length := binary.BigEndian.Uint16(header[1:])
+	if length == 0 {
+		return msg, nil
+	}
+	msg.Payload = make([]byte, length)
+	if _, err := io.ReadFull(r, msg.Payload); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// Marshal encodes the message to write it to the audio socket
+func (m *Message) Marshal() []byte {
+	b := make([]byte, headerSize+len(m.Payload))
+	b[0] = byte(m.Kind)
+	binary.BigEndian.PutUint16(b[1:], uint16(len(m.Payload)))
+	copy(b[headerSize:], m.Payload)
+	return b
+}
+
+// Uuid is the call identifier asterisk sends as the first message
+func (m *Message) Uuid() (string, error) {
+	if m.Kind != KindUuid {
+		return "", fmt.Errorf("expected uuid message, got kind %#x", byte(m.Kind))
+	}
+	id, err := uuid.FromBytes(m.Payload)
+	if err != nil {
+		return "", fmt.Errorf("illegal uuid of audio socket: %w", err)
+	}
+	return id.String(), nil
+}
+
+func NewAudioMessage(audio []byte) *Message {
+	return &Message{Kind: KindAudio, Payload: audio}
+}
+
+func NewHangupMessage() *Message {
+	return &Message{Kind: KindHangup}
+}
+
+func NewUuidMessage(id uuid.UUID) *Message {
+	return &Message{Kind: KindUuid, Payload: id[:]}
+}
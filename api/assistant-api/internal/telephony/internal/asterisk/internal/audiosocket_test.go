@@ -0,0 +1,89 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_asterisk
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		message *Message
+		encoded []byte
+	}{
+		{
+			name:    "hangup",
+			message: NewHangupMessage(),
+			encoded: []byte{0x00, 0x00, 0x00},
+		},
+		{
+			name:    "dtmf",
+			message: &Message{Kind: KindDtmf, Payload: []byte("5")},
+			encoded: []byte{0x03, 0x00, 0x01, '5'},
+		},
+		{
+			name:    "audio",
+			message: NewAudioMessage([]byte{0x01, 0x02, 0x03, 0x04}),
+			encoded: []byte{0x10, 0x00, 0x04, 0x01, 0x02, 0x03, 0x04},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.encoded, tt.message.Marshal())
+			msg, err := ReadMessage(bytes.NewReader(tt.encoded))
+			require.NoError(t, err)
+			assert.Equal(t, tt.message.Kind, msg.Kind)
+			assert.Equal(t, len(tt.message.Payload), len(msg.Payload))
+			assert.True(t, bytes.Equal(tt.message.Payload, msg.Payload))
+		})
+	}
+}
+
+func TestReadMessageStream(t *testing.T) {
+	id := uuid.New()
+	var stream bytes.Buffer
+	stream.Write(NewUuidMessage(id).Marshal())
+	stream.Write(NewAudioMessage(make([]byte, 320)).Marshal())
+	stream.Write(NewHangupMessage().Marshal())
+
+	msg, err := ReadMessage(&stream)
+	require.NoError(t, err)
+	got, err := msg.Uuid()
+	require.NoError(t, err)
+	assert.Equal(t, id.String(), got)
+
+	msg, err = ReadMessage(&stream)
+	require.NoError(t, err)
+	assert.Equal(t, KindAudio, msg.Kind)
+	assert.Len(t, msg.Payload, 320)
+
+	msg, err = ReadMessage(&stream)
+	require.NoError(t, err)
+	assert.Equal(t, KindHangup, msg.Kind)
+
+	_, err = ReadMessage(&stream)
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestReadMessageTruncated(t *testing.T) {
+	_, err := ReadMessage(bytes.NewReader([]byte{0x10, 0x00, 0x04, 0x01}))
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestUuid(t *testing.T) {
+	_, err := NewAudioMessage([]byte{0x01}).Uuid()
+	assert.Error(t, err)
+
+	_, err = (&Message{Kind: KindUuid, Payload: []byte{0x01, 0x02}}).Uuid()
+	assert.Error(t, err)
+}
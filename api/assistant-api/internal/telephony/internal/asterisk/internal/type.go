@@ -0,0 +1,18 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_asterisk
+
+// OriginateRequest is the body of an ari originate, the variables are set on the channel
+type OriginateRequest struct {
+	Variables map[string]string `json:"variables"`
+}
+
+// Channel is the channel ari created for the call
+type Channel struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
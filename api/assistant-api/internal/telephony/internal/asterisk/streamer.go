@@ -0,0 +1,94 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_asterisk_telephony
+
+import (
+	"context"
+	"io"
+
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	internal_telephony_base "github.com/rapidaai/api/assistant-api/internal/telephony/internal/base"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+)
+
+// 1ms 16 bytes @ 8kHz 16 bit mono, 60ms of audio as silero can't process smaller chunk
+const inputBufferThreshold = 16 * 60
+
+type audioSocketStreamer struct {
+	streamer   internal_telephony_base.BaseTelephonyStreamer
+	logger     commons.Logger
+	connection *connection
+	connected  bool
+}
+
+func newAudioSocketStreamer(logger commons.Logger, c *connection, assistant *internal_assistant_entity.Assistant, conversation *internal_conversation_entity.AssistantConversation, vlt *protos.VaultCredential,
+) streamers.Streamer {
+	return &audioSocketStreamer{
+		logger:     logger,
+		connection: c,
+		streamer:   internal_telephony_base.NewBaseTelephonyStreamer(logger, nil, assistant, conversation, vlt),
+	}
+}
+
+func (as *audioSocketStreamer) Context() context.Context {
+	return as.streamer.Context()
+}
+
+func (as *audioSocketStreamer) Recv() (*protos.AssistantMessagingRequest, error) {
+	// asterisk answered the call before it opened the socket, the assistant is connected right away
+	if !as.connected {
+		as.connected = true
+		return as.streamer.CreateConnectionRequest(internal_audio.NewLinear8khzMonoAudioConfig(), internal_audio.NewLinear8khzMonoAudioConfig())
+	}
+
+	select {
+	case <-as.connection.Done():
+		as.streamer.Cancel()
+		return nil, io.EOF
+	case <-as.Context().Done():
+		return nil, io.EOF
+	case f := <-as.connection.Frames():
+		if f.digit != "" {
+			return as.streamer.CreateDtmfRequest(f.digit), nil
+		}
+		as.streamer.LockInputAudioBuffer()
+		defer as.streamer.UnlockInputAudioBuffer()
+		as.streamer.InputBuffer().Write(f.audio)
+		if as.streamer.InputBuffer().Len() >= inputBufferThreshold {
+			audioRequest := as.streamer.CreateVoiceRequest(as.streamer.InputBuffer().Bytes())
+			as.streamer.InputBuffer().Reset()
+			return audioRequest, nil
+		}
+		return nil, nil
+	}
+}
+
+func (as *audioSocketStreamer) Send(response *protos.AssistantMessagingResponse) error {
+	switch data := response.GetData().(type) {
+	case *protos.AssistantMessagingResponse_Assistant:
+		switch content := data.Assistant.Message.(type) {
+		case *protos.AssistantConversationAssistantMessage_Audio:
+			// the connection paces the audio as 20ms frames
+			as.connection.Write(content.Audio.GetContent())
+		}
+	case *protos.AssistantMessagingResponse_Interruption:
+		// interrupt on word given by stt
+		if data.Interruption.Type == protos.AssistantConversationInterruption_INTERRUPTION_TYPE_WORD {
+			as.connection.Clear()
+		}
+	case *protos.AssistantMessagingResponse_Action:
+		if data.Action.GetAction() == protos.AssistantConversationAction_END_CONVERSATION {
+			if err := as.connection.Hangup(); err != nil {
+				as.logger.Errorf("Error disconnecting audio socket: %v", err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_asterisk_telephony
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	internal_asterisk "github.com/rapidaai/api/assistant-api/internal/telephony/internal/asterisk/internal"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// the uuid of the audio socket is kept on the request between receiving and answering the call
+const audioSocketUuidKey = "asterisk.uuid"
+
+type asteriskTelephony struct {
+	logger commons.Logger
+	appCfg *config.AssistantConfig
+}
+
+// NewAsteriskTelephony connects calls of an on-prem asterisk. The dialplan or the ari application
+// asks for the uuid of the call and opens an audio socket with it, the audio of the call is
+// streamed over that socket.
+func NewAsteriskTelephony(config *config.AssistantConfig, logger commons.Logger) (internal_type.Telephony, error) {
+	return &asteriskTelephony{
+		logger: logger,
+		appCfg: config,
+	}, nil
+}
+
+// params of the request, the dialplan sends them as query and ari applications as form
+func params(c *gin.Context) map[string]string {
+	values := make(map[string]string)
+	for key, v := range c.Request.URL.Query() {
+		if len(v) > 0 {
+			values[key] = v[0]
+		}
+	}
+	if err := c.Request.ParseForm(); err == nil {
+		for key, v := range c.Request.PostForm {
+			if len(v) > 0 {
+				values[key] = v[0]
+			}
+		}
+	}
+	return values
+}
+
+// statusOf maps DIALSTATUS of the dialplan to the status of the call
+func statusOf(dialStatus string) string {
+	switch strings.ToUpper(dialStatus) {
+	case "ANSWER", "COMPLETED":
+		return "completed"
+	case "BUSY":
+		return "busy"
+	case "NOANSWER", "CANCEL":
+		return "no-answer"
+	default:
+		return "failed"
+	}
+}
+
+// VerifyWebhook accepts every request, asterisk does not sign the requests of the dialplan and ARI,
+// they are authenticated by the project key of the path only
+func (tpc *asteriskTelephony) VerifyWebhook(c *gin.Context, vaultCredential *protos.VaultCredential, opts utils.Option) error {
+	return nil
+}
+
+// Recording is not supported, the calls are recorded by the assistant only
+func (tpc *asteriskTelephony) Recording(ctx context.Context, vaultCredential *protos.VaultCredential, recordingUrl string) (*internal_type.Recording, error) {
+	return nil, internal_type.ErrProviderRecordingNotSupported
+}
+
+func (tpc *asteriskTelephony) CatchAllStatusCallback(ctx *gin.Context) ([]types.Telemetry, error) {
+	return nil, nil
+}
+
+// StatusCallback takes the status the hangup handler of the dialplan reports as status
+func (tpc *asteriskTelephony) StatusCallback(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, assistantConversationId uint64) ([]types.Telemetry, error) {
+	eventDetails := params(c)
+	dialStatus, ok := eventDetails["status"]
+	if !ok || dialStatus == "" {
+		return nil, fmt.Errorf("missing status of asterisk call")
+	}
+	return []types.Telemetry{types.NewMetric("STATUS", statusOf(dialStatus), utils.Ptr("Status of call or update")), types.NewEvent(dialStatus, eventDetails)}, nil
+}
+
+// ReceiveCall takes the caller as from, the uuid of the audio socket is given by the pbx as
+// uuid or created for it
+func (tpc *asteriskTelephony) ReceiveCall(c *gin.Context) (*string, []types.Telemetry, error) {
+	queryParams := params(c)
+	clientNumber, ok := queryParams["from"]
+	if !ok || clientNumber == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid caller"})
+		return nil, nil, fmt.Errorf("missing or empty 'from' parameter")
+	}
+
+	id := uuid.NewString()
+	if v, ok := queryParams["uuid"]; ok && v != "" {
+		parsed, err := uuid.Parse(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid uuid"})
+			return nil, nil, fmt.Errorf("illegal uuid of audio socket %s", v)
+		}
+		id = parsed.String()
+	}
+	c.Set(audioSocketUuidKey, id)
+	return utils.Ptr(clientNumber), []types.Telemetry{
+		types.NewMetadata("telephony.uuid", id),
+		types.NewMetadata("telephony.provider", "asterisk"),
+		types.NewEvent("webhook", queryParams),
+		types.NewMetric("STATUS", "SUCCESS", utils.Ptr("Status of telephony api")),
+	}, nil
+}
+
+// InboundCall answers the uuid the pbx opens the audio socket with
+func (tpc *asteriskTelephony) InboundCall(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, clientNumber string, assistantConversationId uint64, opts utils.Option) error {
+	id := c.GetString(audioSocketUuidKey)
+	if id == "" {
+		return fmt.Errorf("call was not received before it was answered")
+	}
+	c.String(http.StatusOK, id)
+	return nil
+}
+
+// OutboundCall originates the call through ari, the channel enters the context of the options
+// which opens the audio socket with RAPIDA_UUID and reports its status to RAPIDA_EVENT_URL
+func (tpc *asteriskTelephony) OutboundCall(
+	auth types.SimplePrinciple,
+	toPhone string,
+	fromPhone string,
+	assistantId, assistantConversationId uint64,
+	vaultCredential *protos.VaultCredential,
+	opts utils.Option) ([]types.Telemetry, error) {
+	mtds := []types.Telemetry{
+		types.NewMetadata("telephony.toPhone", toPhone),
+		types.NewMetadata("telephony.fromPhone", fromPhone),
+		types.NewMetadata("telephony.provider", "asterisk"),
+	}
+	credential := vaultCredential.GetValue().AsMap()
+	ariUrl, ok := credential["ari_url"].(string)
+	if !ok || ariUrl == "" {
+		return append(mtds, types.NewEvent("FAILED", "Failed to find ari url, check credentials"), &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of telephony api"}), fmt.Errorf("illegal vault config ari_url is not found")
+	}
+	trunk, ok := credential["trunk"].(string)
+	if !ok || trunk == "" {
+		return append(mtds, types.NewEvent("FAILED", "Failed to find trunk, check credentials"), &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of telephony api"}), fmt.Errorf("illegal vault config trunk is not found")
+	}
+	username, _ := credential["username"].(string)
+	password, _ := credential["password"].(string)
+	dialplanContext, err := opts.GetString("context")
+	if err != nil {
+		return append(mtds, types.NewEvent("FAILED", "Failed to find dialplan context"), &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of telephony api"}), fmt.Errorf("illegal context option is not found")
+	}
+	extension, err := opts.GetString("extension")
+	if err != nil {
+		extension = "s"
+	}
+
+	id := uuid.NewString()
+	query := url.Values{}
+	query.Set("endpoint", fmt.Sprintf("PJSIP/%s@%s", toPhone, trunk))
+	query.Set("context", dialplanContext)
+	query.Set("extension", extension)
+	query.Set("priority", "1")
+	query.Set("callerId", fromPhone)
+	query.Set("timeout", "60")
+	body, err := json.Marshal(internal_asterisk.OriginateRequest{Variables: map[string]string{
+		"RAPIDA_UUID":      id,
+		"RAPIDA_EVENT_URL": fmt.Sprintf("https://%s/%s", tpc.appCfg.PublicAssistantHost, internal_type.GetEventPath("asterisk", auth, assistantId, assistantConversationId)),
+	}})
+	if err != nil {
+		return append(mtds, &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of telephony api"}), err
+	}
+
+	client := &http.Client{Timeout: 60 * time.Second}
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/ari/channels?%s", strings.TrimSuffix(ariUrl, "/"), query.Encode()), bytes.NewReader(body))
+	if err != nil {
+		return append(mtds, &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of telephony api"}), err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(username, password)
+	resp, err := client.Do(req)
+	if err != nil {
+		return append(mtds, &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of telephony api"}), err
+	}
+	defer resp.Body.Close()
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return append(mtds, &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of telephony api"}), err
+	}
+	if resp.StatusCode != http.StatusOK {
+		tpc.logger.Errorf("Unexpected HTTP Status: %d, Response Body: %s\n", resp.StatusCode, string(bodyBytes))
+		return append(mtds, types.NewEvent("Failed", string(bodyBytes)), &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of telephony API HTTP error"}), fmt.Errorf("status code %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var channel internal_asterisk.Channel
+	if err := json.Unmarshal(bodyBytes, &channel); err != nil {
+		return append(mtds, types.NewEvent("Failed", "Failed to decode response"), &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of transaction"}), err
+	}
+	return append(mtds, types.NewMetadata("telephony.uuid", id), types.NewMetadata("telephony.channel", channel.Id), types.NewEvent("initiated", channel), &types.Metric{Name: "STATUS", Value: "SUCCESS", Description: "Status of telephony api"}), nil
+}
+
+// Streamer connects the assistant to the audio socket of the conversation, the socket is found by
+// the telephony.uuid of the conversation. There is no websocket, context and connection are not
+// used.
+func (tpc *asteriskTelephony) Streamer(c *gin.Context, connection *websocket.Conn, assistant *internal_assistant_entity.Assistant, conversation *internal_conversation_entity.AssistantConversation, vlt *protos.VaultCredential) internal_streamers.Streamer {
+	server, err := current()
+	if err != nil {
+		tpc.logger.Errorf("no audio socket for conversation %d: %v", conversation.Id, err)
+		return nil
+	}
+	id, err := conversation.GetMetadatas().GetString("telephony.uuid")
+	if err != nil {
+		tpc.logger.Errorf("no audio socket for conversation %d: %v", conversation.Id, err)
+		return nil
+	}
+	conn := server.connection(id)
+	if conn == nil {
+		tpc.logger.Errorf("audio socket %s of conversation %d has closed", id, conversation.Id)
+		return nil
+	}
+	return newAudioSocketStreamer(tpc.logger, conn, assistant, conversation, vlt)
+}
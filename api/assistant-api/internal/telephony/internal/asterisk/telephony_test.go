@@ -0,0 +1,255 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_asterisk_telephony
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	internal_asterisk "github.com/rapidaai/api/assistant-api/internal/telephony/internal/asterisk/internal"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func newTestTelephony(t *testing.T) *asteriskTelephony {
+	t.Helper()
+	logger, _ := commons.NewApplicationLogger()
+	tel, err := NewAsteriskTelephony(&config.AssistantConfig{PublicAssistantHost: "assistant.rapida.ai"}, logger)
+	require.NoError(t, err)
+	return tel.(*asteriskTelephony)
+}
+
+func newTestContext(method, target string, form url.Values) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	if form != nil {
+		c.Request = httptest.NewRequest(method, target, strings.NewReader(form.Encode()))
+		c.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	} else {
+		c.Request = httptest.NewRequest(method, target, nil)
+	}
+	return c, w
+}
+
+func telemetryOf(telemetry []types.Telemetry) (map[string]string, []string, map[string]string) {
+	metadata, events, metrics := map[string]string{}, []string{}, map[string]string{}
+	for _, tel := range telemetry {
+		switch v := tel.(type) {
+		case *types.Metadata:
+			metadata[v.Key] = v.Value
+		case *types.Event:
+			events = append(events, v.EventType)
+		case *types.Metric:
+			metrics[v.Name] = v.Value
+		}
+	}
+	return metadata, events, metrics
+}
+
+func TestReceiveCall(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        string
+		expectedError bool
+		expectedPhone string
+		expectedUuid  string
+	}{
+		{
+			name:          "caller with uuid of the dialplan",
+			target:        "/v1/talk/asterisk/call/1?from=%2B15703768754&uuid=40a8e2a6-8d8c-4b31-9a4d-9a3b7f1f7c11",
+			expectedPhone: "+15703768754",
+			expectedUuid:  "40a8e2a6-8d8c-4b31-9a4d-9a3b7f1f7c11",
+		},
+		{
+			name:          "caller without uuid",
+			target:        "/v1/talk/asterisk/call/1?from=1001",
+			expectedPhone: "1001",
+		},
+		{
+			name:          "missing caller",
+			target:        "/v1/talk/asterisk/call/1?uuid=40a8e2a6-8d8c-4b31-9a4d-9a3b7f1f7c11",
+			expectedError: true,
+		},
+		{
+			name:          "illegal uuid",
+			target:        "/v1/talk/asterisk/call/1?from=1001&uuid=1234",
+			expectedError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tel := newTestTelephony(t)
+			c, w := newTestContext("GET", tt.target, nil)
+			phone, telemetry, err := tel.ReceiveCall(c)
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, phone)
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPhone, *phone)
+
+			metadata, events, metrics := telemetryOf(telemetry)
+			assert.NotEmpty(t, metadata["telephony.uuid"])
+			if tt.expectedUuid != "" {
+				assert.Equal(t, tt.expectedUuid, metadata["telephony.uuid"])
+			}
+			assert.Contains(t, events, "webhook")
+			assert.Equal(t, "SUCCESS", metrics["STATUS"])
+
+			// the uuid is answered to the dialplan
+			require.NoError(t, tel.InboundCall(c, &types.ProjectScope{}, 1, *phone, 1, nil))
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, metadata["telephony.uuid"], w.Body.String())
+		})
+	}
+}
+
+func TestInboundCallWithoutReceiveCall(t *testing.T) {
+	tel := newTestTelephony(t)
+	c, _ := newTestContext("GET", "/v1/talk/asterisk/call/1?from=1001", nil)
+	assert.Error(t, tel.InboundCall(c, &types.ProjectScope{}, 1, "1001", 1, nil))
+}
+
+func TestStatusCallback(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		form           url.Values
+		expectedError  bool
+		expectedStatus string
+	}{
+		{name: "answered", target: "/event?status=ANSWER", expectedStatus: "completed"},
+		{name: "busy", target: "/event?status=BUSY", expectedStatus: "busy"},
+		{name: "no answer", target: "/event?status=NOANSWER", expectedStatus: "no-answer"},
+		{name: "cancelled", target: "/event?status=CANCEL", expectedStatus: "no-answer"},
+		{name: "congestion", target: "/event?status=CONGESTION", expectedStatus: "failed"},
+		{name: "form of ari", target: "/event", form: url.Values{"status": {"ANSWER"}}, expectedStatus: "completed"},
+		{name: "missing status", target: "/event", expectedError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tel := newTestTelephony(t)
+			c, _ := newTestContext("POST", tt.target, tt.form)
+			telemetry, err := tel.StatusCallback(c, &types.ProjectScope{}, 1, 1)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			_, events, metrics := telemetryOf(telemetry)
+			assert.Equal(t, tt.expectedStatus, metrics["STATUS"])
+			assert.Len(t, events, 1)
+		})
+	}
+}
+
+func TestOutboundCall(t *testing.T) {
+	var (
+		request   *http.Request
+		originate internal_asterisk.OriginateRequest
+	)
+	ari := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		json.NewDecoder(r.Body).Decode(&originate)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"1712345678.42","name":"PJSIP/trunk-00000001","state":"Down"}`))
+	}))
+	defer ari.Close()
+
+	value, err := structpb.NewStruct(map[string]interface{}{"ari_url": ari.URL, "username": "rapida", "password": "secret", "trunk": "trunk"})
+	require.NoError(t, err)
+	auth := &types.ProjectScope{CurrentToken: "key"}
+
+	tel := newTestTelephony(t)
+	telemetry, err := tel.OutboundCall(auth, "+15551234567", "+15557654321", 1, 2, &protos.VaultCredential{Value: value}, utils.Option{"context": "rapida-outbound"})
+	require.NoError(t, err)
+
+	metadata, _, metrics := telemetryOf(telemetry)
+	assert.Equal(t, "SUCCESS", metrics["STATUS"])
+	assert.Equal(t, "1712345678.42", metadata["telephony.channel"])
+	assert.Equal(t, "asterisk", metadata["telephony.provider"])
+
+	require.NotNil(t, request)
+	assert.Equal(t, "/ari/channels", request.URL.Path)
+	assert.Equal(t, "PJSIP/+15551234567@trunk", request.URL.Query().Get("endpoint"))
+	assert.Equal(t, "rapida-outbound", request.URL.Query().Get("context"))
+	assert.Equal(t, "s", request.URL.Query().Get("extension"))
+	assert.Equal(t, "+15557654321", request.URL.Query().Get("callerId"))
+	username, password, ok := request.BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "rapida", username)
+	assert.Equal(t, "secret", password)
+
+	// the dialplan opens the audio socket with the uuid of the conversation
+	assert.Equal(t, metadata["telephony.uuid"], originate.Variables["RAPIDA_UUID"])
+	assert.Equal(t, "https://assistant.rapida.ai/v1/talk/asterisk/prj/event/1/2/key", originate.Variables["RAPIDA_EVENT_URL"])
+}
+
+func TestOutboundCallFailed(t *testing.T) {
+	ari := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"message":"Allocation failed"}`))
+	}))
+	defer ari.Close()
+
+	tests := []struct {
+		name       string
+		credential map[string]interface{}
+		opts       utils.Option
+	}{
+		{name: "missing ari url", credential: map[string]interface{}{"trunk": "trunk"}, opts: utils.Option{"context": "rapida"}},
+		{name: "missing trunk", credential: map[string]interface{}{"ari_url": ari.URL}, opts: utils.Option{"context": "rapida"}},
+		{name: "missing context", credential: map[string]interface{}{"ari_url": ari.URL, "trunk": "trunk"}, opts: utils.Option{}},
+		{name: "rejected by ari", credential: map[string]interface{}{"ari_url": ari.URL, "trunk": "trunk"}, opts: utils.Option{"context": "rapida"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := structpb.NewStruct(tt.credential)
+			require.NoError(t, err)
+			tel := newTestTelephony(t)
+			telemetry, err := tel.OutboundCall(&types.ProjectScope{}, "+15551234567", "+15557654321", 1, 2, &protos.VaultCredential{Value: value}, tt.opts)
+			assert.Error(t, err)
+			_, _, metrics := telemetryOf(telemetry)
+			assert.Equal(t, "FAILED", metrics["STATUS"])
+		})
+	}
+}
+
+func TestStreamerWithoutAudioSocket(t *testing.T) {
+	tel := newTestTelephony(t)
+
+	// no audio socket server is listening
+	assert.Nil(t, tel.Streamer(nil, nil, nil, &internal_conversation_entity.AssistantConversation{}, nil))
+
+	// the audio socket of the conversation has not connected
+	server := newTestServer(t, newTestHandler())
+	runningMu.Lock()
+	running = server
+	runningMu.Unlock()
+	t.Cleanup(func() {
+		runningMu.Lock()
+		running = nil
+		runningMu.Unlock()
+	})
+	assert.Nil(t, tel.Streamer(nil, nil, nil, &internal_conversation_entity.AssistantConversation{
+		Metadatas: []*internal_conversation_entity.AssistantConversationMetadata{},
+	}, nil))
+}
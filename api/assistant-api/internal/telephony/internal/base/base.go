@@ -72,6 +72,21 @@ func (base *BaseTelephonyStreamer) CreateVoiceRequest(audioData []byte) *protos.
 	}
 }
 
+// CreateDtmfRequest wraps a keypad digit pressed by the caller
+func (base *BaseTelephonyStreamer) CreateDtmfRequest(digit string) *protos.AssistantMessagingRequest {
+	return &protos.AssistantMessagingRequest{
+		Request: &protos.AssistantMessagingRequest_Message{
+			Message: &protos.AssistantConversationUserMessage{
+				Message: &protos.AssistantConversationUserMessage_Dtmf{
+					Dtmf: &protos.AssistantConversationMessageDtmfContent{
+						Digit: digit,
+					},
+				},
+			},
+		},
+	}
+}
+
 func (base *BaseTelephonyStreamer) GetAssistantDefinition() *protos.AssistantDefinition {
 	return &protos.AssistantDefinition{
 		AssistantId: base.assistant.Id,
@@ -151,6 +166,23 @@ func (base *BaseTelephonyStreamer) GetUuid() string {
 	return v
 }
 
+// GetMetadata returns the metadata the telephony wrote on the conversation, empty when it is missing
+func (base *BaseTelephonyStreamer) GetMetadata(key string) string {
+	v, err := base.assistantConversation.GetMetadatas().GetString(key)
+	if err != nil {
+		return ""
+	}
+	return v
+}
+
+// GetDeploymentOptions returns the options of the phone deployment the call is made with
+func (base *BaseTelephonyStreamer) GetDeploymentOptions() utils.Option {
+	if base.assistant == nil || base.assistant.AssistantPhoneDeployment == nil {
+		return utils.Option{}
+	}
+	return base.assistant.AssistantPhoneDeployment.GetOptions()
+}
+
 func (base *BaseTelephonyStreamer) CreateConnectionRequest(in, out *protos.AudioConfig) (*protos.AssistantMessagingRequest, error) {
 	return &protos.AssistantMessagingRequest{
 		Request: &protos.AssistantMessagingRequest_Configuration{
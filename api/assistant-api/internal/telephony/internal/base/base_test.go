@@ -65,6 +65,22 @@ func TestCreateVoiceRequest(t *testing.T) {
 	}
 }
 
+// TestCreateDtmfRequest tests the CreateDtmfRequest method
+func TestCreateDtmfRequest(t *testing.T) {
+	streamer := &BaseTelephonyStreamer{}
+
+	for _, digit := range []string{"0", "9", "*", "#"} {
+		t.Run(digit, func(t *testing.T) {
+			request := streamer.CreateDtmfRequest(digit)
+
+			require.NotNil(t, request)
+			require.NotNil(t, request.GetMessage().GetDtmf())
+			assert.Nil(t, request.GetMessage().GetAudio())
+			assert.Equal(t, digit, request.GetMessage().GetDtmf().GetDigit())
+		})
+	}
+}
+
 // TestContext tests the Context method
 func TestContext(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -9,6 +9,12 @@ type ExotelMediaEvent struct {
 	Event     string       `json:"event"`
 	StreamSid string       `json:"stream_sid"`
 	Media     *ExotelMedia `json:"media,omitempty"`
+	Dtmf      *ExotelDtmf  `json:"dtmf,omitempty"`
+}
+
+type ExotelDtmf struct {
+	Digit    string `json:"digit"`
+	Duration string `json:"duration"`
 }
 
 type ExotelMedia struct {
@@ -6,6 +6,8 @@
 package internal_exotel_telephony
 
 import (
+	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -29,6 +31,19 @@ import (
 	"github.com/rapidaai/protos"
 )
 
+// recordings are only fetched from exotel, the credential of the account is sent along
+var (
+	recordingDomains = []string{"exotel.com", "exotel.in"}
+	recordingClient  = &http.Client{Timeout: 5 * time.Minute}
+)
+
+// WebhookSecretOptionsKey of the phone deployment is the password of the basic auth of the app and
+// status callback urls, exotel does not sign its webhooks
+const WebhookSecretOptionsKey = "exotel.webhook_secret"
+
+// webhookUser is the user of the basic auth of the webhook urls
+const webhookUser = "rapida"
+
 type exotelTelephony struct {
 	logger commons.Logger
 	appCfg *config.AssistantConfig
@@ -41,6 +56,71 @@ func NewExotelTelephony(config *config.AssistantConfig, logger commons.Logger) (
 	}, nil
 }
 
+// VerifyWebhook checks the basic auth of the request, exotel does not sign its webhooks and sends the
+// credential of the url it is given instead. The urls of the app and the status callback carry the
+// webhook secret of the phone deployment, never the credential of the account.
+func (tpc *exotelTelephony) VerifyWebhook(c *gin.Context, vaultCredential *protos.VaultCredential, opts utils.Option) error {
+	secret, err := opts.GetString(WebhookSecretOptionsKey)
+	if err != nil || secret == "" {
+		if tpc.appCfg != nil && tpc.appCfg.UnverifiedWebhooks {
+			tpc.logger.Warnf("exotel webhook is not verified, %s is missing in the phone deployment", WebhookSecretOptionsKey)
+			return nil
+		}
+		return fmt.Errorf("illegal phone deployment %s not found", WebhookSecretOptionsKey)
+	}
+	username, password, ok := c.Request.BasicAuth()
+	if !ok {
+		return fmt.Errorf("missing exotel credential")
+	}
+	if subtle.ConstantTimeCompare([]byte(username), []byte(webhookUser)) != 1 || subtle.ConstantTimeCompare([]byte(password), []byte(secret)) != 1 {
+		return fmt.Errorf("illegal exotel credential")
+	}
+	return nil
+}
+
+// Recording downloads the recording of the call as mp3 with the api key and token of the account
+func (tpc *exotelTelephony) Recording(ctx context.Context, vaultCredential *protos.VaultCredential, recordingUrl string) (*internal_type.Recording, error) {
+	u, err := url.Parse(recordingUrl)
+	if err != nil || u.Scheme != "https" || !isRecordingHost(u.Hostname()) {
+		return nil, fmt.Errorf("illegal exotel recording url %s", recordingUrl)
+	}
+	clientId, ok := vaultCredential.GetValue().AsMap()["client_id"].(string)
+	if !ok || clientId == "" {
+		return nil, fmt.Errorf("illegal vault config client_id not found")
+	}
+	authToken, ok := vaultCredential.GetValue().AsMap()["client_secret"].(string)
+	if !ok || authToken == "" {
+		return nil, fmt.Errorf("illegal vault config client_secret not found")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, recordingUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(clientId, authToken)
+	resp, err := recordingClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to download exotel recording, status code %d", resp.StatusCode)
+	}
+	audio, err := internal_type.ReadRecording(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &internal_type.Recording{Mixed: audio, Channels: 1, Source: "exotel", Format: "mp3"}, nil
+}
+
+func isRecordingHost(host string) bool {
+	for _, domain := range recordingDomains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
 func (tpc *exotelTelephony) CatchAllStatusCallback(ctx *gin.Context) ([]types.Telemetry, error) {
 	return nil, nil
 }
@@ -62,7 +142,13 @@ func (tpc *exotelTelephony) StatusCallback(c *gin.Context, auth types.SimplePrin
 		}
 	}
 	callStatus := eventDetails["Status"]
-	return []types.Telemetry{types.NewMetric("STATUS", fmt.Sprintf("%v", callStatus), utils.Ptr("Status of call or update")), types.NewEvent(fmt.Sprintf("%v", callStatus), eventDetails)}, nil
+	telemetry := []types.Telemetry{types.NewMetric("STATUS", fmt.Sprintf("%v", callStatus), utils.Ptr("Status of call or update")), types.NewEvent(fmt.Sprintf("%v", callStatus), eventDetails)}
+	// the recording of the call is part of the status once the call ended
+	if recordingUrl, ok := eventDetails["RecordingUrl"].(string); ok && recordingUrl != "" && recordingUrl != "null" {
+		recording := map[string]interface{}{"CallSid": eventDetails["CallSid"], "RecordingUrl": recordingUrl, internal_type.ProviderRecordingUrlKey: recordingUrl}
+		telemetry = append(telemetry, types.NewEvent(internal_type.ProviderRecordingEvent, recording))
+	}
+	return telemetry, nil
 
 }
 func (tpc *exotelTelephony) ClientUrl(vaultCredential *protos.VaultCredential, opts utils.Option) (*string, error) {
@@ -83,6 +169,24 @@ func (tpc *exotelTelephony) ClientUrl(vaultCredential *protos.VaultCredential, o
 
 }
 
+// StatusCallbackUrl carries the webhook secret of the phone deployment exotel sends back as basic
+// auth, the url has no credential while unverified webhooks are accepted for deployments without it
+func (tpc *exotelTelephony) StatusCallbackUrl(opts utils.Option, path string) (*string, error) {
+	statusCallback := url.URL{
+		Scheme: "https",
+		Host:   tpc.appCfg.PublicAssistantHost,
+		Path:   "/" + path,
+	}
+	secret, err := opts.GetString(WebhookSecretOptionsKey)
+	switch {
+	case err == nil && secret != "":
+		statusCallback.User = url.UserPassword(webhookUser, secret)
+	case !tpc.appCfg.UnverifiedWebhooks:
+		return nil, fmt.Errorf("illegal phone deployment %s not found", WebhookSecretOptionsKey)
+	}
+	return utils.Ptr(statusCallback.String()), nil
+}
+
 func (tpc *exotelTelephony) AppUrl(
 	vaultCredential *protos.VaultCredential,
 	opts utils.Option) (*string, error) {
@@ -127,7 +231,14 @@ func (tpc *exotelTelephony) OutboundCall(
 	formData.Set("CallerId", fromPhone)
 	formData.Set("To", fromPhone)
 	formData.Set("Url", *appUrl)
-	formData.Set("StatusCallback", fmt.Sprintf("https://%s/%s", tpc.appCfg.PublicAssistantHost, internal_type.GetEventPath("exotel", auth, assistantId, assistantConversationId)))
+	statusCallback, err := tpc.StatusCallbackUrl(opts, internal_type.GetEventPath("exotel", auth, assistantId, assistantConversationId))
+	if err != nil {
+		return append(mtds, types.NewEvent("FAILED", "Failed to build status callback url"), &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of telephony api"}), err
+	}
+	formData.Set("StatusCallback", *statusCallback)
+	if internal_type.IsProviderRecordingEnabled(opts) {
+		formData.Set("Record", "true")
+	}
 	// for exotel there is no way to set dynamic path so pass it as custom filed
 	formData.Set("CustomField", internal_type.GetAnswerPath("exotel", auth, assistantId,
 		assistantConversationId,
@@ -163,7 +274,7 @@ func (tpc *exotelTelephony) OutboundCall(
 	return append(mtds, types.NewMetadata("telephony.uuid", jsonResponse.Call.Sid), types.NewEvent(jsonResponse.Call.Status, jsonResponse), &types.Metric{Name: "STATUS", Value: "SUCCESS", Description: "Status of telephony api"}), nil
 }
 
-func (tpc *exotelTelephony) InboundCall(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, clientNumber string, assistantConversationId uint64) error {
+func (tpc *exotelTelephony) InboundCall(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, clientNumber string, assistantConversationId uint64, opts utils.Option) error {
 	response := map[string]string{
 		"url": fmt.Sprintf("wss://%s/%s",
 			tpc.appCfg.PublicAssistantHost,
@@ -66,7 +66,10 @@ func (exotel *exotelWebsocketStreamer) Recv() (*protos.AssistantMessagingRequest
 	case "media":
 		return exotel.handleMediaEvent(mediaEvent)
 	case "dtmf":
-		return nil, nil
+		if mediaEvent.Dtmf == nil || mediaEvent.Dtmf.Digit == "" {
+			return nil, nil
+		}
+		return exotel.streamer.CreateDtmfRequest(mediaEvent.Dtmf.Digit), nil
 	case "stop":
 		exotel.streamer.Cancel()
 		return nil, io.EOF
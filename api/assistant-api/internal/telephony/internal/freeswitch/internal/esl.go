@@ -0,0 +1,89 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_freeswitch
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Esl is an inbound connection to the event socket of freeswitch, it is only used to run
+// commands and reads the reply of every command before the next one is sent
+type Esl struct {
+	conn   net.Conn
+	reader *textproto.Reader
+}
+
+// DialEsl connects to the event socket and authenticates with the password
+func DialEsl(address, password string, timeout time.Duration) (*Esl, error) {
+	conn, err := net.DialTimeout("tcp", address, timeout)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetDeadline(time.Now().Add(timeout))
+	esl := &Esl{conn: conn, reader: textproto.NewReader(bufio.NewReader(conn))}
+	header, _, err := esl.read()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if header.Get("Content-Type") != "auth/request" {
+		conn.Close()
+		return nil, fmt.Errorf("unexpected event socket greeting %q", header.Get("Content-Type"))
+	}
+	if _, err := esl.Command("auth " + password); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return esl, nil
+}
+
+// Command sends the command and returns the reply text, a reply starting with -ERR is an error
+func (e *Esl) Command(command string) (string, error) {
+	if _, err := fmt.Fprintf(e.conn, "%s\n\n", command); err != nil {
+		return "", err
+	}
+	header, _, err := e.read()
+	if err != nil {
+		return "", err
+	}
+	reply := header.Get("Reply-Text")
+	if strings.HasPrefix(reply, "-ERR") {
+		return "", fmt.Errorf("event socket replied %s", reply)
+	}
+	return reply, nil
+}
+
+// read reads the headers of the next message and its body when it has one
+func (e *Esl) read() (textproto.MIMEHeader, []byte, error) {
+	header, err := e.reader.ReadMIMEHeader()
+	if err != nil {
+		return nil, nil, err
+	}
+	length := header.Get("Content-Length")
+	if length == "" {
+		return header, nil, nil
+	}
+	size, err := strconv.Atoi(length)
+	if err != nil {
+		return nil, nil, fmt.Errorf("illegal content length %q", length)
+	}
+	body := make([]byte, size)
+	if _, err := io.ReadFull(e.reader.R, body); err != nil {
+		return nil, nil, err
+	}
+	return header, body, nil
+}
+
+func (e *Esl) Close() error {
+	return e.conn.Close()
+}
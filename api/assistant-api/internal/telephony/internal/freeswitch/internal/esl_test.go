@@ -0,0 +1,78 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_freeswitch
+
+import (
+	"bufio"
+	"net"
+	"net/textproto"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// eventSocket accepts one connection and answers the commands with the replies in order
+func eventSocket(t *testing.T, replies ...string) (string, <-chan string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	commands := make(chan string, len(replies))
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := textproto.NewReader(bufio.NewReader(conn))
+		conn.Write([]byte("Content-Type: auth/request\n\n"))
+		for _, reply := range replies {
+			command, err := reader.ReadLine()
+			if err != nil {
+				return
+			}
+			reader.ReadLine()
+			commands <- command
+			conn.Write([]byte("Content-Type: command/reply\nReply-Text: " + reply + "\n\n"))
+		}
+		close(commands)
+	}()
+	return listener.Addr().String(), commands
+}
+
+func TestDialEsl(t *testing.T) {
+	address, commands := eventSocket(t, "+OK accepted", "+OK Job-UUID: 7f4db78a-17d7-11dd-b7a0-db4edd065621")
+
+	esl, err := DialEsl(address, "ClueCon", time.Second)
+	require.NoError(t, err)
+	defer esl.Close()
+	assert.Equal(t, "auth ClueCon", <-commands)
+
+	reply, err := esl.Command("bgapi originate sofia/gateway/trunk/+15551234567 &park()")
+	require.NoError(t, err)
+	assert.Equal(t, "+OK Job-UUID: 7f4db78a-17d7-11dd-b7a0-db4edd065621", reply)
+	assert.Equal(t, "bgapi originate sofia/gateway/trunk/+15551234567 &park()", <-commands)
+}
+
+func TestDialEslRejected(t *testing.T) {
+	address, _ := eventSocket(t, "-ERR invalid")
+
+	_, err := DialEsl(address, "wrong", time.Second)
+	assert.EqualError(t, err, "event socket replied -ERR invalid")
+}
+
+func TestDialEslUnreachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	listener.Close()
+
+	_, err = DialEsl(address, "ClueCon", time.Second)
+	assert.Error(t, err)
+}
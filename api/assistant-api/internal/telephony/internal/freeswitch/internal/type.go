@@ -0,0 +1,37 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_freeswitch
+
+// AudioForkCommand is a text message mod_audio_fork takes from the websocket
+type AudioForkCommand struct {
+	Type string     `json:"type"`
+	Data *PlayAudio `json:"data,omitempty"`
+}
+
+// PlayAudio is the audio mod_audio_fork plays to the caller, the content is base64 of raw audio
+type PlayAudio struct {
+	AudioContentType string `json:"audioContentType"`
+	SampleRate       int    `json:"sampleRate"`
+	AudioContent     string `json:"audioContent"`
+}
+
+func NewPlayAudioCommand(sampleRate int, content string) *AudioForkCommand {
+	return &AudioForkCommand{Type: "playAudio", Data: &PlayAudio{
+		AudioContentType: "raw",
+		SampleRate:       sampleRate,
+		AudioContent:     content,
+	}}
+}
+
+// NewKillAudioCommand stops the audio mod_audio_fork is playing
+func NewKillAudioCommand() *AudioForkCommand {
+	return &AudioForkCommand{Type: "killAudio"}
+}
+
+// NewDisconnectCommand closes the fork, the call is hung up by the dialplan after it
+func NewDisconnectCommand() *AudioForkCommand {
+	return &AudioForkCommand{Type: "disconnect"}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_freeswitch_telephony
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	internal_freeswitch "github.com/rapidaai/api/assistant-api/internal/telephony/internal/freeswitch/internal"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+const eslTimeout = 10 * time.Second
+
+var (
+	// dialNumber is an E.164 number or the digits of a dial string, anything else would end the
+	// command or the channel variables of the originate
+	dialNumber  = regexp.MustCompile(`^\+?[0-9*#]{1,32}$`)
+	gatewayName = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+	// variableValue escapes the separators of the channel variables within a value
+	variableValue = strings.NewReplacer(`\`, `\\`, ",", `\,`, "'", `\'`, "\r", "", "\n", "")
+)
+
+type freeswitchTelephony struct {
+	logger commons.Logger
+	appCfg *config.AssistantConfig
+}
+
+// NewFreeswitchTelephony connects calls of an on-prem freeswitch. The dialplan asks for the
+// websocket of the call and forks the audio of the channel to it with mod_audio_fork.
+func NewFreeswitchTelephony(config *config.AssistantConfig, logger commons.Logger) (internal_type.Telephony, error) {
+	return &freeswitchTelephony{
+		logger: logger,
+		appCfg: config,
+	}, nil
+}
+
+// params of the request, curl of the dialplan sends them as query or as form
+func params(c *gin.Context) map[string]string {
+	values := make(map[string]string)
+	for key, v := range c.Request.URL.Query() {
+		if len(v) > 0 {
+			values[key] = v[0]
+		}
+	}
+	if err := c.Request.ParseForm(); err == nil {
+		for key, v := range c.Request.PostForm {
+			if len(v) > 0 {
+				values[key] = v[0]
+			}
+		}
+	}
+	return values
+}
+
+// statusOf maps the hangup cause of the channel to the status of the call
+func statusOf(hangupCause string) string {
+	switch strings.ToUpper(hangupCause) {
+	case "NORMAL_CLEARING", "COMPLETED":
+		return "completed"
+	case "USER_BUSY":
+		return "busy"
+	case "NO_ANSWER", "NO_USER_RESPONSE", "ORIGINATOR_CANCEL":
+		return "no-answer"
+	default:
+		return "failed"
+	}
+}
+
+// VerifyWebhook accepts every request, freeswitch does not sign the requests of the dialplan and the
+// hangup hook, they are authenticated by the project key of the path only
+func (tpc *freeswitchTelephony) VerifyWebhook(c *gin.Context, vaultCredential *protos.VaultCredential, opts utils.Option) error {
+	return nil
+}
+
+// Recording is not supported, the calls are recorded by the assistant only
+func (tpc *freeswitchTelephony) Recording(ctx context.Context, vaultCredential *protos.VaultCredential, recordingUrl string) (*internal_type.Recording, error) {
+	return nil, internal_type.ErrProviderRecordingNotSupported
+}
+
+func (tpc *freeswitchTelephony) CatchAllStatusCallback(ctx *gin.Context) ([]types.Telemetry, error) {
+	return nil, nil
+}
+
+// StatusCallback takes the hangup cause the hangup hook of the channel reports as status
+func (tpc *freeswitchTelephony) StatusCallback(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, assistantConversationId uint64) ([]types.Telemetry, error) {
+	eventDetails := params(c)
+	hangupCause, ok := eventDetails["status"]
+	if !ok || hangupCause == "" {
+		return nil, fmt.Errorf("missing status of freeswitch call")
+	}
+	return []types.Telemetry{types.NewMetric("STATUS", statusOf(hangupCause), utils.Ptr("Status of call or update")), types.NewEvent(hangupCause, eventDetails)}, nil
+}
+
+// ReceiveCall takes the caller as from and the uuid of the channel as uuid
+func (tpc *freeswitchTelephony) ReceiveCall(c *gin.Context) (*string, []types.Telemetry, error) {
+	queryParams := params(c)
+	clientNumber, ok := queryParams["from"]
+	if !ok || clientNumber == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid caller"})
+		return nil, nil, fmt.Errorf("missing or empty 'from' parameter")
+	}
+
+	telemetry := []types.Telemetry{
+		types.NewEvent("webhook", queryParams),
+		types.NewMetric("STATUS", "SUCCESS", utils.Ptr("Status of telephony api")),
+	}
+	if channelUuid, ok := queryParams["uuid"]; ok && channelUuid != "" {
+		telemetry = append(telemetry, types.NewMetadata("telephony.uuid", channelUuid))
+	}
+	return utils.Ptr(clientNumber), telemetry, nil
+}
+
+// InboundCall answers the websocket the dialplan forks the audio of the channel to
+func (tpc *freeswitchTelephony) InboundCall(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, clientNumber string, assistantConversationId uint64, opts utils.Option) error {
+	c.String(http.StatusOK, fmt.Sprintf("wss://%s/%s", tpc.appCfg.PublicAssistantHost,
+		internal_type.GetAnswerPath("freeswitch", auth, assistantId, assistantConversationId, clientNumber)))
+	return nil
+}
+
+// OutboundCall originates the call through the event socket, the audio is forked to the
+// assistant once the callee answered and the hangup cause is reported as status
+func (tpc *freeswitchTelephony) OutboundCall(
+	auth types.SimplePrinciple,
+	toPhone string,
+	fromPhone string,
+	assistantId, assistantConversationId uint64,
+	vaultCredential *protos.VaultCredential,
+	opts utils.Option) ([]types.Telemetry, error) {
+	mtds := []types.Telemetry{
+		types.NewMetadata("telephony.toPhone", toPhone),
+		types.NewMetadata("telephony.fromPhone", fromPhone),
+		types.NewMetadata("telephony.provider", "freeswitch"),
+	}
+	if !dialNumber.MatchString(toPhone) || !dialNumber.MatchString(fromPhone) {
+		return append(mtds, types.NewEvent("FAILED", "Illegal phone number"), &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of telephony api"}), fmt.Errorf("illegal phone number %q or %q for freeswitch", toPhone, fromPhone)
+	}
+	credential := vaultCredential.GetValue().AsMap()
+	address, ok := credential["esl_address"].(string)
+	if !ok || address == "" {
+		return append(mtds, types.NewEvent("FAILED", "Failed to find event socket, check credentials"), &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of telephony api"}), fmt.Errorf("illegal vault config esl_address is not found")
+	}
+	gateway, ok := credential["gateway"].(string)
+	if !ok || !gatewayName.MatchString(gateway) {
+		return append(mtds, types.NewEvent("FAILED", "Failed to find gateway, check credentials"), &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of telephony api"}), fmt.Errorf("illegal vault config gateway is not found")
+	}
+	password, _ := credential["password"].(string)
+
+	esl, err := internal_freeswitch.DialEsl(address, password, eslTimeout)
+	if err != nil {
+		return append(mtds, types.NewEvent("FAILED", err.Error()), &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of telephony api"}), err
+	}
+	defer esl.Close()
+
+	id := uuid.NewString()
+	reply, err := esl.Command(tpc.originate(id, gateway, toPhone, fromPhone,
+		fmt.Sprintf("wss://%s/%s", tpc.appCfg.PublicAssistantHost, internal_type.GetAnswerPath("freeswitch", auth, assistantId, assistantConversationId, toPhone)),
+		fmt.Sprintf("https://%s/%s", tpc.appCfg.PublicAssistantHost, internal_type.GetEventPath("freeswitch", auth, assistantId, assistantConversationId))))
+	if err != nil {
+		tpc.logger.Errorf("unable to originate freeswitch call: %v", err)
+		return append(mtds, types.NewEvent("Failed", err.Error()), &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of telephony api"}), err
+	}
+	return append(mtds, types.NewMetadata("telephony.uuid", id), types.NewEvent("initiated", map[string]string{"reply": reply}), &types.Metric{Name: "STATUS", Value: "SUCCESS", Description: "Status of telephony api"}), nil
+}
+
+// originate is the command which places the call, the channel is parked while the audio is forked.
+// The numbers and the gateway are checked before, the values of the variables are escaped.
+func (tpc *freeswitchTelephony) originate(id, gateway, toPhone, fromPhone, answerUrl, eventUrl string) string {
+	variables := []string{
+		"origination_uuid=" + variableValue.Replace(id),
+		"origination_caller_id_number=" + variableValue.Replace(fromPhone),
+		fmt.Sprintf("api_on_answer='uuid_audio_fork %s start %s mono 8k'", variableValue.Replace(id), variableValue.Replace(answerUrl)),
+		fmt.Sprintf("api_hangup_hook='curl %s?status=${hangup_cause}'", variableValue.Replace(eventUrl)),
+	}
+	return fmt.Sprintf("bgapi originate {%s}sofia/gateway/%s/%s &park()", strings.Join(variables, ","), gateway, toPhone)
+}
+
+func (tpc *freeswitchTelephony) Streamer(c *gin.Context, connection *websocket.Conn, assistant *internal_assistant_entity.Assistant, conversation *internal_conversation_entity.AssistantConversation, vlt *protos.VaultCredential) internal_streamers.Streamer {
+	return NewFreeswitchWebsocketStreamer(tpc.logger, connection, assistant, conversation, vlt)
+}
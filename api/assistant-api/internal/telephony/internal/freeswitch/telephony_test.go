@@ -0,0 +1,247 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_freeswitch_telephony
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/api/assistant-api/config"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func newTestTelephony(t *testing.T) *freeswitchTelephony {
+	t.Helper()
+	logger, _ := commons.NewApplicationLogger()
+	tel, err := NewFreeswitchTelephony(&config.AssistantConfig{PublicAssistantHost: "assistant.rapida.ai"}, logger)
+	require.NoError(t, err)
+	return tel.(*freeswitchTelephony)
+}
+
+func newTestContext(method, target string, form url.Values) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	if form != nil {
+		c.Request = httptest.NewRequest(method, target, strings.NewReader(form.Encode()))
+		c.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	} else {
+		c.Request = httptest.NewRequest(method, target, nil)
+	}
+	return c, w
+}
+
+func telemetryOf(telemetry []types.Telemetry) (map[string]string, []string, map[string]string) {
+	metadata, events, metrics := map[string]string{}, []string{}, map[string]string{}
+	for _, tel := range telemetry {
+		switch v := tel.(type) {
+		case *types.Metadata:
+			metadata[v.Key] = v.Value
+		case *types.Event:
+			events = append(events, v.EventType)
+		case *types.Metric:
+			metrics[v.Name] = v.Value
+		}
+	}
+	return metadata, events, metrics
+}
+
+func TestReceiveCall(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        string
+		expectedError bool
+		expectedPhone string
+		expectedUuid  string
+	}{
+		{
+			name:          "caller with channel uuid",
+			target:        "/v1/talk/freeswitch/call/1?from=%2B15703768754&uuid=1b0a5c3e-0d7e-4a83-9a3c-5ad0a7d0d1f2",
+			expectedPhone: "+15703768754",
+			expectedUuid:  "1b0a5c3e-0d7e-4a83-9a3c-5ad0a7d0d1f2",
+		},
+		{
+			name:          "caller without channel uuid",
+			target:        "/v1/talk/freeswitch/call/1?from=1001",
+			expectedPhone: "1001",
+		},
+		{
+			name:          "missing caller",
+			target:        "/v1/talk/freeswitch/call/1?uuid=1b0a5c3e-0d7e-4a83-9a3c-5ad0a7d0d1f2",
+			expectedError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tel := newTestTelephony(t)
+			c, w := newTestContext("GET", tt.target, nil)
+			phone, telemetry, err := tel.ReceiveCall(c)
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, phone)
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPhone, *phone)
+			metadata, events, metrics := telemetryOf(telemetry)
+			assert.Equal(t, tt.expectedUuid, metadata["telephony.uuid"])
+			assert.Contains(t, events, "webhook")
+			assert.Equal(t, "SUCCESS", metrics["STATUS"])
+		})
+	}
+}
+
+func TestInboundCall(t *testing.T) {
+	tel := newTestTelephony(t)
+	c, w := newTestContext("GET", "/v1/talk/freeswitch/call/1?from=1001", nil)
+	require.NoError(t, tel.InboundCall(c, &types.ProjectScope{CurrentToken: "key"}, 1, "1001", 2, nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "wss://assistant.rapida.ai/v1/talk/freeswitch/prj/1/1001/2/key", w.Body.String())
+}
+
+func TestStatusCallback(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		form           url.Values
+		expectedError  bool
+		expectedStatus string
+	}{
+		{name: "normal clearing", target: "/event?status=NORMAL_CLEARING", expectedStatus: "completed"},
+		{name: "busy", target: "/event?status=USER_BUSY", expectedStatus: "busy"},
+		{name: "no answer", target: "/event?status=NO_ANSWER", expectedStatus: "no-answer"},
+		{name: "no user response", target: "/event?status=NO_USER_RESPONSE", expectedStatus: "no-answer"},
+		{name: "cancelled", target: "/event?status=ORIGINATOR_CANCEL", expectedStatus: "no-answer"},
+		{name: "unallocated number", target: "/event?status=UNALLOCATED_NUMBER", expectedStatus: "failed"},
+		{name: "posted by curl", target: "/event", form: url.Values{"status": {"NORMAL_CLEARING"}}, expectedStatus: "completed"},
+		{name: "missing status", target: "/event", expectedError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tel := newTestTelephony(t)
+			c, _ := newTestContext("POST", tt.target, tt.form)
+			telemetry, err := tel.StatusCallback(c, &types.ProjectScope{}, 1, 1)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			_, events, metrics := telemetryOf(telemetry)
+			assert.Equal(t, tt.expectedStatus, metrics["STATUS"])
+			assert.Len(t, events, 1)
+		})
+	}
+}
+
+// eventSocket accepts one connection, authenticates it and answers every command with the reply
+func eventSocket(t *testing.T, reply string) (string, <-chan string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	commands := make(chan string, 2)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := textproto.NewReader(bufio.NewReader(conn))
+		conn.Write([]byte("Content-Type: auth/request\n\n"))
+		for _, r := range []string{"+OK accepted", reply} {
+			command, err := reader.ReadLine()
+			if err != nil {
+				return
+			}
+			reader.ReadLine()
+			commands <- command
+			conn.Write([]byte("Content-Type: command/reply\nReply-Text: " + r + "\n\n"))
+		}
+	}()
+	return listener.Addr().String(), commands
+}
+
+func TestOutboundCall(t *testing.T) {
+	address, commands := eventSocket(t, "+OK Job-UUID: 7f4db78a-17d7-11dd-b7a0-db4edd065621")
+	value, err := structpb.NewStruct(map[string]interface{}{"esl_address": address, "password": "ClueCon", "gateway": "trunk"})
+	require.NoError(t, err)
+
+	tel := newTestTelephony(t)
+	telemetry, err := tel.OutboundCall(&types.ProjectScope{CurrentToken: "key"}, "+15551234567", "+15557654321", 1, 2, &protos.VaultCredential{Value: value}, utils.Option{})
+	require.NoError(t, err)
+
+	metadata, _, metrics := telemetryOf(telemetry)
+	assert.Equal(t, "SUCCESS", metrics["STATUS"])
+	assert.Equal(t, "freeswitch", metadata["telephony.provider"])
+	id := metadata["telephony.uuid"]
+	require.NotEmpty(t, id)
+
+	assert.Equal(t, "auth ClueCon", <-commands)
+	originate := <-commands
+	assert.True(t, strings.HasPrefix(originate, "bgapi originate {origination_uuid="+id+","))
+	assert.Contains(t, originate, "origination_caller_id_number=+15557654321")
+	assert.Contains(t, originate, "api_on_answer='uuid_audio_fork "+id+" start wss://assistant.rapida.ai/v1/talk/freeswitch/prj/1/+15551234567/2/key mono 8k'")
+	assert.Contains(t, originate, "api_hangup_hook='curl https://assistant.rapida.ai/v1/talk/freeswitch/prj/event/1/2/key?status=${hangup_cause}'")
+	assert.True(t, strings.HasSuffix(originate, "}sofia/gateway/trunk/+15551234567 &park()"))
+}
+
+func TestOriginateEscapesVariables(t *testing.T) {
+	tel := newTestTelephony(t)
+	originate := tel.originate("id", "trunk", "+15551234567", "+15557654321", "wss://assistant.rapida.ai/a,b", "https://assistant.rapida.ai/it's")
+	assert.Contains(t, originate, `start wss://assistant.rapida.ai/a\,b mono 8k'`)
+	assert.Contains(t, originate, `curl https://assistant.rapida.ai/it\'s?status=${hangup_cause}'`)
+}
+
+func TestOutboundCallFailed(t *testing.T) {
+	rejected, _ := eventSocket(t, "-ERR NO_ROUTE_DESTINATION")
+	tests := []struct {
+		name       string
+		credential map[string]interface{}
+		toPhone    string
+		fromPhone  string
+	}{
+		{name: "missing event socket", credential: map[string]interface{}{"gateway": "trunk"}},
+		{name: "missing gateway", credential: map[string]interface{}{"esl_address": rejected}},
+		{name: "rejected by freeswitch", credential: map[string]interface{}{"esl_address": rejected, "gateway": "trunk"}},
+		{name: "illegal gateway", credential: map[string]interface{}{"esl_address": rejected, "gateway": "trunk/+1555}"}},
+		{name: "command in the number", credential: map[string]interface{}{"esl_address": rejected, "gateway": "trunk"}, toPhone: "+15551234567\n\napi shutdown"},
+		{name: "variable in the number", credential: map[string]interface{}{"esl_address": rejected, "gateway": "trunk"}, toPhone: "+1555,origination_caller_id_name=x}"},
+		{name: "illegal caller id", credential: map[string]interface{}{"esl_address": rejected, "gateway": "trunk"}, fromPhone: "+1555'7654321"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := structpb.NewStruct(tt.credential)
+			require.NoError(t, err)
+			toPhone, fromPhone := "+15551234567", "+15557654321"
+			if tt.toPhone != "" {
+				toPhone = tt.toPhone
+			}
+			if tt.fromPhone != "" {
+				fromPhone = tt.fromPhone
+			}
+			tel := newTestTelephony(t)
+			telemetry, err := tel.OutboundCall(&types.ProjectScope{}, toPhone, fromPhone, 1, 2, &protos.VaultCredential{Value: value}, utils.Option{})
+			assert.Error(t, err)
+			_, _, metrics := telemetryOf(telemetry)
+			assert.Equal(t, "FAILED", metrics["STATUS"])
+		})
+	}
+}
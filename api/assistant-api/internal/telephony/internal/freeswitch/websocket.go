@@ -0,0 +1,141 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_freeswitch_telephony
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/gorilla/websocket"
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	internal_telephony_base "github.com/rapidaai/api/assistant-api/internal/telephony/internal/base"
+	internal_freeswitch "github.com/rapidaai/api/assistant-api/internal/telephony/internal/freeswitch/internal"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+)
+
+const (
+	sampleRate = 8000
+	// 1ms 16 bytes @ 8kHz 16 bit mono, 60ms of audio as silero can't process smaller chunk
+	inputBufferThreshold = 16 * 60
+	// mod_audio_fork plays every message as a file, audio is sent in 500ms to keep the gaps short
+	outputBufferThreshold = 16 * 500
+)
+
+type freeswitchWebsocketStreamer struct {
+	streamer  internal_telephony_base.BaseTelephonyStreamer
+	logger    commons.Logger
+	connected bool
+}
+
+func NewFreeswitchWebsocketStreamer(logger commons.Logger, connection *websocket.Conn, assistant *internal_assistant_entity.Assistant, conversation *internal_conversation_entity.AssistantConversation, vlt *protos.VaultCredential,
+) streamers.Streamer {
+	return &freeswitchWebsocketStreamer{
+		logger:   logger,
+		streamer: internal_telephony_base.NewBaseTelephonyStreamer(logger, connection, assistant, conversation, vlt),
+	}
+}
+
+func (fs *freeswitchWebsocketStreamer) Context() context.Context {
+	return fs.streamer.Context()
+}
+
+func (fs *freeswitchWebsocketStreamer) Recv() (*protos.AssistantMessagingRequest, error) {
+	if fs.streamer.Connection() == nil {
+		return nil, io.EOF
+	}
+	// the fork starts on an answered channel, the assistant is connected right away
+	if !fs.connected {
+		fs.connected = true
+		return fs.streamer.CreateConnectionRequest(internal_audio.NewLinear8khzMonoAudioConfig(), internal_audio.NewLinear8khzMonoAudioConfig())
+	}
+
+	messageType, message, err := fs.streamer.Connection().ReadMessage()
+	if err != nil {
+		fs.streamer.Cancel()
+		return nil, io.EOF
+	}
+
+	// text messages are the metadata given to uuid_audio_fork
+	if messageType != websocket.BinaryMessage {
+		fs.logger.Debugf("freeswitch audio fork metadata %s", string(message))
+		return nil, nil
+	}
+
+	fs.streamer.LockInputAudioBuffer()
+	defer fs.streamer.UnlockInputAudioBuffer()
+	fs.streamer.InputBuffer().Write(message)
+	if fs.streamer.InputBuffer().Len() >= inputBufferThreshold {
+		audioRequest := fs.streamer.CreateVoiceRequest(fs.streamer.InputBuffer().Bytes())
+		fs.streamer.InputBuffer().Reset()
+		return audioRequest, nil
+	}
+	return nil, nil
+}
+
+func (fs *freeswitchWebsocketStreamer) Send(response *protos.AssistantMessagingResponse) error {
+	switch data := response.GetData().(type) {
+	case *protos.AssistantMessagingResponse_Assistant:
+		switch content := data.Assistant.Message.(type) {
+		case *protos.AssistantConversationAssistantMessage_Audio:
+			fs.streamer.LockOutputAudioBuffer()
+			defer fs.streamer.UnlockOutputAudioBuffer()
+
+			fs.streamer.OutputBuffer().Write(content.Audio.GetContent())
+			for fs.streamer.OutputBuffer().Len() >= outputBufferThreshold {
+				chunk := fs.streamer.OutputBuffer().Next(outputBufferThreshold)
+				if err := fs.sendingFreeswitchMessage(internal_freeswitch.NewPlayAudioCommand(sampleRate, fs.streamer.Encoder().EncodeToString(chunk))); err != nil {
+					return err
+				}
+			}
+
+			// If response is marked as completed, flush any remaining audio in the buffer
+			if data.Assistant.GetCompleted() && fs.streamer.OutputBuffer().Len() > 0 {
+				if err := fs.sendingFreeswitchMessage(internal_freeswitch.NewPlayAudioCommand(sampleRate, fs.streamer.Encoder().EncodeToString(fs.streamer.OutputBuffer().Bytes()))); err != nil {
+					return err
+				}
+				fs.streamer.OutputBuffer().Reset()
+			}
+		}
+	case *protos.AssistantMessagingResponse_Interruption:
+		// interrupt on word given by stt
+		if data.Interruption.Type == protos.AssistantConversationInterruption_INTERRUPTION_TYPE_WORD {
+			fs.streamer.LockOutputAudioBuffer()
+			fs.streamer.OutputBuffer().Reset()
+			fs.streamer.UnlockOutputAudioBuffer()
+			if err := fs.sendingFreeswitchMessage(internal_freeswitch.NewKillAudioCommand()); err != nil {
+				fs.logger.Errorf("Error sending kill audio command: %v", err)
+			}
+		}
+	case *protos.AssistantMessagingResponse_Action:
+		if data.Action.GetAction() == protos.AssistantConversationAction_END_CONVERSATION {
+			if err := fs.sendingFreeswitchMessage(internal_freeswitch.NewDisconnectCommand()); err != nil {
+				fs.logger.Errorf("Error sending disconnect command: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (fs *freeswitchWebsocketStreamer) sendingFreeswitchMessage(command *internal_freeswitch.AudioForkCommand) error {
+	if fs.streamer.Connection() == nil {
+		return nil
+	}
+	message, err := json.Marshal(command)
+	if err != nil {
+		fs.logger.Error("Failed to marshal freeswitch message", "error", err.Error())
+		return err
+	}
+	if err := fs.streamer.Connection().WriteMessage(websocket.TextMessage, message); err != nil {
+		fs.logger.Error("Failed to send message to freeswitch", "error", err.Error())
+		return err
+	}
+	return nil
+}
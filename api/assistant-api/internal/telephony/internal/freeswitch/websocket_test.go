@@ -0,0 +1,130 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_freeswitch_telephony
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	internal_freeswitch "github.com/rapidaai/api/assistant-api/internal/telephony/internal/freeswitch/internal"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestStreamer connects a websocket standing in for mod_audio_fork to the streamer
+func newTestStreamer(t *testing.T) (streamers.Streamer, *websocket.Conn) {
+	t.Helper()
+	logger, _ := commons.NewApplicationLogger()
+	streamer := make(chan streamers.Streamer, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		streamer <- NewFreeswitchWebsocketStreamer(logger, conn, &internal_assistant_entity.Assistant{}, &internal_conversation_entity.AssistantConversation{}, nil)
+	}))
+	t.Cleanup(server.Close)
+
+	fork, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { fork.Close() })
+	fork.SetReadDeadline(time.Now().Add(5 * time.Second))
+	return <-streamer, fork
+}
+
+func readCommand(t *testing.T, fork *websocket.Conn) internal_freeswitch.AudioForkCommand {
+	t.Helper()
+	messageType, message, err := fork.ReadMessage()
+	require.NoError(t, err)
+	assert.Equal(t, websocket.TextMessage, messageType)
+	var command internal_freeswitch.AudioForkCommand
+	require.NoError(t, json.Unmarshal(message, &command))
+	return command
+}
+
+func TestStreamerRecv(t *testing.T) {
+	streamer, fork := newTestStreamer(t)
+
+	request, err := streamer.Recv()
+	require.NoError(t, err)
+	configuration := request.GetConfiguration()
+	require.NotNil(t, configuration)
+	assert.Equal(t, uint32(8000), configuration.GetInputConfig().GetAudio().GetSampleRate())
+	assert.Equal(t, protos.AudioConfig_LINEAR16, configuration.GetOutputConfig().GetAudio().GetAudioFormat())
+
+	// metadata of uuid_audio_fork is not audio
+	require.NoError(t, fork.WriteMessage(websocket.TextMessage, []byte(`{"callerId":"1001"}`)))
+	request, err = streamer.Recv()
+	require.NoError(t, err)
+	assert.Nil(t, request)
+
+	// audio is buffered to 60ms
+	require.NoError(t, fork.WriteMessage(websocket.BinaryMessage, make([]byte, 640)))
+	request, err = streamer.Recv()
+	require.NoError(t, err)
+	assert.Nil(t, request)
+	require.NoError(t, fork.WriteMessage(websocket.BinaryMessage, make([]byte, 640)))
+	request, err = streamer.Recv()
+	require.NoError(t, err)
+	assert.Len(t, request.GetMessage().GetAudio().GetContent(), 1280)
+
+	fork.Close()
+	_, err = streamer.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestStreamerSend(t *testing.T) {
+	streamer, fork := newTestStreamer(t)
+
+	audio := func(content []byte, completed bool) *protos.AssistantMessagingResponse {
+		return &protos.AssistantMessagingResponse{Data: &protos.AssistantMessagingResponse_Assistant{
+			Assistant: &protos.AssistantConversationAssistantMessage{
+				Message:   &protos.AssistantConversationAssistantMessage_Audio{Audio: &protos.AssistantConversationMessageAudioContent{Content: content}},
+				Completed: completed,
+			},
+		}}
+	}
+
+	// audio is played in 500ms and the rest once the response completed
+	require.NoError(t, streamer.Send(audio(make([]byte, outputBufferThreshold+100), false)))
+	command := readCommand(t, fork)
+	assert.Equal(t, "playAudio", command.Type)
+	require.NotNil(t, command.Data)
+	assert.Equal(t, "raw", command.Data.AudioContentType)
+	assert.Equal(t, 8000, command.Data.SampleRate)
+	content, err := base64.StdEncoding.DecodeString(command.Data.AudioContent)
+	require.NoError(t, err)
+	assert.Len(t, content, outputBufferThreshold)
+
+	require.NoError(t, streamer.Send(audio(make([]byte, 60), true)))
+	command = readCommand(t, fork)
+	content, err = base64.StdEncoding.DecodeString(command.Data.AudioContent)
+	require.NoError(t, err)
+	assert.Len(t, content, 160)
+
+	require.NoError(t, streamer.Send(&protos.AssistantMessagingResponse{Data: &protos.AssistantMessagingResponse_Interruption{
+		Interruption: &protos.AssistantConversationInterruption{Type: protos.AssistantConversationInterruption_INTERRUPTION_TYPE_WORD},
+	}}))
+	assert.Equal(t, "killAudio", readCommand(t, fork).Type)
+
+	require.NoError(t, streamer.Send(&protos.AssistantMessagingResponse{Data: &protos.AssistantMessagingResponse_Action{
+		Action: &protos.AssistantConversationAction{Action: protos.AssistantConversationAction_END_CONVERSATION},
+	}}))
+	assert.Equal(t, "disconnect", readCommand(t, fork).Type)
+}
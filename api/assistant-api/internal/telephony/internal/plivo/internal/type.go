@@ -0,0 +1,61 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_plivo
+
+type PlivoMediaEvent struct {
+	Event          string `json:"event"`
+	SequenceNumber int    `json:"sequenceNumber"`
+	StreamId       string `json:"streamId"`
+	Start          *struct {
+		CallId      string   `json:"callId"`
+		StreamId    string   `json:"streamId"`
+		AccountId   string   `json:"accountId"`
+		Tracks      []string `json:"tracks"`
+		MediaFormat struct {
+			Encoding   string `json:"encoding"`
+			SampleRate int    `json:"sampleRate"`
+		} `json:"mediaFormat"`
+	} `json:"start,omitempty"`
+	Media *struct {
+		Track     string `json:"track"`
+		Timestamp string `json:"timestamp"`
+		Chunk     int    `json:"chunk"`
+		Payload   string `json:"payload"`
+	} `json:"media,omitempty"`
+	Dtmf *struct {
+		Track string `json:"track"`
+		Digit string `json:"digit"`
+	} `json:"dtmf,omitempty"`
+}
+
+type PlayAudio struct {
+	ContentType string `json:"contentType"`
+	SampleRate  int    `json:"sampleRate"`
+	Payload     string `json:"payload"`
+}
+
+type PlivoCommand struct {
+	Event    string     `json:"event"`
+	StreamId string     `json:"streamId,omitempty"`
+	Media    *PlayAudio `json:"media,omitempty"`
+}
+
+type CallRequest struct {
+	From         string `json:"from"`
+	To           string `json:"to"`
+	AnswerUrl    string `json:"answer_url"`
+	AnswerMethod string `json:"answer_method"`
+	RingUrl      string `json:"ring_url"`
+	RingMethod   string `json:"ring_method"`
+	HangupUrl    string `json:"hangup_url"`
+	HangupMethod string `json:"hangup_method"`
+}
+
+type CallResponse struct {
+	ApiId       string `json:"api_id"`
+	Message     string `json:"message"`
+	RequestUuid string `json:"request_uuid"`
+}
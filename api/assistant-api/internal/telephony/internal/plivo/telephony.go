@@ -0,0 +1,332 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_plivo_telephony
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	internal_plivo "github.com/rapidaai/api/assistant-api/internal/telephony/internal/plivo/internal"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// api of plivo, it is replaced in tests
+var apiUrl = "https://api.plivo.com/v1"
+
+type plivoTelephony struct {
+	logger commons.Logger
+	appCfg *config.AssistantConfig
+}
+
+// NewPlivoTelephony connects calls of plivo. The answer url of an outbound call returns the same
+// xml as the answer url of a number, both stream the audio of the call over the audio stream websocket.
+func NewPlivoTelephony(config *config.AssistantConfig, logger commons.Logger) (internal_type.Telephony, error) {
+	return &plivoTelephony{
+		logger: logger,
+		appCfg: config,
+	}, nil
+}
+
+func credentialOf(vaultCredential *protos.VaultCredential) (string, string, error) {
+	authId, ok := vaultCredential.GetValue().AsMap()["auth_id"].(string)
+	if !ok || authId == "" {
+		return "", "", fmt.Errorf("illegal vault config auth_id is not found")
+	}
+	authToken, ok := vaultCredential.GetValue().AsMap()["auth_token"].(string)
+	if !ok || authToken == "" {
+		return "", "", fmt.Errorf("illegal vault config auth_token is not found")
+	}
+	return authId, authToken, nil
+}
+
+// request calls the account api of plivo with the auth id and token
+func request(method, authId, authToken, path string, body interface{}) ([]byte, error) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequest(method, fmt.Sprintf("%s/Account/%s%s", apiUrl, url.PathEscape(authId), path), reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(authId, authToken)
+	client := &http.Client{Timeout: 60 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return bodyBytes, fmt.Errorf("status code %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+	return bodyBytes, nil
+}
+
+// hangup ends the call with the given uuid
+func hangup(vaultCredential *protos.VaultCredential, callUuid string) error {
+	authId, authToken, err := credentialOf(vaultCredential)
+	if err != nil {
+		return err
+	}
+	_, err = request("DELETE", authId, authToken, fmt.Sprintf("/Call/%s/", url.PathEscape(callUuid)), nil)
+	return err
+}
+
+// statusOf maps the call status of plivo to the status twilio would report
+func statusOf(callStatus string) string {
+	switch callStatus {
+	case "ringing", "in-progress", "completed", "busy":
+		return callStatus
+	case "no-answer", "timeout":
+		return "no-answer"
+	case "cancel":
+		return "canceled"
+	default:
+		return "failed"
+	}
+}
+
+// params of the callbacks of plivo, they are posted as form and can be given in the url
+func params(c *gin.Context) map[string]string {
+	values := make(map[string]string)
+	for key, value := range c.Request.URL.Query() {
+		if len(value) > 0 {
+			values[key] = value[0]
+		}
+	}
+	if err := c.Request.ParseForm(); err == nil {
+		for key, value := range c.Request.PostForm {
+			if len(value) > 0 {
+				values[key] = value[0]
+			}
+		}
+	}
+	return values
+}
+
+// signedUrl is the url plivo signs, the query is sorted and the posted parameters are appended
+// sorted by their name
+func signedUrl(requestUrl *url.URL, postForm url.Values) string {
+	base := fmt.Sprintf("%s://%s%s", requestUrl.Scheme, requestUrl.Host, requestUrl.Path)
+	query := requestUrl.Query()
+	if len(query) > 0 {
+		keys := make([]string, 0, len(query))
+		for key := range query {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		params := []string{}
+		for _, key := range keys {
+			values := query[key]
+			sort.Strings(values)
+			for _, value := range values {
+				params = append(params, key+"="+value)
+			}
+		}
+		base = base + "?" + strings.Join(params, "&")
+	}
+	if len(postForm) == 0 {
+		return base
+	}
+	keys := make([]string, 0, len(postForm))
+	for key := range postForm {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var builder strings.Builder
+	builder.WriteString(base + ".")
+	for _, key := range keys {
+		values := postForm[key]
+		sort.Strings(values)
+		for _, value := range values {
+			builder.WriteString(key + value)
+		}
+	}
+	return builder.String()
+}
+
+// VerifyWebhook checks the X-Plivo-Signature-V3 of the request, plivo signs the url and the nonce
+// with the auth token of the account
+func (tpc *plivoTelephony) VerifyWebhook(c *gin.Context, vaultCredential *protos.VaultCredential, opts utils.Option) error {
+	_, authToken, err := credentialOf(vaultCredential)
+	if err != nil {
+		return err
+	}
+	signatures := c.GetHeader("X-Plivo-Signature-V3")
+	nonce := c.GetHeader("X-Plivo-Signature-V3-Nonce")
+	if signatures == "" || nonce == "" {
+		return fmt.Errorf("missing plivo signature")
+	}
+	postForm := url.Values{}
+	if c.Request.Method == http.MethodPost {
+		if err := c.Request.ParseForm(); err != nil {
+			return fmt.Errorf("failed to parse form: %w", err)
+		}
+		postForm = c.Request.PostForm
+	}
+	requestUrl, err := url.Parse(fmt.Sprintf("https://%s%s", tpc.appCfg.PublicAssistantHost, c.Request.URL.RequestURI()))
+	if err != nil {
+		return err
+	}
+	mac := hmac.New(sha256.New, []byte(authToken))
+	mac.Write([]byte(signedUrl(requestUrl, postForm) + "." + nonce))
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	for _, signature := range strings.Split(signatures, ",") {
+		if hmac.Equal([]byte(strings.TrimSpace(signature)), []byte(expected)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("illegal plivo signature")
+}
+
+// Recording is not supported, the calls are recorded by the assistant only
+func (tpc *plivoTelephony) Recording(ctx context.Context, vaultCredential *protos.VaultCredential, recordingUrl string) (*internal_type.Recording, error) {
+	return nil, internal_type.ErrProviderRecordingNotSupported
+}
+
+func (tpc *plivoTelephony) CatchAllStatusCallback(ctx *gin.Context) ([]types.Telemetry, error) {
+	return nil, nil
+}
+
+// StatusCallback takes the ring and hangup callbacks of the call and the status callbacks of the
+// audio stream, the latter carry no call status and are kept as events
+func (tpc *plivoTelephony) StatusCallback(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, assistantConversationId uint64) ([]types.Telemetry, error) {
+	body := params(c)
+	if callStatus, ok := body["CallStatus"]; ok && callStatus != "" {
+		status := statusOf(callStatus)
+		return []types.Telemetry{types.NewMetric("STATUS", status, utils.Ptr("Status of conversation")), types.NewEvent(status, body)}, nil
+	}
+	if event, ok := body["Event"]; ok && event != "" {
+		return []types.Telemetry{types.NewEvent(event, body)}, nil
+	}
+	tpc.logger.Errorf("illegal plivo callback without status %+v", body)
+	return nil, fmt.Errorf("missing call status")
+}
+
+func (tpc *plivoTelephony) ReceiveCall(c *gin.Context) (*string, []types.Telemetry, error) {
+	queryParams := make(map[string]string)
+	telemetry := []types.Telemetry{}
+	for key, values := range c.Request.URL.Query() {
+		if len(values) > 0 {
+			queryParams[key] = values[0]
+		}
+	}
+
+	clientNumber, ok := queryParams["From"]
+	if !ok || clientNumber == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid caller"})
+		return nil, telemetry, fmt.Errorf("missing or empty 'from' query parameter")
+	}
+
+	if v, ok := queryParams["CallUUID"]; ok && v != "" {
+		telemetry = append(telemetry, types.NewMetadata("telephony.uuid", v))
+	}
+	return utils.Ptr(clientNumber), append(telemetry, types.NewEvent("webhook", queryParams), types.NewMetric("STATUS", "SUCCESS", utils.Ptr("Status of telephony api"))), nil
+}
+
+// CreateXML streams both directions of the call in mu-law, the events of the stream are posted to
+// the status callback
+func (tpc *plivoTelephony) CreateXML(mediaServer string, path, eventPath string) string {
+	return fmt.Sprintf(`
+	    <Response>
+	        <Stream bidirectional="true" keepCallAlive="true" contentType="audio/x-mulaw;rate=8000" statusCallbackUrl="https://%s/%s" statusCallbackMethod="POST">wss://%s/%s</Stream>
+	    </Response>
+	`,
+		mediaServer,
+		eventPath,
+		mediaServer,
+		path,
+	)
+}
+
+func (tpc *plivoTelephony) InboundCall(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, clientNumber string, assistantConversationId uint64, opts utils.Option) error {
+	c.Data(http.StatusOK, "text/xml", []byte(
+		tpc.CreateXML(
+			tpc.appCfg.PublicAssistantHost,
+			internal_type.GetAnswerPath("plivo", auth, assistantId, assistantConversationId, clientNumber),
+			internal_type.GetEventPath("plivo", auth, assistantId, assistantConversationId),
+		),
+	))
+	return nil
+}
+
+// OutboundCall places the call through the call api, plivo fetches the instruction path once the
+// call is answered
+func (tpc *plivoTelephony) OutboundCall(
+	auth types.SimplePrinciple,
+	toPhone string,
+	fromPhone string,
+	assistantId, assistantConversationId uint64,
+	vaultCredential *protos.VaultCredential,
+	opts utils.Option) ([]types.Telemetry, error) {
+	mtds := []types.Telemetry{
+		types.NewMetadata("telephony.toPhone", toPhone),
+		types.NewMetadata("telephony.fromPhone", fromPhone),
+		types.NewMetadata("telephony.provider", "plivo"),
+	}
+	authId, authToken, err := credentialOf(vaultCredential)
+	if err != nil {
+		return append(mtds, types.NewEvent("FAILED", "Failed to find auth id or token, check credentials"), types.NewMetric("STATUS", "FAILED", utils.Ptr("Status of telephony api"))), err
+	}
+
+	eventUrl := fmt.Sprintf("https://%s/%s", tpc.appCfg.PublicAssistantHost, internal_type.GetEventPath("plivo", auth, assistantId, assistantConversationId))
+	body, err := request("POST", authId, authToken, "/Call/", &internal_plivo.CallRequest{
+		From:         fromPhone,
+		To:           toPhone,
+		AnswerUrl:    fmt.Sprintf("https://%s/%s", tpc.appCfg.PublicAssistantHost, internal_type.GetInstructionPath("plivo", auth, assistantId, assistantConversationId, toPhone)),
+		AnswerMethod: "GET",
+		RingUrl:      eventUrl,
+		RingMethod:   "POST",
+		HangupUrl:    eventUrl,
+		HangupMethod: "POST",
+	})
+	if err != nil {
+		tpc.logger.Errorf("unable to place plivo call: %v", err)
+		return append(mtds, types.NewMetadata("telephony.error", fmt.Sprintf("API error: %s", err.Error())), types.NewMetric("STATUS", "FAILED", utils.Ptr("Status of telephony api"))), err
+	}
+	var resp internal_plivo.CallResponse
+	if err := json.Unmarshal(body, &resp); err != nil || resp.RequestUuid == "" {
+		return append(mtds, types.NewEvent("Failed", "Failed to decode response"), types.NewMetric("STATUS", "FAILED", utils.Ptr("Status of telephony api"))), fmt.Errorf("illegal response of plivo %s", string(body))
+	}
+	return append(mtds,
+		types.NewMetadata("telephony.uuid", resp.RequestUuid),
+		types.NewEvent("initiated", resp),
+		types.NewMetric("STATUS", "SUCCESS", utils.Ptr("Status of telephony api"))), nil
+}
+
+func (tpc *plivoTelephony) Streamer(c *gin.Context, connection *websocket.Conn, assistant *internal_assistant_entity.Assistant, conversation *internal_conversation_entity.AssistantConversation, vlt *protos.VaultCredential) internal_streamers.Streamer {
+	return NewPlivoWebsocketStreamer(tpc.logger, connection, assistant, conversation, vlt)
+}
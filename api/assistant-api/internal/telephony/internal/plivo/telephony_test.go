@@ -0,0 +1,342 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_plivo_telephony
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_plivo "github.com/rapidaai/api/assistant-api/internal/telephony/internal/plivo/internal"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func newTestTelephony(t *testing.T) *plivoTelephony {
+	t.Helper()
+	logger, _ := commons.NewApplicationLogger()
+	tel, err := NewPlivoTelephony(&config.AssistantConfig{PublicAssistantHost: "assistant.rapida.ai"}, logger)
+	require.NoError(t, err)
+	return tel.(*plivoTelephony)
+}
+
+func newTestContext(method, target string, form url.Values) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	if form != nil {
+		c.Request = httptest.NewRequest(method, target, strings.NewReader(form.Encode()))
+		c.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	} else {
+		c.Request = httptest.NewRequest(method, target, nil)
+	}
+	return c, w
+}
+
+func telemetryOf(telemetry []types.Telemetry) (map[string]string, []string, map[string]string) {
+	metadata, events, metrics := map[string]string{}, []string{}, map[string]string{}
+	for _, tel := range telemetry {
+		switch v := tel.(type) {
+		case *types.Metadata:
+			metadata[v.Key] = v.Value
+		case *types.Event:
+			events = append(events, v.EventType)
+		case *types.Metric:
+			metrics[v.Name] = v.Value
+		}
+	}
+	return metadata, events, metrics
+}
+
+// plivoApi stands in for the api of plivo and answers every request with the status and body
+func plivoApi(t *testing.T, status int, body string) <-chan *http.Request {
+	t.Helper()
+	requests := make(chan *http.Request, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, _ := io.ReadAll(r.Body)
+		r.Body = io.NopCloser(strings.NewReader(string(payload)))
+		requests <- r
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	previous := apiUrl
+	apiUrl = server.URL
+	t.Cleanup(func() {
+		apiUrl = previous
+		server.Close()
+	})
+	return requests
+}
+
+func TestReceiveCall(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        string
+		expectedError bool
+		expectedPhone string
+		expectedUuid  string
+	}{
+		{
+			name:          "answer url with call uuid",
+			target:        "/v1/talk/plivo/call/1?From=15703768754&To=13345895552&CallUUID=ce4fe2a4-2fe5-11ee-9a39-b1a1a4dd5d1f&Direction=inbound&CallStatus=ringing",
+			expectedPhone: "15703768754",
+			expectedUuid:  "ce4fe2a4-2fe5-11ee-9a39-b1a1a4dd5d1f",
+		},
+		{
+			name:          "answer url without call uuid",
+			target:        "/v1/talk/plivo/call/1?From=15703768754",
+			expectedPhone: "15703768754",
+		},
+		{
+			name:          "missing caller",
+			target:        "/v1/talk/plivo/call/1?To=13345895552",
+			expectedError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tel := newTestTelephony(t)
+			c, w := newTestContext("GET", tt.target, nil)
+			phone, telemetry, err := tel.ReceiveCall(c)
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, phone)
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPhone, *phone)
+			metadata, events, metrics := telemetryOf(telemetry)
+			assert.Equal(t, tt.expectedUuid, metadata["telephony.uuid"])
+			assert.Contains(t, events, "webhook")
+			assert.Equal(t, "SUCCESS", metrics["STATUS"])
+		})
+	}
+}
+
+func TestInboundCall(t *testing.T) {
+	tel := newTestTelephony(t)
+	c, w := newTestContext("GET", "/v1/talk/plivo/call/1?From=15703768754", nil)
+	require.NoError(t, tel.InboundCall(c, &types.ProjectScope{CurrentToken: "key"}, 1, "15703768754", 2, nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/xml", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), `statusCallbackUrl="https://assistant.rapida.ai/v1/talk/plivo/prj/event/1/2/key"`)
+	assert.Contains(t, w.Body.String(), `>wss://assistant.rapida.ai/v1/talk/plivo/prj/1/15703768754/2/key</Stream>`)
+}
+
+func TestStatusCallback(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		form           url.Values
+		expectedError  bool
+		expectedStatus string
+		expectedEvent  string
+	}{
+		{name: "ringing", target: "/event", form: url.Values{"CallStatus": {"ringing"}}, expectedStatus: "ringing", expectedEvent: "ringing"},
+		{name: "completed", target: "/event", form: url.Values{"CallStatus": {"completed"}, "HangupCause": {"NORMAL_CLEARING"}}, expectedStatus: "completed", expectedEvent: "completed"},
+		{name: "busy", target: "/event", form: url.Values{"CallStatus": {"busy"}}, expectedStatus: "busy", expectedEvent: "busy"},
+		{name: "timeout", target: "/event", form: url.Values{"CallStatus": {"timeout"}}, expectedStatus: "no-answer", expectedEvent: "no-answer"},
+		{name: "cancelled", target: "/event", form: url.Values{"CallStatus": {"cancel"}}, expectedStatus: "canceled", expectedEvent: "canceled"},
+		{name: "failed", target: "/event", form: url.Values{"CallStatus": {"failed"}}, expectedStatus: "failed", expectedEvent: "failed"},
+		{name: "given in url", target: "/event?CallStatus=completed", expectedStatus: "completed", expectedEvent: "completed"},
+		{name: "stream event", target: "/event", form: url.Values{"Event": {"StartStream"}, "StreamID": {"20170ada-f610-433b-8758-c02a2aab3662"}}, expectedEvent: "StartStream"},
+		{name: "missing status", target: "/event", form: url.Values{"CallUUID": {"ce4fe2a4"}}, expectedError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tel := newTestTelephony(t)
+			c, _ := newTestContext("POST", tt.target, tt.form)
+			telemetry, err := tel.StatusCallback(c, &types.ProjectScope{}, 1, 1)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			_, events, metrics := telemetryOf(telemetry)
+			assert.Equal(t, tt.expectedStatus, metrics["STATUS"])
+			assert.Equal(t, []string{tt.expectedEvent}, events)
+		})
+	}
+}
+
+func TestOutboundCall(t *testing.T) {
+	requests := plivoApi(t, http.StatusCreated, `{"api_id":"97ceeb52-58b6-11e1-86da-77300b68f8bb","message":"call fired","request_uuid":"75c5a0c5-6d25-4f8b-9c3e-0c1cdaa0d7d0"}`)
+	value, err := structpb.NewStruct(map[string]interface{}{"auth_id": "MAXXXXXXXXXXXXXXXXXX", "auth_token": "token"})
+	require.NoError(t, err)
+
+	tel := newTestTelephony(t)
+	telemetry, err := tel.OutboundCall(&types.ProjectScope{CurrentToken: "key"}, "15551234567", "15557654321", 1, 2, &protos.VaultCredential{Value: value}, utils.Option{})
+	require.NoError(t, err)
+
+	metadata, _, metrics := telemetryOf(telemetry)
+	assert.Equal(t, "SUCCESS", metrics["STATUS"])
+	assert.Equal(t, "plivo", metadata["telephony.provider"])
+	assert.Equal(t, "75c5a0c5-6d25-4f8b-9c3e-0c1cdaa0d7d0", metadata["telephony.uuid"])
+
+	r := <-requests
+	assert.Equal(t, "POST", r.Method)
+	assert.Equal(t, "/Account/MAXXXXXXXXXXXXXXXXXX/Call/", r.URL.Path)
+	authId, authToken, ok := r.BasicAuth()
+	require.True(t, ok)
+	assert.Equal(t, "MAXXXXXXXXXXXXXXXXXX", authId)
+	assert.Equal(t, "token", authToken)
+	var call internal_plivo.CallRequest
+	require.NoError(t, json.NewDecoder(r.Body).Decode(&call))
+	assert.Equal(t, "15551234567", call.To)
+	assert.Equal(t, "15557654321", call.From)
+	assert.Equal(t, "https://assistant.rapida.ai/v1/talk/plivo/prj/instruction/1/15551234567/2/key", call.AnswerUrl)
+	assert.Equal(t, "https://assistant.rapida.ai/v1/talk/plivo/prj/event/1/2/key", call.HangupUrl)
+	assert.Equal(t, call.HangupUrl, call.RingUrl)
+}
+
+func TestOutboundCallFailed(t *testing.T) {
+	tests := []struct {
+		name       string
+		credential map[string]interface{}
+		status     int
+		body       string
+	}{
+		{name: "missing auth id", credential: map[string]interface{}{"auth_token": "token"}, status: http.StatusCreated},
+		{name: "missing auth token", credential: map[string]interface{}{"auth_id": "MAXXXXXXXXXXXXXXXXXX"}, status: http.StatusCreated},
+		{name: "rejected by plivo", credential: map[string]interface{}{"auth_id": "MAXXXXXXXXXXXXXXXXXX", "auth_token": "token"}, status: http.StatusBadRequest, body: `{"api_id":"97ceeb52","error":"invalid to number"}`},
+		{name: "illegal response", credential: map[string]interface{}{"auth_id": "MAXXXXXXXXXXXXXXXXXX", "auth_token": "token"}, status: http.StatusCreated, body: `{"message":"call fired"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plivoApi(t, tt.status, tt.body)
+			value, err := structpb.NewStruct(tt.credential)
+			require.NoError(t, err)
+			tel := newTestTelephony(t)
+			telemetry, err := tel.OutboundCall(&types.ProjectScope{}, "15551234567", "15557654321", 1, 2, &protos.VaultCredential{Value: value}, utils.Option{})
+			assert.Error(t, err)
+			_, _, metrics := telemetryOf(telemetry)
+			assert.Equal(t, "FAILED", metrics["STATUS"])
+		})
+	}
+}
+
+func TestSignedUrl(t *testing.T) {
+	requestUrl, err := url.Parse("https://assistant.rapida.ai/v1/talk/plivo/call/1?x-api-key=key&From=15703768754&CallUUID=ce4fe2a4")
+	require.NoError(t, err)
+	assert.Equal(t, "https://assistant.rapida.ai/v1/talk/plivo/call/1?CallUUID=ce4fe2a4&From=15703768754&x-api-key=key", signedUrl(requestUrl, nil))
+
+	requestUrl, err = url.Parse("https://assistant.rapida.ai/v1/talk/plivo/prj/event/1/2/key")
+	require.NoError(t, err)
+	assert.Equal(t, "https://assistant.rapida.ai/v1/talk/plivo/prj/event/1/2/key.CallStatuscompletedCallUUIDce4fe2a4", signedUrl(requestUrl, url.Values{"CallUUID": {"ce4fe2a4"}, "CallStatus": {"completed"}}))
+}
+
+func TestVerifyWebhook(t *testing.T) {
+	credential := map[string]interface{}{"auth_id": "MAXXXXXXXXXXXXXXXXXX", "auth_token": "token"}
+	form := url.Values{"CallUUID": {"ce4fe2a4"}, "CallStatus": {"completed"}}
+	sign := func(authToken, signed, nonce string) string {
+		mac := hmac.New(sha256.New, []byte(authToken))
+		mac.Write([]byte(signed + "." + nonce))
+		return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	}
+	eventUrl := "https://assistant.rapida.ai/v1/talk/plivo/prj/event/1/2/key.CallStatuscompletedCallUUIDce4fe2a4"
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		form       url.Values
+		signature  string
+		credential map[string]interface{}
+		wantErr    bool
+	}{
+		{
+			name:       "signed hangup callback",
+			method:     "POST",
+			target:     "/v1/talk/plivo/prj/event/1/2/key",
+			form:       form,
+			signature:  sign("token", eventUrl, "12345"),
+			credential: credential,
+		},
+		{
+			name:       "signed answer url",
+			method:     "GET",
+			target:     "/v1/talk/plivo/call/1?x-api-key=key&From=15703768754",
+			signature:  sign("token", "https://assistant.rapida.ai/v1/talk/plivo/call/1?From=15703768754&x-api-key=key", "12345"),
+			credential: credential,
+		},
+		{
+			name:       "one of the signatures of rotated tokens",
+			method:     "POST",
+			target:     "/v1/talk/plivo/prj/event/1/2/key",
+			form:       form,
+			signature:  sign("old", eventUrl, "12345") + "," + sign("token", eventUrl, "12345"),
+			credential: credential,
+		},
+		{
+			name:       "forged parameters",
+			method:     "POST",
+			target:     "/v1/talk/plivo/prj/event/1/2/key",
+			form:       url.Values{"CallUUID": {"ce4fe2a4"}, "CallStatus": {"busy"}},
+			signature:  sign("token", eventUrl, "12345"),
+			credential: credential,
+			wantErr:    true,
+		},
+		{
+			name:       "signed with another token",
+			method:     "POST",
+			target:     "/v1/talk/plivo/prj/event/1/2/key",
+			form:       form,
+			signature:  sign("other", eventUrl, "12345"),
+			credential: credential,
+			wantErr:    true,
+		},
+		{
+			name:       "missing signature",
+			method:     "POST",
+			target:     "/v1/talk/plivo/prj/event/1/2/key",
+			form:       form,
+			credential: credential,
+			wantErr:    true,
+		},
+		{
+			name:       "missing auth token",
+			method:     "POST",
+			target:     "/v1/talk/plivo/prj/event/1/2/key",
+			form:       form,
+			signature:  sign("token", eventUrl, "12345"),
+			credential: map[string]interface{}{"auth_id": "MAXXXXXXXXXXXXXXXXXX"},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newTestContext(tt.method, tt.target, tt.form)
+			if tt.signature != "" {
+				c.Request.Header.Set("X-Plivo-Signature-V3", tt.signature)
+				c.Request.Header.Set("X-Plivo-Signature-V3-Nonce", "12345")
+			}
+			value, err := structpb.NewStruct(tt.credential)
+			require.NoError(t, err)
+
+			err = newTestTelephony(t).VerifyWebhook(c, &protos.VaultCredential{Value: value}, utils.Option{})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_plivo_telephony
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/gorilla/websocket"
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	internal_telephony_base "github.com/rapidaai/api/assistant-api/internal/telephony/internal/base"
+	internal_plivo "github.com/rapidaai/api/assistant-api/internal/telephony/internal/plivo/internal"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+)
+
+type plivoWebsocketStreamer struct {
+	streamId string
+	callId   string
+	streamer internal_telephony_base.BaseTelephonyStreamer
+	logger   commons.Logger
+}
+
+func NewPlivoWebsocketStreamer(logger commons.Logger, connection *websocket.Conn, assistant *internal_assistant_entity.Assistant, conversation *internal_conversation_entity.AssistantConversation, vlt *protos.VaultCredential) internal_streamers.Streamer {
+	return &plivoWebsocketStreamer{
+		logger:   logger,
+		streamer: internal_telephony_base.NewBaseTelephonyStreamer(logger, connection, assistant, conversation, vlt),
+	}
+}
+
+func (pws *plivoWebsocketStreamer) Context() context.Context {
+	return pws.streamer.Context()
+}
+
+func (pws *plivoWebsocketStreamer) Recv() (*protos.AssistantMessagingRequest, error) {
+	if pws.streamer.Connection() == nil {
+		return nil, io.EOF
+	}
+	_, message, err := pws.streamer.Connection().ReadMessage()
+	if err != nil {
+		pws.streamer.Cancel()
+		return nil, io.EOF
+	}
+
+	var mediaEvent internal_plivo.PlivoMediaEvent
+	if err := json.Unmarshal(message, &mediaEvent); err != nil {
+		pws.logger.Error("Failed to unmarshal Plivo media event", "error", err.Error())
+		return nil, nil
+	}
+	switch mediaEvent.Event {
+	case "start":
+		// plivo sends no connected event, the assistant is connected once the stream starts
+		if mediaEvent.Start != nil {
+			pws.callId = mediaEvent.Start.CallId
+			pws.streamId = mediaEvent.Start.StreamId
+		}
+		return pws.streamer.CreateConnectionRequest(internal_audio.NewMulaw8khzMonoAudioConfig(), internal_audio.NewMulaw8khzMonoAudioConfig())
+	case "media":
+		return pws.handleMediaEvent(mediaEvent)
+	case "dtmf":
+		if mediaEvent.Dtmf == nil || mediaEvent.Dtmf.Digit == "" {
+			return nil, nil
+		}
+		return pws.streamer.CreateDtmfRequest(mediaEvent.Dtmf.Digit), nil
+	case "stop":
+		pws.logger.Info("Plivo stream stopped")
+		pws.streamer.Cancel()
+		return nil, io.EOF
+	case "playedStream", "clearedAudio":
+		return nil, nil
+	default:
+		pws.logger.Warn("Unhandled Plivo event", "event", mediaEvent.Event)
+		return nil, nil
+	}
+}
+
+func (pws *plivoWebsocketStreamer) Send(response *protos.AssistantMessagingResponse) error {
+	switch data := response.GetData().(type) {
+	case *protos.AssistantMessagingResponse_Assistant:
+		switch content := data.Assistant.Message.(type) {
+		case *protos.AssistantConversationAssistantMessage_Audio:
+			// 1ms 8 bytes @ 8kHz µ-law mono, audio is played in 100ms
+			bufferSizeThreshold := 8 * 100
+			pws.streamer.LockOutputAudioBuffer()
+			defer pws.streamer.UnlockOutputAudioBuffer()
+
+			pws.streamer.OutputBuffer().Write(content.Audio.GetContent())
+			for pws.streamer.OutputBuffer().Len() >= bufferSizeThreshold {
+				chunk := pws.streamer.OutputBuffer().Next(bufferSizeThreshold)
+				if err := pws.sendPlivoMessage(pws.playAudio(chunk)); err != nil {
+					pws.logger.Error("Failed to send audio chunk", "error", err.Error())
+					return err
+				}
+			}
+
+			// If response is marked as completed, flush any remaining audio in the buffer
+			if data.Assistant.GetCompleted() && pws.streamer.OutputBuffer().Len() > 0 {
+				if err := pws.sendPlivoMessage(pws.playAudio(pws.streamer.OutputBuffer().Bytes())); err != nil {
+					pws.logger.Error("Failed to send final audio chunk", "error", err.Error())
+					return err
+				}
+				pws.streamer.OutputBuffer().Reset()
+			}
+		}
+	case *protos.AssistantMessagingResponse_Interruption:
+		if data.Interruption.Type == protos.AssistantConversationInterruption_INTERRUPTION_TYPE_WORD {
+			pws.streamer.LockOutputAudioBuffer()
+			pws.streamer.OutputBuffer().Reset()
+			pws.streamer.UnlockOutputAudioBuffer()
+
+			if err := pws.sendPlivoMessage(&internal_plivo.PlivoCommand{Event: "clearAudio", StreamId: pws.streamId}); err != nil {
+				pws.logger.Errorf("Error sending clear audio command: %v", err)
+			}
+		}
+	case *protos.AssistantMessagingResponse_Action:
+		if data.Action.GetAction() == protos.AssistantConversationAction_END_CONVERSATION {
+			if pws.callId != "" {
+				if err := hangup(pws.streamer.VaultCredential(), pws.callId); err != nil {
+					pws.logger.Errorf("Error ending Plivo call: %v", err)
+				}
+			}
+			if err := pws.streamer.Cancel(); err != nil {
+				pws.logger.Errorf("Error disconnecting command: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (pws *plivoWebsocketStreamer) playAudio(chunk []byte) *internal_plivo.PlivoCommand {
+	return &internal_plivo.PlivoCommand{
+		Event: "playAudio",
+		Media: &internal_plivo.PlayAudio{
+			ContentType: "audio/x-mulaw",
+			SampleRate:  8000,
+			Payload:     pws.streamer.Encoder().EncodeToString(chunk),
+		},
+	}
+}
+
+func (pws *plivoWebsocketStreamer) handleMediaEvent(mediaEvent internal_plivo.PlivoMediaEvent) (*protos.AssistantMessagingRequest, error) {
+	if mediaEvent.Media == nil {
+		return nil, nil
+	}
+	payloadBytes, err := pws.streamer.Encoder().DecodeString(mediaEvent.Media.Payload)
+	if err != nil {
+		pws.logger.Warn("Failed to decode media payload", "error", err.Error())
+		return nil, nil
+	}
+
+	pws.streamer.LockInputAudioBuffer()
+	defer pws.streamer.UnlockInputAudioBuffer()
+
+	// 1ms 8 bytes @ 8kHz µ-law mono 60ms of audio as silero can't process smaller chunk for mulaw
+	pws.streamer.InputBuffer().Write(payloadBytes)
+	const bufferSizeThreshold = 8 * 60
+	if pws.streamer.InputBuffer().Len() >= bufferSizeThreshold {
+		audioRequest := pws.streamer.CreateVoiceRequest(pws.streamer.InputBuffer().Bytes())
+		pws.streamer.InputBuffer().Reset()
+		return audioRequest, nil
+	}
+	return nil, nil
+}
+
+func (pws *plivoWebsocketStreamer) sendPlivoMessage(command *internal_plivo.PlivoCommand) error {
+	if pws.streamer.Connection() == nil {
+		return nil
+	}
+	message, err := json.Marshal(command)
+	if err != nil {
+		pws.logger.Error("Failed to marshal Plivo message", "error", err.Error())
+		return err
+	}
+	if err := pws.streamer.Connection().WriteMessage(websocket.TextMessage, message); err != nil {
+		pws.logger.Error("Failed to send message to Plivo", "error", err.Error())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_plivo_telephony
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	internal_plivo "github.com/rapidaai/api/assistant-api/internal/telephony/internal/plivo/internal"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// newTestStreamer connects a websocket standing in for the audio stream of plivo to the streamer
+func newTestStreamer(t *testing.T, vlt *protos.VaultCredential) (internal_streamers.Streamer, *websocket.Conn) {
+	t.Helper()
+	logger, _ := commons.NewApplicationLogger()
+	streamer := make(chan internal_streamers.Streamer, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		streamer <- NewPlivoWebsocketStreamer(logger, conn, &internal_assistant_entity.Assistant{}, &internal_conversation_entity.AssistantConversation{}, vlt)
+	}))
+	t.Cleanup(server.Close)
+
+	stream, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { stream.Close() })
+	stream.SetReadDeadline(time.Now().Add(5 * time.Second))
+	return <-streamer, stream
+}
+
+func readCommand(t *testing.T, stream *websocket.Conn) internal_plivo.PlivoCommand {
+	t.Helper()
+	_, message, err := stream.ReadMessage()
+	require.NoError(t, err)
+	var command internal_plivo.PlivoCommand
+	require.NoError(t, json.Unmarshal(message, &command))
+	return command
+}
+
+func mediaEvent(size int) []byte {
+	message, _ := json.Marshal(map[string]interface{}{
+		"event":    "media",
+		"streamId": "20170ada-f610-433b-8758-c02a2aab3662",
+		"media":    map[string]interface{}{"track": "inbound", "chunk": 1, "payload": base64.StdEncoding.EncodeToString(make([]byte, size))},
+	})
+	return message
+}
+
+func TestStreamerRecv(t *testing.T) {
+	streamer, stream := newTestStreamer(t, nil)
+
+	require.NoError(t, stream.WriteMessage(websocket.TextMessage, []byte(`{"event":"start","sequenceNumber":0,"start":{"callId":"ce4fe2a4-2fe5-11ee-9a39-b1a1a4dd5d1f","streamId":"20170ada-f610-433b-8758-c02a2aab3662","tracks":["inbound"],"mediaFormat":{"encoding":"audio/x-mulaw","sampleRate":8000}}}`)))
+	request, err := streamer.Recv()
+	require.NoError(t, err)
+	configuration := request.GetConfiguration()
+	require.NotNil(t, configuration)
+	assert.Equal(t, uint32(8000), configuration.GetInputConfig().GetAudio().GetSampleRate())
+	assert.Equal(t, protos.AudioConfig_MuLaw8, configuration.GetOutputConfig().GetAudio().GetAudioFormat())
+	assert.Equal(t, "ce4fe2a4-2fe5-11ee-9a39-b1a1a4dd5d1f", streamer.(*plivoWebsocketStreamer).callId)
+	assert.Equal(t, "20170ada-f610-433b-8758-c02a2aab3662", streamer.(*plivoWebsocketStreamer).streamId)
+
+	// audio is buffered to 60ms
+	require.NoError(t, stream.WriteMessage(websocket.TextMessage, mediaEvent(320)))
+	request, err = streamer.Recv()
+	require.NoError(t, err)
+	assert.Nil(t, request)
+	require.NoError(t, stream.WriteMessage(websocket.TextMessage, mediaEvent(320)))
+	request, err = streamer.Recv()
+	require.NoError(t, err)
+	assert.Len(t, request.GetMessage().GetAudio().GetContent(), 640)
+
+	require.NoError(t, stream.WriteMessage(websocket.TextMessage, []byte(`{"event":"dtmf","dtmf":{"track":"inbound","digit":"5"}}`)))
+	request, err = streamer.Recv()
+	require.NoError(t, err)
+	assert.NotNil(t, request)
+
+	require.NoError(t, stream.WriteMessage(websocket.TextMessage, []byte(`{"event":"playedStream","name":"chunk"}`)))
+	request, err = streamer.Recv()
+	require.NoError(t, err)
+	assert.Nil(t, request)
+
+	stream.Close()
+	_, err = streamer.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestStreamerSend(t *testing.T) {
+	requests := plivoApi(t, http.StatusNoContent, "")
+	value, err := structpb.NewStruct(map[string]interface{}{"auth_id": "MAXXXXXXXXXXXXXXXXXX", "auth_token": "token"})
+	require.NoError(t, err)
+	streamer, stream := newTestStreamer(t, &protos.VaultCredential{Value: value})
+
+	audio := func(content []byte, completed bool) *protos.AssistantMessagingResponse {
+		return &protos.AssistantMessagingResponse{Data: &protos.AssistantMessagingResponse_Assistant{
+			Assistant: &protos.AssistantConversationAssistantMessage{
+				Message:   &protos.AssistantConversationAssistantMessage_Audio{Audio: &protos.AssistantConversationMessageAudioContent{Content: content}},
+				Completed: completed,
+			},
+		}}
+	}
+	payloadOf := func(command internal_plivo.PlivoCommand) []byte {
+		t.Helper()
+		assert.Equal(t, "playAudio", command.Event)
+		require.NotNil(t, command.Media)
+		assert.Equal(t, "audio/x-mulaw", command.Media.ContentType)
+		assert.Equal(t, 8000, command.Media.SampleRate)
+		content, err := base64.StdEncoding.DecodeString(command.Media.Payload)
+		require.NoError(t, err)
+		return content
+	}
+
+	// audio is played in 100ms and the rest once the response completed
+	require.NoError(t, streamer.Send(audio(make([]byte, 900), false)))
+	assert.Len(t, payloadOf(readCommand(t, stream)), 800)
+	require.NoError(t, streamer.Send(audio(make([]byte, 60), true)))
+	assert.Len(t, payloadOf(readCommand(t, stream)), 160)
+
+	streamer.(*plivoWebsocketStreamer).streamId = "20170ada-f610-433b-8758-c02a2aab3662"
+	require.NoError(t, streamer.Send(&protos.AssistantMessagingResponse{Data: &protos.AssistantMessagingResponse_Interruption{
+		Interruption: &protos.AssistantConversationInterruption{Type: protos.AssistantConversationInterruption_INTERRUPTION_TYPE_WORD},
+	}}))
+	command := readCommand(t, stream)
+	assert.Equal(t, "clearAudio", command.Event)
+	assert.Equal(t, "20170ada-f610-433b-8758-c02a2aab3662", command.StreamId)
+
+	// the call of the stream is hung up
+	streamer.(*plivoWebsocketStreamer).callId = "ce4fe2a4-2fe5-11ee-9a39-b1a1a4dd5d1f"
+	require.NoError(t, streamer.Send(&protos.AssistantMessagingResponse{Data: &protos.AssistantMessagingResponse_Action{
+		Action: &protos.AssistantConversationAction{Action: protos.AssistantConversationAction_END_CONVERSATION},
+	}}))
+	r := <-requests
+	assert.Equal(t, "DELETE", r.Method)
+	assert.Equal(t, "/Account/MAXXXXXXXXXXXXXXXXXX/Call/ce4fe2a4-2fe5-11ee-9a39-b1a1a4dd5d1f/", r.URL.Path)
+}
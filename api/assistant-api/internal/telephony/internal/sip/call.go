@@ -0,0 +1,545 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sip_telephony
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	internal_sip "github.com/rapidaai/api/assistant-api/internal/telephony/internal/sip/internal"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/utils"
+)
+
+type callState int
+
+const (
+	// offered to the handler or ringing at the callee
+	callRinging callState = iota
+	callAnswered
+	callEnded
+)
+
+// userPart is the characters of the user of a sip uri which need no escaping, without the
+// separators of uri parameters and headers, numbers and extensions are dialed as the user
+var userPart = regexp.MustCompile(`^[A-Za-z0-9\-_.!~*'()&=+$]+$`)
+
+// trunk is where outbound calls are placed
+type trunk struct {
+	server   internal_sip.URI
+	addr     *net.UDPAddr
+	domain   string
+	username string
+	password string
+}
+
+// call is a dialog of the user agent and its audio
+type call struct {
+	ua    *userAgent
+	id    string
+	dial  *internal_type.SipDial
+	media *media
+	// signalling of the dialog is sent to the trunk it came from
+	addr net.Addr
+	// sdp of the dialog keeps its session id
+	sessionId uint64
+
+	mu     sync.Mutex
+	state  callState
+	invite *internal_sip.Message
+	// from and to headers of the dialog as sent by this side, with their tags
+	local        string
+	remote       string
+	remoteTarget string
+	cseq         uint32
+	ack          *internal_sip.Message
+	transferring bool
+
+	acked   chan struct{}
+	ackOnce sync.Once
+	done    chan struct{}
+}
+
+func newInboundCall(ua *userAgent, invite *internal_sip.Message, addr net.Addr, m *media) *call {
+	remoteTarget := invite.From().URI.String()
+	if contact := invite.Get("Contact"); contact != "" {
+		remoteTarget = internal_sip.ParseAddress(contact).URI.String()
+	}
+	return &call{
+		ua:           ua,
+		id:           invite.CallID(),
+		media:        m,
+		addr:         addr,
+		sessionId:    uint64(time.Now().Unix()),
+		invite:       invite,
+		local:        invite.Get("To") + ";tag=" + internal_sip.NewTag(),
+		remote:       invite.Get("From"),
+		remoteTarget: remoteTarget,
+		acked:        make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+}
+
+// dial places an outbound call through the trunk, it returns once the trunk accepted the call
+func (ua *userAgent) dial(ctx context.Context, to, from string, t *trunk, dial *internal_type.SipDial) (*call, error) {
+	// the numbers go into the request uri and the headers of the invite
+	if !userPart.MatchString(to) || !userPart.MatchString(from) {
+		return nil, fmt.Errorf("illegal sip user %q or %q", to, from)
+	}
+	m, err := ua.openMedia()
+	if err != nil {
+		return nil, err
+	}
+	domain := t.domain
+	if domain == "" {
+		domain = t.server.Host
+	}
+	requestURI := internal_sip.URI{Scheme: "sip", User: to, Host: t.server.Host, Port: t.server.Port}.String()
+	c := &call{
+		ua:           ua,
+		id:           internal_sip.NewCallID(),
+		dial:         dial,
+		media:        m,
+		addr:         t.addr,
+		sessionId:    uint64(time.Now().Unix()),
+		local:        fmt.Sprintf("<sip:%s@%s>;tag=%s", from, domain, internal_sip.NewTag()),
+		remote:       fmt.Sprintf("<sip:%s@%s>", to, domain),
+		remoteTarget: requestURI,
+		cseq:         1,
+		acked:        make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+	offer := &internal_sip.SessionDescription{
+		Address: ua.host,
+		Port:    m.Port(),
+		Codecs:  []internal_sip.Codec{internal_sip.PCMU, internal_sip.PCMA, internal_sip.TelephoneEvent},
+	}
+	invite := internal_sip.NewRequest(internal_sip.INVITE, requestURI)
+	invite.Set("Via", ua.via())
+	invite.Set("Max-Forwards", "70")
+	invite.Set("From", c.local)
+	invite.Set("To", c.remote)
+	invite.Set("Call-ID", c.id)
+	invite.Set("CSeq", fmt.Sprintf("%d %s", c.cseq, internal_sip.INVITE))
+	invite.Set("Contact", ua.contact(from))
+	invite.Set("Allow", allow)
+	invite.Set("User-Agent", userAgentName)
+	invite.Set("Content-Type", "application/sdp")
+	invite.Body = offer.Marshal(c.sessionId)
+	c.invite = invite
+
+	ua.mu.Lock()
+	ua.calls[c.id] = c
+	ua.mu.Unlock()
+
+	accepted := make(chan error, 1)
+	utils.Go(ua.ctx, func() {
+		c.ring(t, accepted)
+	})
+	select {
+	case err := <-accepted:
+		if err != nil {
+			return nil, err
+		}
+		return c, nil
+	case <-ctx.Done():
+		c.Hangup()
+		return nil, ctx.Err()
+	}
+}
+
+// ring waits for the callee to answer, accepted is told once the trunk took the call
+func (c *call) ring(t *trunk, accepted chan<- error) {
+	var once sync.Once
+	accept := func(err error) {
+		once.Do(func() { accepted <- err })
+	}
+	ctx, cancel := context.WithTimeout(c.ua.ctx, ringTimeout+transactionTimeout)
+	defer cancel()
+	ringing := time.AfterFunc(ringTimeout, c.cancel)
+	defer ringing.Stop()
+
+	res, err := c.ua.authorized(ctx, c.invite, c.addr, t.username, t.password, func(*internal_sip.Message) {
+		accept(nil)
+	})
+	if err != nil {
+		accept(err)
+		c.close("failed", err.Error())
+		return
+	}
+	if res.StatusCode >= 300 {
+		c.ua.send(ackOf(c.invite, res), c.addr)
+		accept(fmt.Errorf("call rejected with %d %s", res.StatusCode, res.Reason))
+		c.close(statusOf(res.StatusCode), fmt.Sprintf("%d %s", res.StatusCode, res.Reason))
+		return
+	}
+	if err := c.answered(res); err != nil {
+		c.ua.logger.Errorf("unable to take answer of sip call %s: %v", c.id, err)
+		accept(err)
+		c.Hangup()
+		return
+	}
+	accept(nil)
+	c.ua.handler.Answered(c.ua.ctx, c)
+}
+
+// answered acknowledges the answer of the callee and starts the audio
+func (c *call) answered(res *internal_sip.Message) error {
+	c.mu.Lock()
+	c.remote = res.Get("To")
+	if contact := res.Get("Contact"); contact != "" {
+		c.remoteTarget = internal_sip.ParseAddress(contact).URI.String()
+	}
+	c.cseq, _ = c.invite.CSeq()
+	ack := internal_sip.NewRequest(internal_sip.ACK, c.remoteTarget)
+	ack.Set("Via", c.ua.via())
+	ack.Set("Max-Forwards", "70")
+	ack.Set("From", c.local)
+	ack.Set("To", c.remote)
+	ack.Set("Call-ID", c.id)
+	ack.Set("CSeq", fmt.Sprintf("%d %s", c.cseq, internal_sip.ACK))
+	c.ack = ack
+	c.state = callAnswered
+	c.mu.Unlock()
+	c.ua.send(ack, c.addr)
+
+	answer, err := internal_sip.ParseSDP(res.Body)
+	if err != nil {
+		return err
+	}
+	codec, event, err := internal_sip.Negotiate(answer, internal_sip.PCMU, internal_sip.PCMA)
+	if err != nil {
+		return err
+	}
+	remote, err := net.ResolveUDPAddr("udp", net.JoinHostPort(answer.Address, strconv.Itoa(answer.Port)))
+	if err != nil {
+		return err
+	}
+	c.media.Negotiated(remote, codec, event)
+	c.media.Start()
+	return nil
+}
+
+// statusOf maps the failure of an outbound call to the status of the call
+func statusOf(statusCode int) string {
+	switch statusCode {
+	case 486, 600:
+		return "busy"
+	case 408, 480, 487:
+		return "no-answer"
+	default:
+		return "failed"
+	}
+}
+
+// cancel stops ringing, the trunk ends the invite with 487
+func (c *call) cancel() {
+	c.mu.Lock()
+	if c.state != callRinging || c.dial == nil {
+		c.mu.Unlock()
+		return
+	}
+	invite := c.invite
+	c.mu.Unlock()
+
+	seq, _ := invite.CSeq()
+	req := internal_sip.NewRequest(internal_sip.CANCEL, invite.RequestURI)
+	req.Set("Via", invite.Get("Via"))
+	req.Set("Max-Forwards", "70")
+	req.Set("From", invite.Get("From"))
+	req.Set("To", invite.Get("To"))
+	req.Set("Call-ID", c.id)
+	req.Set("CSeq", fmt.Sprintf("%d %s", seq, internal_sip.CANCEL))
+	utils.Go(c.ua.ctx, func() {
+		ctx, cancel := context.WithTimeout(c.ua.ctx, transactionTimeout)
+		defer cancel()
+		if _, err := c.ua.request(ctx, req, c.addr, nil); err != nil {
+			c.ua.logger.Warnf("unable to cancel sip call %s: %v", c.id, err)
+		}
+	})
+}
+
+func (c *call) Id() string {
+	return c.id
+}
+
+func (c *call) User() string {
+	return internal_sip.ParseURI(c.invite.RequestURI).User
+}
+
+func (c *call) Param(name string) string {
+	return internal_sip.ParseURI(c.invite.RequestURI).Params[strings.ToLower(name)]
+}
+
+func (c *call) Header(name string) string {
+	return c.invite.Get(name)
+}
+
+func (c *call) From() string {
+	return c.invite.From().URI.User
+}
+
+func (c *call) To() string {
+	return c.invite.To().URI.User
+}
+
+func (c *call) Outbound() *internal_type.SipDial {
+	return c.dial
+}
+
+func (c *call) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *call) description() []byte {
+	c.media.mu.Lock()
+	codecs := []internal_sip.Codec{c.media.codec}
+	if c.media.event != nil {
+		codecs = append(codecs, *c.media.event)
+	}
+	c.media.mu.Unlock()
+	sd := &internal_sip.SessionDescription{Address: c.ua.host, Port: c.media.Port(), Codecs: codecs}
+	return sd.Marshal(c.sessionId)
+}
+
+func (c *call) Answer() error {
+	c.mu.Lock()
+	if c.dial != nil || c.state != callRinging {
+		c.mu.Unlock()
+		return errors.New("only a ringing inbound call can be answered")
+	}
+	c.state = callAnswered
+	res := internal_sip.NewResponse(c.invite, 200, "OK")
+	res.Set("To", c.local)
+	c.mu.Unlock()
+
+	res.Set("Contact", c.ua.contact(""))
+	res.Set("Allow", allow)
+	res.Set("User-Agent", userAgentName)
+	res.Set("Content-Type", "application/sdp")
+	res.Body = c.description()
+	c.ua.reply(c.invite, c.addr, res)
+	c.media.Start()
+	utils.Go(c.ua.ctx, func() {
+		c.retransmitAnswer(res)
+	})
+	return nil
+}
+
+// retransmitAnswer sends the 200 until the caller acknowledges it, the call is ended when the
+// ack does not come
+func (c *call) retransmitAnswer(res *internal_sip.Message) {
+	interval := t1
+	timeout := time.NewTimer(transactionTimeout)
+	defer timeout.Stop()
+	for {
+		select {
+		case <-c.acked:
+			return
+		case <-c.done:
+			return
+		case <-timeout.C:
+			c.ua.logger.Warnf("sip call %s was answered but never acknowledged", c.id)
+			c.Hangup()
+			return
+		case <-time.After(interval):
+			c.ua.send(res, c.addr)
+			interval = min(interval*2, t2)
+		}
+	}
+}
+
+func (c *call) Reject(statusCode int, reason string) error {
+	c.mu.Lock()
+	if c.dial != nil || c.state != callRinging {
+		c.mu.Unlock()
+		return errors.New("only a ringing inbound call can be rejected")
+	}
+	c.mu.Unlock()
+	c.ua.respond(c.invite, c.addr, statusCode, reason)
+	c.close("", reason)
+	return nil
+}
+
+// Hangup ends the call, a ringing outbound call is cancelled and a ringing inbound call declined
+func (c *call) Hangup() error {
+	c.mu.Lock()
+	state := c.state
+	c.mu.Unlock()
+	switch state {
+	case callEnded:
+		return nil
+	case callRinging:
+		if c.dial == nil {
+			return c.Reject(603, "Decline")
+		}
+		c.cancel()
+		return nil
+	}
+
+	req := c.request(internal_sip.BYE)
+	c.end("hangup")
+	utils.Go(context.Background(), func() {
+		ctx, cancel := context.WithTimeout(context.Background(), transactionTimeout)
+		defer cancel()
+		if _, err := c.ua.request(ctx, req, c.addr, nil); err != nil {
+			c.ua.logger.Warnf("bye of sip call %s was not answered: %v", c.id, err)
+		}
+	})
+	return nil
+}
+
+// Transfer refers the caller to the target, a number is called through the trunk of the call.
+// The call is ended once the trunk reports the transfer succeeded.
+func (c *call) Transfer(target string) error {
+	c.mu.Lock()
+	state := c.state
+	remote := internal_sip.ParseAddress(c.remote).URI
+	local := internal_sip.ParseAddress(c.local).URI
+	c.mu.Unlock()
+	if state != callAnswered {
+		return errors.New("only an answered call can be transferred")
+	}
+
+	referTo := target
+	if !strings.Contains(target, ":") {
+		referTo = internal_sip.URI{Scheme: "sip", User: target, Host: remote.Host, Port: remote.Port}.String()
+	}
+	req := c.request(internal_sip.REFER)
+	req.Set("Refer-To", "<"+referTo+">")
+	req.Set("Referred-By", "<"+local.String()+">")
+	ctx, cancel := context.WithTimeout(c.ua.ctx, transactionTimeout)
+	defer cancel()
+	res, err := c.ua.request(ctx, req, c.addr, nil)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("transfer rejected with %d %s", res.StatusCode, res.Reason)
+	}
+	c.mu.Lock()
+	c.transferring = true
+	c.mu.Unlock()
+	return nil
+}
+
+// request builds a request within the dialog
+func (c *call) request(method string) *internal_sip.Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cseq++
+	req := internal_sip.NewRequest(method, c.remoteTarget)
+	req.Set("Via", c.ua.via())
+	req.Set("Max-Forwards", "70")
+	req.Set("From", c.local)
+	req.Set("To", c.remote)
+	req.Set("Call-ID", c.id)
+	req.Set("CSeq", fmt.Sprintf("%d %s", c.cseq, method))
+	req.Set("Contact", c.ua.contact(""))
+	req.Set("User-Agent", userAgentName)
+	return req
+}
+
+func (c *call) onAck() {
+	c.ackOnce.Do(func() { close(c.acked) })
+}
+
+func (c *call) resendAck() {
+	c.mu.Lock()
+	ack := c.ack
+	c.mu.Unlock()
+	if ack != nil {
+		c.ua.send(ack, c.addr)
+	}
+}
+
+// onCancel ends an inbound call the caller gave up before it was answered
+func (c *call) onCancel() {
+	c.mu.Lock()
+	ringing := c.state == callRinging && c.dial == nil
+	c.mu.Unlock()
+	if !ringing {
+		return
+	}
+	c.ua.respond(c.invite, c.addr, 487, "Request Terminated")
+	c.close("", "cancelled by caller")
+}
+
+// onReinvite answers a session refresh or hold of the other side with the same audio
+func (c *call) onReinvite(req *internal_sip.Message, addr net.Addr) {
+	if offer, err := internal_sip.ParseSDP(req.Body); err == nil {
+		if remote, err := net.ResolveUDPAddr("udp", net.JoinHostPort(offer.Address, strconv.Itoa(offer.Port))); err == nil {
+			c.media.mu.Lock()
+			c.media.remote = remote
+			c.media.mu.Unlock()
+		}
+	}
+	res := internal_sip.NewResponse(req, 200, "OK")
+	res.Set("Contact", c.ua.contact(""))
+	res.Set("User-Agent", userAgentName)
+	res.Set("Content-Type", "application/sdp")
+	res.Body = c.description()
+	c.ua.reply(req, addr, res)
+}
+
+// onNotify follows the progress of a transfer, the call ends once the target answered
+func (c *call) onNotify(req *internal_sip.Message) {
+	c.mu.Lock()
+	transferring := c.transferring
+	c.mu.Unlock()
+	if !transferring || !strings.HasPrefix(strings.ToLower(req.Get("Event")), "refer") {
+		return
+	}
+	// message/sipfrag body, e.g. SIP/2.0 200 OK
+	fields := strings.Fields(string(req.Body))
+	if len(fields) < 2 {
+		return
+	}
+	statusCode, err := strconv.Atoi(fields[1])
+	if err != nil || statusCode < 200 {
+		return
+	}
+	if statusCode >= 300 {
+		c.ua.logger.Warnf("transfer of sip call %s failed with %d", c.id, statusCode)
+		c.mu.Lock()
+		c.transferring = false
+		c.mu.Unlock()
+		return
+	}
+	c.ua.logger.Infof("sip call %s transferred", c.id)
+	c.Hangup()
+}
+
+func (c *call) end(reason string) {
+	c.close("completed", reason)
+}
+
+// close releases the call, the handler is told how an outbound call ended
+func (c *call) close(status, reason string) {
+	c.mu.Lock()
+	if c.state == callEnded {
+		c.mu.Unlock()
+		return
+	}
+	c.state = callEnded
+	c.mu.Unlock()
+
+	c.media.Close()
+	c.ua.mu.Lock()
+	delete(c.ua.calls, c.id)
+	c.ua.mu.Unlock()
+	close(c.done)
+	if c.dial != nil && status != "" {
+		c.ua.handler.Ended(c.ua.ctx, c, status, reason)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sip
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Challenge is the digest challenge of a 401 or 407 response, rfc 2617
+type Challenge struct {
+	Realm     string
+	Nonce     string
+	Opaque    string
+	Algorithm string
+	Qop       string
+}
+
+func ParseChallenge(header string) (*Challenge, error) {
+	scheme, rest, ok := strings.Cut(strings.TrimSpace(header), " ")
+	if !ok || !strings.EqualFold(scheme, "Digest") {
+		return nil, fmt.Errorf("unsupported authentication scheme %q", scheme)
+	}
+	c := &Challenge{}
+	for _, param := range splitValues(rest) {
+		key, value, _ := strings.Cut(param, "=")
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "realm":
+			c.Realm = value
+		case "nonce":
+			c.Nonce = value
+		case "opaque":
+			c.Opaque = value
+		case "algorithm":
+			c.Algorithm = value
+		case "qop":
+			// auth-int is not offered for requests we send
+			for _, qop := range strings.Split(value, ",") {
+				if strings.TrimSpace(qop) == "auth" {
+					c.Qop = "auth"
+				}
+			}
+		}
+	}
+	if c.Nonce == "" {
+		return nil, fmt.Errorf("digest challenge without nonce")
+	}
+	if c.Algorithm != "" && !strings.EqualFold(c.Algorithm, "MD5") {
+		return nil, fmt.Errorf("unsupported digest algorithm %q", c.Algorithm)
+	}
+	return c, nil
+}
+
+// Authorization answers the challenge for the request, nc counts the requests sent with the
+// same nonce
+func (c *Challenge) Authorization(method, uri, username, password string, nc int) string {
+	ha1 := md5Hex(username + ":" + c.Realm + ":" + password)
+	ha2 := md5Hex(method + ":" + uri)
+	var b strings.Builder
+	fmt.Fprintf(&b, `Digest username="%s", realm="%s", nonce="%s", uri="%s"`, username, c.Realm, c.Nonce, uri)
+	if c.Qop == "auth" {
+		cnonce := random(8)
+		count := fmt.Sprintf("%08x", nc)
+		response := md5Hex(ha1 + ":" + c.Nonce + ":" + count + ":" + cnonce + ":auth:" + ha2)
+		fmt.Fprintf(&b, `, response="%s", qop=auth, nc=%s, cnonce="%s"`, response, count, cnonce)
+	} else {
+		fmt.Fprintf(&b, `, response="%s"`, md5Hex(ha1+":"+c.Nonce+":"+ha2))
+	}
+	fmt.Fprintf(&b, ", algorithm=MD5")
+	if c.Opaque != "" {
+		fmt.Fprintf(&b, `, opaque="%s"`, c.Opaque)
+	}
+	return b.String()
+}
+
+func md5Hex(v string) string {
+	sum := md5.Sum([]byte(v))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChallenge(t *testing.T) {
+	c, err := ParseChallenge(`Digest realm="trunk.example.com", nonce="dcd98b7102dd2f0e", opaque="5ccc069c", qop="auth,auth-int", algorithm=MD5`)
+	require.NoError(t, err)
+	assert.Equal(t, &Challenge{Realm: "trunk.example.com", Nonce: "dcd98b7102dd2f0e", Opaque: "5ccc069c", Algorithm: "MD5", Qop: "auth"}, c)
+
+	for _, header := range []string{
+		`Basic realm="trunk"`,
+		`Digest realm="trunk"`,
+		`Digest realm="trunk", nonce="abc", algorithm=SHA-256`,
+	} {
+		_, err := ParseChallenge(header)
+		assert.Error(t, err, header)
+	}
+}
+
+func TestChallenge_Authorization(t *testing.T) {
+	// rfc 2069 digest of the challenge without qop
+	c := &Challenge{Realm: "testrealm@host.com", Nonce: "dcd98b7102dd2f0e8b11d0f600bfb0c093"}
+	authorization := c.Authorization("GET", "/dir/index.html", "Mufasa", "CircleOfLife", 1)
+	assert.Contains(t, authorization, `response="1949323746fe6a43ef61f9606e7febea"`)
+
+	c.Qop = "auth"
+	c.Opaque = "5ccc069c403ebaf9f0171e9517f40e41"
+	authorization = c.Authorization("REGISTER", "sip:trunk.example.com", "Mufasa", "CircleOfLife", 2)
+	assert.Contains(t, authorization, "qop=auth, nc=00000002")
+	assert.Contains(t, authorization, `opaque="5ccc069c403ebaf9f0171e9517f40e41"`)
+}
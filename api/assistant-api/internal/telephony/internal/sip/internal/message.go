@@ -0,0 +1,444 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sip
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	Version = "SIP/2.0"
+	// branch of every via must start with the magic cookie of rfc 3261
+	BranchPrefix = "z9hG4bK"
+)
+
+const (
+	INVITE   = "INVITE"
+	ACK      = "ACK"
+	BYE      = "BYE"
+	CANCEL   = "CANCEL"
+	OPTIONS  = "OPTIONS"
+	REGISTER = "REGISTER"
+	REFER    = "REFER"
+	NOTIFY   = "NOTIFY"
+)
+
+// compact forms of the header names, rfc 3261 section 7.3.3
+var compactHeaders = map[string]string{
+	"i": "Call-ID",
+	"m": "Contact",
+	"e": "Content-Encoding",
+	"l": "Content-Length",
+	"c": "Content-Type",
+	"f": "From",
+	"s": "Subject",
+	"k": "Supported",
+	"t": "To",
+	"v": "Via",
+	"r": "Refer-To",
+	"b": "Referred-By",
+}
+
+type Header struct {
+	Name  string
+	Value string
+}
+
+// Message is a sip request or response, requests have a method and responses a status code
+type Message struct {
+	Method     string
+	RequestURI string
+	StatusCode int
+	Reason     string
+	Headers    []Header
+	Body       []byte
+}
+
+func NewRequest(method, requestURI string) *Message {
+	return &Message{Method: method, RequestURI: requestURI}
+}
+
+// NewResponse answers the request, the headers identifying the transaction and the dialog are
+// copied from the request
+func NewResponse(req *Message, statusCode int, reason string) *Message {
+	res := &Message{StatusCode: statusCode, Reason: reason}
+	for _, h := range req.Headers {
+		switch h.Name {
+		case "Via", "From", "To", "Call-ID", "CSeq", "Record-Route":
+			res.Headers = append(res.Headers, h)
+		}
+	}
+	return res
+}
+
+func (m *Message) IsRequest() bool {
+	return m.Method != ""
+}
+
+func canonical(name string) string {
+	if full, ok := compactHeaders[strings.ToLower(name)]; ok {
+		return full
+	}
+	for _, full := range compactHeaders {
+		if strings.EqualFold(full, name) {
+			return full
+		}
+	}
+	switch strings.ToLower(name) {
+	case "cseq":
+		return "CSeq"
+	case "www-authenticate":
+		return "WWW-Authenticate"
+	}
+	parts := strings.Split(strings.ToLower(name), "-")
+	for i, p := range parts {
+		if p != "" {
+			parts[i] = strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+// Get returns the first value of the header
+func (m *Message) Get(name string) string {
+	name = canonical(name)
+	for _, h := range m.Headers {
+		if h.Name == name {
+			return h.Value
+		}
+	}
+	return ""
+}
+
+// Values returns every value of the header, comma separated values are split
+func (m *Message) Values(name string) []string {
+	name = canonical(name)
+	var values []string
+	for _, h := range m.Headers {
+		if h.Name == name {
+			values = append(values, splitValues(h.Value)...)
+		}
+	}
+	return values
+}
+
+func (m *Message) Add(name, value string) {
+	m.Headers = append(m.Headers, Header{Name: canonical(name), Value: value})
+}
+
+// Set replaces every value of the header
+func (m *Message) Set(name, value string) {
+	m.Del(name)
+	m.Add(name, value)
+}
+
+func (m *Message) Del(name string) {
+	name = canonical(name)
+	headers := m.Headers[:0]
+	for _, h := range m.Headers {
+		if h.Name != name {
+			headers = append(headers, h)
+		}
+	}
+	m.Headers = headers
+}
+
+// CSeq returns the sequence number and the method of the message
+func (m *Message) CSeq() (uint32, string) {
+	fields := strings.Fields(m.Get("CSeq"))
+	if len(fields) != 2 {
+		return 0, ""
+	}
+	seq, _ := strconv.ParseUint(fields[0], 10, 32)
+	return uint32(seq), fields[1]
+}
+
+// Branch is the transaction of the topmost via
+func (m *Message) Branch() string {
+	values := m.Values("Via")
+	if len(values) == 0 {
+		return ""
+	}
+	return ParseParams(values[0])["branch"]
+}
+
+func (m *Message) CallID() string {
+	return m.Get("Call-ID")
+}
+
+func (m *Message) From() Address {
+	return ParseAddress(m.Get("From"))
+}
+
+func (m *Message) To() Address {
+	return ParseAddress(m.Get("To"))
+}
+
+func (m *Message) Bytes() []byte {
+	var b bytes.Buffer
+	if m.IsRequest() {
+		fmt.Fprintf(&b, "%s %s %s\r\n", m.Method, m.RequestURI, Version)
+	} else {
+		fmt.Fprintf(&b, "%s %d %s\r\n", Version, m.StatusCode, m.Reason)
+	}
+	for _, h := range m.Headers {
+		if h.Name == "Content-Length" {
+			continue
+		}
+		fmt.Fprintf(&b, "%s: %s\r\n", h.Name, h.Value)
+	}
+	fmt.Fprintf(&b, "Content-Length: %d\r\n\r\n", len(m.Body))
+	b.Write(m.Body)
+	return b.Bytes()
+}
+
+func (m *Message) String() string {
+	return string(m.Bytes())
+}
+
+// Parse reads a sip message of a datagram
+func Parse(data []byte) (*Message, error) {
+	head, body, found := bytes.Cut(data, []byte("\r\n\r\n"))
+	if !found {
+		head, body, found = bytes.Cut(data, []byte("\n\n"))
+		if !found {
+			return nil, fmt.Errorf("sip message without end of headers")
+		}
+	}
+	lines := strings.Split(strings.ReplaceAll(string(head), "\r\n", "\n"), "\n")
+	m := &Message{}
+	start := strings.SplitN(lines[0], " ", 3)
+	if len(start) != 3 {
+		return nil, fmt.Errorf("illegal sip start line %q", lines[0])
+	}
+	if start[0] == Version {
+		code, err := strconv.Atoi(start[1])
+		if err != nil {
+			return nil, fmt.Errorf("illegal sip status code %q", start[1])
+		}
+		m.StatusCode, m.Reason = code, start[2]
+	} else {
+		if start[2] != Version {
+			return nil, fmt.Errorf("unsupported sip version %q", start[2])
+		}
+		m.Method, m.RequestURI = start[0], start[1]
+	}
+
+	for _, line := range lines[1:] {
+		if line == "" {
+			continue
+		}
+		// folded header continues the previous one
+		if (line[0] == ' ' || line[0] == '\t') && len(m.Headers) > 0 {
+			m.Headers[len(m.Headers)-1].Value += " " + strings.TrimSpace(line)
+			continue
+		}
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("illegal sip header %q", line)
+		}
+		m.Add(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+
+	if length := m.Get("Content-Length"); length != "" {
+		n, err := strconv.Atoi(length)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("illegal content length %q", length)
+		}
+		if n > len(body) {
+			return nil, fmt.Errorf("sip body is shorter than content length")
+		}
+		body = body[:n]
+	}
+	if len(body) > 0 {
+		m.Body = append([]byte(nil), body...)
+	}
+	return m, nil
+}
+
+// splitValues splits comma separated header values, commas in quotes and angle brackets are
+// part of the value
+func splitValues(v string) []string {
+	var (
+		values  []string
+		quoted  bool
+		bracket bool
+		start   int
+	)
+	for i, r := range v {
+		switch r {
+		case '"':
+			quoted = !quoted
+		case '<':
+			bracket = !quoted
+		case '>':
+			bracket = false
+		case ',':
+			if !quoted && !bracket {
+				values = append(values, strings.TrimSpace(v[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(values, strings.TrimSpace(v[start:]))
+}
+
+// ParseParams reads the ;key=value parameters after the value of a header
+func ParseParams(v string) map[string]string {
+	params := map[string]string{}
+	if i := strings.LastIndex(v, ">"); i >= 0 {
+		v = v[i+1:]
+	}
+	parts := strings.Split(v, ";")
+	for _, p := range parts[1:] {
+		key, value, _ := strings.Cut(strings.TrimSpace(p), "=")
+		if key != "" {
+			params[strings.ToLower(key)] = strings.Trim(value, `"`)
+		}
+	}
+	return params
+}
+
+// Address is the name-addr of from, to and contact headers
+type Address struct {
+	Display string
+	URI     URI
+	Params  map[string]string
+}
+
+func ParseAddress(v string) Address {
+	v = strings.TrimSpace(v)
+	addr := Address{Params: ParseParams(v)}
+	if start := strings.Index(v, "<"); start >= 0 {
+		addr.Display = strings.Trim(strings.TrimSpace(v[:start]), `"`)
+		end := strings.Index(v[start:], ">")
+		if end < 0 {
+			end = len(v) - start
+		}
+		addr.URI = ParseURI(v[start+1 : start+end])
+		return addr
+	}
+	// without brackets the parameters belong to the header, not to the uri
+	uri, _, _ := strings.Cut(v, ";")
+	addr.URI = ParseURI(uri)
+	return addr
+}
+
+func (a Address) Tag() string {
+	return a.Params["tag"]
+}
+
+func (a Address) String() string {
+	var b strings.Builder
+	if a.Display != "" {
+		fmt.Fprintf(&b, "%q ", a.Display)
+	}
+	fmt.Fprintf(&b, "<%s>", a.URI.String())
+	writeParams(&b, a.Params)
+	return b.String()
+}
+
+// URI is a sip or sips uri
+type URI struct {
+	Scheme string
+	User   string
+	Host   string
+	Port   int
+	Params map[string]string
+}
+
+func ParseURI(v string) URI {
+	uri := URI{Scheme: "sip", Params: map[string]string{}}
+	v = strings.TrimSpace(v)
+	if scheme, rest, ok := strings.Cut(v, ":"); ok && (scheme == "sip" || scheme == "sips" || scheme == "tel") {
+		uri.Scheme, v = scheme, rest
+	}
+	v, _, _ = strings.Cut(v, "?")
+	parts := strings.Split(v, ";")
+	for _, p := range parts[1:] {
+		key, value, _ := strings.Cut(p, "=")
+		if key != "" {
+			uri.Params[strings.ToLower(key)] = value
+		}
+	}
+	hostport := parts[0]
+	if user, host, ok := strings.Cut(hostport, "@"); ok {
+		uri.User, hostport = user, host
+	}
+	if i := strings.LastIndex(hostport, ":"); i >= 0 && !strings.HasSuffix(hostport, "]") {
+		if port, err := strconv.Atoi(hostport[i+1:]); err == nil {
+			uri.Port = port
+			hostport = hostport[:i]
+		}
+	}
+	uri.Host = hostport
+	return uri
+}
+
+// HostPort is the address the uri points to, the default sip port is used without a port
+func (u URI) HostPort() string {
+	port := u.Port
+	if port == 0 {
+		port = 5060
+	}
+	return fmt.Sprintf("%s:%d", u.Host, port)
+}
+
+func (u URI) String() string {
+	var b strings.Builder
+	b.WriteString(u.Scheme)
+	b.WriteString(":")
+	if u.User != "" {
+		b.WriteString(u.User)
+		b.WriteString("@")
+	}
+	b.WriteString(u.Host)
+	if u.Port != 0 {
+		fmt.Fprintf(&b, ":%d", u.Port)
+	}
+	writeParams(&b, u.Params)
+	return b.String()
+}
+
+// writeParams writes the parameters in a stable order
+func writeParams(b *strings.Builder, params map[string]string) {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if params[key] == "" {
+			fmt.Fprintf(b, ";%s", key)
+			continue
+		}
+		fmt.Fprintf(b, ";%s=%s", key, params[key])
+	}
+}
+
+func random(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func NewBranch() string {
+	return BranchPrefix + random(8)
+}
+
+func NewTag() string {
+	return random(6)
+}
+
+func NewCallID() string {
+	return random(16)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sip
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const invite = "INVITE sip:42@rapida.example.com;x-api-key=secret SIP/2.0\r\n" +
+	"v: SIP/2.0/UDP 198.51.100.7:5060;branch=z9hG4bK776asdhds, SIP/2.0/UDP 10.0.0.1;branch=z9hG4bKproxy\r\n" +
+	"Max-Forwards: 70\r\n" +
+	"t: <sip:42@rapida.example.com>\r\n" +
+	"f: \"Alice, Smith\" <sip:+15551234@trunk.example.com>;tag=1928301774\r\n" +
+	"i: a84b4c76e66710@198.51.100.7\r\n" +
+	"CSeq: 314159 INVITE\r\n" +
+	"m: <sip:+15551234@198.51.100.7:5060>\r\n" +
+	"Subject: call\r\n" +
+	" folded\r\n" +
+	"c: application/sdp\r\n" +
+	"l: 4\r\n" +
+	"\r\n" +
+	"v=0\r\nignored"
+
+func TestParse(t *testing.T) {
+	m, err := Parse([]byte(invite))
+	require.NoError(t, err)
+
+	assert.True(t, m.IsRequest())
+	assert.Equal(t, INVITE, m.Method)
+	assert.Equal(t, "a84b4c76e66710@198.51.100.7", m.CallID())
+	assert.Equal(t, "z9hG4bK776asdhds", m.Branch())
+	assert.Len(t, m.Values("Via"), 2)
+	assert.Equal(t, "call folded", m.Get("subject"))
+	assert.Equal(t, []byte("v=0\r"), m.Body)
+
+	seq, method := m.CSeq()
+	assert.Equal(t, uint32(314159), seq)
+	assert.Equal(t, INVITE, method)
+
+	from := m.From()
+	assert.Equal(t, "Alice, Smith", from.Display)
+	assert.Equal(t, "+15551234", from.URI.User)
+	assert.Equal(t, "1928301774", from.Tag())
+	assert.Equal(t, "", m.To().Tag())
+
+	uri := ParseURI(m.RequestURI)
+	assert.Equal(t, "42", uri.User)
+	assert.Equal(t, "rapida.example.com:5060", uri.HostPort())
+	assert.Equal(t, "secret", uri.Params["x-api-key"])
+}
+
+func TestParse_Illegal(t *testing.T) {
+	for _, data := range []string{
+		"INVITE sip:42@host SIP/2.0\r\nVia: x",
+		"INVITE sip:42@host\r\n\r\n",
+		"INVITE sip:42@host SIP/3.0\r\n\r\n",
+		"SIP/2.0 abc OK\r\n\r\n",
+		"INVITE sip:42@host SIP/2.0\r\nContent-Length: 10\r\n\r\nv=0",
+	} {
+		_, err := Parse([]byte(data))
+		assert.Error(t, err, data)
+	}
+}
+
+func TestNewResponse(t *testing.T) {
+	req, err := Parse([]byte(invite))
+	require.NoError(t, err)
+
+	res := NewResponse(req, 200, "OK")
+	res.Set("To", res.Get("To")+";tag=abc")
+	res.Body = []byte("v=0\r\n")
+
+	parsed, err := Parse(res.Bytes())
+	require.NoError(t, err)
+	assert.False(t, parsed.IsRequest())
+	assert.Equal(t, 200, parsed.StatusCode)
+	assert.Equal(t, "OK", parsed.Reason)
+	assert.Equal(t, req.Values("Via"), parsed.Values("Via"))
+	assert.Equal(t, req.CallID(), parsed.CallID())
+	assert.Equal(t, "abc", parsed.To().Tag())
+	assert.Equal(t, "", parsed.Get("Max-Forwards"))
+	assert.Equal(t, "5", parsed.Get("Content-Length"))
+	assert.True(t, strings.HasPrefix(res.String(), "SIP/2.0 200 OK\r\n"))
+}
+
+func TestAddress(t *testing.T) {
+	addr := ParseAddress("sip:bob@example.com:5070;tag=xyz")
+	assert.Equal(t, "bob", addr.URI.User)
+	assert.Equal(t, 5070, addr.URI.Port)
+	assert.Equal(t, "xyz", addr.Tag())
+
+	addr = ParseAddress(`"Bob" <sip:bob@example.com;transport=udp>;tag=xyz`)
+	assert.Equal(t, `"Bob" <sip:bob@example.com;transport=udp>;tag=xyz`, addr.String())
+}
+
+func TestNewBranch(t *testing.T) {
+	assert.True(t, strings.HasPrefix(NewBranch(), BranchPrefix))
+	assert.NotEqual(t, NewBranch(), NewBranch())
+	assert.NotEqual(t, NewTag(), NewTag())
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sip
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const rtpHeaderSize = 12
+
+// Packet is an rtp packet, rfc 3550
+type Packet struct {
+	Marker      bool
+	PayloadType uint8
+	Sequence    uint16
+	Timestamp   uint32
+	SSRC        uint32
+	Payload     []byte
+}
+
+func (p *Packet) Marshal() []byte {
+	b := make([]byte, rtpHeaderSize+len(p.Payload))
+	// version 2 without padding, extension and csrc
+	b[0] = 2 << 6
+	b[1] = p.PayloadType & 0x7f
+	if p.Marker {
+		b[1] |= 0x80
+	}
+	binary.BigEndian.PutUint16(b[2:], p.Sequence)
+	binary.BigEndian.PutUint32(b[4:], p.Timestamp)
+	binary.BigEndian.PutUint32(b[8:], p.SSRC)
+	copy(b[rtpHeaderSize:], p.Payload)
+	return b
+}
+
+func UnmarshalPacket(b []byte) (*Packet, error) {
+	if len(b) < rtpHeaderSize {
+		return nil, fmt.Errorf("rtp packet is too short")
+	}
+	if b[0]>>6 != 2 {
+		return nil, fmt.Errorf("unsupported rtp version %d", b[0]>>6)
+	}
+	p := &Packet{
+		Marker:      b[1]&0x80 != 0,
+		PayloadType: b[1] & 0x7f,
+		Sequence:    binary.BigEndian.Uint16(b[2:]),
+		Timestamp:   binary.BigEndian.Uint32(b[4:]),
+		SSRC:        binary.BigEndian.Uint32(b[8:]),
+	}
+	offset := rtpHeaderSize + int(b[0]&0x0f)*4
+	if b[0]&0x10 != 0 {
+		if len(b) < offset+4 {
+			return nil, fmt.Errorf("rtp header extension is too short")
+		}
+		offset += 4 + int(binary.BigEndian.Uint16(b[offset+2:]))*4
+	}
+	end := len(b)
+	if b[0]&0x20 != 0 && end > 0 {
+		end -= int(b[end-1])
+	}
+	if offset > end {
+		return nil, fmt.Errorf("rtp packet is too short")
+	}
+	p.Payload = b[offset:end]
+	return p, nil
+}
+
+// events of rfc 4733 in the order of their codes
+var dtmfDigits = "0123456789*#ABCD"
+
+// DtmfEvent is a telephone event payload, rfc 4733
+type DtmfEvent struct {
+	Digit    string
+	End      bool
+	Volume   uint8
+	Duration uint16
+}
+
+func UnmarshalDtmfEvent(payload []byte) (*DtmfEvent, error) {
+	if len(payload) < 4 {
+		return nil, fmt.Errorf("telephone event is too short")
+	}
+	if int(payload[0]) >= len(dtmfDigits) {
+		return nil, fmt.Errorf("unsupported telephone event %d", payload[0])
+	}
+	return &DtmfEvent{
+		Digit:    string(dtmfDigits[payload[0]]),
+		End:      payload[1]&0x80 != 0,
+		Volume:   payload[1] & 0x3f,
+		Duration: binary.BigEndian.Uint16(payload[2:]),
+	}, nil
+}
+
+func (e *DtmfEvent) Marshal() []byte {
+	b := make([]byte, 4)
+	for i := range dtmfDigits {
+		if string(dtmfDigits[i]) == e.Digit {
+			b[0] = byte(i)
+		}
+	}
+	b[1] = e.Volume & 0x3f
+	if e.End {
+		b[1] |= 0x80
+	}
+	binary.BigEndian.PutUint16(b[2:], e.Duration)
+	return b
+}
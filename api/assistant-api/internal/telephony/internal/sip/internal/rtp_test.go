@@ -0,0 +1,54 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPacket(t *testing.T) {
+	p := &Packet{Marker: true, PayloadType: 8, Sequence: 65535, Timestamp: 160, SSRC: 0xdeadbeef, Payload: []byte{1, 2, 3}}
+	parsed, err := UnmarshalPacket(p.Marshal())
+	require.NoError(t, err)
+	assert.Equal(t, p, parsed)
+}
+
+func TestUnmarshalPacket(t *testing.T) {
+	// one csrc, a header extension of one word and two bytes of padding
+	b := []byte{
+		0xb1, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0xa0, 0x00, 0x00, 0x00, 0x01,
+		0x00, 0x00, 0x00, 0x02,
+		0xbe, 0xde, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00,
+		0x7f, 0x7e,
+		0x00, 0x02,
+	}
+	p, err := UnmarshalPacket(b)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(0), p.PayloadType)
+	assert.Equal(t, []byte{0x7f, 0x7e}, p.Payload)
+
+	_, err = UnmarshalPacket(b[:8])
+	assert.Error(t, err)
+	_, err = UnmarshalPacket(append([]byte{0x40}, b[1:]...))
+	assert.Error(t, err)
+}
+
+func TestDtmfEvent(t *testing.T) {
+	for _, digit := range []string{"0", "5", "*", "#", "D"} {
+		e := &DtmfEvent{Digit: digit, End: true, Volume: 10, Duration: 800}
+		parsed, err := UnmarshalDtmfEvent(e.Marshal())
+		require.NoError(t, err)
+		assert.Equal(t, e, parsed)
+	}
+
+	_, err := UnmarshalDtmfEvent([]byte{1, 2})
+	assert.Error(t, err)
+	_, err = UnmarshalDtmfEvent([]byte{16, 0, 0, 0})
+	assert.Error(t, err)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sip
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Codec is a payload type of the audio stream
+type Codec struct {
+	PayloadType uint8
+	Name        string
+	ClockRate   int
+}
+
+var (
+	PCMU           = Codec{PayloadType: 0, Name: "PCMU", ClockRate: 8000}
+	PCMA           = Codec{PayloadType: 8, Name: "PCMA", ClockRate: 8000}
+	TelephoneEvent = Codec{PayloadType: 101, Name: "telephone-event", ClockRate: 8000}
+)
+
+// static payload types which may come without rtpmap
+var staticCodecs = map[uint8]Codec{
+	PCMU.PayloadType: PCMU,
+	PCMA.PayloadType: PCMA,
+}
+
+// SessionDescription is the audio stream of an sdp offer or answer
+type SessionDescription struct {
+	Address string
+	Port    int
+	Codecs  []Codec
+}
+
+func ParseSDP(body []byte) (*SessionDescription, error) {
+	sd := &SessionDescription{}
+	var (
+		sessionAddress string
+		mediaAddress   string
+		inAudio        bool
+		payloadTypes   []uint8
+		rtpmap         = map[uint8]Codec{}
+	)
+	for _, line := range strings.Split(strings.ReplaceAll(string(body), "\r\n", "\n"), "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "c":
+			// c=IN IP4 203.0.113.1
+			fields := strings.Fields(value)
+			if len(fields) != 3 {
+				return nil, fmt.Errorf("illegal sdp connection %q", value)
+			}
+			if inAudio {
+				mediaAddress = fields[2]
+			} else if sd.Port == 0 {
+				sessionAddress = fields[2]
+			}
+		case "m":
+			// m=audio 49170 RTP/AVP 0 8 101
+			fields := strings.Fields(value)
+			inAudio = len(fields) >= 4 && fields[0] == "audio" && sd.Port == 0
+			if !inAudio {
+				continue
+			}
+			port, err := strconv.Atoi(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("illegal sdp media port %q", fields[1])
+			}
+			sd.Port = port
+			for _, f := range fields[3:] {
+				pt, err := strconv.ParseUint(f, 10, 8)
+				if err != nil {
+					return nil, fmt.Errorf("illegal sdp payload type %q", f)
+				}
+				payloadTypes = append(payloadTypes, uint8(pt))
+			}
+		case "a":
+			// a=rtpmap:101 telephone-event/8000
+			if !inAudio || !strings.HasPrefix(value, "rtpmap:") {
+				continue
+			}
+			pt, encoding, ok := strings.Cut(strings.TrimPrefix(value, "rtpmap:"), " ")
+			if !ok {
+				continue
+			}
+			payloadType, err := strconv.ParseUint(pt, 10, 8)
+			if err != nil {
+				continue
+			}
+			name, rate, _ := strings.Cut(encoding, "/")
+			rate, _, _ = strings.Cut(rate, "/")
+			clockRate, _ := strconv.Atoi(rate)
+			rtpmap[uint8(payloadType)] = Codec{PayloadType: uint8(payloadType), Name: name, ClockRate: clockRate}
+		}
+	}
+	if sd.Port == 0 {
+		return nil, fmt.Errorf("sdp without audio stream")
+	}
+	sd.Address = mediaAddress
+	if sd.Address == "" {
+		sd.Address = sessionAddress
+	}
+	for _, pt := range payloadTypes {
+		if codec, ok := rtpmap[pt]; ok {
+			sd.Codecs = append(sd.Codecs, codec)
+			continue
+		}
+		if codec, ok := staticCodecs[pt]; ok {
+			sd.Codecs = append(sd.Codecs, codec)
+		}
+	}
+	return sd, nil
+}
+
+// Marshal writes the sdp of the audio stream, the session id stays the same for the dialog
+func (sd *SessionDescription) Marshal(sessionId uint64) []byte {
+	var b strings.Builder
+	payloadTypes := make([]string, 0, len(sd.Codecs))
+	for _, codec := range sd.Codecs {
+		payloadTypes = append(payloadTypes, strconv.Itoa(int(codec.PayloadType)))
+	}
+	fmt.Fprintf(&b, "v=0\r\n")
+	fmt.Fprintf(&b, "o=rapida %d %d IN IP4 %s\r\n", sessionId, sessionId, sd.Address)
+	fmt.Fprintf(&b, "s=rapida\r\n")
+	fmt.Fprintf(&b, "c=IN IP4 %s\r\n", sd.Address)
+	fmt.Fprintf(&b, "t=0 0\r\n")
+	fmt.Fprintf(&b, "m=audio %d RTP/AVP %s\r\n", sd.Port, strings.Join(payloadTypes, " "))
+	for _, codec := range sd.Codecs {
+		fmt.Fprintf(&b, "a=rtpmap:%d %s/%d\r\n", codec.PayloadType, codec.Name, codec.ClockRate)
+		if codec.Name == TelephoneEvent.Name {
+			fmt.Fprintf(&b, "a=fmtp:%d 0-16\r\n", codec.PayloadType)
+		}
+	}
+	fmt.Fprintf(&b, "a=ptime:20\r\n")
+	fmt.Fprintf(&b, "a=sendrecv\r\n")
+	return []byte(b.String())
+}
+
+// Negotiate picks the first audio codec of the offer which is supported, and the telephone
+// event payload type of the offer when there is one
+func Negotiate(offer *SessionDescription, supported ...Codec) (Codec, *Codec, error) {
+	var (
+		audio *Codec
+		event *Codec
+	)
+	for _, codec := range offer.Codecs {
+		if strings.EqualFold(codec.Name, TelephoneEvent.Name) && codec.ClockRate == 8000 {
+			if event == nil {
+				e := codec
+				event = &e
+			}
+			continue
+		}
+		if audio != nil {
+			continue
+		}
+		for _, s := range supported {
+			if strings.EqualFold(codec.Name, s.Name) && codec.ClockRate == s.ClockRate {
+				audio = &Codec{PayloadType: codec.PayloadType, Name: s.Name, ClockRate: s.ClockRate}
+				break
+			}
+		}
+	}
+	if audio == nil {
+		return Codec{}, nil, fmt.Errorf("no supported codec offered")
+	}
+	return *audio, event, nil
+}
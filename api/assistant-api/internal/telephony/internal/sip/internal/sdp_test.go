@@ -0,0 +1,74 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSDP(t *testing.T) {
+	offer := "v=0\r\n" +
+		"o=- 1 1 IN IP4 198.51.100.7\r\n" +
+		"s=-\r\n" +
+		"c=IN IP4 198.51.100.7\r\n" +
+		"t=0 0\r\n" +
+		"m=audio 49170 RTP/AVP 18 8 0 96\r\n" +
+		"c=IN IP4 198.51.100.8\r\n" +
+		"a=rtpmap:18 G729/8000\r\n" +
+		"a=rtpmap:96 telephone-event/8000\r\n" +
+		"m=video 51372 RTP/AVP 31\r\n" +
+		"a=rtpmap:31 H261/90000\r\n"
+	sd, err := ParseSDP([]byte(offer))
+	require.NoError(t, err)
+	assert.Equal(t, "198.51.100.8", sd.Address)
+	assert.Equal(t, 49170, sd.Port)
+	assert.Equal(t, []Codec{
+		{PayloadType: 18, Name: "G729", ClockRate: 8000},
+		PCMA,
+		PCMU,
+		{PayloadType: 96, Name: "telephone-event", ClockRate: 8000},
+	}, sd.Codecs)
+
+	_, err = ParseSDP([]byte("v=0\r\nm=video 51372 RTP/AVP 31\r\n"))
+	assert.Error(t, err)
+}
+
+func TestSessionDescription_Marshal(t *testing.T) {
+	sd := &SessionDescription{Address: "203.0.113.1", Port: 10000, Codecs: []Codec{PCMU, TelephoneEvent}}
+	parsed, err := ParseSDP(sd.Marshal(7))
+	require.NoError(t, err)
+	assert.Equal(t, sd, parsed)
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name   string
+		offer  []Codec
+		audio  Codec
+		event  *Codec
+		failed bool
+	}{
+		{name: "first supported", offer: []Codec{{PayloadType: 18, Name: "G729", ClockRate: 8000}, PCMA, PCMU}, audio: PCMA},
+		{name: "with telephone event", offer: []Codec{PCMU, {PayloadType: 96, Name: "telephone-event", ClockRate: 8000}}, audio: PCMU, event: &Codec{PayloadType: 96, Name: "telephone-event", ClockRate: 8000}},
+		{name: "dynamic payload type", offer: []Codec{{PayloadType: 97, Name: "pcmu", ClockRate: 8000}}, audio: Codec{PayloadType: 97, Name: "PCMU", ClockRate: 8000}},
+		{name: "nothing supported", offer: []Codec{{PayloadType: 9, Name: "G722", ClockRate: 8000}}, failed: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			audio, event, err := Negotiate(&SessionDescription{Codecs: tt.offer}, PCMU, PCMA)
+			if tt.failed {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.audio, audio)
+			assert.Equal(t, tt.event, event)
+		})
+	}
+}
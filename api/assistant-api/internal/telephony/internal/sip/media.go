@@ -0,0 +1,243 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sip_telephony
+
+import (
+	"bytes"
+	"math/rand/v2"
+	"net"
+	"sync"
+	"time"
+
+	internal_sip "github.com/rapidaai/api/assistant-api/internal/telephony/internal/sip/internal"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/zaf/g711"
+)
+
+const (
+	// every rtp packet carries 20ms of audio, 160 samples at 8khz
+	framePeriod  = 20 * time.Millisecond
+	frameSamples = 160
+	// mu-law silence
+	mulawSilence = 0xff
+	// frames waiting for the streamer, older audio is dropped when it does not keep up
+	frameQueueSize = 256
+)
+
+// frame is audio or a keypad digit of the caller
+type frame struct {
+	audio []byte
+	digit string
+}
+
+// media is the rtp stream of a call. Audio is mu-law at 8khz both ways, a-law of the trunk is
+// converted when it was negotiated.
+type media struct {
+	logger commons.Logger
+	conn   *net.UDPConn
+
+	mu     sync.Mutex
+	remote *net.UDPAddr
+	// the stream of the caller is latched to the address and ssrc of its first packet, packets
+	// of any other source are dropped
+	source *uint32
+	codec  internal_sip.Codec
+	event  *internal_sip.Codec
+	output bytes.Buffer
+
+	ssrc      uint32
+	sequence  uint16
+	timestamp uint32
+	// timestamp of the last telephone event, the end of an event is sent three times
+	lastEvent *uint32
+
+	frames    chan frame
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newMedia(logger commons.Logger, conn *net.UDPConn) *media {
+	return &media{
+		logger:    logger,
+		conn:      conn,
+		ssrc:      rand.Uint32(),
+		sequence:  uint16(rand.Uint32()),
+		timestamp: rand.Uint32(),
+		frames:    make(chan frame, frameQueueSize),
+		done:      make(chan struct{}),
+	}
+}
+
+func (m *media) Port() int {
+	return m.conn.LocalAddr().(*net.UDPAddr).Port
+}
+
+// Negotiated sets the codec and where the audio of the call is sent, the stream is latched again
+// to the first packet of the negotiated media
+func (m *media) Negotiated(remote *net.UDPAddr, codec internal_sip.Codec, event *internal_sip.Codec) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.remote = remote
+	m.source = nil
+	m.codec = codec
+	m.event = event
+}
+
+func (m *media) Start() {
+	go m.receive()
+	go m.send()
+}
+
+func (m *media) receive() {
+	buf := make([]byte, 1500)
+	for {
+		n, addr, err := m.conn.ReadFromUDP(buf)
+		if err != nil {
+			select {
+			case <-m.done:
+				return
+			default:
+				continue
+			}
+		}
+		pkt, err := internal_sip.UnmarshalPacket(buf[:n])
+		if err != nil {
+			continue
+		}
+
+		m.mu.Lock()
+		codec, event := m.codec, m.event
+		accepted := m.accept(pkt, addr)
+		m.mu.Unlock()
+		if !accepted {
+			continue
+		}
+
+		switch {
+		case pkt.PayloadType == codec.PayloadType:
+			audio := append([]byte(nil), pkt.Payload...)
+			if codec.Name == internal_sip.PCMA.Name {
+				audio = g711.Alaw2Ulaw(audio)
+			}
+			m.push(frame{audio: audio})
+		case event != nil && pkt.PayloadType == event.PayloadType:
+			if digit := m.dtmf(pkt); digit != "" {
+				m.push(frame{digit: digit})
+			}
+		}
+	}
+}
+
+// accept latches the stream to the first audio or event packet, behind nat the audio comes from
+// another address than the sdp says and is answered where it comes from. Once latched only the
+// packets of that address and ssrc are accepted.
+func (m *media) accept(pkt *internal_sip.Packet, addr *net.UDPAddr) bool {
+	if pkt.PayloadType != m.codec.PayloadType && (m.event == nil || pkt.PayloadType != m.event.PayloadType) {
+		return false
+	}
+	if m.source == nil {
+		ssrc := pkt.SSRC
+		m.source = &ssrc
+		m.remote = addr
+		return true
+	}
+	return *m.source == pkt.SSRC && m.remote.IP.Equal(addr.IP) && m.remote.Port == addr.Port
+}
+
+// dtmf returns the digit once its event has ended
+func (m *media) dtmf(pkt *internal_sip.Packet) string {
+	event, err := internal_sip.UnmarshalDtmfEvent(pkt.Payload)
+	if err != nil || !event.End {
+		return ""
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.lastEvent != nil && *m.lastEvent == pkt.Timestamp {
+		return ""
+	}
+	timestamp := pkt.Timestamp
+	m.lastEvent = &timestamp
+	return event.Digit
+}
+
+func (m *media) push(f frame) {
+	select {
+	case m.frames <- f:
+	case <-m.done:
+	default:
+		m.logger.Debugf("sip audio queue is full, dropping audio of the caller")
+	}
+}
+
+// send paces the audio of the assistant as 20ms packets, silence is sent in between so the
+// trunk does not end the call for missing audio
+func (m *media) send() {
+	ticker := time.NewTicker(framePeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-ticker.C:
+		}
+		m.mu.Lock()
+		remote := m.remote
+		payload := make([]byte, frameSamples)
+		n, _ := m.output.Read(payload)
+		for i := n; i < frameSamples; i++ {
+			payload[i] = mulawSilence
+		}
+		if m.codec.Name == internal_sip.PCMA.Name {
+			payload = g711.Ulaw2Alaw(payload)
+		}
+		pkt := &internal_sip.Packet{
+			PayloadType: m.codec.PayloadType,
+			Sequence:    m.sequence,
+			Timestamp:   m.timestamp,
+			SSRC:        m.ssrc,
+			Payload:     payload,
+		}
+		m.sequence++
+		m.timestamp += frameSamples
+		m.mu.Unlock()
+
+		if remote == nil {
+			continue
+		}
+		if _, err := m.conn.WriteToUDP(pkt.Marshal(), remote); err != nil {
+			m.logger.Debugf("unable to send sip audio %v", err)
+		}
+	}
+}
+
+// Write queues mu-law audio to play to the caller
+func (m *media) Write(audio []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.output.Write(audio)
+}
+
+// Clear drops the audio which has not been played yet
+func (m *media) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.output.Reset()
+}
+
+func (m *media) Frames() <-chan frame {
+	return m.frames
+}
+
+func (m *media) Done() <-chan struct{} {
+	return m.done
+}
+
+func (m *media) Close() {
+	m.closeOnce.Do(func() {
+		close(m.done)
+		m.conn.Close()
+	})
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sip_telephony
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	internal_sip "github.com/rapidaai/api/assistant-api/internal/telephony/internal/sip/internal"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMediaLatchesSource(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	m := newMedia(logger, conn)
+	defer m.Close()
+
+	caller, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	defer caller.Close()
+	other, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	defer other.Close()
+
+	// the sdp announces an address the audio does not come from
+	m.Negotiated(&net.UDPAddr{IP: net.IPv4(203, 0, 113, 1), Port: 10000}, internal_sip.PCMU, &internal_sip.TelephoneEvent)
+	m.Start()
+
+	send := func(from *net.UDPConn, pkt *internal_sip.Packet) {
+		_, err := from.WriteToUDP(pkt.Marshal(), conn.LocalAddr().(*net.UDPAddr))
+		require.NoError(t, err)
+	}
+	received := func() (frame, bool) {
+		select {
+		case f := <-m.Frames():
+			return f, true
+		case <-time.After(200 * time.Millisecond):
+			return frame{}, false
+		}
+	}
+
+	// a packet of an unknown payload type does not latch the stream
+	send(other, &internal_sip.Packet{PayloadType: 96, SSRC: 2, Payload: []byte{1}})
+	_, ok := received()
+	assert.False(t, ok)
+
+	send(caller, &internal_sip.Packet{PayloadType: internal_sip.PCMU.PayloadType, SSRC: 1, Payload: []byte{1}})
+	f, ok := received()
+	require.True(t, ok)
+	assert.Equal(t, []byte{1}, f.audio)
+
+	t.Run("packets of another address are dropped", func(t *testing.T) {
+		send(other, &internal_sip.Packet{PayloadType: internal_sip.PCMU.PayloadType, SSRC: 1, Payload: []byte{2}})
+		_, ok := received()
+		assert.False(t, ok)
+	})
+
+	t.Run("packets of another ssrc are dropped", func(t *testing.T) {
+		send(caller, &internal_sip.Packet{PayloadType: internal_sip.PCMU.PayloadType, SSRC: 2, Payload: []byte{3}})
+		_, ok := received()
+		assert.False(t, ok)
+	})
+
+	t.Run("audio is sent to the latched source", func(t *testing.T) {
+		m.mu.Lock()
+		remote := m.remote
+		m.mu.Unlock()
+		assert.Equal(t, caller.LocalAddr().(*net.UDPAddr).Port, remote.Port)
+	})
+
+	send(caller, &internal_sip.Packet{PayloadType: internal_sip.PCMU.PayloadType, SSRC: 1, Payload: []byte{4}})
+	f, ok = received()
+	require.True(t, ok)
+	assert.Equal(t, []byte{4}, f.audio)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sip_telephony
+
+import (
+	"context"
+	"io"
+
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	internal_telephony_base "github.com/rapidaai/api/assistant-api/internal/telephony/internal/base"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// 1ms 8 bytes @ 8kHz µ-law mono 60ms of audio as silero can't process smaller chunk for mulaw
+const inputBufferThreshold = 8 * 60
+
+type sipStreamer struct {
+	streamer  internal_telephony_base.BaseTelephonyStreamer
+	logger    commons.Logger
+	call      *call
+	connected bool
+}
+
+func newSipStreamer(logger commons.Logger, c *call, assistant *internal_assistant_entity.Assistant, conversation *internal_conversation_entity.AssistantConversation, vlt *protos.VaultCredential,
+) streamers.Streamer {
+	return &sipStreamer{
+		logger:   logger,
+		call:     c,
+		streamer: internal_telephony_base.NewBaseTelephonyStreamer(logger, nil, assistant, conversation, vlt),
+	}
+}
+
+func (sip *sipStreamer) Context() context.Context {
+	return sip.streamer.Context()
+}
+
+func (sip *sipStreamer) Recv() (*protos.AssistantMessagingRequest, error) {
+	// the call is answered when the streamer is created, the assistant is connected right away
+	if !sip.connected {
+		sip.connected = true
+		return sip.streamer.CreateConnectionRequest(internal_audio.NewMulaw8khzMonoAudioConfig(), internal_audio.NewMulaw8khzMonoAudioConfig())
+	}
+
+	select {
+	case <-sip.call.Done():
+		sip.streamer.Cancel()
+		return nil, io.EOF
+	case <-sip.Context().Done():
+		return nil, io.EOF
+	case f := <-sip.call.media.Frames():
+		if f.digit != "" {
+			return sip.streamer.CreateDtmfRequest(f.digit), nil
+		}
+		sip.streamer.LockInputAudioBuffer()
+		defer sip.streamer.UnlockInputAudioBuffer()
+		sip.streamer.InputBuffer().Write(f.audio)
+		if sip.streamer.InputBuffer().Len() >= inputBufferThreshold {
+			audioRequest := sip.streamer.CreateVoiceRequest(sip.streamer.InputBuffer().Bytes())
+			sip.streamer.InputBuffer().Reset()
+			return audioRequest, nil
+		}
+		return nil, nil
+	}
+}
+
+func (sip *sipStreamer) Send(response *protos.AssistantMessagingResponse) error {
+	switch data := response.GetData().(type) {
+	case *protos.AssistantMessagingResponse_Assistant:
+		switch content := data.Assistant.Message.(type) {
+		case *protos.AssistantConversationAssistantMessage_Audio:
+			// the media of the call paces the audio as 20ms packets
+			sip.call.media.Write(content.Audio.GetContent())
+		}
+	case *protos.AssistantMessagingResponse_Interruption:
+		// interrupt on word given by stt
+		if data.Interruption.Type == protos.AssistantConversationInterruption_INTERRUPTION_TYPE_WORD {
+			sip.call.media.Clear()
+		}
+	case *protos.AssistantMessagingResponse_Action:
+		switch data.Action.GetAction() {
+		case protos.AssistantConversationAction_END_CONVERSATION:
+			if err := sip.call.Hangup(); err != nil {
+				sip.logger.Errorf("Error disconnecting sip call: %v", err)
+			}
+		case protos.AssistantConversationAction_TRANSFER_CONVERSATION:
+			to, ok := data.Action.GetArgs()["to"]
+			if !ok {
+				sip.logger.Errorf("transfer of sip call %s without destination", sip.call.Id())
+				return nil
+			}
+			target, err := utils.AnyToString(to)
+			if err != nil || target == "" {
+				sip.logger.Errorf("transfer of sip call %s with illegal destination", sip.call.Id())
+				return nil
+			}
+			// the trunk takes a while to accept the transfer, audio keeps flowing meanwhile
+			utils.Go(sip.Context(), func() {
+				if err := sip.call.Transfer(target); err != nil {
+					sip.logger.Errorf("unable to transfer sip call %s: %v", sip.call.Id(), err)
+				}
+			})
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sip_telephony
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	internal_sip "github.com/rapidaai/api/assistant-api/internal/telephony/internal/sip/internal"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+var errNoWebhook = errors.New("sip calls are signalled to the user agent, not over http")
+
+type sipTelephony struct {
+	logger commons.Logger
+	appCfg *config.AssistantConfig
+}
+
+// NewSipTelephony talks to sip trunks directly through the user agent of the process, calls
+// are routed by the sip call handler instead of http webhooks
+func NewSipTelephony(config *config.AssistantConfig, logger commons.Logger) (internal_type.Telephony, error) {
+	return &sipTelephony{
+		logger: logger,
+		appCfg: config,
+	}, nil
+}
+
+func (tpc *sipTelephony) VerifyWebhook(c *gin.Context, vaultCredential *protos.VaultCredential, opts utils.Option) error {
+	return errNoWebhook
+}
+
+// Recording is not supported, the calls are recorded by the assistant only
+func (tpc *sipTelephony) Recording(ctx context.Context, vaultCredential *protos.VaultCredential, recordingUrl string) (*internal_type.Recording, error) {
+	return nil, internal_type.ErrProviderRecordingNotSupported
+}
+
+func (tpc *sipTelephony) CatchAllStatusCallback(ctx *gin.Context) ([]types.Telemetry, error) {
+	return nil, nil
+}
+
+func (tpc *sipTelephony) StatusCallback(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, assistantConversationId uint64) ([]types.Telemetry, error) {
+	return nil, errNoWebhook
+}
+
+func (tpc *sipTelephony) ReceiveCall(c *gin.Context) (*string, []types.Telemetry, error) {
+	return nil, nil, errNoWebhook
+}
+
+func (tpc *sipTelephony) InboundCall(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, clientNumber string, assistantConversationId uint64, opts utils.Option) error {
+	return errNoWebhook
+}
+
+// trunkOf reads the trunk of the vault credential, server is host[:port] of the trunk
+func trunkOf(vaultCredential *protos.VaultCredential) (*trunk, error) {
+	credential := vaultCredential.GetValue().AsMap()
+	server, ok := credential["server"].(string)
+	if !ok || server == "" {
+		return nil, fmt.Errorf("illegal vault config server is not found")
+	}
+	t := &trunk{server: internal_sip.ParseURI("sip:" + server)}
+	t.username, _ = credential["username"].(string)
+	t.password, _ = credential["password"].(string)
+	t.domain, _ = credential["domain"].(string)
+	addr, err := net.ResolveUDPAddr("udp", t.server.HostPort())
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve sip trunk %s: %w", server, err)
+	}
+	t.addr = addr
+	return t, nil
+}
+
+func (tpc *sipTelephony) OutboundCall(
+	auth types.SimplePrinciple,
+	toPhone string,
+	fromPhone string,
+	assistantId, assistantConversationId uint64,
+	vaultCredential *protos.VaultCredential,
+	opts utils.Option) ([]types.Telemetry, error) {
+	mtds := []types.Telemetry{
+		types.NewMetadata("telephony.toPhone", toPhone),
+		types.NewMetadata("telephony.fromPhone", fromPhone),
+		types.NewMetadata("telephony.provider", "sip"),
+	}
+	ua, err := current()
+	if err != nil {
+		return append(mtds, types.NewEvent("FAILED", err.Error()), &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of telephony api"}), err
+	}
+	t, err := trunkOf(vaultCredential)
+	if err != nil {
+		return append(mtds, types.NewEvent("FAILED", "Failed to find trunk, check credentials"), &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of telephony api"}), err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), transactionTimeout)
+	defer cancel()
+	c, err := ua.dial(ctx, toPhone, fromPhone, t, &internal_type.SipDial{
+		Auth:                    auth,
+		AssistantId:             assistantId,
+		AssistantConversationId: assistantConversationId,
+	})
+	if err != nil {
+		return append(mtds, types.NewEvent("FAILED", err.Error()), &types.Metric{Name: "STATUS", Value: "FAILED", Description: "Status of telephony api"}), err
+	}
+	return append(mtds, types.NewMetadata("telephony.uuid", c.Id()), types.NewEvent("initiated", map[string]interface{}{"call_id": c.Id(), "to": toPhone, "from": fromPhone}), &types.Metric{Name: "STATUS", Value: "SUCCESS", Description: "Status of telephony api"}), nil
+}
+
+// Streamer connects the assistant to the sip call of the conversation, the call is found by
+// the telephony.uuid of the conversation. There is no websocket, context and connection are
+// not used.
+func (tpc *sipTelephony) Streamer(c *gin.Context, connection *websocket.Conn, assistant *internal_assistant_entity.Assistant, conversation *internal_conversation_entity.AssistantConversation, vlt *protos.VaultCredential) internal_streamers.Streamer {
+	ua, err := current()
+	if err != nil {
+		tpc.logger.Errorf("no sip call for conversation %d: %v", conversation.Id, err)
+		return nil
+	}
+	callId, err := conversation.GetMetadatas().GetString("telephony.uuid")
+	if err != nil {
+		tpc.logger.Errorf("no sip call for conversation %d: %v", conversation.Id, err)
+		return nil
+	}
+	call := ua.call(callId)
+	if call == nil {
+		tpc.logger.Errorf("sip call %s of conversation %d has ended", callId, conversation.Id)
+		return nil
+	}
+	return newSipStreamer(tpc.logger, call, assistant, conversation, vlt)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sip_telephony
+
+import (
+	"testing"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestTrunkOf(t *testing.T) {
+	tests := []struct {
+		name          string
+		credential    map[string]interface{}
+		expectedError bool
+		expectedHost  string
+		expectedPort  int
+	}{
+		{
+			name:         "server with port and credentials",
+			credential:   map[string]interface{}{"server": "127.0.0.1:5080", "username": "user", "password": "secret", "domain": "trunk.example.com"},
+			expectedHost: "127.0.0.1",
+			expectedPort: 5080,
+		},
+		{
+			name:         "server without port",
+			credential:   map[string]interface{}{"server": "127.0.0.1"},
+			expectedHost: "127.0.0.1",
+			expectedPort: 5060,
+		},
+		{
+			name:          "missing server",
+			credential:    map[string]interface{}{"username": "user"},
+			expectedError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := structpb.NewStruct(tt.credential)
+			require.NoError(t, err)
+			trunk, err := trunkOf(&protos.VaultCredential{Value: value})
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedHost, trunk.addr.IP.String())
+			assert.Equal(t, tt.expectedPort, trunk.addr.Port)
+			assert.Equal(t, tt.credential["username"], nilIfEmpty(trunk.username))
+			assert.Equal(t, tt.credential["password"], nilIfEmpty(trunk.password))
+		})
+	}
+}
+
+func TestWebhooksAreNotUsed(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	tel, err := NewSipTelephony(&config.AssistantConfig{}, logger)
+	require.NoError(t, err)
+
+	_, _, err = tel.ReceiveCall(nil)
+	assert.ErrorIs(t, err, errNoWebhook)
+	_, err = tel.StatusCallback(nil, nil, 1, 1)
+	assert.ErrorIs(t, err, errNoWebhook)
+	assert.ErrorIs(t, tel.InboundCall(nil, nil, 1, "+15550001111", 1, nil), errNoWebhook)
+	assert.ErrorIs(t, tel.VerifyWebhook(nil, nil, nil), errNoWebhook)
+}
+
+func TestStreamerWithoutCall(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	tel, err := NewSipTelephony(&config.AssistantConfig{}, logger)
+	require.NoError(t, err)
+
+	// no user agent is listening
+	assert.Nil(t, tel.Streamer(nil, nil, nil, &internal_conversation_entity.AssistantConversation{}, nil))
+
+	// the conversation was not placed on a sip call
+	ua := newTestUserAgent(t, newTestHandler())
+	runningMu.Lock()
+	running = ua
+	runningMu.Unlock()
+	t.Cleanup(func() {
+		runningMu.Lock()
+		running = nil
+		runningMu.Unlock()
+	})
+	conversation := &internal_conversation_entity.AssistantConversation{
+		Metadatas: []*internal_conversation_entity.AssistantConversationMetadata{},
+	}
+	assert.Nil(t, tel.Streamer(nil, nil, nil, conversation, nil))
+}
+
+func nilIfEmpty(v string) interface{} {
+	if v == "" {
+		return nil
+	}
+	return v
+}
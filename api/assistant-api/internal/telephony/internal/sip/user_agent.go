@@ -0,0 +1,584 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sip_telephony
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_sip "github.com/rapidaai/api/assistant-api/internal/telephony/internal/sip/internal"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+)
+
+const (
+	// retransmission of requests and responses over udp, timers T1 and T2 of rfc 3261
+	t1 = 500 * time.Millisecond
+	t2 = 4 * time.Second
+	// a transaction without response fails after 64*T1
+	transactionTimeout = 64 * t1
+	// an outbound call which is not answered in time is cancelled
+	ringTimeout = 60 * time.Second
+	// registration is renewed before it expires, failures are retried after
+	defaultRegisterExpires = 3600
+	registerRetry          = 30 * time.Second
+
+	userAgentName = "rapida"
+	allow         = "INVITE, ACK, BYE, CANCEL, OPTIONS, REFER, NOTIFY"
+)
+
+var errTransactionTimeout = errors.New("sip transaction timed out")
+
+// the user agent of the process, outbound calls and streamers of the telephony use it
+var (
+	running   *userAgent
+	runningMu sync.RWMutex
+)
+
+func current() (*userAgent, error) {
+	runningMu.RLock()
+	defer runningMu.RUnlock()
+	if running == nil {
+		return nil, errors.New("sip user agent is not listening")
+	}
+	return running, nil
+}
+
+// Listen starts the user agent of the sip telephony, it registers to the trunk when configured
+// and hands the calls to the handler until the context is done.
+func Listen(ctx context.Context, cfg *config.AssistantConfig, logger commons.Logger, handler internal_type.SipCallHandler) error {
+	ua, err := newUserAgent(ctx, cfg.SipConfig, logger, handler)
+	if err != nil {
+		return err
+	}
+	runningMu.Lock()
+	running = ua
+	runningMu.Unlock()
+
+	logger.Infof("sip user agent listening on %s", ua.conn.LocalAddr())
+	utils.Go(ctx, ua.serve)
+	utils.Go(ctx, ua.register)
+	utils.Go(ctx, func() {
+		<-ctx.Done()
+		runningMu.Lock()
+		if running == ua {
+			running = nil
+		}
+		runningMu.Unlock()
+		ua.close()
+	})
+	return nil
+}
+
+type userAgent struct {
+	ctx     context.Context
+	logger  commons.Logger
+	cfg     config.SipConfig
+	handler internal_type.SipCallHandler
+
+	conn net.PacketConn
+	// address of the user agent in via, contact and sdp
+	host string
+	port int
+
+	mu sync.Mutex
+	// client transactions waiting for responses, by branch and method
+	transactions map[string]chan *internal_sip.Message
+	// last response of server transactions to answer retransmitted requests
+	responses map[string]*internal_sip.Message
+	// dialogs by call id
+	calls    map[string]*call
+	nextPort int
+	// sources calls to the registration are accepted from besides the trunk
+	allowFrom internal_type.SourceAllowList
+}
+
+func newUserAgent(ctx context.Context, cfg config.SipConfig, logger commons.Logger, handler internal_type.SipCallHandler) (*userAgent, error) {
+	if cfg.Listen == "" {
+		return nil, errors.New("sip listen address is not configured")
+	}
+	allowFrom, err := internal_type.NewSourceAllowList(cfg.AllowFrom)
+	if err != nil {
+		return nil, fmt.Errorf("illegal sip allow_from: %w", err)
+	}
+	conn, err := net.ListenPacket("udp", cfg.Listen)
+	if err != nil {
+		return nil, fmt.Errorf("unable to listen for sip on %s: %w", cfg.Listen, err)
+	}
+	local := conn.LocalAddr().(*net.UDPAddr)
+	return &userAgent{
+		ctx:          ctx,
+		logger:       logger,
+		cfg:          cfg,
+		handler:      handler,
+		conn:         conn,
+		host:         publicHost(cfg.PublicIp, local.IP),
+		port:         local.Port,
+		transactions: map[string]chan *internal_sip.Message{},
+		responses:    map[string]*internal_sip.Message{},
+		calls:        map[string]*call{},
+		nextPort:     cfg.RtpPortMin,
+		allowFrom:    allowFrom,
+	}, nil
+}
+
+// publicHost is the configured public address, or the address the user agent listens on, or the
+// address of the interface to the internet
+func publicHost(publicIp string, listen net.IP) string {
+	if publicIp != "" {
+		return publicIp
+	}
+	if listen != nil && !listen.IsUnspecified() {
+		return listen.String()
+	}
+	if conn, err := net.Dial("udp", "8.8.8.8:53"); err == nil {
+		defer conn.Close()
+		return conn.LocalAddr().(*net.UDPAddr).IP.String()
+	}
+	return "127.0.0.1"
+}
+
+func (ua *userAgent) close() {
+	ua.mu.Lock()
+	calls := make([]*call, 0, len(ua.calls))
+	for _, c := range ua.calls {
+		calls = append(calls, c)
+	}
+	ua.mu.Unlock()
+	for _, c := range calls {
+		c.Hangup()
+	}
+	ua.conn.Close()
+}
+
+func (ua *userAgent) serve() {
+	buf := make([]byte, 65535)
+	for {
+		n, addr, err := ua.conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			continue
+		}
+		// keep alive of the trunk
+		if strings.TrimSpace(string(buf[:n])) == "" {
+			continue
+		}
+		msg, err := internal_sip.Parse(buf[:n])
+		if err != nil {
+			ua.logger.Debugf("illegal sip message from %s: %v", addr, err)
+			continue
+		}
+		if msg.IsRequest() {
+			ua.onRequest(msg, addr)
+			continue
+		}
+		ua.onResponse(msg, addr)
+	}
+}
+
+func (ua *userAgent) send(msg *internal_sip.Message, addr net.Addr) {
+	if _, err := ua.conn.WriteTo(msg.Bytes(), addr); err != nil {
+		ua.logger.Errorf("unable to send sip message to %s: %v", addr, err)
+	}
+}
+
+func transactionKey(msg *internal_sip.Message) string {
+	_, method := msg.CSeq()
+	return msg.Branch() + " " + method
+}
+
+// reply sends the response of a request, responses of invites are kept to answer the
+// retransmissions of the invite
+func (ua *userAgent) reply(req *internal_sip.Message, addr net.Addr, res *internal_sip.Message) {
+	if req.Method == internal_sip.INVITE {
+		key := transactionKey(req)
+		ua.mu.Lock()
+		ua.responses[key] = res
+		ua.mu.Unlock()
+		time.AfterFunc(transactionTimeout, func() {
+			ua.mu.Lock()
+			defer ua.mu.Unlock()
+			if ua.responses[key] == res {
+				delete(ua.responses, key)
+			}
+		})
+	}
+	ua.send(res, addr)
+}
+
+func (ua *userAgent) respond(req *internal_sip.Message, addr net.Addr, statusCode int, reason string) {
+	res := internal_sip.NewResponse(req, statusCode, reason)
+	res.Set("User-Agent", userAgentName)
+	ua.reply(req, addr, res)
+}
+
+func (ua *userAgent) call(callId string) *call {
+	ua.mu.Lock()
+	defer ua.mu.Unlock()
+	return ua.calls[callId]
+}
+
+func (ua *userAgent) onRequest(req *internal_sip.Message, addr net.Addr) {
+	ua.mu.Lock()
+	last, retransmitted := ua.responses[transactionKey(req)]
+	ua.mu.Unlock()
+	if retransmitted && req.Method == internal_sip.INVITE {
+		ua.send(last, addr)
+		return
+	}
+
+	c := ua.call(req.CallID())
+	switch req.Method {
+	case internal_sip.INVITE:
+		if c == nil {
+			ua.onInvite(req, addr)
+			return
+		}
+		c.onReinvite(req, addr)
+	case internal_sip.ACK:
+		if c != nil {
+			c.onAck()
+		}
+	case internal_sip.OPTIONS:
+		res := internal_sip.NewResponse(req, 200, "OK")
+		res.Set("Allow", allow)
+		ua.reply(req, addr, res)
+	case internal_sip.BYE:
+		if c == nil {
+			ua.respond(req, addr, 481, "Call/Transaction Does Not Exist")
+			return
+		}
+		ua.respond(req, addr, 200, "OK")
+		c.end("hangup by caller")
+	case internal_sip.CANCEL:
+		if c == nil {
+			ua.respond(req, addr, 481, "Call/Transaction Does Not Exist")
+			return
+		}
+		ua.respond(req, addr, 200, "OK")
+		c.onCancel()
+	case internal_sip.NOTIFY:
+		if c == nil {
+			ua.respond(req, addr, 481, "Call/Transaction Does Not Exist")
+			return
+		}
+		ua.respond(req, addr, 200, "OK")
+		c.onNotify(req)
+	default:
+		ua.respond(req, addr, 501, "Not Implemented")
+	}
+}
+
+func (ua *userAgent) onResponse(res *internal_sip.Message, addr net.Addr) {
+	ua.mu.Lock()
+	responses, ok := ua.transactions[transactionKey(res)]
+	ua.mu.Unlock()
+	if ok {
+		select {
+		case responses <- res:
+		default:
+		}
+		return
+	}
+	// the 200 of an invite is retransmitted when the ack was lost
+	if _, method := res.CSeq(); method == internal_sip.INVITE && res.StatusCode >= 200 && res.StatusCode < 300 {
+		if c := ua.call(res.CallID()); c != nil {
+			c.resendAck()
+		}
+	}
+}
+
+// onInvite accepts the offer of an inbound call and hands it to the handler to route
+func (ua *userAgent) onInvite(req *internal_sip.Message, addr net.Addr) {
+	if !ua.admitted(req, addr) {
+		ua.logger.Warnf("sip invite %s to the registration from untrusted %s", req.CallID(), addr)
+		ua.respond(req, addr, 403, "Forbidden")
+		return
+	}
+	ua.respond(req, addr, 100, "Trying")
+
+	offer, err := internal_sip.ParseSDP(req.Body)
+	if err != nil {
+		ua.logger.Warnf("sip invite %s without usable offer: %v", req.CallID(), err)
+		ua.respond(req, addr, 488, "Not Acceptable Here")
+		return
+	}
+	codec, event, err := internal_sip.Negotiate(offer, internal_sip.PCMU, internal_sip.PCMA)
+	if err != nil {
+		ua.respond(req, addr, 488, "Not Acceptable Here")
+		return
+	}
+	remote, err := net.ResolveUDPAddr("udp", net.JoinHostPort(offer.Address, strconv.Itoa(offer.Port)))
+	if err != nil {
+		ua.respond(req, addr, 488, "Not Acceptable Here")
+		return
+	}
+	m, err := ua.openMedia()
+	if err != nil {
+		ua.logger.Errorf("unable to open sip audio for call %s: %v", req.CallID(), err)
+		ua.respond(req, addr, 503, "Service Unavailable")
+		return
+	}
+	m.Negotiated(remote, codec, event)
+
+	c := newInboundCall(ua, req, addr, m)
+	ua.mu.Lock()
+	ua.calls[c.id] = c
+	ua.mu.Unlock()
+	utils.Go(ua.ctx, func() {
+		ua.handler.Invite(ua.ctx, c)
+	})
+}
+
+// admitted tells whether the invite may be handed to the handler. Calls to the registration are
+// routed with the key of the configuration, they are only accepted from the trunk or the allow
+// list. Any other call is authenticated by the key it carries.
+func (ua *userAgent) admitted(req *internal_sip.Message, addr net.Addr) bool {
+	reg := ua.cfg.Register
+	if reg.Username == "" || internal_sip.ParseURI(req.RequestURI).User != reg.Username {
+		return true
+	}
+	if ua.allowFrom.Allows(addr) {
+		return true
+	}
+	udp, ok := addr.(*net.UDPAddr)
+	if !ok || reg.Server == "" {
+		return false
+	}
+	ips, err := net.LookupIP(internal_sip.ParseURI("sip:" + reg.Server).Host)
+	if err != nil {
+		ua.logger.Errorf("unable to resolve sip trunk %s: %v", reg.Server, err)
+		return false
+	}
+	for _, ip := range ips {
+		if ip.Equal(udp.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+// openMedia binds the rtp port of a call within the configured range
+func (ua *userAgent) openMedia() (*media, error) {
+	ip := net.IPv4zero
+	if local, ok := ua.conn.LocalAddr().(*net.UDPAddr); ok {
+		ip = local.IP
+	}
+	if ua.cfg.RtpPortMin <= 0 || ua.cfg.RtpPortMax < ua.cfg.RtpPortMin {
+		conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: ip})
+		if err != nil {
+			return nil, err
+		}
+		return newMedia(ua.logger, conn), nil
+	}
+
+	ua.mu.Lock()
+	defer ua.mu.Unlock()
+	size := ua.cfg.RtpPortMax - ua.cfg.RtpPortMin + 1
+	for i := 0; i < size; i++ {
+		port := ua.nextPort
+		ua.nextPort++
+		if ua.nextPort > ua.cfg.RtpPortMax {
+			ua.nextPort = ua.cfg.RtpPortMin
+		}
+		// rtp uses even ports, the odd port above is left to rtcp
+		if port%2 != 0 {
+			continue
+		}
+		conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: ip, Port: port})
+		if err == nil {
+			return newMedia(ua.logger, conn), nil
+		}
+	}
+	return nil, fmt.Errorf("no free rtp port between %d and %d", ua.cfg.RtpPortMin, ua.cfg.RtpPortMax)
+}
+
+// request sends the request and waits for its final response, provisional responses are
+// passed to provisional. The request is retransmitted until a response arrives.
+func (ua *userAgent) request(ctx context.Context, req *internal_sip.Message, addr net.Addr, provisional func(*internal_sip.Message)) (*internal_sip.Message, error) {
+	key := transactionKey(req)
+	responses := make(chan *internal_sip.Message, 16)
+	ua.mu.Lock()
+	ua.transactions[key] = responses
+	ua.mu.Unlock()
+	defer func() {
+		ua.mu.Lock()
+		delete(ua.transactions, key)
+		ua.mu.Unlock()
+	}()
+
+	ua.send(req, addr)
+	interval := t1
+	retransmit := time.NewTimer(interval)
+	defer retransmit.Stop()
+	timeout := time.NewTimer(transactionTimeout)
+	defer timeout.Stop()
+	received := false
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timeout.C:
+			// a ringing invite waits for its final response until the context is done
+			if !received {
+				return nil, errTransactionTimeout
+			}
+		case <-retransmit.C:
+			if !received {
+				ua.send(req, addr)
+				interval = min(interval*2, t2)
+				retransmit.Reset(interval)
+			}
+		case res := <-responses:
+			if res.StatusCode >= 200 {
+				return res, nil
+			}
+			received = true
+			if provisional != nil {
+				provisional(res)
+			}
+		}
+	}
+}
+
+// authorized sends the request and answers the digest challenge of the trunk once
+func (ua *userAgent) authorized(ctx context.Context, req *internal_sip.Message, addr net.Addr, username, password string, provisional func(*internal_sip.Message)) (*internal_sip.Message, error) {
+	res, err := ua.request(ctx, req, addr, provisional)
+	if err != nil || (res.StatusCode != 401 && res.StatusCode != 407) || username == "" {
+		return res, err
+	}
+	if req.Method == internal_sip.INVITE {
+		ua.send(ackOf(req, res), addr)
+	}
+	challengeHeader, authorizationHeader := "WWW-Authenticate", "Authorization"
+	if res.StatusCode == 407 {
+		challengeHeader, authorizationHeader = "Proxy-Authenticate", "Proxy-Authorization"
+	}
+	challenge, err := internal_sip.ParseChallenge(res.Get(challengeHeader))
+	if err != nil {
+		ua.logger.Warnf("unable to answer sip challenge: %v", err)
+		return res, nil
+	}
+	seq, method := req.CSeq()
+	req.Set("CSeq", fmt.Sprintf("%d %s", seq+1, method))
+	req.Set("Via", ua.via())
+	req.Set(authorizationHeader, challenge.Authorization(req.Method, req.RequestURI, username, password, 1))
+	return ua.request(ctx, req, addr, provisional)
+}
+
+// ackOf acknowledges a failure response of an invite within its transaction
+func ackOf(invite, res *internal_sip.Message) *internal_sip.Message {
+	ack := internal_sip.NewRequest(internal_sip.ACK, invite.RequestURI)
+	ack.Set("Via", invite.Get("Via"))
+	ack.Set("Max-Forwards", "70")
+	ack.Set("From", invite.Get("From"))
+	ack.Set("To", res.Get("To"))
+	ack.Set("Call-ID", invite.CallID())
+	seq, _ := invite.CSeq()
+	ack.Set("CSeq", fmt.Sprintf("%d %s", seq, internal_sip.ACK))
+	return ack
+}
+
+func (ua *userAgent) via() string {
+	return fmt.Sprintf("%s/UDP %s:%d;branch=%s;rport", internal_sip.Version, ua.host, ua.port, internal_sip.NewBranch())
+}
+
+func (ua *userAgent) contact(user string) string {
+	if user == "" {
+		return fmt.Sprintf("<sip:%s:%d>", ua.host, ua.port)
+	}
+	return fmt.Sprintf("<sip:%s@%s:%d>", user, ua.host, ua.port)
+}
+
+// register keeps the user agent registered to the configured trunk
+func (ua *userAgent) register() {
+	reg := ua.cfg.Register
+	if reg.Server == "" {
+		return
+	}
+	expires := reg.Expires
+	if expires <= 0 {
+		expires = defaultRegisterExpires
+	}
+	server := internal_sip.ParseURI("sip:" + reg.Server)
+	registration := &registration{callId: internal_sip.NewCallID(), tag: internal_sip.NewTag()}
+	for {
+		wait := registerRetry
+		granted, err := ua.sendRegister(ua.ctx, server, registration, expires)
+		if err != nil {
+			ua.logger.Errorf("unable to register to sip trunk %s: %v", reg.Server, err)
+		} else if granted/2 > int(registerRetry.Seconds()) {
+			wait = time.Duration(granted/2) * time.Second
+		}
+		select {
+		case <-ua.ctx.Done():
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			ua.sendRegister(ctx, server, registration, 0)
+			cancel()
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+type registration struct {
+	callId string
+	tag    string
+	cseq   uint32
+}
+
+// sendRegister registers the contact for the time requested, it returns the time the trunk
+// granted
+func (ua *userAgent) sendRegister(ctx context.Context, server internal_sip.URI, registration *registration, expires int) (int, error) {
+	reg := ua.cfg.Register
+	addr, err := net.ResolveUDPAddr("udp", server.HostPort())
+	if err != nil {
+		return 0, err
+	}
+	aor := fmt.Sprintf("<sip:%s@%s>", reg.Username, server.Host)
+	registration.cseq++
+	req := internal_sip.NewRequest(internal_sip.REGISTER, fmt.Sprintf("sip:%s", server.Host))
+	req.Set("Via", ua.via())
+	req.Set("Max-Forwards", "70")
+	req.Set("From", aor+";tag="+registration.tag)
+	req.Set("To", aor)
+	req.Set("Call-ID", registration.callId)
+	req.Set("CSeq", fmt.Sprintf("%d %s", registration.cseq, internal_sip.REGISTER))
+	req.Set("Contact", ua.contact(reg.Username))
+	req.Set("Expires", strconv.Itoa(expires))
+	req.Set("User-Agent", userAgentName)
+
+	res, err := ua.authorized(ctx, req, addr, reg.Username, reg.Password, nil)
+	if err != nil {
+		return 0, err
+	}
+	// the cseq of the challenged request was taken by the retry
+	registration.cseq, _ = req.CSeq()
+	if res.StatusCode >= 300 {
+		return 0, fmt.Errorf("registration rejected with %d %s", res.StatusCode, res.Reason)
+	}
+	// expires of the contact takes precedence over the header
+	granted := expires
+	if v, err := strconv.Atoi(res.Get("Expires")); err == nil {
+		granted = v
+	}
+	for _, contact := range res.Values("Contact") {
+		if v, err := strconv.Atoi(internal_sip.ParseParams(contact)["expires"]); err == nil {
+			granted = v
+		}
+	}
+	return granted, nil
+}
@@ -0,0 +1,643 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_sip_telephony
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	internal_sip "github.com/rapidaai/api/assistant-api/internal/telephony/internal/sip/internal"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zaf/g711"
+)
+
+const testTimeout = 5 * time.Second
+
+// testHandler hands the calls of the user agent to the test
+type testHandler struct {
+	invites  chan internal_type.SipCall
+	answered chan internal_type.SipCall
+	ended    chan string
+}
+
+func newTestHandler() *testHandler {
+	return &testHandler{
+		invites:  make(chan internal_type.SipCall, 4),
+		answered: make(chan internal_type.SipCall, 4),
+		ended:    make(chan string, 4),
+	}
+}
+
+func (h *testHandler) Invite(ctx context.Context, call internal_type.SipCall) {
+	h.invites <- call
+}
+
+func (h *testHandler) Answered(ctx context.Context, call internal_type.SipCall) {
+	h.answered <- call
+}
+
+func (h *testHandler) Ended(ctx context.Context, call internal_type.SipCall, status string, reason string) {
+	h.ended <- status
+}
+
+func newTestUserAgent(t *testing.T, handler internal_type.SipCallHandler) *userAgent {
+	return newConfiguredTestUserAgent(t, config.SipConfig{Listen: "127.0.0.1:0"}, handler)
+}
+
+func newConfiguredTestUserAgent(t *testing.T, cfg config.SipConfig, handler internal_type.SipCallHandler) *userAgent {
+	logger, _ := commons.NewApplicationLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	ua, err := newUserAgent(ctx, cfg, logger, handler)
+	require.NoError(t, err)
+	go ua.serve()
+	t.Cleanup(func() {
+		cancel()
+		ua.close()
+	})
+	return ua
+}
+
+// testPeer stands in for the sip client or trunk on the other side of the user agent
+type testPeer struct {
+	t    *testing.T
+	conn *net.UDPConn
+	rtp  *net.UDPConn
+	ua   net.Addr
+}
+
+func newTestPeer(t *testing.T, ua *userAgent) *testPeer {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	rtp, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+		rtp.Close()
+	})
+	return &testPeer{t: t, conn: conn, rtp: rtp, ua: ua.conn.LocalAddr()}
+}
+
+func (p *testPeer) addr() *net.UDPAddr {
+	return p.conn.LocalAddr().(*net.UDPAddr)
+}
+
+func (p *testPeer) via() string {
+	return fmt.Sprintf("%s/UDP %s;branch=%s", internal_sip.Version, p.addr(), internal_sip.NewBranch())
+}
+
+func (p *testPeer) send(msg *internal_sip.Message) {
+	_, err := p.conn.WriteTo(msg.Bytes(), p.ua)
+	require.NoError(p.t, err)
+}
+
+// expect returns the next message which matches, other messages are skipped
+func (p *testPeer) expect(match func(*internal_sip.Message) bool) *internal_sip.Message {
+	buf := make([]byte, 65535)
+	deadline := time.Now().Add(testTimeout)
+	for {
+		require.NoError(p.t, p.conn.SetReadDeadline(deadline))
+		n, err := p.conn.Read(buf)
+		require.NoError(p.t, err, "expected sip message was not received")
+		msg, err := internal_sip.Parse(buf[:n])
+		require.NoError(p.t, err)
+		if match(msg) {
+			return msg
+		}
+	}
+}
+
+func isResponse(statusCode int, method string) func(*internal_sip.Message) bool {
+	return func(msg *internal_sip.Message) bool {
+		_, m := msg.CSeq()
+		return !msg.IsRequest() && msg.StatusCode == statusCode && m == method
+	}
+}
+
+func isRequest(method string) func(*internal_sip.Message) bool {
+	return func(msg *internal_sip.Message) bool {
+		return msg.IsRequest() && msg.Method == method
+	}
+}
+
+func (p *testPeer) offer(codecs ...internal_sip.Codec) []byte {
+	sd := &internal_sip.SessionDescription{
+		Address: "127.0.0.1",
+		Port:    p.rtp.LocalAddr().(*net.UDPAddr).Port,
+		Codecs:  codecs,
+	}
+	return sd.Marshal(1)
+}
+
+func (p *testPeer) invite(requestURI string, codecs ...internal_sip.Codec) *internal_sip.Message {
+	req := internal_sip.NewRequest(internal_sip.INVITE, requestURI)
+	req.Set("Via", p.via())
+	req.Set("Max-Forwards", "70")
+	req.Set("From", fmt.Sprintf("<sip:+15550001111@%s>;tag=%s", p.addr(), internal_sip.NewTag()))
+	req.Set("To", fmt.Sprintf("<%s>", requestURI))
+	req.Set("Call-ID", internal_sip.NewCallID())
+	req.Set("CSeq", "1 INVITE")
+	req.Set("Contact", fmt.Sprintf("<sip:+15550001111@%s>", p.addr()))
+	req.Set("Content-Type", "application/sdp")
+	req.Body = p.offer(codecs...)
+	return req
+}
+
+// request builds a request within the dialog of an answered inbound call
+func (p *testPeer) request(method string, invite, answer *internal_sip.Message, cseq int) *internal_sip.Message {
+	req := internal_sip.NewRequest(method, internal_sip.ParseAddress(answer.Get("Contact")).URI.String())
+	req.Set("Via", p.via())
+	req.Set("Max-Forwards", "70")
+	req.Set("From", invite.Get("From"))
+	req.Set("To", answer.Get("To"))
+	req.Set("Call-ID", invite.CallID())
+	req.Set("CSeq", fmt.Sprintf("%d %s", cseq, method))
+	return req
+}
+
+// answer accepts the request of the user agent with the sdp of the peer
+func (p *testPeer) answer(req *internal_sip.Message, codecs ...internal_sip.Codec) *internal_sip.Message {
+	res := internal_sip.NewResponse(req, 200, "OK")
+	res.Set("To", req.Get("To")+";tag=peer")
+	res.Set("Contact", fmt.Sprintf("<sip:peer@%s>", p.addr()))
+	res.Set("Content-Type", "application/sdp")
+	res.Body = p.offer(codecs...)
+	return res
+}
+
+// audio sends rtp to the port of the sdp
+func (p *testPeer) audio(sdp []byte, pkt *internal_sip.Packet) {
+	sd, err := internal_sip.ParseSDP(sdp)
+	require.NoError(p.t, err)
+	_, err = p.rtp.WriteToUDP(pkt.Marshal(), &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: sd.Port})
+	require.NoError(p.t, err)
+}
+
+// expectAudio returns the payload of the first rtp packet which is not silence
+func (p *testPeer) expectAudio() *internal_sip.Packet {
+	buf := make([]byte, 1500)
+	deadline := time.Now().Add(testTimeout)
+	for {
+		require.NoError(p.t, p.rtp.SetReadDeadline(deadline))
+		n, err := p.rtp.Read(buf)
+		require.NoError(p.t, err, "expected audio was not received")
+		pkt, err := internal_sip.UnmarshalPacket(buf[:n])
+		require.NoError(p.t, err)
+		if !bytes.Equal(pkt.Payload, bytes.Repeat([]byte{mulawSilence}, frameSamples)) &&
+			!bytes.Equal(pkt.Payload, g711.Ulaw2Alaw(bytes.Repeat([]byte{mulawSilence}, frameSamples))) {
+			return pkt
+		}
+	}
+}
+
+// recv skips the calls of the streamer which buffered audio without a request
+func recv(t *testing.T, s streamers.Streamer) (*protos.AssistantMessagingRequest, error) {
+	type result struct {
+		req *protos.AssistantMessagingRequest
+		err error
+	}
+	results := make(chan result, 1)
+	go func() {
+		for {
+			req, err := s.Recv()
+			if err != nil || req != nil {
+				results <- result{req, err}
+				return
+			}
+		}
+	}()
+	select {
+	case r := <-results:
+		return r.req, r.err
+	case <-time.After(testTimeout):
+		t.Fatal("streamer did not receive")
+		return nil, nil
+	}
+}
+
+func receive[T any](t *testing.T, ch <-chan T) T {
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(testTimeout):
+		t.Fatal("expected call was not handed to the handler")
+		var zero T
+		return zero
+	}
+}
+
+func TestInboundCall(t *testing.T) {
+	tests := []struct {
+		name   string
+		codecs []internal_sip.Codec
+		encode func([]byte) []byte
+	}{
+		{
+			name:   "mu-law",
+			codecs: []internal_sip.Codec{internal_sip.PCMU, internal_sip.TelephoneEvent},
+			encode: func(b []byte) []byte { return b },
+		},
+		{
+			name:   "a-law",
+			codecs: []internal_sip.Codec{internal_sip.PCMA, internal_sip.TelephoneEvent},
+			encode: g711.Ulaw2Alaw,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := newTestHandler()
+			ua := newTestUserAgent(t, handler)
+			peer := newTestPeer(t, ua)
+
+			invite := peer.invite(fmt.Sprintf("sip:2263072539095859200@%s;x-api-key=secret", ua.conn.LocalAddr()), tt.codecs...)
+			peer.send(invite)
+			peer.expect(isResponse(100, internal_sip.INVITE))
+
+			sipCall := receive(t, handler.invites)
+			assert.Equal(t, invite.CallID(), sipCall.Id())
+			assert.Equal(t, "2263072539095859200", sipCall.User())
+			assert.Equal(t, "secret", sipCall.Param("x-api-key"))
+			assert.Equal(t, "+15550001111", sipCall.From())
+			assert.Nil(t, sipCall.Outbound())
+
+			require.NoError(t, sipCall.Answer())
+			answer := peer.expect(isResponse(200, internal_sip.INVITE))
+			assert.NotEmpty(t, answer.To().Tag())
+			sd, err := internal_sip.ParseSDP(answer.Body)
+			require.NoError(t, err)
+			require.NotEmpty(t, sd.Codecs)
+			assert.Equal(t, tt.codecs[0].Name, sd.Codecs[0].Name)
+
+			ack := peer.request(internal_sip.ACK, invite, answer, 1)
+			peer.send(ack)
+
+			c := sipCall.(*call)
+			streamer := newSipStreamer(c.ua.logger, c, &internal_assistant_entity.Assistant{}, &internal_conversation_entity.AssistantConversation{}, nil)
+			req, err := recv(t, streamer)
+			require.NoError(t, err)
+			assert.Equal(t, protos.AudioConfig_MuLaw8, req.GetConfiguration().GetInputConfig().GetAudio().GetAudioFormat())
+
+			// 60ms of audio of the caller reach the assistant as one request
+			spoken := bytes.Repeat([]byte{0x10}, frameSamples)
+			for i := 0; i < 3; i++ {
+				peer.audio(answer.Body, &internal_sip.Packet{PayloadType: tt.codecs[0].PayloadType, Sequence: uint16(i), Timestamp: uint32(i * frameSamples), SSRC: 1, Payload: tt.encode(spoken)})
+			}
+			req, err = recv(t, streamer)
+			require.NoError(t, err)
+			expected := bytes.Repeat(spoken, 3)
+			if tt.codecs[0].Name == internal_sip.PCMA.Name {
+				expected = g711.Alaw2Ulaw(tt.encode(expected))
+			}
+			assert.Equal(t, expected, req.GetMessage().GetAudio().GetContent())
+
+			// the end of a key press is sent three times and reaches the assistant once
+			event := &internal_sip.DtmfEvent{Digit: "5", End: true, Duration: 800}
+			for i := 0; i < 3; i++ {
+				peer.audio(answer.Body, &internal_sip.Packet{PayloadType: internal_sip.TelephoneEvent.PayloadType, Sequence: uint16(10 + i), Timestamp: 4000, SSRC: 1, Payload: event.Marshal()})
+			}
+			req, err = recv(t, streamer)
+			require.NoError(t, err)
+			assert.Equal(t, "5", req.GetMessage().GetDtmf().GetDigit())
+
+			// audio of the assistant is played to the caller
+			said := bytes.Repeat([]byte{0x20}, frameSamples)
+			require.NoError(t, streamer.Send(&protos.AssistantMessagingResponse{
+				Data: &protos.AssistantMessagingResponse_Assistant{
+					Assistant: &protos.AssistantConversationAssistantMessage{
+						Message: &protos.AssistantConversationAssistantMessage_Audio{
+							Audio: &protos.AssistantConversationMessageAudioContent{Content: said},
+						},
+					},
+				},
+			}))
+			pkt := peer.expectAudio()
+			assert.Equal(t, tt.codecs[0].PayloadType, pkt.PayloadType)
+			assert.Equal(t, tt.encode(said), pkt.Payload)
+
+			peer.send(peer.request(internal_sip.BYE, invite, answer, 2))
+			peer.expect(isResponse(200, internal_sip.BYE))
+			_, err = recv(t, streamer)
+			assert.ErrorIs(t, err, io.EOF)
+			assert.Nil(t, ua.call(invite.CallID()))
+		})
+	}
+}
+
+func TestInboundCallRejected(t *testing.T) {
+	handler := newTestHandler()
+	ua := newTestUserAgent(t, handler)
+	peer := newTestPeer(t, ua)
+
+	invite := peer.invite(fmt.Sprintf("sip:unknown@%s", ua.conn.LocalAddr()), internal_sip.PCMU)
+	peer.send(invite)
+	call := receive(t, handler.invites)
+	require.NoError(t, call.Reject(404, "Not Found"))
+	peer.expect(isResponse(404, internal_sip.INVITE))
+	assert.Nil(t, ua.call(invite.CallID()))
+	assert.Error(t, call.Answer())
+}
+
+func TestInboundCallToRegistration(t *testing.T) {
+	tests := []struct {
+		name     string
+		server   string
+		allow    string
+		admitted bool
+	}{
+		{name: "call from the trunk", server: "127.0.0.1:5060", admitted: true},
+		{name: "call from an allowed address", server: "192.0.2.1:5060", allow: "198.51.100.7, 127.0.0.1", admitted: true},
+		{name: "call from an allowed network", server: "192.0.2.1:5060", allow: "127.0.0.0/8", admitted: true},
+		{name: "call from anywhere else", server: "192.0.2.1:5060", allow: "10.0.0.0/8"},
+		{name: "call without trunk or allow list", allow: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := newTestHandler()
+			ua := newConfiguredTestUserAgent(t, config.SipConfig{
+				Listen:    "127.0.0.1:0",
+				Register:  config.SipRegistration{Server: tt.server, Username: "trunk"},
+				AllowFrom: tt.allow,
+			}, handler)
+			peer := newTestPeer(t, ua)
+
+			peer.send(peer.invite(fmt.Sprintf("sip:trunk@%s", ua.conn.LocalAddr()), internal_sip.PCMU))
+			if !tt.admitted {
+				peer.expect(isResponse(403, internal_sip.INVITE))
+				assert.Empty(t, handler.invites)
+				return
+			}
+			peer.expect(isResponse(100, internal_sip.INVITE))
+			assert.Equal(t, "trunk", receive(t, handler.invites).User())
+		})
+	}
+
+	logger, _ := commons.NewApplicationLogger()
+	_, err := newUserAgent(context.Background(), config.SipConfig{Listen: "127.0.0.1:0", AllowFrom: "trunk.example.com"}, logger, newTestHandler())
+	assert.Error(t, err, "the allow list takes addresses and networks")
+}
+
+func TestInboundCallCancelled(t *testing.T) {
+	handler := newTestHandler()
+	ua := newTestUserAgent(t, handler)
+	peer := newTestPeer(t, ua)
+
+	invite := peer.invite(fmt.Sprintf("sip:2263@%s", ua.conn.LocalAddr()), internal_sip.PCMU)
+	peer.send(invite)
+	call := receive(t, handler.invites)
+
+	cancel := internal_sip.NewRequest(internal_sip.CANCEL, invite.RequestURI)
+	for _, name := range []string{"Via", "From", "To", "Call-ID"} {
+		cancel.Set(name, invite.Get(name))
+	}
+	cancel.Set("CSeq", "1 CANCEL")
+	peer.send(cancel)
+	peer.expect(isResponse(200, internal_sip.CANCEL))
+	peer.expect(isResponse(487, internal_sip.INVITE))
+	assert.Error(t, call.Answer())
+}
+
+func TestInboundCallWithoutSupportedCodec(t *testing.T) {
+	handler := newTestHandler()
+	ua := newTestUserAgent(t, handler)
+	peer := newTestPeer(t, ua)
+
+	g729 := internal_sip.Codec{PayloadType: 18, Name: "G729", ClockRate: 8000}
+	peer.send(peer.invite(fmt.Sprintf("sip:2263@%s", ua.conn.LocalAddr()), g729))
+	peer.expect(isResponse(488, internal_sip.INVITE))
+	assert.Empty(t, handler.invites)
+}
+
+func TestRequestsOutsideOfCalls(t *testing.T) {
+	tests := []struct {
+		method     string
+		statusCode int
+	}{
+		{method: internal_sip.OPTIONS, statusCode: 200},
+		{method: internal_sip.BYE, statusCode: 481},
+		{method: internal_sip.NOTIFY, statusCode: 481},
+		{method: "MESSAGE", statusCode: 501},
+	}
+	ua := newTestUserAgent(t, newTestHandler())
+	peer := newTestPeer(t, ua)
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			req := internal_sip.NewRequest(tt.method, fmt.Sprintf("sip:%s", ua.conn.LocalAddr()))
+			req.Set("Via", peer.via())
+			req.Set("From", "<sip:peer@127.0.0.1>;tag=1")
+			req.Set("To", "<sip:rapida@127.0.0.1>")
+			req.Set("Call-ID", internal_sip.NewCallID())
+			req.Set("CSeq", "1 "+tt.method)
+			peer.send(req)
+			peer.expect(isResponse(tt.statusCode, tt.method))
+		})
+	}
+}
+
+func TestTransfer(t *testing.T) {
+	handler := newTestHandler()
+	ua := newTestUserAgent(t, handler)
+	peer := newTestPeer(t, ua)
+
+	invite := peer.invite(fmt.Sprintf("sip:2263@%s", ua.conn.LocalAddr()), internal_sip.PCMU)
+	peer.send(invite)
+	c := receive(t, handler.invites).(*call)
+	require.NoError(t, c.Answer())
+	answer := peer.expect(isResponse(200, internal_sip.INVITE))
+	peer.send(peer.request(internal_sip.ACK, invite, answer, 1))
+
+	transferred := make(chan error, 1)
+	go func() { transferred <- c.Transfer("+15559990000") }()
+	refer := peer.expect(isRequest(internal_sip.REFER))
+	assert.Equal(t, fmt.Sprintf("<sip:+15559990000@%s>", peer.addr()), refer.Get("Refer-To"))
+	peer.send(internal_sip.NewResponse(refer, 202, "Accepted"))
+	require.NoError(t, receive(t, transferred))
+
+	// the trunk reports the target answered, the user agent leaves the call
+	notify := peer.request(internal_sip.NOTIFY, invite, answer, 2)
+	notify.Set("Event", "refer")
+	notify.Set("Content-Type", "message/sipfrag")
+	notify.Body = []byte("SIP/2.0 200 OK")
+	peer.send(notify)
+	peer.expect(isResponse(200, internal_sip.NOTIFY))
+	bye := peer.expect(isRequest(internal_sip.BYE))
+	assert.Equal(t, invite.CallID(), bye.CallID())
+	peer.send(internal_sip.NewResponse(bye, 200, "OK"))
+	receive(t, c.Done())
+}
+
+func TestOutboundCall(t *testing.T) {
+	handler := newTestHandler()
+	ua := newTestUserAgent(t, handler)
+	trunk := newTestPeer(t, ua)
+	dial := &internal_type.SipDial{AssistantId: 1, AssistantConversationId: 2}
+
+	dialed := make(chan error, 1)
+	go func() {
+		_, err := ua.dial(context.Background(), "+15559990000", "+15550001111", testTrunk(trunk, "user", "secret"), dial)
+		dialed <- err
+	}()
+
+	// the trunk asks for credentials first
+	invite := trunk.expect(isRequest(internal_sip.INVITE))
+	assert.Equal(t, "+15559990000", invite.To().URI.User)
+	challenge := internal_sip.NewResponse(invite, 401, "Unauthorized")
+	challenge.Set("To", invite.Get("To")+";tag=challenge")
+	challenge.Set("WWW-Authenticate", `Digest realm="trunk", nonce="abc", algorithm=MD5, qop="auth"`)
+	trunk.send(challenge)
+	trunk.expect(isRequest(internal_sip.ACK))
+
+	invite = trunk.expect(isRequest(internal_sip.INVITE))
+	seq, _ := invite.CSeq()
+	assert.Equal(t, uint32(2), seq)
+	assert.Contains(t, invite.Get("Authorization"), `username="user"`)
+	offer, err := internal_sip.ParseSDP(invite.Body)
+	require.NoError(t, err)
+	assert.Len(t, offer.Codecs, 3)
+
+	ringing := internal_sip.NewResponse(invite, 180, "Ringing")
+	ringing.Set("To", invite.Get("To")+";tag=peer")
+	trunk.send(ringing)
+	require.NoError(t, receive(t, dialed))
+
+	trunk.send(trunk.answer(invite, internal_sip.PCMU))
+	trunk.expect(isRequest(internal_sip.ACK))
+	call := receive(t, handler.answered)
+	assert.Equal(t, dial, call.Outbound())
+
+	bye := internal_sip.NewRequest(internal_sip.BYE, internal_sip.ParseAddress(invite.Get("Contact")).URI.String())
+	bye.Set("Via", trunk.via())
+	bye.Set("From", invite.Get("To")+";tag=peer")
+	bye.Set("To", invite.Get("From"))
+	bye.Set("Call-ID", invite.CallID())
+	bye.Set("CSeq", "1 BYE")
+	trunk.send(bye)
+	trunk.expect(isResponse(200, internal_sip.BYE))
+	assert.Equal(t, "completed", receive(t, handler.ended))
+}
+
+func TestOutboundCallFailed(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		reason     string
+		status     string
+	}{
+		{statusCode: 486, reason: "Busy Here", status: "busy"},
+		{statusCode: 480, reason: "Temporarily Unavailable", status: "no-answer"},
+		{statusCode: 404, reason: "Not Found", status: "failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.reason, func(t *testing.T) {
+			handler := newTestHandler()
+			ua := newTestUserAgent(t, handler)
+			trunk := newTestPeer(t, ua)
+
+			dialed := make(chan error, 1)
+			go func() {
+				_, err := ua.dial(context.Background(), "+15559990000", "+15550001111", testTrunk(trunk, "", ""), &internal_type.SipDial{})
+				dialed <- err
+			}()
+			invite := trunk.expect(isRequest(internal_sip.INVITE))
+			res := internal_sip.NewResponse(invite, tt.statusCode, tt.reason)
+			res.Set("To", invite.Get("To")+";tag=peer")
+			trunk.send(res)
+			trunk.expect(isRequest(internal_sip.ACK))
+			assert.Error(t, receive(t, dialed))
+			assert.Equal(t, tt.status, receive(t, handler.ended))
+			assert.Nil(t, ua.call(invite.CallID()))
+		})
+	}
+}
+
+func TestOutboundCallIllegalUser(t *testing.T) {
+	tests := []struct {
+		name string
+		to   string
+		from string
+	}{
+		{name: "header in the number", to: "+15559990000\r\nX-Injected: 1", from: "+15550001111"},
+		{name: "uri parameter in the number", to: "+15559990000;transport=tcp", from: "+15550001111"},
+		{name: "address in the caller id", to: "+15559990000", from: "+15550001111@evil.example>"},
+		{name: "empty number", to: "", from: "+15550001111"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ua := newTestUserAgent(t, newTestHandler())
+			trunk := newTestPeer(t, ua)
+			_, err := ua.dial(context.Background(), tt.to, tt.from, testTrunk(trunk, "", ""), &internal_type.SipDial{})
+			assert.Error(t, err)
+			ua.mu.Lock()
+			assert.Empty(t, ua.calls)
+			ua.mu.Unlock()
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	ua := newTestUserAgent(t, newTestHandler())
+	registrar := newTestPeer(t, ua)
+	ua.cfg.Register = config.SipRegistration{Username: "rapida", Password: "secret"}
+
+	registered := make(chan int, 1)
+	go func() {
+		granted, err := ua.sendRegister(context.Background(), internal_sip.ParseURI("sip:"+registrar.addr().String()), &registration{callId: internal_sip.NewCallID(), tag: internal_sip.NewTag()}, 3600)
+		assert.NoError(t, err)
+		registered <- granted
+	}()
+
+	req := registrar.expect(isRequest(internal_sip.REGISTER))
+	challenge := internal_sip.NewResponse(req, 401, "Unauthorized")
+	challenge.Set("WWW-Authenticate", `Digest realm="trunk", nonce="abc"`)
+	registrar.send(challenge)
+
+	req = registrar.expect(isRequest(internal_sip.REGISTER))
+	assert.Contains(t, req.Get("Authorization"), `username="rapida"`)
+	assert.True(t, strings.HasPrefix(req.Get("Contact"), "<sip:rapida@127.0.0.1:"))
+	res := internal_sip.NewResponse(req, 200, "OK")
+	res.Set("Contact", req.Get("Contact")+";expires=600")
+	res.Set("Expires", "3600")
+	registrar.send(res)
+	assert.Equal(t, 600, receive(t, registered))
+}
+
+func TestStatusOf(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		expected   string
+	}{
+		{486, "busy"},
+		{600, "busy"},
+		{408, "no-answer"},
+		{480, "no-answer"},
+		{487, "no-answer"},
+		{403, "failed"},
+		{503, "failed"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, statusOf(tt.statusCode), "status code %d", tt.statusCode)
+	}
+}
+
+func testTrunk(peer *testPeer, username, password string) *trunk {
+	return &trunk{
+		server:   internal_sip.ParseURI("sip:" + peer.addr().String()),
+		addr:     peer.addr(),
+		username: username,
+		password: password,
+	}
+}
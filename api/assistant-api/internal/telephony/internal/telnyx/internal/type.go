@@ -0,0 +1,63 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_telnyx
+
+type TelnyxMediaEvent struct {
+	Event    string `json:"event"`
+	StreamId string `json:"stream_id"`
+	Start    *struct {
+		CallControlId string `json:"call_control_id"`
+		MediaFormat   struct {
+			Encoding   string `json:"encoding"`
+			SampleRate int    `json:"sample_rate"`
+			Channels   int    `json:"channels"`
+		} `json:"media_format"`
+	} `json:"start,omitempty"`
+	Media *struct {
+		Track   string `json:"track"`
+		Payload string `json:"payload"`
+	} `json:"media,omitempty"`
+	Dtmf *struct {
+		Digit string `json:"digit"`
+	} `json:"dtmf,omitempty"`
+	Payload *struct {
+		Code   int    `json:"code"`
+		Title  string `json:"title"`
+		Detail string `json:"detail"`
+	} `json:"payload,omitempty"`
+}
+
+// CallControlEvent is the webhook call control posts for every change of a call
+type CallControlEvent struct {
+	Data struct {
+		EventType  string                 `json:"event_type"`
+		Id         string                 `json:"id"`
+		OccurredAt string                 `json:"occurred_at"`
+		Payload    map[string]interface{} `json:"payload"`
+	} `json:"data"`
+}
+
+type DialRequest struct {
+	ConnectionId             string `json:"connection_id"`
+	To                       string `json:"to"`
+	From                     string `json:"from"`
+	WebhookUrl               string `json:"webhook_url"`
+	WebhookUrlMethod         string `json:"webhook_url_method"`
+	StreamUrl                string `json:"stream_url"`
+	StreamTrack              string `json:"stream_track"`
+	StreamBidirectionalMode  string `json:"stream_bidirectional_mode"`
+	StreamBidirectionalCodec string `json:"stream_bidirectional_codec"`
+}
+
+type DialResponse struct {
+	Data struct {
+		CallControlId string `json:"call_control_id"`
+		CallLegId     string `json:"call_leg_id"`
+		CallSessionId string `json:"call_session_id"`
+		IsAlive       bool   `json:"is_alive"`
+		RecordType    string `json:"record_type"`
+	} `json:"data"`
+}
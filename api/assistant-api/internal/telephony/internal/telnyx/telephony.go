@@ -0,0 +1,301 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_telnyx_telephony
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	internal_telnyx "github.com/rapidaai/api/assistant-api/internal/telephony/internal/telnyx/internal"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// api of call control, it is replaced in tests
+var apiUrl = "https://api.telnyx.com/v2"
+
+// webhooks signed earlier are taken as replayed
+const signatureTolerance = 5 * time.Minute
+
+type telnyxTelephony struct {
+	logger commons.Logger
+	appCfg *config.AssistantConfig
+}
+
+// NewTelnyxTelephony connects calls of telnyx. Inbound calls are answered by a TeXML application
+// as its webhook can be answered without the api key, outbound calls are placed and hung up
+// through call control. The audio of both is streamed over the media streaming websocket.
+func NewTelnyxTelephony(config *config.AssistantConfig, logger commons.Logger) (internal_type.Telephony, error) {
+	return &telnyxTelephony{
+		logger: logger,
+		appCfg: config,
+	}, nil
+}
+
+func apiKeyOf(vaultCredential *protos.VaultCredential) (string, error) {
+	apiKey, ok := vaultCredential.GetValue().AsMap()["api_key"].(string)
+	if !ok || apiKey == "" {
+		return "", fmt.Errorf("illegal vault config api_key is not found")
+	}
+	return apiKey, nil
+}
+
+// request calls the call control api with the api key
+func request(method, path, apiKey string, body interface{}) ([]byte, error) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequest(method, apiUrl+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+	client := &http.Client{Timeout: 60 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return bodyBytes, fmt.Errorf("status code %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+	return bodyBytes, nil
+}
+
+// hangup ends the call through call control
+func hangup(vaultCredential *protos.VaultCredential, callControlId string) error {
+	apiKey, err := apiKeyOf(vaultCredential)
+	if err != nil {
+		return err
+	}
+	_, err = request("POST", fmt.Sprintf("/calls/%s/actions/hangup", url.PathEscape(callControlId)), apiKey, map[string]string{})
+	return err
+}
+
+// statusOf maps the event of call control to the status of the call, events which do not change
+// the status are not mapped
+func statusOf(eventType string, payload map[string]interface{}) (string, bool) {
+	switch eventType {
+	case "call.initiated":
+		return "initiated", true
+	case "call.answered":
+		return "in-progress", true
+	case "call.hangup":
+		cause, _ := payload["hangup_cause"].(string)
+		switch strings.ToLower(cause) {
+		case "normal_clearing", "time_limit":
+			return "completed", true
+		case "user_busy":
+			return "busy", true
+		case "timeout", "no_answer":
+			return "no-answer", true
+		case "originator_cancel":
+			return "canceled", true
+		default:
+			return "failed", true
+		}
+	default:
+		return "", false
+	}
+}
+
+// VerifyWebhook checks the ed25519 signature of the webhook, telnyx signs the timestamp and the body
+// with the key of the account whose public key is kept in the vault
+func (tpc *telnyxTelephony) VerifyWebhook(c *gin.Context, vaultCredential *protos.VaultCredential, opts utils.Option) error {
+	encodedKey, ok := vaultCredential.GetValue().AsMap()["public_key"].(string)
+	if !ok || encodedKey == "" {
+		return fmt.Errorf("illegal vault config public_key is not found")
+	}
+	publicKey, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return fmt.Errorf("illegal vault config public_key")
+	}
+	signature, err := base64.StdEncoding.DecodeString(c.GetHeader("telnyx-signature-ed25519"))
+	if err != nil || len(signature) == 0 {
+		return fmt.Errorf("missing telnyx signature")
+	}
+	timestamp := c.GetHeader("telnyx-timestamp")
+	signedAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("missing telnyx timestamp")
+	}
+	if time.Since(time.Unix(signedAt, 0)).Abs() > signatureTolerance {
+		return fmt.Errorf("telnyx signature has expired")
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read request body: %w", err)
+	}
+	if !ed25519.Verify(publicKey, []byte(timestamp+"|"+string(body)), signature) {
+		return fmt.Errorf("illegal telnyx signature")
+	}
+	return nil
+}
+
+// Recording is not supported, the calls are recorded by the assistant only
+func (tpc *telnyxTelephony) Recording(ctx context.Context, vaultCredential *protos.VaultCredential, recordingUrl string) (*internal_type.Recording, error) {
+	return nil, internal_type.ErrProviderRecordingNotSupported
+}
+
+func (tpc *telnyxTelephony) CatchAllStatusCallback(ctx *gin.Context) ([]types.Telemetry, error) {
+	return nil, nil
+}
+
+// StatusCallback takes the webhooks of call control, the events of the call are reported with
+// the status twilio would report for them
+func (tpc *telnyxTelephony) StatusCallback(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, assistantConversationId uint64) ([]types.Telemetry, error) {
+	body, err := c.GetRawData()
+	if err != nil {
+		tpc.logger.Errorf("failed to read event body with error %+v", err)
+		return nil, fmt.Errorf("failed to read request body")
+	}
+	var event internal_telnyx.CallControlEvent
+	if err := json.Unmarshal(body, &event); err != nil || event.Data.EventType == "" {
+		tpc.logger.Errorf("failed to parse call control event %s", string(body))
+		return nil, fmt.Errorf("failed to parse request body")
+	}
+	eventDetails := event.Data.Payload
+	if eventDetails == nil {
+		eventDetails = map[string]interface{}{}
+	}
+	eventDetails["event_type"] = event.Data.EventType
+
+	status, ok := statusOf(event.Data.EventType, eventDetails)
+	if !ok {
+		return []types.Telemetry{types.NewEvent(event.Data.EventType, eventDetails)}, nil
+	}
+	return []types.Telemetry{types.NewMetric("STATUS", status, utils.Ptr("Status of conversation")), types.NewEvent(status, eventDetails)}, nil
+}
+
+// ReceiveCall takes the webhook of the TeXML application, its parameters are the ones of twilio
+func (tpc *telnyxTelephony) ReceiveCall(c *gin.Context) (*string, []types.Telemetry, error) {
+	queryParams := make(map[string]string)
+	telemetry := []types.Telemetry{}
+	for key, values := range c.Request.URL.Query() {
+		if len(values) > 0 {
+			queryParams[key] = values[0]
+		}
+	}
+
+	clientNumber, ok := queryParams["From"]
+	if !ok || clientNumber == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid caller"})
+		return nil, telemetry, fmt.Errorf("missing or empty 'from' query parameter")
+	}
+
+	if v, ok := queryParams["CallSid"]; ok && v != "" {
+		telemetry = append(telemetry, types.NewMetadata("telephony.uuid", v))
+	}
+	return utils.Ptr(clientNumber), append(telemetry, types.NewEvent("webhook", queryParams), types.NewMetric("STATUS", "SUCCESS", utils.Ptr("Status of telephony api"))), nil
+}
+
+// CreateTeXML connects the call to the media streaming websocket, the assistant speaks mu-law
+// over rtp
+func (tpc *telnyxTelephony) CreateTeXML(mediaServer string, name, path string) string {
+	return fmt.Sprintf(`
+	    <Response>
+		 	<Connect>
+	        	<Stream url="wss://%s/%s" name="%s" bidirectionalMode="rtp" bidirectionalCodec="PCMU"/>
+			</Connect>
+	    </Response>
+	`,
+		mediaServer,
+		path,
+		name,
+	)
+}
+
+func (tpc *telnyxTelephony) InboundCall(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, clientNumber string, assistantConversationId uint64, opts utils.Option) error {
+	c.Data(http.StatusOK, "text/xml", []byte(
+		tpc.CreateTeXML(
+			tpc.appCfg.PublicAssistantHost,
+			fmt.Sprintf("%d__%d", assistantId, assistantConversationId),
+			internal_type.GetAnswerPath("telnyx", auth, assistantId, assistantConversationId, clientNumber),
+		),
+	))
+	return nil
+}
+
+// OutboundCall dials through call control with the stream of the call, the events of the call are
+// posted to the status callback
+func (tpc *telnyxTelephony) OutboundCall(
+	auth types.SimplePrinciple,
+	toPhone string,
+	fromPhone string,
+	assistantId, assistantConversationId uint64,
+	vaultCredential *protos.VaultCredential,
+	opts utils.Option) ([]types.Telemetry, error) {
+	mtds := []types.Telemetry{
+		types.NewMetadata("telephony.toPhone", toPhone),
+		types.NewMetadata("telephony.fromPhone", fromPhone),
+		types.NewMetadata("telephony.provider", "telnyx"),
+	}
+	apiKey, err := apiKeyOf(vaultCredential)
+	if err != nil {
+		return append(mtds, types.NewEvent("FAILED", "Failed to find api key, check credentials"), types.NewMetric("STATUS", "FAILED", utils.Ptr("Status of telephony api"))), err
+	}
+	connectionId, ok := vaultCredential.GetValue().AsMap()["connection_id"].(string)
+	if !ok || connectionId == "" {
+		return append(mtds, types.NewEvent("FAILED", "Failed to find connection, check credentials"), types.NewMetric("STATUS", "FAILED", utils.Ptr("Status of telephony api"))), fmt.Errorf("illegal vault config connection_id is not found")
+	}
+
+	body, err := request("POST", "/calls", apiKey, &internal_telnyx.DialRequest{
+		ConnectionId:             connectionId,
+		To:                       toPhone,
+		From:                     fromPhone,
+		WebhookUrl:               fmt.Sprintf("https://%s/%s", tpc.appCfg.PublicAssistantHost, internal_type.GetEventPath("telnyx", auth, assistantId, assistantConversationId)),
+		WebhookUrlMethod:         "POST",
+		StreamUrl:                fmt.Sprintf("wss://%s/%s", tpc.appCfg.PublicAssistantHost, internal_type.GetAnswerPath("telnyx", auth, assistantId, assistantConversationId, toPhone)),
+		StreamTrack:              "inbound_track",
+		StreamBidirectionalMode:  "rtp",
+		StreamBidirectionalCodec: "PCMU",
+	})
+	if err != nil {
+		tpc.logger.Errorf("unable to dial telnyx call: %v", err)
+		return append(mtds, types.NewMetadata("telephony.error", fmt.Sprintf("API error: %s", err.Error())), types.NewMetric("STATUS", "FAILED", utils.Ptr("Status of telephony api"))), err
+	}
+	var resp internal_telnyx.DialResponse
+	if err := json.Unmarshal(body, &resp); err != nil || resp.Data.CallControlId == "" {
+		return append(mtds, types.NewEvent("Failed", "Failed to decode response"), types.NewMetric("STATUS", "FAILED", utils.Ptr("Status of telephony api"))), fmt.Errorf("illegal response of telnyx %s", string(body))
+	}
+	return append(mtds,
+		types.NewMetadata("telephony.uuid", resp.Data.CallControlId),
+		types.NewEvent("initiated", resp.Data),
+		types.NewMetric("STATUS", "SUCCESS", utils.Ptr("Status of telephony api"))), nil
+}
+
+func (tpc *telnyxTelephony) Streamer(c *gin.Context, connection *websocket.Conn, assistant *internal_assistant_entity.Assistant, conversation *internal_conversation_entity.AssistantConversation, vlt *protos.VaultCredential) internal_streamers.Streamer {
+	return NewTelnyxWebsocketStreamer(tpc.logger, connection, assistant, conversation, vlt)
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_telnyx_telephony
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_telnyx "github.com/rapidaai/api/assistant-api/internal/telephony/internal/telnyx/internal"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func newTestTelephony(t *testing.T) *telnyxTelephony {
+	t.Helper()
+	logger, _ := commons.NewApplicationLogger()
+	tel, err := NewTelnyxTelephony(&config.AssistantConfig{PublicAssistantHost: "assistant.rapida.ai"}, logger)
+	require.NoError(t, err)
+	return tel.(*telnyxTelephony)
+}
+
+func newTestContext(method, target string, body string) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(method, target, strings.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	return c, w
+}
+
+func telemetryOf(telemetry []types.Telemetry) (map[string]string, []string, map[string]string) {
+	metadata, events, metrics := map[string]string{}, []string{}, map[string]string{}
+	for _, tel := range telemetry {
+		switch v := tel.(type) {
+		case *types.Metadata:
+			metadata[v.Key] = v.Value
+		case *types.Event:
+			events = append(events, v.EventType)
+		case *types.Metric:
+			metrics[v.Name] = v.Value
+		}
+	}
+	return metadata, events, metrics
+}
+
+// callControl stands in for the call control api and answers every request with the status and body
+func callControl(t *testing.T, status int, body string) <-chan *http.Request {
+	t.Helper()
+	requests := make(chan *http.Request, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, _ := io.ReadAll(r.Body)
+		r.Body = io.NopCloser(strings.NewReader(string(payload)))
+		requests <- r
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	previous := apiUrl
+	apiUrl = server.URL
+	t.Cleanup(func() {
+		apiUrl = previous
+		server.Close()
+	})
+	return requests
+}
+
+func TestReceiveCall(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        string
+		expectedError bool
+		expectedPhone string
+		expectedUuid  string
+	}{
+		{
+			name:          "TeXML webhook with call sid",
+			target:        "/v1/talk/telnyx/call/1?From=%2B15703768754&To=%2B13345895552&CallSid=v3:T02llQxIyaRkhfRKxgAP8nY511EhFLizdvdUKJiSw8d6A9BharFMfw&Direction=inbound",
+			expectedPhone: "+15703768754",
+			expectedUuid:  "v3:T02llQxIyaRkhfRKxgAP8nY511EhFLizdvdUKJiSw8d6A9BharFMfw",
+		},
+		{
+			name:          "TeXML webhook without call sid",
+			target:        "/v1/talk/telnyx/call/1?From=%2B15703768754",
+			expectedPhone: "+15703768754",
+		},
+		{
+			name:          "missing caller",
+			target:        "/v1/talk/telnyx/call/1?To=%2B13345895552",
+			expectedError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tel := newTestTelephony(t)
+			c, w := newTestContext("GET", tt.target, "")
+			phone, telemetry, err := tel.ReceiveCall(c)
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, phone)
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPhone, *phone)
+			metadata, events, metrics := telemetryOf(telemetry)
+			assert.Equal(t, tt.expectedUuid, metadata["telephony.uuid"])
+			assert.Contains(t, events, "webhook")
+			assert.Equal(t, "SUCCESS", metrics["STATUS"])
+		})
+	}
+}
+
+func TestInboundCall(t *testing.T) {
+	tel := newTestTelephony(t)
+	c, w := newTestContext("GET", "/v1/talk/telnyx/call/1?From=%2B15703768754", "")
+	require.NoError(t, tel.InboundCall(c, &types.ProjectScope{CurrentToken: "key"}, 1, "+15703768754", 2, nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/xml", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), `<Stream url="wss://assistant.rapida.ai/v1/talk/telnyx/prj/1/+15703768754/2/key" name="1__2" bidirectionalMode="rtp" bidirectionalCodec="PCMU"/>`)
+}
+
+func TestStatusCallback(t *testing.T) {
+	event := func(eventType, cause string) string {
+		payload := map[string]interface{}{"call_control_id": "v3:call"}
+		if cause != "" {
+			payload["hangup_cause"] = cause
+		}
+		body, _ := json.Marshal(map[string]interface{}{"data": map[string]interface{}{"event_type": eventType, "id": "0ccc7b54", "payload": payload}})
+		return string(body)
+	}
+	tests := []struct {
+		name           string
+		body           string
+		expectedError  bool
+		expectedStatus string
+		expectedEvent  string
+	}{
+		{name: "initiated", body: event("call.initiated", ""), expectedStatus: "initiated", expectedEvent: "initiated"},
+		{name: "answered", body: event("call.answered", ""), expectedStatus: "in-progress", expectedEvent: "in-progress"},
+		{name: "normal clearing", body: event("call.hangup", "normal_clearing"), expectedStatus: "completed", expectedEvent: "completed"},
+		{name: "busy", body: event("call.hangup", "user_busy"), expectedStatus: "busy", expectedEvent: "busy"},
+		{name: "not answered", body: event("call.hangup", "timeout"), expectedStatus: "no-answer", expectedEvent: "no-answer"},
+		{name: "cancelled", body: event("call.hangup", "originator_cancel"), expectedStatus: "canceled", expectedEvent: "canceled"},
+		{name: "rejected", body: event("call.hangup", "call_rejected"), expectedStatus: "failed", expectedEvent: "failed"},
+		{name: "streaming started", body: event("streaming.started", ""), expectedEvent: "streaming.started"},
+		{name: "missing event type", body: `{"data":{}}`, expectedError: true},
+		{name: "illegal body", body: `status=completed`, expectedError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tel := newTestTelephony(t)
+			c, _ := newTestContext("POST", "/event", tt.body)
+			telemetry, err := tel.StatusCallback(c, &types.ProjectScope{}, 1, 1)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			_, events, metrics := telemetryOf(telemetry)
+			assert.Equal(t, tt.expectedStatus, metrics["STATUS"])
+			assert.Equal(t, []string{tt.expectedEvent}, events)
+		})
+	}
+}
+
+func TestOutboundCall(t *testing.T) {
+	requests := callControl(t, http.StatusOK, `{"data":{"call_control_id":"v3:call","call_leg_id":"leg","call_session_id":"session","is_alive":false,"record_type":"call"}}`)
+	value, err := structpb.NewStruct(map[string]interface{}{"api_key": "KEY0123", "connection_id": "1684641123236054244"})
+	require.NoError(t, err)
+
+	tel := newTestTelephony(t)
+	telemetry, err := tel.OutboundCall(&types.ProjectScope{CurrentToken: "key"}, "+15551234567", "+15557654321", 1, 2, &protos.VaultCredential{Value: value}, utils.Option{})
+	require.NoError(t, err)
+
+	metadata, _, metrics := telemetryOf(telemetry)
+	assert.Equal(t, "SUCCESS", metrics["STATUS"])
+	assert.Equal(t, "telnyx", metadata["telephony.provider"])
+	assert.Equal(t, "v3:call", metadata["telephony.uuid"])
+
+	r := <-requests
+	assert.Equal(t, "POST", r.Method)
+	assert.Equal(t, "/calls", r.URL.Path)
+	assert.Equal(t, "Bearer KEY0123", r.Header.Get("Authorization"))
+	var dial internal_telnyx.DialRequest
+	require.NoError(t, json.NewDecoder(r.Body).Decode(&dial))
+	assert.Equal(t, "1684641123236054244", dial.ConnectionId)
+	assert.Equal(t, "+15551234567", dial.To)
+	assert.Equal(t, "+15557654321", dial.From)
+	assert.Equal(t, "https://assistant.rapida.ai/v1/talk/telnyx/prj/event/1/2/key", dial.WebhookUrl)
+	assert.Equal(t, "wss://assistant.rapida.ai/v1/talk/telnyx/prj/1/+15551234567/2/key", dial.StreamUrl)
+	assert.Equal(t, "PCMU", dial.StreamBidirectionalCodec)
+}
+
+func TestOutboundCallFailed(t *testing.T) {
+	tests := []struct {
+		name       string
+		credential map[string]interface{}
+		status     int
+		body       string
+	}{
+		{name: "missing api key", credential: map[string]interface{}{"connection_id": "1684641123236054244"}, status: http.StatusOK},
+		{name: "missing connection", credential: map[string]interface{}{"api_key": "KEY0123"}, status: http.StatusOK},
+		{name: "rejected by telnyx", credential: map[string]interface{}{"api_key": "KEY0123", "connection_id": "1684641123236054244"}, status: http.StatusUnprocessableEntity, body: `{"errors":[{"code":"90015","title":"Invalid destination"}]}`},
+		{name: "illegal response", credential: map[string]interface{}{"api_key": "KEY0123", "connection_id": "1684641123236054244"}, status: http.StatusOK, body: `{"data":{}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			callControl(t, tt.status, tt.body)
+			value, err := structpb.NewStruct(tt.credential)
+			require.NoError(t, err)
+			tel := newTestTelephony(t)
+			telemetry, err := tel.OutboundCall(&types.ProjectScope{}, "+15551234567", "+15557654321", 1, 2, &protos.VaultCredential{Value: value}, utils.Option{})
+			assert.Error(t, err)
+			_, _, metrics := telemetryOf(telemetry)
+			assert.Equal(t, "FAILED", metrics["STATUS"])
+		})
+	}
+}
+
+func TestVerifyWebhook(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	credential := map[string]interface{}{"api_key": "KEY0123", "public_key": base64.StdEncoding.EncodeToString(publicKey)}
+	body := `{"data":{"event_type":"call.hangup","payload":{"hangup_cause":"normal_clearing"}}}`
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	sign := func(key ed25519.PrivateKey, timestamp, body string) string {
+		return base64.StdEncoding.EncodeToString(ed25519.Sign(key, []byte(timestamp+"|"+body)))
+	}
+
+	tests := []struct {
+		name       string
+		body       string
+		timestamp  string
+		signature  string
+		credential map[string]interface{}
+		wantErr    bool
+	}{
+		{name: "signed event", body: body, timestamp: now, signature: sign(privateKey, now, body), credential: credential},
+		{name: "signed TeXML webhook without body", timestamp: now, signature: sign(privateKey, now, ""), credential: credential},
+		{name: "forged body", body: strings.Replace(body, "normal_clearing", "user_busy", 1), timestamp: now, signature: sign(privateKey, now, body), credential: credential, wantErr: true},
+		{name: "signed with another key", body: body, timestamp: now, signature: sign(otherKey, now, body), credential: credential, wantErr: true},
+		{name: "replayed", body: body, timestamp: "1700000000", signature: sign(privateKey, "1700000000", body), credential: credential, wantErr: true},
+		{name: "missing signature", body: body, timestamp: now, credential: credential, wantErr: true},
+		{name: "missing public key", body: body, timestamp: now, signature: sign(privateKey, now, body), credential: map[string]interface{}{"api_key": "KEY0123"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newTestContext("POST", "/v1/talk/telnyx/prj/event/1/2/key", tt.body)
+			c.Request.Header.Set("telnyx-timestamp", tt.timestamp)
+			if tt.signature != "" {
+				c.Request.Header.Set("telnyx-signature-ed25519", tt.signature)
+			}
+			value, err := structpb.NewStruct(tt.credential)
+			require.NoError(t, err)
+
+			err = newTestTelephony(t).VerifyWebhook(c, &protos.VaultCredential{Value: value}, utils.Option{})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
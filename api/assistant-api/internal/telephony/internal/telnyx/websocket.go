@@ -0,0 +1,193 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_telnyx_telephony
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/gorilla/websocket"
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	internal_telephony_base "github.com/rapidaai/api/assistant-api/internal/telephony/internal/base"
+	internal_telnyx "github.com/rapidaai/api/assistant-api/internal/telephony/internal/telnyx/internal"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+)
+
+type telnyxWebsocketStreamer struct {
+	callControlId string
+	streamer      internal_telephony_base.BaseTelephonyStreamer
+	logger        commons.Logger
+}
+
+func NewTelnyxWebsocketStreamer(logger commons.Logger, connection *websocket.Conn, assistant *internal_assistant_entity.Assistant, conversation *internal_conversation_entity.AssistantConversation, vlt *protos.VaultCredential) internal_streamers.Streamer {
+	return &telnyxWebsocketStreamer{
+		logger:   logger,
+		streamer: internal_telephony_base.NewBaseTelephonyStreamer(logger, connection, assistant, conversation, vlt),
+	}
+}
+
+func (tws *telnyxWebsocketStreamer) Context() context.Context {
+	return tws.streamer.Context()
+}
+
+func (tws *telnyxWebsocketStreamer) Recv() (*protos.AssistantMessagingRequest, error) {
+	if tws.streamer.Connection() == nil {
+		return nil, io.EOF
+	}
+	_, message, err := tws.streamer.Connection().ReadMessage()
+	if err != nil {
+		tws.streamer.Cancel()
+		return nil, io.EOF
+	}
+
+	var mediaEvent internal_telnyx.TelnyxMediaEvent
+	if err := json.Unmarshal(message, &mediaEvent); err != nil {
+		tws.logger.Error("Failed to unmarshal Telnyx media event", "error", err.Error())
+		return nil, nil
+	}
+	switch mediaEvent.Event {
+	case "connected":
+		return tws.streamer.CreateConnectionRequest(internal_audio.NewMulaw8khzMonoAudioConfig(), internal_audio.NewMulaw8khzMonoAudioConfig())
+	case "start":
+		tws.handleStartEvent(mediaEvent)
+		return nil, nil
+	case "media":
+		return tws.handleMediaEvent(mediaEvent)
+	case "dtmf":
+		if mediaEvent.Dtmf == nil || mediaEvent.Dtmf.Digit == "" {
+			return nil, nil
+		}
+		return tws.streamer.CreateDtmfRequest(mediaEvent.Dtmf.Digit), nil
+	case "stop":
+		tws.logger.Info("Telnyx stream stopped")
+		tws.streamer.Cancel()
+		return nil, io.EOF
+	case "error":
+		if mediaEvent.Payload != nil {
+			tws.logger.Warn("Telnyx stream error", "code", mediaEvent.Payload.Code, "detail", mediaEvent.Payload.Detail)
+		}
+		return nil, nil
+	default:
+		tws.logger.Warn("Unhandled Telnyx event", "event", mediaEvent.Event)
+		return nil, nil
+	}
+}
+
+func (tws *telnyxWebsocketStreamer) Send(response *protos.AssistantMessagingResponse) error {
+	switch data := response.GetData().(type) {
+	case *protos.AssistantMessagingResponse_Assistant:
+		switch content := data.Assistant.Message.(type) {
+		case *protos.AssistantConversationAssistantMessage_Audio:
+			// 20ms of 8khz mu-law is 160 bytes, telnyx sends every message as rtp packet
+			bufferSizeThreshold := 8 * 20
+			tws.streamer.LockOutputAudioBuffer()
+			defer tws.streamer.UnlockOutputAudioBuffer()
+
+			tws.streamer.OutputBuffer().Write(content.Audio.GetContent())
+			for tws.streamer.OutputBuffer().Len() >= bufferSizeThreshold {
+				chunk := tws.streamer.OutputBuffer().Next(bufferSizeThreshold)
+				if err := tws.sendTelnyxMessage("media", map[string]interface{}{
+					"payload": tws.streamer.Encoder().EncodeToString(chunk),
+				}); err != nil {
+					tws.logger.Error("Failed to send audio chunk", "error", err.Error())
+					return err
+				}
+			}
+
+			// If response is marked as completed, flush any remaining audio in the buffer
+			if data.Assistant.GetCompleted() && tws.streamer.OutputBuffer().Len() > 0 {
+				if err := tws.sendTelnyxMessage("media", map[string]interface{}{
+					"payload": tws.streamer.Encoder().EncodeToString(tws.streamer.OutputBuffer().Bytes()),
+				}); err != nil {
+					tws.logger.Error("Failed to send final audio chunk", "error", err.Error())
+					return err
+				}
+				tws.streamer.OutputBuffer().Reset()
+			}
+		}
+	case *protos.AssistantMessagingResponse_Interruption:
+		if data.Interruption.Type == protos.AssistantConversationInterruption_INTERRUPTION_TYPE_WORD {
+			tws.streamer.LockOutputAudioBuffer()
+			tws.streamer.OutputBuffer().Reset()
+			tws.streamer.UnlockOutputAudioBuffer()
+
+			if err := tws.sendTelnyxMessage("clear", nil); err != nil {
+				tws.logger.Errorf("Error sending clear command: %v", err)
+			}
+		}
+	case *protos.AssistantMessagingResponse_Action:
+		if data.Action.GetAction() == protos.AssistantConversationAction_END_CONVERSATION {
+			if tws.callControlId != "" {
+				if err := hangup(tws.streamer.VaultCredential(), tws.callControlId); err != nil {
+					tws.logger.Errorf("Error ending Telnyx call: %v", err)
+				}
+			}
+			if err := tws.streamer.Cancel(); err != nil {
+				tws.logger.Errorf("Error disconnecting command: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+// start event carries the call control id the call is hung up with
+func (tws *telnyxWebsocketStreamer) handleStartEvent(mediaEvent internal_telnyx.TelnyxMediaEvent) {
+	if mediaEvent.Start != nil {
+		tws.callControlId = mediaEvent.Start.CallControlId
+	}
+}
+
+func (tws *telnyxWebsocketStreamer) handleMediaEvent(mediaEvent internal_telnyx.TelnyxMediaEvent) (*protos.AssistantMessagingRequest, error) {
+	// the stream carries the audio of the caller only, the track is checked for streams of both
+	if mediaEvent.Media == nil || (mediaEvent.Media.Track != "" && mediaEvent.Media.Track != "inbound") {
+		return nil, nil
+	}
+	payloadBytes, err := tws.streamer.Encoder().DecodeString(mediaEvent.Media.Payload)
+	if err != nil {
+		tws.logger.Warn("Failed to decode media payload", "error", err.Error())
+		return nil, nil
+	}
+
+	tws.streamer.LockInputAudioBuffer()
+	defer tws.streamer.UnlockInputAudioBuffer()
+
+	// 1ms 8 bytes @ 8kHz µ-law mono 60ms of audio as silero can't process smaller chunk for mulaw
+	tws.streamer.InputBuffer().Write(payloadBytes)
+	const bufferSizeThreshold = 8 * 60
+	if tws.streamer.InputBuffer().Len() >= bufferSizeThreshold {
+		audioRequest := tws.streamer.CreateVoiceRequest(tws.streamer.InputBuffer().Bytes())
+		tws.streamer.InputBuffer().Reset()
+		return audioRequest, nil
+	}
+	return nil, nil
+}
+
+func (tws *telnyxWebsocketStreamer) sendTelnyxMessage(eventType string, mediaData map[string]interface{}) error {
+	if tws.streamer.Connection() == nil {
+		return nil
+	}
+	message := map[string]interface{}{
+		"event": eventType,
+	}
+	if mediaData != nil {
+		message["media"] = mediaData
+	}
+	telnyxMessageJSON, err := json.Marshal(message)
+	if err != nil {
+		tws.logger.Error("Failed to marshal Telnyx message", "error", err.Error())
+		return err
+	}
+	if err := tws.streamer.Connection().WriteMessage(websocket.TextMessage, telnyxMessageJSON); err != nil {
+		tws.logger.Error("Failed to send message to Telnyx", "error", err.Error())
+		return err
+	}
+	return nil
+}
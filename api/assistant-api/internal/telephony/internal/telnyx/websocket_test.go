@@ -0,0 +1,151 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_telnyx_telephony
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// newTestStreamer connects a websocket standing in for the media stream of telnyx to the streamer
+func newTestStreamer(t *testing.T, vlt *protos.VaultCredential) (internal_streamers.Streamer, *websocket.Conn) {
+	t.Helper()
+	logger, _ := commons.NewApplicationLogger()
+	streamer := make(chan internal_streamers.Streamer, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		streamer <- NewTelnyxWebsocketStreamer(logger, conn, &internal_assistant_entity.Assistant{}, &internal_conversation_entity.AssistantConversation{}, vlt)
+	}))
+	t.Cleanup(server.Close)
+
+	media, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { media.Close() })
+	media.SetReadDeadline(time.Now().Add(5 * time.Second))
+	return <-streamer, media
+}
+
+func readMessage(t *testing.T, media *websocket.Conn) map[string]interface{} {
+	t.Helper()
+	_, message, err := media.ReadMessage()
+	require.NoError(t, err)
+	var event map[string]interface{}
+	require.NoError(t, json.Unmarshal(message, &event))
+	return event
+}
+
+func mediaEvent(track string, size int) []byte {
+	message, _ := json.Marshal(map[string]interface{}{
+		"event":     "media",
+		"stream_id": "32de0dea-53cb-4226-a41f-0e1c3c8c7b0b",
+		"media":     map[string]interface{}{"track": track, "payload": base64.StdEncoding.EncodeToString(make([]byte, size))},
+	})
+	return message
+}
+
+func TestStreamerRecv(t *testing.T) {
+	streamer, media := newTestStreamer(t, nil)
+
+	require.NoError(t, media.WriteMessage(websocket.TextMessage, []byte(`{"event":"connected","version":"1.0.0"}`)))
+	request, err := streamer.Recv()
+	require.NoError(t, err)
+	configuration := request.GetConfiguration()
+	require.NotNil(t, configuration)
+	assert.Equal(t, uint32(8000), configuration.GetInputConfig().GetAudio().GetSampleRate())
+	assert.Equal(t, protos.AudioConfig_MuLaw8, configuration.GetOutputConfig().GetAudio().GetAudioFormat())
+
+	require.NoError(t, media.WriteMessage(websocket.TextMessage, []byte(`{"event":"start","stream_id":"32de0dea-53cb-4226-a41f-0e1c3c8c7b0b","start":{"call_control_id":"v3:call","media_format":{"encoding":"PCMU","sample_rate":8000,"channels":1}}}`)))
+	request, err = streamer.Recv()
+	require.NoError(t, err)
+	assert.Nil(t, request)
+	assert.Equal(t, "v3:call", streamer.(*telnyxWebsocketStreamer).callControlId)
+
+	// audio of the assistant is not taken and the audio of the caller is buffered to 60ms
+	require.NoError(t, media.WriteMessage(websocket.TextMessage, mediaEvent("outbound", 480)))
+	request, err = streamer.Recv()
+	require.NoError(t, err)
+	assert.Nil(t, request)
+	require.NoError(t, media.WriteMessage(websocket.TextMessage, mediaEvent("inbound", 320)))
+	request, err = streamer.Recv()
+	require.NoError(t, err)
+	assert.Nil(t, request)
+	require.NoError(t, media.WriteMessage(websocket.TextMessage, mediaEvent("inbound", 320)))
+	request, err = streamer.Recv()
+	require.NoError(t, err)
+	assert.Len(t, request.GetMessage().GetAudio().GetContent(), 640)
+
+	require.NoError(t, media.WriteMessage(websocket.TextMessage, []byte(`{"event":"dtmf","dtmf":{"digit":"5"}}`)))
+	request, err = streamer.Recv()
+	require.NoError(t, err)
+	assert.NotNil(t, request)
+
+	require.NoError(t, media.WriteMessage(websocket.TextMessage, []byte(`{"event":"stop","stop":{"reason":"hangup"}}`)))
+	_, err = streamer.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestStreamerSend(t *testing.T) {
+	requests := callControl(t, http.StatusOK, `{"data":{"result":"ok"}}`)
+	value, err := structpb.NewStruct(map[string]interface{}{"api_key": "KEY0123"})
+	require.NoError(t, err)
+	streamer, media := newTestStreamer(t, &protos.VaultCredential{Value: value})
+
+	audio := func(content []byte, completed bool) *protos.AssistantMessagingResponse {
+		return &protos.AssistantMessagingResponse{Data: &protos.AssistantMessagingResponse_Assistant{
+			Assistant: &protos.AssistantConversationAssistantMessage{
+				Message:   &protos.AssistantConversationAssistantMessage_Audio{Audio: &protos.AssistantConversationMessageAudioContent{Content: content}},
+				Completed: completed,
+			},
+		}}
+	}
+	payloadOf := func(event map[string]interface{}) []byte {
+		t.Helper()
+		assert.Equal(t, "media", event["event"])
+		content, err := base64.StdEncoding.DecodeString(event["media"].(map[string]interface{})["payload"].(string))
+		require.NoError(t, err)
+		return content
+	}
+
+	// audio is sent in rtp packets of 20ms and the rest once the response completed
+	require.NoError(t, streamer.Send(audio(make([]byte, 200), false)))
+	assert.Len(t, payloadOf(readMessage(t, media)), 160)
+	require.NoError(t, streamer.Send(audio(make([]byte, 10), true)))
+	assert.Len(t, payloadOf(readMessage(t, media)), 50)
+
+	require.NoError(t, streamer.Send(&protos.AssistantMessagingResponse{Data: &protos.AssistantMessagingResponse_Interruption{
+		Interruption: &protos.AssistantConversationInterruption{Type: protos.AssistantConversationInterruption_INTERRUPTION_TYPE_WORD},
+	}}))
+	assert.Equal(t, "clear", readMessage(t, media)["event"])
+
+	// the call is hung up with the call control id of the stream
+	streamer.(*telnyxWebsocketStreamer).callControlId = "v3:call"
+	require.NoError(t, streamer.Send(&protos.AssistantMessagingResponse{Data: &protos.AssistantMessagingResponse_Action{
+		Action: &protos.AssistantConversationAction{Action: protos.AssistantConversationAction_END_CONVERSATION},
+	}}))
+	r := <-requests
+	assert.Equal(t, "/calls/v3:call/actions/hangup", r.URL.Path)
+	assert.Equal(t, "Bearer KEY0123", r.Header.Get("Authorization"))
+}
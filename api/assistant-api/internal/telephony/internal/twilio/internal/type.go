@@ -13,5 +13,12 @@ type TwilioMediaEvent struct {
 		Timestamp string `json:"timestamp"`
 		Payload   string `json:"payload"`
 	} `json:"media"`
+	Dtmf struct {
+		Track string `json:"track"`
+		Digit string `json:"digit"`
+	} `json:"dtmf"`
+	Start struct {
+		CustomParameters map[string]string `json:"customParameters"`
+	} `json:"start"`
 	StreamSid string `json:"streamSid"`
 }
@@ -6,13 +6,16 @@
 package internal_twilio_telephony
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
 	"github.com/rapidaai/api/assistant-api/config"
+	internal_amd "github.com/rapidaai/api/assistant-api/internal/amd"
 	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
 	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
 	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
@@ -23,9 +26,16 @@ import (
 	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
 	"github.com/twilio/twilio-go"
+	twilio_client "github.com/twilio/twilio-go/client"
 	openapi "github.com/twilio/twilio-go/rest/api/v2010"
 )
 
+// recordings are only fetched from the api of twilio, the credential of the account is sent along
+var (
+	recordingHost   = "api.twilio.com"
+	recordingClient = &http.Client{Timeout: 5 * time.Minute}
+)
+
 type twilioTelephony struct {
 	appCfg *config.AssistantConfig
 	logger commons.Logger
@@ -61,6 +71,65 @@ func (tpc *twilioTelephony) clientParam(vaultCredential *protos.VaultCredential)
 	}, nil
 }
 
+// VerifyWebhook checks the X-Twilio-Signature of the request, twilio signs the url it requested
+// and the posted parameters with the auth token of the account
+func (tpc *twilioTelephony) VerifyWebhook(c *gin.Context, vaultCredential *protos.VaultCredential, opts utils.Option) error {
+	authToken, ok := vaultCredential.GetValue().AsMap()["account_token"].(string)
+	if !ok || authToken == "" {
+		return fmt.Errorf("illegal vault config account_token not found")
+	}
+	signature := c.GetHeader("X-Twilio-Signature")
+	if signature == "" {
+		return fmt.Errorf("missing twilio signature")
+	}
+	params := make(map[string]string)
+	if c.Request.Method == http.MethodPost {
+		if err := c.Request.ParseForm(); err != nil {
+			return fmt.Errorf("failed to parse form: %w", err)
+		}
+		for key, values := range c.Request.PostForm {
+			if len(values) > 0 {
+				params[key] = values[0]
+			}
+		}
+	}
+	validator := twilio_client.NewRequestValidator(authToken)
+	if !validator.Validate(fmt.Sprintf("https://%s%s", tpc.appCfg.PublicAssistantHost, c.Request.URL.RequestURI()), params, signature) {
+		return fmt.Errorf("illegal twilio signature")
+	}
+	return nil
+}
+
+// Recording downloads the recording of the call as wav
+func (tpc *twilioTelephony) Recording(ctx context.Context, vaultCredential *protos.VaultCredential, recordingUrl string) (*internal_type.Recording, error) {
+	u, err := url.Parse(recordingUrl)
+	if err != nil || u.Scheme != "https" || u.Host != recordingHost {
+		return nil, fmt.Errorf("illegal twilio recording url %s", recordingUrl)
+	}
+	clientParams, err := tpc.clientParam(vaultCredential)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, recordingUrl+".wav", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(clientParams.Username, clientParams.Password)
+	resp, err := recordingClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to download twilio recording, status code %d", resp.StatusCode)
+	}
+	audio, err := internal_type.ReadRecording(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &internal_type.Recording{Mixed: audio, Channels: 1, Source: "twilio", Format: "wav"}, nil
+}
+
 func (tpc *twilioTelephony) CatchAllStatusCallback(ctx *gin.Context) ([]types.Telemetry, error) {
 	return nil, nil
 }
@@ -86,10 +155,24 @@ func (tpc *twilioTelephony) StatusCallback(c *gin.Context, auth types.SimplePrin
 		}
 	}
 
+	// the recording of the call is reported to the status callback once it is written
+	if _, ok := eventDetails["RecordingSid"]; ok {
+		status := fmt.Sprintf("%v", eventDetails["RecordingStatus"])
+		if status != "completed" {
+			return []types.Telemetry{types.NewEvent(fmt.Sprintf("recording-%s", status), eventDetails)}, nil
+		}
+		eventDetails[internal_type.ProviderRecordingUrlKey] = eventDetails["RecordingUrl"]
+		return []types.Telemetry{types.NewEvent(internal_type.ProviderRecordingEvent, eventDetails)}, nil
+	}
+
 	callStatusOrStreamEvent := eventDetails["CallStatus"]
 	if streamEvent, ok := eventDetails["StreamEvent"]; ok {
 		callStatusOrStreamEvent = streamEvent
 	}
+	// the asynchronous answering machine detection reports who answered without a call status
+	if callStatusOrStreamEvent == nil && eventDetails["AnsweredBy"] != nil {
+		return []types.Telemetry{types.NewEvent("answered_by", eventDetails)}, nil
+	}
 	return []types.Telemetry{types.NewMetric("STATUS", fmt.Sprintf("%v", callStatusOrStreamEvent), utils.Ptr("Status of conversation")), types.NewEvent(fmt.Sprintf("%v", callStatusOrStreamEvent), eventDetails)}, nil
 
 }
@@ -115,6 +198,20 @@ func (tpc *twilioTelephony) OutboundCall(auth types.SimplePrinciple, toPhone str
 		"initiated", "ringing", "answered", "completed",
 	})
 	callParams.SetStatusCallbackMethod("POST")
+	if amd, enabled, _ := internal_amd.NewConfig(opts); enabled {
+		// with a voicemail to leave twilio reports again once the greeting of the machine ended
+		detection := "Enable"
+		if amd.Action == internal_amd.ActionVoicemail {
+			detection = "DetectMessageEnd"
+		}
+		// the call connects right away, the result is posted to the status callback
+		callParams.SetMachineDetection(detection)
+		callParams.SetAsyncAmd("true")
+		callParams.SetAsyncAmdStatusCallback(
+			fmt.Sprintf("https://%s/%s", tpc.appCfg.PublicAssistantHost, internal_type.GetEventPath("twilio", auth, assistantId, assistantConversationId)),
+		)
+		callParams.SetAsyncAmdStatusCallbackMethod("POST")
+	}
 	callParams.SetTwiml(
 		tpc.CreateTwinML(
 			tpc.appCfg.PublicAssistantHost,
@@ -124,6 +221,7 @@ func (tpc *twilioTelephony) OutboundCall(auth types.SimplePrinciple, toPhone str
 				toPhone,
 			),
 			fmt.Sprintf("https://%s/%s", tpc.appCfg.PublicAssistantHost, internal_type.GetEventPath("twilio", auth, assistantId, assistantConversationId)),
+			tpc.recordingCallback(auth, assistantId, assistantConversationId, opts),
 			assistantId,
 			toPhone),
 	)
@@ -138,9 +236,24 @@ func (tpc *twilioTelephony) OutboundCall(auth types.SimplePrinciple, toPhone str
 		types.NewMetric("STATUS", "SUCCESS", utils.Ptr("Status of telephony api"))), nil
 }
 
-func (tpc *twilioTelephony) CreateTwinML(mediaServer string, name, path string, callback string, assistantId uint64, clientNumber string) string {
+// recordingCallback is the status callback the recording of the call is reported to, empty when
+// the provider does not record the calls of the deployment
+func (tpc *twilioTelephony) recordingCallback(auth types.SimplePrinciple, assistantId, assistantConversationId uint64, opts utils.Option) string {
+	if !internal_type.IsProviderRecordingEnabled(opts) {
+		return ""
+	}
+	return fmt.Sprintf("https://%s/%s", tpc.appCfg.PublicAssistantHost, internal_type.GetEventPath("twilio", auth, assistantId, assistantConversationId))
+}
+
+func (tpc *twilioTelephony) CreateTwinML(mediaServer string, name, path string, callback string, recordingCallback string, assistantId uint64, clientNumber string) string {
+	// the recording starts with the call and keeps both sides of the call till it ends
+	recording := ""
+	if recordingCallback != "" {
+		recording = fmt.Sprintf(`<Start><Recording recordingStatusCallback="%s" recordingStatusCallbackMethod="POST" recordingStatusCallbackEvent="completed" track="both" channels="mono"/></Start>`, recordingCallback)
+	}
 	return fmt.Sprintf(`
 	    <Response>
+			%s
 		 	<Connect>
 	        	<Stream url="wss://%s/%s" name="%s" statusCallback="%s" statusCallbackEvent="initiated ringing answered completed">
 					<Parameter name="assistant_id" value="%d"/>
@@ -149,6 +262,7 @@ func (tpc *twilioTelephony) CreateTwinML(mediaServer string, name, path string,
 			</Connect>
 	    </Response>
 	`,
+		recording,
 		mediaServer,
 		path,
 		name,
@@ -158,7 +272,28 @@ func (tpc *twilioTelephony) CreateTwinML(mediaServer string, name, path string,
 	)
 }
 
-func (tpc *twilioTelephony) InboundCall(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, clientNumber string, assistantConversationId uint64) error {
+// CreateConferenceTwiML moves the caller into the conference with the agent, the assistant keeps
+// hearing the caller through a stream forked off the call which twilio does not play back
+func CreateConferenceTwiML(streamUrl string, name string) string {
+	return fmt.Sprintf(`
+	    <Response>
+			<Start>
+				<Stream url="%s" track="inbound_track">
+					<Parameter name="conference_mode" value="%s"/>
+				</Stream>
+			</Start>
+			<Dial>
+				<Conference endConferenceOnExit="true">%s</Conference>
+			</Dial>
+	    </Response>
+	`,
+		streamUrl,
+		internal_type.ConferenceModeListen,
+		name,
+	)
+}
+
+func (tpc *twilioTelephony) InboundCall(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, clientNumber string, assistantConversationId uint64, opts utils.Option) error {
 	c.Data(http.StatusOK, "text/xml", []byte(
 		tpc.CreateTwinML(
 			tpc.appCfg.PublicAssistantHost,
@@ -167,13 +302,14 @@ func (tpc *twilioTelephony) InboundCall(c *gin.Context, auth types.SimplePrincip
 				assistantId,
 				clientNumber, assistantConversationId, auth.GetCurrentToken()),
 			fmt.Sprintf("https://%s/%s", tpc.appCfg.PublicAssistantHost, internal_type.GetEventPath("twilio", auth, assistantId, assistantConversationId)),
+			tpc.recordingCallback(auth, assistantId, assistantConversationId, opts),
 			assistantId, clientNumber),
 	))
 	return nil
 }
 
 func (tpc *twilioTelephony) Streamer(c *gin.Context, connection *websocket.Conn, assistant *internal_assistant_entity.Assistant, conversation *internal_conversation_entity.AssistantConversation, vlt *protos.VaultCredential) internal_streamers.Streamer {
-	return NewTwilioWebsocketStreamer(tpc.logger, connection, assistant, conversation, vlt)
+	return NewTwilioWebsocketStreamer(tpc.logger, connection, assistant, conversation, vlt, fmt.Sprintf("wss://%s%s", tpc.appCfg.PublicAssistantHost, c.Request.URL.Path))
 }
 
 func (tpc *twilioTelephony) ReceiveCall(c *gin.Context) (*string, []types.Telemetry, error) {
@@ -6,15 +6,26 @@
 package internal_twilio_telephony
 
 import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/xml"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/protos"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 //
@@ -414,3 +425,238 @@ func TestReceiveCall_TelemetryStructure(t *testing.T) {
 	assert.Equal(t, 1, eventCount, "Should have exactly 1 event entry")
 	assert.Equal(t, 1, metricCount, "Should have exactly 1 metric entry")
 }
+
+func TestStatusCallback(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name         string
+		form         url.Values
+		eventType    string
+		status       string
+		recordingUrl string
+	}{
+		{
+			name:      "call status",
+			form:      url.Values{"CallSid": {"CA1"}, "CallStatus": {"completed"}, "AnsweredBy": {"human"}},
+			eventType: "completed",
+			status:    "completed",
+		},
+		{
+			name:      "stream event",
+			form:      url.Values{"CallSid": {"CA1"}, "CallStatus": {"in-progress"}, "StreamEvent": {"stream-started"}},
+			eventType: "stream-started",
+			status:    "stream-started",
+		},
+		{
+			// asynchronous answering machine detection keeps the status of the call as it is
+			name:      "answering machine detection",
+			form:      url.Values{"CallSid": {"CA1"}, "AnsweredBy": {"machine_end_beep"}, "MachineDetectionDuration": {"4200"}},
+			eventType: "answered_by",
+		},
+		{
+			name:         "recording completed",
+			form:         url.Values{"CallSid": {"CA1"}, "RecordingSid": {"RE1"}, "RecordingStatus": {"completed"}, "RecordingUrl": {"https://api.twilio.com/2010-04-01/Accounts/AC1/Recordings/RE1"}},
+			eventType:    internal_type.ProviderRecordingEvent,
+			recordingUrl: "https://api.twilio.com/2010-04-01/Accounts/AC1/Recordings/RE1",
+		},
+		{
+			name:      "recording failed",
+			form:      url.Values{"CallSid": {"CA1"}, "RecordingSid": {"RE1"}, "RecordingStatus": {"failed"}},
+			eventType: "recording-failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.form.Encode()))
+
+			telemetry, err := (&twilioTelephony{}).StatusCallback(c, nil, 1, 2)
+			require.NoError(t, err)
+
+			evnts, mtrs, _ := types.GetDifferentTelemetry(telemetry)
+			require.Len(t, evnts, 1)
+			assert.Equal(t, tt.eventType, evnts[0].EventType)
+			assert.Equal(t, tt.form.Get("AnsweredBy"), stringOf(evnts[0].Payload["AnsweredBy"]))
+			assert.Equal(t, tt.recordingUrl, stringOf(evnts[0].Payload[internal_type.ProviderRecordingUrlKey]))
+			if tt.status == "" {
+				assert.Empty(t, mtrs)
+				return
+			}
+			require.Len(t, mtrs, 1)
+			assert.Equal(t, tt.status, mtrs[0].GetValue())
+		})
+	}
+}
+
+func TestCreateTwinML(t *testing.T) {
+	tel := &twilioTelephony{}
+	twiml := tel.CreateTwinML("assistant.rapida.ai", "1__2", "v1/talk/twilio/prj/1/+15703768754/2/key", "https://assistant.rapida.ai/v1/talk/twilio/prj/event/1/2/key", "", 1, "+15703768754")
+	assert.NotContains(t, twiml, "<Recording")
+
+	twiml = tel.CreateTwinML("assistant.rapida.ai", "1__2", "v1/talk/twilio/prj/1/+15703768754/2/key", "https://assistant.rapida.ai/v1/talk/twilio/prj/event/1/2/key", "https://assistant.rapida.ai/v1/talk/twilio/prj/event/1/2/key", 1, "+15703768754")
+	assert.Contains(t, twiml, `<Start><Recording recordingStatusCallback="https://assistant.rapida.ai/v1/talk/twilio/prj/event/1/2/key"`)
+	assert.Less(t, strings.Index(twiml, "<Start>"), strings.Index(twiml, "<Connect>"))
+}
+
+func TestCreateConferenceTwiML(t *testing.T) {
+	var response struct {
+		Stream struct {
+			Url       string `xml:"url,attr"`
+			Track     string `xml:"track,attr"`
+			Parameter struct {
+				Name  string `xml:"name,attr"`
+				Value string `xml:"value,attr"`
+			} `xml:"Parameter"`
+		} `xml:"Start>Stream"`
+		Conference string `xml:"Dial>Conference"`
+	}
+	require.NoError(t, xml.Unmarshal([]byte(CreateConferenceTwiML("wss://assistant.rapida.ai/v1/talk/twilio/prj/1/+15703768754/2/key", "rapida-2")), &response))
+	assert.Equal(t, "wss://assistant.rapida.ai/v1/talk/twilio/prj/1/+15703768754/2/key", response.Stream.Url)
+	assert.Equal(t, "inbound_track", response.Stream.Track)
+	assert.Equal(t, "conference_mode", response.Stream.Parameter.Name)
+	assert.Equal(t, string(internal_type.ConferenceModeListen), response.Stream.Parameter.Value)
+	assert.Equal(t, "rapida-2", response.Conference)
+}
+
+func TestRecording(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "AC1" || password != "token" || r.URL.Path != "/2010-04-01/Accounts/AC1/Recordings/RE1.wav" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("RIFF"))
+	}))
+	defer server.Close()
+	defer func(host string, client *http.Client) { recordingHost, recordingClient = host, client }(recordingHost, recordingClient)
+	serverUrl, _ := url.Parse(server.URL)
+	recordingHost, recordingClient = serverUrl.Host, server.Client()
+
+	value, err := structpb.NewStruct(map[string]interface{}{"account_sid": "AC1", "account_token": "token"})
+	require.NoError(t, err)
+	vlt := &protos.VaultCredential{Value: value}
+
+	recording, err := (&twilioTelephony{}).Recording(context.Background(), vlt, server.URL+"/2010-04-01/Accounts/AC1/Recordings/RE1")
+	require.NoError(t, err)
+	assert.Equal(t, &internal_type.Recording{Mixed: []byte("RIFF"), Channels: 1, Source: "twilio", Format: "wav"}, recording)
+
+	_, err = (&twilioTelephony{}).Recording(context.Background(), vlt, server.URL+"/2010-04-01/Accounts/AC1/Recordings/RE2")
+	assert.Error(t, err)
+	// the credential of the account is not sent to other hosts
+	_, err = (&twilioTelephony{}).Recording(context.Background(), vlt, "https://example.com/2010-04-01/Accounts/AC1/Recordings/RE1")
+	assert.Error(t, err)
+}
+
+func stringOf(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return ""
+}
+
+// sign signs the url and the posted parameters as twilio does
+func sign(authToken, requestUrl string, form url.Values) string {
+	keys := make([]string, 0, len(form))
+	for key := range form {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	data := requestUrl
+	for _, key := range keys {
+		data += key + form.Get(key)
+	}
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(data))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyWebhook(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	form := url.Values{"CallSid": {"CA1"}, "CallStatus": {"completed"}, "From": {"+15703768754"}}
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		form       url.Values
+		signature  string
+		credential map[string]interface{}
+		wantErr    bool
+	}{
+		{
+			name:       "signed status callback",
+			method:     http.MethodPost,
+			target:     "/v1/talk/twilio/prj/event/1/2/key",
+			form:       form,
+			signature:  sign("token", "https://assistant.rapida.ai/v1/talk/twilio/prj/event/1/2/key", form),
+			credential: map[string]interface{}{"account_sid": "AC1", "account_token": "token"},
+		},
+		{
+			name:       "signed call",
+			method:     http.MethodGet,
+			target:     "/v1/talk/twilio/call/1?x-api-key=key&From=%2B15703768754",
+			signature:  sign("token", "https://assistant.rapida.ai/v1/talk/twilio/call/1?x-api-key=key&From=%2B15703768754", nil),
+			credential: map[string]interface{}{"account_sid": "AC1", "account_token": "token"},
+		},
+		{
+			name:       "forged parameters",
+			method:     http.MethodPost,
+			target:     "/v1/talk/twilio/prj/event/1/2/key",
+			form:       url.Values{"CallSid": {"CA1"}, "CallStatus": {"failed"}, "From": {"+15703768754"}},
+			signature:  sign("token", "https://assistant.rapida.ai/v1/talk/twilio/prj/event/1/2/key", form),
+			credential: map[string]interface{}{"account_sid": "AC1", "account_token": "token"},
+			wantErr:    true,
+		},
+		{
+			name:       "signed with another token",
+			method:     http.MethodPost,
+			target:     "/v1/talk/twilio/prj/event/1/2/key",
+			form:       form,
+			signature:  sign("other", "https://assistant.rapida.ai/v1/talk/twilio/prj/event/1/2/key", form),
+			credential: map[string]interface{}{"account_sid": "AC1", "account_token": "token"},
+			wantErr:    true,
+		},
+		{
+			name:       "missing signature",
+			method:     http.MethodPost,
+			target:     "/v1/talk/twilio/prj/event/1/2/key",
+			form:       form,
+			credential: map[string]interface{}{"account_sid": "AC1", "account_token": "token"},
+			wantErr:    true,
+		},
+		{
+			name:       "missing auth token",
+			method:     http.MethodPost,
+			target:     "/v1/talk/twilio/prj/event/1/2/key",
+			form:       form,
+			signature:  sign("token", "https://assistant.rapida.ai/v1/talk/twilio/prj/event/1/2/key", form),
+			credential: map[string]interface{}{"account_sid": "AC1"},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.form.Encode()))
+			if tt.form != nil {
+				c.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			}
+			if tt.signature != "" {
+				c.Request.Header.Set("X-Twilio-Signature", tt.signature)
+			}
+			value, err := structpb.NewStruct(tt.credential)
+			require.NoError(t, err)
+
+			tel := &twilioTelephony{appCfg: &config.AssistantConfig{PublicAssistantHost: "assistant.rapida.ai"}}
+			err = tel.VerifyWebhook(c, &protos.VaultCredential{Value: value}, nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
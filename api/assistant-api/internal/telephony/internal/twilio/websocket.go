@@ -18,23 +18,31 @@ import (
 	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
 	internal_telephony_base "github.com/rapidaai/api/assistant-api/internal/telephony/internal/base"
 	internal_twilio "github.com/rapidaai/api/assistant-api/internal/telephony/internal/twilio/internal"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
 	"github.com/twilio/twilio-go"
 	openapi "github.com/twilio/twilio-go/rest/api/v2010"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 type twilioWebsocketStreamer struct {
-	streamID string
-	streamer internal_telephony_base.BaseTelephonyStreamer
-	logger   commons.Logger
+	streamID  string
+	streamUrl string
+	streamer  internal_telephony_base.BaseTelephonyStreamer
+	logger    commons.Logger
+
+	// the stream forked off a call in conference only listens, twilio does not play back its audio
+	conferenceMode internal_type.ConferenceMode
 }
 
-func NewTwilioWebsocketStreamer(logger commons.Logger, connection *websocket.Conn, assistant *internal_assistant_entity.Assistant, conversation *internal_conversation_entity.AssistantConversation, vlt *protos.VaultCredential) internal_streamers.Streamer {
+func NewTwilioWebsocketStreamer(logger commons.Logger, connection *websocket.Conn, assistant *internal_assistant_entity.Assistant, conversation *internal_conversation_entity.AssistantConversation, vlt *protos.VaultCredential, streamUrl string) internal_streamers.Streamer {
 	return &twilioWebsocketStreamer{
-		logger:   logger,
-		streamID: "",
-		streamer: internal_telephony_base.NewBaseTelephonyStreamer(logger, connection, assistant, conversation, vlt),
+		logger:    logger,
+		streamID:  "",
+		streamUrl: streamUrl,
+		streamer:  internal_telephony_base.NewBaseTelephonyStreamer(logger, connection, assistant, conversation, vlt),
 	}
 }
 
@@ -64,6 +72,11 @@ func (tws *twilioWebsocketStreamer) Recv() (*protos.AssistantMessagingRequest, e
 		return nil, nil
 	case "media":
 		return tws.handleMediaEvent(mediaEvent)
+	case "dtmf":
+		if mediaEvent.Dtmf.Digit == "" {
+			return nil, nil
+		}
+		return tws.streamer.CreateDtmfRequest(mediaEvent.Dtmf.Digit), nil
 	case "stop":
 		tws.logger.Info("Twilio stream stopped")
 		tws.streamer.Cancel()
@@ -75,6 +88,9 @@ func (tws *twilioWebsocketStreamer) Recv() (*protos.AssistantMessagingRequest, e
 }
 
 func (tws *twilioWebsocketStreamer) Send(response *protos.AssistantMessagingResponse) error {
+	if tws.conferenceMode == internal_type.ConferenceModeListen {
+		return tws.sendListening(response)
+	}
 	switch data := response.GetData().(type) {
 	case *protos.AssistantMessagingResponse_Assistant:
 		switch content := data.Assistant.Message.(type) {
@@ -152,13 +168,79 @@ func (tws *twilioWebsocketStreamer) Send(response *protos.AssistantMessagingResp
 				tws.logger.Errorf("Error disconnecting command:", err)
 			}
 		}
+		if data.Action.GetAction() == protos.AssistantConversationAction_CONFERENCE_CONVERSATION {
+			args := data.Action.GetArgs()
+			// the agent takes a while to pick up, audio keeps flowing meanwhile
+			utils.Go(tws.Context(), func() {
+				if err := tws.conference(args); err != nil {
+					tws.logger.Errorf("unable to conference twilio call %s: %v", tws.streamer.GetUuid(), err)
+				}
+			})
+		}
+	}
+	return nil
+}
+
+// sendListening drops the audio of the assistant while it listens to the conference, ending the
+// conversation only closes the stream as the caller stays with the agent
+func (tws *twilioWebsocketStreamer) sendListening(response *protos.AssistantMessagingResponse) error {
+	if data, ok := response.GetData().(*protos.AssistantMessagingResponse_Action); ok && data.Action.GetAction() == protos.AssistantConversationAction_END_CONVERSATION {
+		if err := tws.streamer.Cancel(); err != nil {
+			tws.logger.Errorf("Error disconnecting command:", err)
+		}
+	}
+	return nil
+}
+
+// conference calls the agent into a conference and moves the caller to it, twilio can not dial the
+// websocket of the assistant into a conference so the assistant only listens to the caller
+func (tws *twilioWebsocketStreamer) conference(args map[string]*anypb.Any) error {
+	to, mode, err := internal_type.ConferenceArgs(args)
+	if err != nil {
+		return err
+	}
+	if !internal_type.IsConferenceSupported("twilio", mode) {
+		return fmt.Errorf("%w: %s", internal_type.ErrConferenceNotSupported, mode)
+	}
+	if to == "" {
+		return fmt.Errorf("agent is required to conference the call")
+	}
+	if tws.streamer.GetUuid() == "" {
+		return fmt.Errorf("call is not known to twilio yet")
+	}
+	from, err := tws.streamer.GetDeploymentOptions().GetString("phone")
+	if err != nil {
+		return fmt.Errorf("phone of the deployment is required to call the agent")
 	}
+	client, err := tws.client(tws.streamer.VaultCredential())
+	if err != nil {
+		return err
+	}
+
+	// the agent waits in the conference till the caller is moved in
+	name := internal_type.ConferenceName(tws.streamer.GetConversationId())
+	participant := &openapi.CreateParticipantParams{}
+	participant.SetFrom(from)
+	participant.SetTo(to)
+	participant.SetLabel("agent")
+	if _, err := client.Api.CreateParticipant(name, participant); err != nil {
+		return err
+	}
+	params := &openapi.UpdateCallParams{}
+	params.SetTwiml(CreateConferenceTwiML(tws.streamUrl, name))
+	if _, err := client.Api.UpdateCall(tws.streamer.GetUuid(), params); err != nil {
+		return err
+	}
+	tws.logger.Infof("twilio call %s is in conference %s with %s", tws.streamer.GetUuid(), name, to)
 	return nil
 }
 
 // start event contains streamSid to be used for subsequent media messages
 func (tws *twilioWebsocketStreamer) handleStartEvent(mediaEvent internal_twilio.TwilioMediaEvent) {
 	tws.streamID = mediaEvent.StreamSid
+	if mode, ok := mediaEvent.Start.CustomParameters["conference_mode"]; ok {
+		tws.conferenceMode = internal_type.ConferenceMode(mode)
+	}
 }
 
 func (tws *twilioWebsocketStreamer) handleMediaEvent(mediaEvent internal_twilio.TwilioMediaEvent) (*protos.AssistantMessagingRequest, error) {
@@ -0,0 +1,98 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_vonage_telephony
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/protos"
+	vonage_jwt "github.com/vonage/vonage-go-sdk/jwt"
+	"github.com/vonage/vonage-go-sdk/ncco"
+)
+
+// the websocket of the assistant stays a leg of the conversation of the call, the agent joins the
+// conversation by its name which is only known to the conversation api
+var (
+	conversationHost   = "https://api.nexmo.com"
+	conversationClient = &http.Client{Timeout: 10 * time.Second}
+)
+
+// applicationToken signs a token of the application of the vault credential
+func applicationToken(vaultCredential *protos.VaultCredential) (string, error) {
+	privateKey, ok := vaultCredential.GetValue().AsMap()["private_key"].(string)
+	if !ok || privateKey == "" {
+		return "", fmt.Errorf("illegal vault config private_key is not found")
+	}
+	applicationId, ok := vaultCredential.GetValue().AsMap()["application_id"].(string)
+	if !ok || applicationId == "" {
+		return "", fmt.Errorf("illegal vault config application_id is not found")
+	}
+	token, err := vonage_jwt.NewGenerator(applicationId, []byte(privateKey)).GenerateToken()
+	if err != nil {
+		return "", fmt.Errorf("unable to sign vonage token: %w", err)
+	}
+	return token, nil
+}
+
+// conversationName looks up the name of the conversation the call is in
+func conversationName(ctx context.Context, token string, conversationUuid string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v0.3/conversations/%s", conversationHost, url.PathEscape(conversationUuid)), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := conversationClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unable to get vonage conversation, status code %d", resp.StatusCode)
+	}
+	var conversation struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&conversation); err != nil {
+		return "", err
+	}
+	if conversation.Name == "" {
+		return "", fmt.Errorf("vonage conversation %s has no name", conversationUuid)
+	}
+	return conversation.Name, nil
+}
+
+// callerHears keeps the assistant away from the caller unless the assistant speaks to everyone,
+// nil lets the leg hear everyone in the conversation
+func callerHears(mode internal_type.ConferenceMode, agentUuid string) []string {
+	if mode == internal_type.ConferenceModeSpeak {
+		return nil
+	}
+	return []string{agentUuid}
+}
+
+// agentHears keeps the assistant away from the agent while the assistant only listens
+func agentHears(mode internal_type.ConferenceMode, callerUuid string) []string {
+	if mode == internal_type.ConferenceModeListen {
+		return []string{callerUuid}
+	}
+	return nil
+}
+
+// conferenceNcco keeps a leg in the conversation of the call with the legs it can hear
+func conferenceNcco(name string, canHear []string) ncco.Ncco {
+	conference := ncco.Ncco{}
+	conference.AddAction(ncco.ConversationAction{
+		Name:    name,
+		CanHear: canHear,
+	})
+	return conference
+}
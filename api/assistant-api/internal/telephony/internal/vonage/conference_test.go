@@ -0,0 +1,96 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_vonage_telephony
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConferenceHears(t *testing.T) {
+	tests := []struct {
+		mode   internal_type.ConferenceMode
+		caller []string
+		agent  []string
+	}{
+		{mode: internal_type.ConferenceModeListen, caller: []string{"agent-uuid"}, agent: []string{"caller-uuid"}},
+		{mode: internal_type.ConferenceModeWhisper, caller: []string{"agent-uuid"}, agent: nil},
+		{mode: internal_type.ConferenceModeSpeak, caller: nil, agent: nil},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			assert.Equal(t, tt.caller, callerHears(tt.mode, "agent-uuid"))
+			assert.Equal(t, tt.agent, agentHears(tt.mode, "caller-uuid"))
+		})
+	}
+}
+
+func TestConferenceNcco(t *testing.T) {
+	tests := []struct {
+		name    string
+		canHear []string
+		want    string
+	}{
+		{name: "hears everyone", want: `[{"action":"conversation","name":"NAM-1","startOnEnter":true}]`},
+		{name: "hears the agent", canHear: []string{"agent-uuid"}, want: `[{"action":"conversation","name":"NAM-1","canHear":["agent-uuid"],"startOnEnter":true}]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := json.Marshal(conferenceNcco("NAM-1", tt.canHear))
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(body))
+		})
+	}
+}
+
+func TestConversationName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/v0.3/conversations/CON-1":
+			w.Write([]byte(`{"uuid":"CON-1","name":"NAM-1"}`))
+		case "/v0.3/conversations/CON-2":
+			w.Write([]byte(`{"uuid":"CON-2"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	defer func(host string, client *http.Client) { conversationHost, conversationClient = host, client }(conversationHost, conversationClient)
+	conversationHost, conversationClient = server.URL, server.Client()
+
+	tests := []struct {
+		name             string
+		conversationUuid string
+		want             string
+		wantErr          bool
+	}{
+		{name: "named conversation", conversationUuid: "CON-1", want: "NAM-1"},
+		{name: "conversation without name", conversationUuid: "CON-2", wantErr: true},
+		{name: "unknown conversation", conversationUuid: "CON-3", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, err := conversationName(context.Background(), "token", tt.conversationUuid)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, name)
+		})
+	}
+}
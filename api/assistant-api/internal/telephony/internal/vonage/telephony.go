@@ -6,13 +6,22 @@
 package internal_vonage_telephony
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/gorilla/websocket"
 	"github.com/rapidaai/api/assistant-api/config"
+	internal_amd "github.com/rapidaai/api/assistant-api/internal/amd"
 	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
 	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
 	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
@@ -25,6 +34,15 @@ import (
 	"github.com/vonage/vonage-go-sdk/ncco"
 )
 
+// recordings are only fetched from the api of vonage, a token of the application is sent along
+var (
+	recordingDomains = []string{"nexmo.com", "vonage.com"}
+	recordingClient  = &http.Client{Timeout: 5 * time.Minute}
+)
+
+// webhooks signed earlier are taken as replayed
+const signatureTolerance = 5 * time.Minute
+
 type vonageTelephony struct {
 	appCfg *config.AssistantConfig
 	logger commons.Logger
@@ -37,6 +55,99 @@ func NewVonageTelephony(config *config.AssistantConfig, logger commons.Logger) (
 	}, nil
 }
 
+// VerifyWebhook checks the signed webhook of vonage, the bearer token is signed with the signature
+// secret of the account and carries the sha256 of the body it was sent with. Credentials created
+// before the signature secret was asked for are rejected unless unverified webhooks are accepted.
+func (tpc *vonageTelephony) VerifyWebhook(c *gin.Context, vaultCredential *protos.VaultCredential, opts utils.Option) error {
+	signatureSecret, ok := vaultCredential.GetValue().AsMap()["signature_secret"].(string)
+	if !ok || signatureSecret == "" {
+		if tpc.appCfg != nil && tpc.appCfg.UnverifiedWebhooks {
+			tpc.logger.Warnf("vonage webhook is not verified, signature_secret is missing in the vault credential")
+			return nil
+		}
+		return fmt.Errorf("illegal vault config signature_secret not found")
+	}
+	tokenString, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if !ok || tokenString == "" {
+		return fmt.Errorf("missing vonage signature")
+	}
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(signatureSecret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()})); err != nil {
+		return fmt.Errorf("illegal vonage signature: %w", err)
+	}
+	signedAt, err := claims.GetIssuedAt()
+	if err != nil || signedAt == nil {
+		return fmt.Errorf("missing vonage signature time")
+	}
+	if time.Since(signedAt.Time).Abs() > signatureTolerance {
+		return fmt.Errorf("vonage signature has expired")
+	}
+	if applicationId, ok := vaultCredential.GetValue().AsMap()["application_id"].(string); ok {
+		if claimed, ok := claims["application_id"].(string); ok && claimed != applicationId {
+			return fmt.Errorf("illegal vonage signature of application %s", claimed)
+		}
+	}
+
+	// requests without body are signed without payload hash, a token without it does not sign a body
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read request body: %w", err)
+	}
+	payloadHash, ok := claims["payload_hash"].(string)
+	if !ok {
+		if len(body) > 0 {
+			return fmt.Errorf("missing vonage payload hash")
+		}
+		return nil
+	}
+	hash := sha256.Sum256(body)
+	if !strings.EqualFold(hex.EncodeToString(hash[:]), payloadHash) {
+		return fmt.Errorf("illegal vonage payload hash")
+	}
+	return nil
+}
+
+// Recording downloads the recording of the call, the file is authorized with a token of the application
+func (tpc *vonageTelephony) Recording(ctx context.Context, vaultCredential *protos.VaultCredential, recordingUrl string) (*internal_type.Recording, error) {
+	u, err := url.Parse(recordingUrl)
+	if err != nil || u.Scheme != "https" || !isRecordingHost(u.Hostname()) {
+		return nil, fmt.Errorf("illegal vonage recording url %s", recordingUrl)
+	}
+	token, err := applicationToken(vaultCredential)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, recordingUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := recordingClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to download vonage recording, status code %d", resp.StatusCode)
+	}
+	audio, err := internal_type.ReadRecording(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &internal_type.Recording{Mixed: audio, Channels: 1, Source: "vonage", Format: "wav"}, nil
+}
+
+func isRecordingHost(host string) bool {
+	for _, domain := range recordingDomains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
 func (tpc *vonageTelephony) CatchAllStatusCallback(ctx *gin.Context) ([]types.Telemetry, error) {
 	return nil, nil
 }
@@ -54,6 +165,11 @@ func (tpc *vonageTelephony) StatusCallback(c *gin.Context, auth types.SimplePrin
 		return nil, fmt.Errorf("failed to parse request body")
 	}
 
+	// the recording of the call is reported without status once it is written
+	if _, ok := payload[internal_type.ProviderRecordingUrlKey].(string); ok {
+		return []types.Telemetry{types.NewEvent(internal_type.ProviderRecordingEvent, payload)}, nil
+	}
+
 	// Extract status from payload
 	status, ok := payload["status"].(string)
 	if !ok {
@@ -85,6 +201,14 @@ func (vt *vonageTelephony) OutboundCall(
 	ct := vonage.NewVoiceClient(cAuth)
 
 	connectAction := ncco.Ncco{}
+	if internal_type.IsProviderRecordingEnabled(opts) {
+		// the recording runs next to the connect action till the call ends
+		connectAction.AddAction(ncco.RecordAction{
+			Format:      "wav",
+			EventUrl:    []string{fmt.Sprintf("https://%s/%s", vt.appCfg.PublicAssistantHost, internal_type.GetEventPath("vonage", auth, assistantId, assistantConversationId))},
+			EventMethod: "POST",
+		})
+	}
 	nccoConnect := ncco.ConnectAction{
 		EventType: "synchronous",
 		EventUrl:  []string{fmt.Sprintf("https://%s/%s", vt.appCfg.PublicAssistantHost, internal_type.GetEventPath("vonage", auth, assistantId, assistantConversationId))},
@@ -96,12 +220,18 @@ func (vt *vonageTelephony) OutboundCall(
 		}},
 	}
 	connectAction.AddAction(nccoConnect)
-	result, vErr, apiError := ct.CreateCall(
-		vonage.CreateCallOpts{
-			From: vonage.CallFrom{Type: "phone", Number: fromPhone},
-			To:   vonage.CallTo{Type: "phone", Number: toPhone},
-			Ncco: connectAction,
-		})
+	callOpts := vonage.CreateCallOpts{
+		From: vonage.CallFrom{Type: "phone", Number: fromPhone},
+		To:   vonage.CallTo{Type: "phone", Number: toPhone},
+		Ncco: connectAction,
+	}
+	if _, enabled, _ := internal_amd.NewConfig(opts); enabled {
+		// the call continues and the event url receives a machine or human status
+		callOpts.MachineDetection = "continue"
+		callOpts.EventUrl = nccoConnect.EventUrl
+		callOpts.EventMethod = "POST"
+	}
+	result, vErr, apiError := ct.CreateCall(callOpts)
 
 	if apiError != nil {
 		return append(mtds, types.NewMetadata("telephony.error", fmt.Sprintf("API error: %s", apiError.Error())), types.NewMetric("STATUS", "FAILED", utils.Ptr("Status of telephony api"))), apiError
@@ -118,9 +248,18 @@ func (vt *vonageTelephony) OutboundCall(
 		types.NewMetric("STATUS", "SUCCESS", utils.Ptr("Status of telephony api"))), nil
 }
 
-func (vt *vonageTelephony) InboundCall(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, clientNumber string, assistantConversationId uint64) error {
-	c.JSON(http.StatusOK, []gin.H{
-		{
+func (vt *vonageTelephony) InboundCall(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, clientNumber string, assistantConversationId uint64, opts utils.Option) error {
+	actions := []gin.H{}
+	if internal_type.IsProviderRecordingEnabled(opts) {
+		actions = append(actions, gin.H{
+			"action":      "record",
+			"format":      "wav",
+			"eventUrl":    []string{fmt.Sprintf("https://%s/%s", vt.appCfg.PublicAssistantHost, internal_type.GetEventPath("vonage", auth, assistantId, assistantConversationId))},
+			"eventMethod": "POST",
+		})
+	}
+	c.JSON(http.StatusOK, append(actions,
+		gin.H{
 			"action":    "connect",
 			"eventType": "synchronous",
 			"eventUrl":  []string{fmt.Sprintf("https://%s/%s", vt.appCfg.PublicAssistantHost, internal_type.GetEventPath("vonage", auth, assistantId, assistantConversationId))},
@@ -134,7 +273,7 @@ func (vt *vonageTelephony) InboundCall(c *gin.Context, auth types.SimplePrincipl
 				},
 			},
 		},
-	})
+	))
 	return nil
 }
 
@@ -6,15 +6,32 @@
 package internal_vonage_telephony
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 //  GET /v1/talk/vonage/call/2263072539095859200?x-api-key=3dd5c2eef53d27942bccd892750fda23ea0b92965d4699e73d8e754ab882955f&to=12019868532&endpoint_type=phone&from=15703768754&conversation_uuid=CON-3d4ae1dd-5e14-4131-be3d-0247cb19a28a&uuid=bccbc3faaf864e1641fe0cdb1921b6aa&region_url=https%3A%2F%2Fapi-ap-3.vonage.com [status:200 request:61ms]
@@ -246,3 +263,234 @@ func TestReceiveCall_QueryParameterExtraction(t *testing.T) {
 		assert.Equal(t, expectedValue, actualValue, "Value for '%s' should match", key)
 	}
 }
+
+// sign signs the webhook as vonage does with the signature secret of the account
+func sign(t *testing.T, secret string, claims jwt.MapClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	require.NoError(t, err)
+	return token
+}
+
+func TestVerifyWebhook(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	body := `{"status":"completed","uuid":"63f61863-4a51-4f6b-86e1-46edebcf9356"}`
+	hash := sha256.Sum256([]byte(body))
+	payloadHash := hex.EncodeToString(hash[:])
+
+	tests := []struct {
+		name          string
+		body          string
+		authorization string
+		credential    map[string]interface{}
+		unverified    bool
+		wantErr       bool
+	}{
+		{
+			name:          "signed event",
+			body:          body,
+			authorization: "Bearer " + sign(t, "secret", jwt.MapClaims{"iat": time.Now().Unix(), "application_id": "app", "payload_hash": payloadHash}),
+			credential:    map[string]interface{}{"application_id": "app", "signature_secret": "secret"},
+		},
+		{
+			name:          "signed answer without body",
+			authorization: "Bearer " + sign(t, "secret", jwt.MapClaims{"iat": time.Now().Unix(), "application_id": "app"}),
+			credential:    map[string]interface{}{"application_id": "app", "signature_secret": "secret"},
+		},
+		{
+			name:          "forged body",
+			body:          `{"status":"failed","uuid":"63f61863-4a51-4f6b-86e1-46edebcf9356"}`,
+			authorization: "Bearer " + sign(t, "secret", jwt.MapClaims{"iat": time.Now().Unix(), "application_id": "app", "payload_hash": payloadHash}),
+			credential:    map[string]interface{}{"application_id": "app", "signature_secret": "secret"},
+			wantErr:       true,
+		},
+		{
+			name:          "signed with another secret",
+			body:          body,
+			authorization: "Bearer " + sign(t, "other", jwt.MapClaims{"iat": time.Now().Unix(), "application_id": "app", "payload_hash": payloadHash}),
+			credential:    map[string]interface{}{"application_id": "app", "signature_secret": "secret"},
+			wantErr:       true,
+		},
+		{
+			name:          "signed for another application",
+			body:          body,
+			authorization: "Bearer " + sign(t, "secret", jwt.MapClaims{"iat": time.Now().Unix(), "application_id": "other", "payload_hash": payloadHash}),
+			credential:    map[string]interface{}{"application_id": "app", "signature_secret": "secret"},
+			wantErr:       true,
+		},
+		{
+			name:       "missing signature",
+			body:       body,
+			credential: map[string]interface{}{"application_id": "app", "signature_secret": "secret"},
+			wantErr:    true,
+		},
+		{
+			name:          "replayed signature",
+			body:          body,
+			authorization: "Bearer " + sign(t, "secret", jwt.MapClaims{"iat": time.Now().Add(-time.Hour).Unix(), "application_id": "app", "payload_hash": payloadHash}),
+			credential:    map[string]interface{}{"application_id": "app", "signature_secret": "secret"},
+			wantErr:       true,
+		},
+		{
+			name:          "signature without time",
+			body:          body,
+			authorization: "Bearer " + sign(t, "secret", jwt.MapClaims{"application_id": "app", "payload_hash": payloadHash}),
+			credential:    map[string]interface{}{"application_id": "app", "signature_secret": "secret"},
+			wantErr:       true,
+		},
+		{
+			name:          "body signed without payload hash",
+			body:          body,
+			authorization: "Bearer " + sign(t, "secret", jwt.MapClaims{"iat": time.Now().Unix(), "application_id": "app"}),
+			credential:    map[string]interface{}{"application_id": "app", "signature_secret": "secret"},
+			wantErr:       true,
+		},
+		{
+			name:       "credential without signature secret",
+			body:       body,
+			credential: map[string]interface{}{"application_id": "app"},
+			wantErr:    true,
+		},
+		{
+			name:       "credential without signature secret while unverified webhooks are accepted",
+			body:       body,
+			credential: map[string]interface{}{"application_id": "app"},
+			unverified: true,
+		},
+	}
+	logger, _ := commons.NewApplicationLogger()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/talk/vonage/prj/event/1/2/key", strings.NewReader(tt.body))
+			if tt.authorization != "" {
+				c.Request.Header.Set("Authorization", tt.authorization)
+			}
+			value, err := structpb.NewStruct(tt.credential)
+			require.NoError(t, err)
+
+			tel := &vonageTelephony{logger: logger, appCfg: &config.AssistantConfig{UnverifiedWebhooks: tt.unverified}}
+			err = tel.VerifyWebhook(c, &protos.VaultCredential{Value: value}, utils.Option{})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestStatusCallback(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger, _ := commons.NewApplicationLogger()
+	tests := []struct {
+		name      string
+		body      string
+		eventType string
+		status    string
+		wantErr   bool
+	}{
+		{
+			name:      "call status",
+			body:      `{"status":"answered","uuid":"63f61863-4a51-4f6b-86e1-46edebcf9356"}`,
+			eventType: "answered",
+			status:    "answered",
+		},
+		{
+			name:      "recording",
+			body:      `{"recording_url":"https://api.nexmo.com/v1/files/aaaaaaaa-bbbb-cccc-dddd-0123456789ab","recording_uuid":"ccccc","conversation_uuid":"CON-1","size":12222}`,
+			eventType: internal_type.ProviderRecordingEvent,
+		},
+		{
+			name:    "event without status",
+			body:    `{"uuid":"63f61863-4a51-4f6b-86e1-46edebcf9356"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/talk/vonage/prj/event/1/2/key", strings.NewReader(tt.body))
+
+			telemetry, err := (&vonageTelephony{logger: logger}).StatusCallback(c, nil, 1, 2)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			evnts, mtrs, _ := types.GetDifferentTelemetry(telemetry)
+			require.Len(t, evnts, 1)
+			assert.Equal(t, tt.eventType, evnts[0].EventType)
+			if tt.status == "" {
+				assert.Empty(t, mtrs)
+				return
+			}
+			require.Len(t, mtrs, 1)
+			assert.Equal(t, tt.status, mtrs[0].GetValue())
+		})
+	}
+}
+
+func TestInboundCall(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tel := &vonageTelephony{appCfg: &config.AssistantConfig{PublicAssistantHost: "assistant.rapida.ai"}}
+	tests := []struct {
+		name    string
+		opts    utils.Option
+		actions []string
+	}{
+		{name: "without recording", actions: []string{"connect"}},
+		{name: "recorded by vonage", opts: utils.Option{internal_type.ProviderRecordingOptionsKey: "true"}, actions: []string{"record", "connect"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			require.NoError(t, tel.InboundCall(c, &types.ProjectScope{CurrentToken: "key"}, 1, "15703768754", 2, tt.opts))
+
+			var ncco []map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &ncco))
+			actions := make([]string, 0, len(ncco))
+			for _, action := range ncco {
+				actions = append(actions, action["action"].(string))
+			}
+			assert.Equal(t, tt.actions, actions)
+		})
+	}
+}
+
+func TestRecording(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	privateKey := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		claims := jwt.MapClaims{}
+		if _, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+			return &key.PublicKey, nil
+		}); !ok || err != nil || claims["application_id"] != "app" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte("RIFF"))
+	}))
+	defer server.Close()
+	defer func(domains []string, client *http.Client) { recordingDomains, recordingClient = domains, client }(recordingDomains, recordingClient)
+	serverUrl, _ := url.Parse(server.URL)
+	recordingDomains, recordingClient = []string{serverUrl.Hostname()}, server.Client()
+
+	value, err := structpb.NewStruct(map[string]interface{}{"application_id": "app", "private_key": privateKey})
+	require.NoError(t, err)
+	vlt := &protos.VaultCredential{Value: value}
+
+	recording, err := (&vonageTelephony{}).Recording(context.Background(), vlt, server.URL+"/v1/files/aaaaaaaa-bbbb-cccc-dddd-0123456789ab")
+	require.NoError(t, err)
+	assert.Equal(t, &internal_type.Recording{Mixed: []byte("RIFF"), Channels: 1, Source: "vonage", Format: "wav"}, recording)
+
+	// the token of the application is not sent to other hosts
+	_, err = (&vonageTelephony{}).Recording(context.Background(), vlt, "https://example.com/v1/files/aaaaaaaa-bbbb-cccc-dddd-0123456789ab")
+	assert.Error(t, err)
+}
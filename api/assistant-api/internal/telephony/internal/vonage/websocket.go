@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/gorilla/websocket"
 	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
@@ -17,14 +18,21 @@ import (
 	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
 	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
 	internal_telephony_base "github.com/rapidaai/api/assistant-api/internal/telephony/internal/base"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
 	protos "github.com/rapidaai/protos"
 	"github.com/vonage/vonage-go-sdk"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 type vonageWebsocketStreamer struct {
 	streamer internal_telephony_base.BaseTelephonyStreamer
 	logger   commons.Logger
+
+	// leg of the agent once the call is in conference
+	conferenceLock  sync.Mutex
+	conferenceAgent string
 }
 
 func NewVonageWebsocketStreamer(logger commons.Logger, connection *websocket.Conn, assistant *internal_assistant_entity.Assistant, conversation *internal_conversation_entity.AssistantConversation, vlt *protos.VaultCredential) internal_streamers.Streamer {
@@ -147,6 +155,14 @@ func (vng *vonageWebsocketStreamer) Send(response *protos.AssistantMessagingResp
 			if err := vng.streamer.Cancel(); err != nil {
 				vng.logger.Errorf("Error disconnecting command:", err)
 			}
+		} else if data.Action.GetAction() == protos.AssistantConversationAction_CONFERENCE_CONVERSATION {
+			args := data.Action.GetArgs()
+			// the agent takes a while to pick up, audio keeps flowing meanwhile
+			utils.Go(vng.Context(), func() {
+				if err := vng.conference(args); err != nil {
+					vng.logger.Errorf("unable to conference vonage call %s: %v", vng.streamer.GetUuid(), err)
+				}
+			})
 		} else {
 			if err := vng.streamer.Cancel(); err != nil {
 				vng.logger.Errorf("Error disconnecting command:", err)
@@ -156,6 +172,67 @@ func (vng *vonageWebsocketStreamer) Send(response *protos.AssistantMessagingResp
 	return nil
 }
 
+// conference brings the agent into the conversation of the call or changes the mode of the running
+// conference. the websocket of the assistant never moves, the legs around it decide who hears it
+func (vng *vonageWebsocketStreamer) conference(args map[string]*anypb.Any) error {
+	to, mode, err := internal_type.ConferenceArgs(args)
+	if err != nil {
+		return err
+	}
+	callerUuid := vng.streamer.GetUuid()
+	conversationUuid := vng.streamer.GetMetadata("telephony.conversation_uuid")
+	if callerUuid == "" || conversationUuid == "" {
+		return fmt.Errorf("call is not known to vonage yet")
+	}
+
+	vng.conferenceLock.Lock()
+	defer vng.conferenceLock.Unlock()
+	if to == "" && vng.conferenceAgent == "" {
+		return fmt.Errorf("no agent to change the conference to %s", mode)
+	}
+
+	token, err := applicationToken(vng.streamer.VaultCredential())
+	if err != nil {
+		return err
+	}
+	name, err := conversationName(vng.Context(), token, conversationUuid)
+	if err != nil {
+		return err
+	}
+	cAuth, err := vng.Auth(vng.streamer.VaultCredential())
+	if err != nil {
+		return err
+	}
+	client := vonage.NewVoiceClient(cAuth)
+	if to != "" {
+		from, err := vng.streamer.GetDeploymentOptions().GetString("phone")
+		if err != nil {
+			return fmt.Errorf("phone of the deployment is required to call the agent")
+		}
+		result, vErr, err := client.CreateCall(vonage.CreateCallOpts{
+			From: vonage.CallFrom{Type: "phone", Number: from},
+			To:   vonage.CallTo{Type: "phone", Number: to},
+			Ncco: conferenceNcco(name, agentHears(mode, callerUuid)),
+		})
+		if err != nil {
+			return err
+		}
+		if vErr.Error != nil {
+			return fmt.Errorf("unable to call the agent: %v", vErr.Error)
+		}
+		vng.conferenceAgent = result.Uuid
+	} else {
+		if _, _, err := client.TransferCall(vonage.TransferCallOpts{Uuid: vng.conferenceAgent, Ncco: conferenceNcco(name, agentHears(mode, callerUuid))}); err != nil {
+			return err
+		}
+	}
+	if _, _, err := client.TransferCall(vonage.TransferCallOpts{Uuid: callerUuid, Ncco: conferenceNcco(name, callerHears(mode, vng.conferenceAgent))}); err != nil {
+		return err
+	}
+	vng.logger.Infof("vonage call %s is in conference with %s in %s mode", callerUuid, vng.conferenceAgent, mode)
+	return nil
+}
+
 func (vng *vonageWebsocketStreamer) handleMediaEvent(message []byte) (*protos.AssistantMessagingRequest, error) {
 	vng.streamer.LockInputAudioBuffer()
 	defer vng.streamer.UnlockInputAudioBuffer()
@@ -7,22 +7,35 @@
 package internal_telephony_factory
 
 import (
+	"context"
 	"errors"
 
 	"github.com/rapidaai/api/assistant-api/config"
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_asterisk_telephony "github.com/rapidaai/api/assistant-api/internal/telephony/internal/asterisk"
 	internal_exotel_telephony "github.com/rapidaai/api/assistant-api/internal/telephony/internal/exotel"
+	internal_freeswitch_telephony "github.com/rapidaai/api/assistant-api/internal/telephony/internal/freeswitch"
+	internal_plivo_telephony "github.com/rapidaai/api/assistant-api/internal/telephony/internal/plivo"
+	internal_sip_telephony "github.com/rapidaai/api/assistant-api/internal/telephony/internal/sip"
+	internal_telnyx_telephony "github.com/rapidaai/api/assistant-api/internal/telephony/internal/telnyx"
 	internal_twilio_telephony "github.com/rapidaai/api/assistant-api/internal/telephony/internal/twilio"
 	internal_vonage_telephony "github.com/rapidaai/api/assistant-api/internal/telephony/internal/vonage"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
 )
 
 type Telephony string
 
 const (
-	Twilio Telephony = "twilio"
-	Exotel Telephony = "exotel"
-	Vonage Telephony = "vonage"
+	Twilio     Telephony = "twilio"
+	Exotel     Telephony = "exotel"
+	Vonage     Telephony = "vonage"
+	Sip        Telephony = "sip"
+	Asterisk   Telephony = "asterisk"
+	FreeSwitch Telephony = "freeswitch"
+	Telnyx     Telephony = "telnyx"
+	Plivo      Telephony = "plivo"
 )
 
 func (at Telephony) String() string {
@@ -37,7 +50,49 @@ func GetTelephony(at Telephony, cfg *config.AssistantConfig, logger commons.Logg
 		return internal_exotel_telephony.NewExotelTelephony(cfg, logger)
 	case Vonage:
 		return internal_vonage_telephony.NewVonageTelephony(cfg, logger)
+	case Sip:
+		return internal_sip_telephony.NewSipTelephony(cfg, logger)
+	case Asterisk:
+		return internal_asterisk_telephony.NewAsteriskTelephony(cfg, logger)
+	case FreeSwitch:
+		return internal_freeswitch_telephony.NewFreeswitchTelephony(cfg, logger)
+	case Telnyx:
+		return internal_telnyx_telephony.NewTelnyxTelephony(cfg, logger)
+	case Plivo:
+		return internal_plivo_telephony.NewPlivoTelephony(cfg, logger)
 	default:
 		return nil, errors.New("illegal telephony provider")
 	}
 }
+
+// GetAudioConfig returns the audio the telephony provider streams to the caller
+func GetAudioConfig(at Telephony) (*protos.AudioConfig, error) {
+	switch at {
+	case Twilio:
+		return internal_audio.NewMulaw8khzMonoAudioConfig(), nil
+	case Exotel:
+		return internal_audio.NewLinear8khzMonoAudioConfig(), nil
+	case Vonage:
+		return internal_audio.NewLinear16khzMonoAudioConfig(), nil
+	case Sip:
+		return internal_audio.NewMulaw8khzMonoAudioConfig(), nil
+	case Asterisk, FreeSwitch:
+		return internal_audio.NewLinear8khzMonoAudioConfig(), nil
+	case Telnyx, Plivo:
+		return internal_audio.NewMulaw8khzMonoAudioConfig(), nil
+	default:
+		return nil, errors.New("illegal telephony provider")
+	}
+}
+
+// ListenSip starts the sip user agent which registers to the trunk and receives its calls, the
+// handler routes the calls to assistants
+func ListenSip(ctx context.Context, cfg *config.AssistantConfig, logger commons.Logger, handler internal_type.SipCallHandler) error {
+	return internal_sip_telephony.Listen(ctx, cfg, logger, handler)
+}
+
+// ListenAudioSocket starts the server asterisk streams the audio of its calls to, the handler runs
+// the assistant of the conversation a socket was opened for
+func ListenAudioSocket(ctx context.Context, cfg *config.AssistantConfig, logger commons.Logger, handler internal_type.AudioSocketHandler) error {
+	return internal_asterisk_telephony.Listen(ctx, cfg, logger, handler)
+}
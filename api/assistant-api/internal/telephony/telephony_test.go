@@ -11,6 +11,7 @@ import (
 
 	"github.com/rapidaai/api/assistant-api/config"
 	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -36,6 +37,31 @@ func TestTelephonyString(t *testing.T) {
 			input:    Vonage,
 			expected: "vonage",
 		},
+		{
+			name:     "Sip",
+			input:    Sip,
+			expected: "sip",
+		},
+		{
+			name:     "Asterisk",
+			input:    Asterisk,
+			expected: "asterisk",
+		},
+		{
+			name:     "FreeSwitch",
+			input:    FreeSwitch,
+			expected: "freeswitch",
+		},
+		{
+			name:     "Telnyx",
+			input:    Telnyx,
+			expected: "telnyx",
+		},
+		{
+			name:     "Plivo",
+			input:    Plivo,
+			expected: "plivo",
+		},
 	}
 
 	for _, tt := range tests {
@@ -133,6 +159,11 @@ func TestAllTelephonyProvidersCallFactory(t *testing.T) {
 		Twilio,
 		Exotel,
 		Vonage,
+		Sip,
+		Asterisk,
+		FreeSwitch,
+		Telnyx,
+		Plivo,
 	}
 
 	for _, provider := range telephonyTypes {
@@ -152,6 +183,11 @@ func TestTelephonyStringConsistency(t *testing.T) {
 		{Twilio, "twilio"},
 		{Exotel, "exotel"},
 		{Vonage, "vonage"},
+		{Sip, "sip"},
+		{Asterisk, "asterisk"},
+		{FreeSwitch, "freeswitch"},
+		{Telnyx, "telnyx"},
+		{Plivo, "plivo"},
 	}
 
 	for _, tt := range tests {
@@ -208,6 +244,11 @@ func TestValidProvidersMatch(t *testing.T) {
 		Twilio,
 		Exotel,
 		Vonage,
+		Sip,
+		Asterisk,
+		FreeSwitch,
+		Telnyx,
+		Plivo,
 	}
 
 	for _, provider := range validProviders {
@@ -280,3 +321,38 @@ func TestTelephonyTypeConversion(t *testing.T) {
 		})
 	}
 }
+
+// TestGetAudioConfig tests the audio streamed to the caller by each provider
+func TestGetAudioConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		provider   Telephony
+		wantRate   uint32
+		wantFormat protos.AudioConfig_AudioFormat
+		wantErr    bool
+	}{
+		{name: "Twilio", provider: Twilio, wantRate: 8000, wantFormat: protos.AudioConfig_MuLaw8},
+		{name: "Exotel", provider: Exotel, wantRate: 8000, wantFormat: protos.AudioConfig_LINEAR16},
+		{name: "Vonage", provider: Vonage, wantRate: 16000, wantFormat: protos.AudioConfig_LINEAR16},
+		{name: "Sip", provider: Sip, wantRate: 8000, wantFormat: protos.AudioConfig_MuLaw8},
+		{name: "Asterisk", provider: Asterisk, wantRate: 8000, wantFormat: protos.AudioConfig_LINEAR16},
+		{name: "FreeSwitch", provider: FreeSwitch, wantRate: 8000, wantFormat: protos.AudioConfig_LINEAR16},
+		{name: "Telnyx", provider: Telnyx, wantRate: 8000, wantFormat: protos.AudioConfig_MuLaw8},
+		{name: "Plivo", provider: Plivo, wantRate: 8000, wantFormat: protos.AudioConfig_MuLaw8},
+		{name: "Unknown", provider: Telephony("unknown"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			audioConfig, err := GetAudioConfig(tt.provider)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, audioConfig)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRate, audioConfig.GetSampleRate())
+			assert.Equal(t, tt.wantFormat, audioConfig.GetAudioFormat())
+		})
+	}
+}
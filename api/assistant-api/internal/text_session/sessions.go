@@ -0,0 +1,64 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_text_session
+
+import (
+	"sync"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+)
+
+// Sessions are the text sessions running on this instance by the key of the user, the lock is
+// only held for the map so a slow session of one user does not hold back the others
+type Sessions struct {
+	mu       sync.Mutex
+	sessions map[string]internal_type.TextStreamer
+}
+
+func NewSessions() *Sessions {
+	return &Sessions{sessions: make(map[string]internal_type.TextStreamer)}
+}
+
+// Get is the session of the user
+func (s *Sessions) Get(key string) (internal_type.TextStreamer, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.sessions[key]
+	return session, ok
+}
+
+// Open keeps the session for the user unless another one was opened for the user meanwhile, the
+// other session is returned and the message goes to it
+func (s *Sessions) Open(key string, session, previous internal_type.TextStreamer) (internal_type.TextStreamer, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if current, ok := s.sessions[key]; ok && current != previous {
+		return current, false
+	}
+	s.sessions[key] = session
+	return session, true
+}
+
+// Close forgets the session once it has ended, a newer session of the user is kept
+func (s *Sessions) Close(key string, session internal_type.TextStreamer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sessions[key] == session {
+		delete(s.sessions, key)
+	}
+}
+
+// Remove stops and forgets any session of the user, nothing more is sent to the user from it
+func (s *Sessions) Remove(key string) {
+	s.mu.Lock()
+	session, ok := s.sessions[key]
+	delete(s.sessions, key)
+	s.mu.Unlock()
+	if ok {
+		session.Stop()
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_text_session
+
+import (
+	"testing"
+	"time"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessions(t *testing.T) {
+	message := &internal_type.TextMessage{Id: "SM1", Content: "hello", Time: time.Now()}
+	first, _ := newTestStreamer(t, sms, message)
+	second, _ := newTestStreamer(t, sms, message)
+
+	t.Run("session is opened for the user", func(t *testing.T) {
+		sessions := NewSessions()
+		session, opened := sessions.Open("sms::1::user", first, nil)
+		require.True(t, opened)
+		assert.Equal(t, first, session)
+		current, ok := sessions.Get("sms::1::user")
+		require.True(t, ok)
+		assert.Equal(t, first, current)
+	})
+
+	t.Run("session opened meanwhile is kept", func(t *testing.T) {
+		sessions := NewSessions()
+		sessions.Open("sms::1::user", first, nil)
+		session, opened := sessions.Open("sms::1::user", second, nil)
+		assert.False(t, opened)
+		assert.Equal(t, first, session)
+	})
+
+	t.Run("closed session is replaced", func(t *testing.T) {
+		sessions := NewSessions()
+		sessions.Open("sms::1::user", first, nil)
+		session, opened := sessions.Open("sms::1::user", second, first)
+		assert.True(t, opened)
+		assert.Equal(t, second, session)
+	})
+
+	t.Run("ended session does not remove the newer one", func(t *testing.T) {
+		sessions := NewSessions()
+		sessions.Open("sms::1::user", first, nil)
+		sessions.Open("sms::1::user", second, first)
+		sessions.Close("sms::1::user", first)
+		current, ok := sessions.Get("sms::1::user")
+		require.True(t, ok)
+		assert.Equal(t, second, current)
+
+		sessions.Close("sms::1::user", second)
+		_, ok = sessions.Get("sms::1::user")
+		assert.False(t, ok)
+	})
+
+	t.Run("removed session is stopped", func(t *testing.T) {
+		sessions := NewSessions()
+		sessions.Open("sms::1::user", first, nil)
+		sessions.Remove("sms::1::user")
+		_, ok := sessions.Get("sms::1::user")
+		assert.False(t, ok)
+		assert.Error(t, first.Context().Err())
+	})
+}
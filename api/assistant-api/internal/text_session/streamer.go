@@ -0,0 +1,286 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_text_session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// the session of a user who stopped writing is closed, the next message resumes the
+	// conversation in a new session
+	sessionIdleTimeout = 15 * time.Minute
+
+	// messages of the user waiting for the session
+	messageBacklog = 32
+)
+
+// ErrSessionClosed is returned for the messages of a closed session, they open a new session
+var ErrSessionClosed = errors.New("text session is closed")
+
+// ErrSessionBusy is returned when the session has more messages waiting than it takes, the
+// provider is asked to deliver the message again
+var ErrSessionBusy = errors.New("text session is busy")
+
+// Channel is what the text sessions of whatsapp and sms differ in
+type Channel struct {
+	// name of the channel in the logs
+	Name string
+	// longest text the provider sends as one message, longer replies are split
+	MaxMessageLength int
+	// replies are only delivered within the window after the last message of the user, there is
+	// no window when it is zero
+	ReplyWindow time.Duration
+}
+
+type textStreamer struct {
+	logger     commons.Logger
+	ctx        context.Context
+	cancelFunc context.CancelFunc
+
+	channel         Channel
+	sender          internal_type.TextSender
+	vaultCredential *protos.VaultCredential
+	assistant       *internal_assistant_entity.Assistant
+	conversationId  uint64
+	// number of the deployment the replies are sent from and the number of the user
+	from, to string
+
+	idleTimeout time.Duration
+	messages    chan *internal_type.TextMessage
+	connected   bool
+
+	mu           sync.Mutex
+	closed       bool
+	ended        bool
+	lastReceived time.Time
+	replies      map[string]*strings.Builder
+}
+
+// NewStreamer opens the text session of the user who sent the message, the session resumes the
+// conversation and receives the message as its first input
+func NewStreamer(logger commons.Logger, channel Channel, sender internal_type.TextSender, assistant *internal_assistant_entity.Assistant, assistantConversationId uint64, message *internal_type.TextMessage, vaultCredential *protos.VaultCredential) internal_type.TextStreamer {
+	ctx, cancel := context.WithCancel(context.Background())
+	streamer := &textStreamer{
+		logger:          logger,
+		ctx:             ctx,
+		cancelFunc:      cancel,
+		channel:         channel,
+		sender:          sender,
+		vaultCredential: vaultCredential,
+		assistant:       assistant,
+		conversationId:  assistantConversationId,
+		from:            message.To,
+		to:              message.From,
+		idleTimeout:     sessionIdleTimeout,
+		messages:        make(chan *internal_type.TextMessage, messageBacklog),
+		replies:         make(map[string]*strings.Builder),
+	}
+	streamer.Receive(message)
+	return streamer
+}
+
+func (s *textStreamer) Context() context.Context {
+	return s.ctx
+}
+
+// Receive hands the message to the session, it fails once the session is closed and the message
+// has to open a new session
+func (s *textStreamer) Receive(message *internal_type.TextMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return ErrSessionClosed
+	}
+	select {
+	case s.messages <- message:
+		s.lastReceived = message.Time
+		return nil
+	default:
+		return fmt.Errorf("%s: %w", s.channel.Name, ErrSessionBusy)
+	}
+}
+
+func (s *textStreamer) Ended() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ended
+}
+
+func (s *textStreamer) Stop() {
+	s.mu.Lock()
+	s.closed = true
+	s.replies = make(map[string]*strings.Builder)
+	s.mu.Unlock()
+	s.cancelFunc()
+}
+
+func (s *textStreamer) Recv() (*protos.AssistantMessagingRequest, error) {
+	if !s.connected {
+		s.connected = true
+		return s.connectionRequest(), nil
+	}
+	timer := time.NewTimer(s.idleTimeout)
+	defer timer.Stop()
+	for {
+		// an ended session reads no more of the pending messages
+		if s.ctx.Err() != nil {
+			return nil, io.EOF
+		}
+		select {
+		case <-s.ctx.Done():
+			return nil, io.EOF
+		case message := <-s.messages:
+			return s.messageRequest(message), nil
+		case <-timer.C:
+			s.mu.Lock()
+			if len(s.messages) > 0 {
+				s.mu.Unlock()
+				continue
+			}
+			s.closed = true
+			s.mu.Unlock()
+			s.cancelFunc()
+			return nil, io.EOF
+		}
+	}
+}
+
+func (s *textStreamer) Send(response *protos.AssistantMessagingResponse) error {
+	switch data := response.GetData().(type) {
+	case *protos.AssistantMessagingResponse_Assistant:
+		content := data.Assistant.GetText().GetContent()
+		if content != "" {
+			s.mu.Lock()
+			reply, ok := s.replies[data.Assistant.GetId()]
+			if !ok {
+				reply = &strings.Builder{}
+				s.replies[data.Assistant.GetId()] = reply
+			}
+			reply.WriteString(content)
+			s.mu.Unlock()
+			return nil
+		}
+		// the reply is complete once the completion without content is sent
+		if data.Assistant.GetCompleted() {
+			return s.flush(data.Assistant.GetId())
+		}
+	case *protos.AssistantMessagingResponse_Interruption:
+		// the reply to an earlier message is dropped for the reply to the latest one
+		s.mu.Lock()
+		s.replies = make(map[string]*strings.Builder)
+		s.mu.Unlock()
+	case *protos.AssistantMessagingResponse_Action:
+		if data.Action.GetAction() == protos.AssistantConversationAction_END_CONVERSATION {
+			s.mu.Lock()
+			ids := make([]string, 0, len(s.replies))
+			for id := range s.replies {
+				ids = append(ids, id)
+			}
+			s.mu.Unlock()
+			for _, id := range ids {
+				s.flush(id)
+			}
+			s.mu.Lock()
+			s.closed, s.ended = true, true
+			s.mu.Unlock()
+			s.cancelFunc()
+		}
+	}
+	return nil
+}
+
+// flush sends the reply to the user within the reply window of the channel
+func (s *textStreamer) flush(id string) error {
+	s.mu.Lock()
+	reply, ok := s.replies[id]
+	delete(s.replies, id)
+	lastReceived := s.lastReceived
+	s.mu.Unlock()
+	if !ok || strings.TrimSpace(reply.String()) == "" {
+		return nil
+	}
+	if s.ctx.Err() != nil {
+		s.logger.Debugf("dropping %s reply to %s, the session is closed", s.channel.Name, s.to)
+		return nil
+	}
+	if s.channel.ReplyWindow > 0 && time.Since(lastReceived) > s.channel.ReplyWindow {
+		s.logger.Warnf("dropping %s reply to %s, the reply window closed at %s", s.channel.Name, s.to, lastReceived.Add(s.channel.ReplyWindow))
+		return nil
+	}
+	for _, text := range split(strings.TrimSpace(reply.String()), s.channel.MaxMessageLength) {
+		if err := s.sender.SendMessage(s.ctx, s.vaultCredential, s.from, s.to, text); err != nil {
+			s.logger.Errorf("unable to send %s reply to %s: %v", s.channel.Name, s.to, err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *textStreamer) connectionRequest() *protos.AssistantMessagingRequest {
+	return &protos.AssistantMessagingRequest{
+		Request: &protos.AssistantMessagingRequest_Configuration{
+			Configuration: &protos.AssistantConversationConfiguration{
+				AssistantConversationId: s.conversationId,
+				Assistant: &protos.AssistantDefinition{
+					AssistantId: s.assistant.Id,
+					Version:     utils.GetVersionString(s.assistant.AssistantProviderId),
+				},
+				InputConfig:  &protos.StreamConfig{Text: &protos.TextConfig{Charset: "utf-8"}},
+				OutputConfig: &protos.StreamConfig{Text: &protos.TextConfig{Charset: "utf-8"}},
+			},
+		}}
+}
+
+func (s *textStreamer) messageRequest(message *internal_type.TextMessage) *protos.AssistantMessagingRequest {
+	return &protos.AssistantMessagingRequest{
+		Request: &protos.AssistantMessagingRequest_Message{
+			Message: &protos.AssistantConversationUserMessage{
+				Id:        message.Id,
+				Completed: true,
+				Time:      timestamppb.New(message.Time),
+				Message: &protos.AssistantConversationUserMessage_Text{
+					Text: &protos.AssistantConversationMessageTextContent{
+						Content: message.Content,
+					},
+				},
+			},
+		},
+	}
+}
+
+// split breaks the text into parts of at most limit characters, at a line or a word when it can
+func split(text string, limit int) []string {
+	parts := make([]string, 0, 1)
+	for utf8.RuneCountInString(text) > limit {
+		runes := []rune(text)
+		cut := limit
+		if at := strings.LastIndexAny(string(runes[:limit]), "\n "); at > 0 {
+			cut = utf8.RuneCountInString(string(runes[:limit])[:at])
+		}
+		parts = append(parts, strings.TrimSpace(string(runes[:cut])))
+		text = strings.TrimSpace(string(runes[cut:]))
+	}
+	if text != "" {
+		parts = append(parts, text)
+	}
+	return parts
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_text_session
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sentMessage struct {
+	from, to, text string
+}
+
+// recordingSender keeps the messages sent to the users
+type recordingSender struct {
+	mu   sync.Mutex
+	sent []sentMessage
+}
+
+func (r *recordingSender) SendMessage(ctx context.Context, vaultCredential *protos.VaultCredential, from, to, text string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sent = append(r.sent, sentMessage{from: from, to: to, text: text})
+	return nil
+}
+
+func (r *recordingSender) messages() []sentMessage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]sentMessage{}, r.sent...)
+}
+
+var (
+	whatsapp = Channel{Name: "whatsapp", MaxMessageLength: 1600, ReplyWindow: 24 * time.Hour}
+	sms      = Channel{Name: "sms", MaxMessageLength: 1600}
+)
+
+func newTestStreamer(t *testing.T, channel Channel, message *internal_type.TextMessage) (*textStreamer, *recordingSender) {
+	t.Helper()
+	logger, _ := commons.NewApplicationLogger()
+	sender := &recordingSender{}
+	streamer := NewStreamer(logger, channel, sender, &internal_assistant_entity.Assistant{}, 42, message, &protos.VaultCredential{})
+	t.Cleanup(func() { streamer.(*textStreamer).cancelFunc() })
+	return streamer.(*textStreamer), sender
+}
+
+func assistantText(id, content string) *protos.AssistantMessagingResponse {
+	return &protos.AssistantMessagingResponse{Data: &protos.AssistantMessagingResponse_Assistant{
+		Assistant: &protos.AssistantConversationAssistantMessage{Id: id, Completed: true, Message: &protos.AssistantConversationAssistantMessage_Text{
+			Text: &protos.AssistantConversationMessageTextContent{Content: content},
+		}},
+	}}
+}
+
+func assistantCompleted(id string) *protos.AssistantMessagingResponse {
+	return &protos.AssistantMessagingResponse{Data: &protos.AssistantMessagingResponse_Assistant{
+		Assistant: &protos.AssistantConversationAssistantMessage{Id: id, Completed: true},
+	}}
+}
+
+func TestStreamer_Recv(t *testing.T) {
+	streamer, _ := newTestStreamer(t, whatsapp, &internal_type.TextMessage{Id: "wamid.1", From: "15551234567", To: "15557654321", Content: "hello", Time: time.Now()})
+
+	req, err := streamer.Recv()
+	require.NoError(t, err)
+	configuration := req.GetConfiguration()
+	require.NotNil(t, configuration)
+	assert.Equal(t, uint64(42), configuration.GetAssistantConversationId())
+	assert.Nil(t, configuration.GetInputConfig().GetAudio())
+	assert.Nil(t, configuration.GetOutputConfig().GetAudio())
+
+	req, err = streamer.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "wamid.1", req.GetMessage().GetId())
+	assert.Equal(t, "hello", req.GetMessage().GetText().GetContent())
+
+	require.NoError(t, streamer.Receive(&internal_type.TextMessage{Id: "wamid.2", Content: "how are you", Time: time.Now()}))
+	req, err = streamer.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "how are you", req.GetMessage().GetText().GetContent())
+}
+
+func TestStreamer_Busy(t *testing.T) {
+	streamer, _ := newTestStreamer(t, sms, &internal_type.TextMessage{Id: "SM1", Content: "hello", Time: time.Now()})
+	// the first message waits in the backlog as well
+	for i := 1; i < messageBacklog; i++ {
+		require.NoError(t, streamer.Receive(&internal_type.TextMessage{Id: fmt.Sprintf("SM%d", i+2), Content: "more", Time: time.Now()}))
+	}
+	assert.ErrorIs(t, streamer.Receive(&internal_type.TextMessage{Id: "SMX", Content: "too many", Time: time.Now()}), ErrSessionBusy)
+}
+
+func TestStreamer_IdleSessionCloses(t *testing.T) {
+	streamer, _ := newTestStreamer(t, sms, &internal_type.TextMessage{Id: "SM1", Content: "hello", Time: time.Now()})
+	streamer.idleTimeout = 10 * time.Millisecond
+	_, err := streamer.Recv()
+	require.NoError(t, err)
+	_, err = streamer.Recv()
+	require.NoError(t, err)
+
+	_, err = streamer.Recv()
+	assert.Equal(t, io.EOF, err)
+	assert.ErrorIs(t, streamer.Receive(&internal_type.TextMessage{Id: "SM2", Content: "again", Time: time.Now()}), ErrSessionClosed)
+	assert.False(t, streamer.Ended())
+}
+
+func TestStreamer_Send(t *testing.T) {
+	tests := []struct {
+		name         string
+		channel      Channel
+		lastReceived time.Time
+		responses    []*protos.AssistantMessagingResponse
+		want         []string
+	}{
+		{
+			name:         "reply is sent once completed",
+			lastReceived: time.Now(),
+			responses:    []*protos.AssistantMessagingResponse{assistantText("m1", "Hello, "), assistantText("m1", "how can I help?"), assistantCompleted("m1")},
+			want:         []string{"Hello, how can I help?"},
+		},
+		{
+			name:         "incomplete reply is not sent",
+			lastReceived: time.Now(),
+			responses:    []*protos.AssistantMessagingResponse{assistantText("m1", "Hello")},
+			want:         nil,
+		},
+		{
+			name:         "interrupted reply is dropped",
+			lastReceived: time.Now(),
+			responses: []*protos.AssistantMessagingResponse{
+				assistantText("m1", "Hello"),
+				{Data: &protos.AssistantMessagingResponse_Interruption{Interruption: &protos.AssistantConversationInterruption{}}},
+				assistantText("m2", "Sure"), assistantCompleted("m1"), assistantCompleted("m2"),
+			},
+			want: []string{"Sure"},
+		},
+		{
+			name:         "reply outside of the reply window is dropped",
+			channel:      whatsapp,
+			lastReceived: time.Now().Add(-25 * time.Hour),
+			responses:    []*protos.AssistantMessagingResponse{assistantText("m1", "Hello"), assistantCompleted("m1")},
+			want:         nil,
+		},
+		{
+			name:         "reply of a channel without window is sent",
+			channel:      sms,
+			lastReceived: time.Now().Add(-25 * time.Hour),
+			responses:    []*protos.AssistantMessagingResponse{assistantText("m1", "Hello"), assistantCompleted("m1")},
+			want:         []string{"Hello"},
+		},
+		{
+			name:         "long reply is split",
+			lastReceived: time.Now(),
+			responses:    []*protos.AssistantMessagingResponse{assistantText("m1", strings.Repeat("word ", 400)), assistantCompleted("m1")},
+			want:         []string{strings.TrimSpace(strings.Repeat("word ", 320)), strings.TrimSpace(strings.Repeat("word ", 80))},
+		},
+		{
+			name:         "pending reply is sent when the conversation ends",
+			lastReceived: time.Now(),
+			responses: []*protos.AssistantMessagingResponse{
+				assistantText("m1", "Goodbye"),
+				{Data: &protos.AssistantMessagingResponse_Action{Action: &protos.AssistantConversationAction{Action: protos.AssistantConversationAction_END_CONVERSATION}}},
+			},
+			want: []string{"Goodbye"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			channel := tt.channel
+			if channel.Name == "" {
+				channel = whatsapp
+			}
+			streamer, sender := newTestStreamer(t, channel, &internal_type.TextMessage{Id: "wamid.1", From: "15551234567", To: "15557654321", Content: "hello", Time: tt.lastReceived})
+			for _, response := range tt.responses {
+				require.NoError(t, streamer.Send(response))
+			}
+			var texts []string
+			for _, sent := range sender.messages() {
+				assert.Equal(t, "15557654321", sent.from)
+				assert.Equal(t, "15551234567", sent.to)
+				texts = append(texts, sent.text)
+			}
+			assert.Equal(t, tt.want, texts)
+		})
+	}
+}
+
+func TestStreamer_EndConversation(t *testing.T) {
+	streamer, _ := newTestStreamer(t, whatsapp, &internal_type.TextMessage{Id: "wamid.1", Content: "bye", Time: time.Now()})
+	require.NoError(t, streamer.Send(&protos.AssistantMessagingResponse{Data: &protos.AssistantMessagingResponse_Action{Action: &protos.AssistantConversationAction{Action: protos.AssistantConversationAction_END_CONVERSATION}}}))
+
+	assert.True(t, streamer.Ended())
+	assert.ErrorIs(t, streamer.Receive(&internal_type.TextMessage{Id: "wamid.2", Content: "hello", Time: time.Now()}), ErrSessionClosed)
+	_, err := streamer.Recv()
+	require.NoError(t, err)
+	_, err = streamer.Recv()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestStreamer_Stop(t *testing.T) {
+	streamer, sender := newTestStreamer(t, sms, &internal_type.TextMessage{Id: "SM1", From: "+15551234567", To: "+15557654321", Content: "hello", Time: time.Now()})
+	require.NoError(t, streamer.Send(assistantText("m1", "Sure, one moment")))
+	streamer.Stop()
+
+	require.NoError(t, streamer.Send(assistantText("m2", "Here it is")))
+	require.NoError(t, streamer.Send(assistantCompleted("m1")))
+	require.NoError(t, streamer.Send(assistantCompleted("m2")))
+	assert.Empty(t, sender.messages())
+	assert.ErrorIs(t, streamer.Receive(&internal_type.TextMessage{Id: "SM2", Content: "hello", Time: time.Now()}), ErrSessionClosed)
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		limit int
+		want  []string
+	}{
+		{name: "short text", text: "hello there", limit: 20, want: []string{"hello there"}},
+		{name: "split at a word", text: "hello there friend", limit: 12, want: []string{"hello there", "friend"}},
+		{name: "split at a line", text: "first line\nsecond", limit: 14, want: []string{"first line", "second"}},
+		{name: "split without space", text: strings.Repeat("a", 10), limit: 4, want: []string{"aaaa", "aaaa", "aa"}},
+		{name: "split by characters", text: "héllo wörld", limit: 6, want: []string{"héllo", "wörld"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, split(tt.text, tt.limit))
+		})
+	}
+}
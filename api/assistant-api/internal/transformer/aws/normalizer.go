@@ -58,11 +58,13 @@ func NewAWSNormalizer(logger commons.Logger, opts utils.Option) internal_type.Te
 		cfg.PauseDurationMs = conjunctionBreak
 	}
 
+	language, _ := opts.GetString("speaker.language")
+
 	// Build normalizer pipeline based on speaker.pronunciation.dictionaries
 	var normalizers []internal_normalizers.Normalizer
 	if dictionaries, err := opts.GetString("speaker.pronunciation.dictionaries"); err == nil && dictionaries != "" {
 		normalizerNames := strings.Split(dictionaries, commons.SEPARATOR)
-		normalizers = internal_type.BuildNormalizerPipeline(logger, normalizerNames)
+		normalizers = internal_type.BuildNormalizerPipeline(logger, language, normalizerNames)
 	}
 
 	return &awsNormalizer{
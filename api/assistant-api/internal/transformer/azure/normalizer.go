@@ -33,16 +33,27 @@ type azureNormalizer struct {
 	// normalizer pipeline
 	normalizers []internal_normalizers.Normalizer
 
+	// pronunciation lexicon, as SSML and as respellings when no voice is named
+	lexicon     internal_normalizers.Normalizer
+	respellings internal_normalizers.Normalizer
+
 	// conjunction handling
 	conjunctionPattern *regexp.Regexp
 }
 
 // NewAzureNormalizer creates an Azure-specific text normalizer.
 func NewAzureNormalizer(logger commons.Logger, opts utils.Option) internal_type.TextNormalizer {
+	return newAzureNormalizer(logger, opts)
+}
+
+func newAzureNormalizer(logger commons.Logger, opts utils.Option) *azureNormalizer {
 	cfg := internal_type.DefaultNormalizerConfig()
 
-	// Get voice name and language
+	// Get voice name and language, the voice of the synthesizer when not named
 	voiceName, _ := opts.GetString("speaker.voice.name")
+	if voiceName == "" {
+		voiceName, _ = opts.GetString("speak.voice.id")
+	}
 	language, _ := opts.GetString("speaker.language")
 	if language == "" {
 		language = "en-US"
@@ -70,7 +81,7 @@ func NewAzureNormalizer(logger commons.Logger, opts utils.Option) internal_type.
 	var normalizers []internal_normalizers.Normalizer
 	if dictionaries, err := opts.GetString("speaker.pronunciation.dictionaries"); err == nil && dictionaries != "" {
 		normalizerNames := strings.Split(dictionaries, commons.SEPARATOR)
-		normalizers = internal_type.BuildNormalizerPipeline(logger, normalizerNames)
+		normalizers = internal_type.BuildNormalizerPipeline(logger, language, normalizerNames)
 	}
 
 	return &azureNormalizer{
@@ -79,6 +90,8 @@ func NewAzureNormalizer(logger commons.Logger, opts utils.Option) internal_type.
 		voiceName:          voiceName,
 		language:           language,
 		normalizers:        normalizers,
+		lexicon:            internal_type.BuildLexiconNormalizer(logger, opts, true),
+		respellings:        internal_type.BuildLexiconNormalizer(logger, opts, false),
 		conjunctionPattern: conjunctionPattern,
 	}
 }
@@ -105,6 +118,11 @@ func (n *azureNormalizer) Normalize(ctx context.Context, text string) string {
 		text = n.insertConjunctionBreaks(text)
 	}
 
+	// Pronunciation lexicon as SSML, after escaping so its elements are kept
+	if n.lexicon != nil {
+		text = n.lexicon.Normalize(text)
+	}
+
 	return n.normalizeWhitespace(text)
 }
 
@@ -171,6 +189,20 @@ func (n *azureNormalizer) normalizeWhitespace(text string) string {
 // Azure SSML Helpers
 // =============================================================================
 
+// Respell speaks the entries of the pronunciation lexicon as their alias or respelling, for the
+// text which is not spoken as SSML
+func (n *azureNormalizer) Respell(text string) string {
+	if n.respellings == nil {
+		return text
+	}
+	return n.respellings.Normalize(text)
+}
+
+// SpeaksSSML reports whether text is spoken as SSML, only the pronunciation lexicon needs it
+func (n *azureNormalizer) SpeaksSSML() bool {
+	return n.lexicon != nil && n.voiceName != ""
+}
+
 func (n *azureNormalizer) WrapWithSSML(text string) string {
 	return fmt.Sprintf(
 		`<speak version="1.0" xmlns="http://www.w3.org/2001/10/synthesis" xmlns:mstts="https://www.w3.org/2001/mstts" xml:lang="%s"><voice name="%s">%s</voice></speak>`,
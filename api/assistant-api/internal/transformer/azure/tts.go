@@ -32,6 +32,7 @@ type azureTextToSpeech struct {
 	stream      *audio.PullAudioOutputStream
 	audioConfig *audio.AudioConfig
 	client      *speech.SpeechSynthesizer
+	normalizer  *azureNormalizer
 	onPacket    func(pkt ...internal_type.Packet) error
 }
 
@@ -52,6 +53,7 @@ func NewAzureTextToSpeech(ctx context.Context, logger commons.Logger, credential
 
 		azureOption: azureOption,
 		logger:      logger,
+		normalizer:  newAzureNormalizer(logger, opts),
 		onPacket:    onPacket,
 	}, nil
 }
@@ -146,7 +148,15 @@ func (azure *azureTextToSpeech) Transform(ctx context.Context, in internal_type.
 
 	switch input := in.(type) {
 	case internal_type.LLMStreamPacket:
-		res := <-cl.StartSpeakingTextAsync(input.Text)
+		// the pronunciation lexicon is spoken as SSML, the voice must then be named in the SSML
+		if azure.normalizer.SpeaksSSML() {
+			res := <-cl.StartSpeakingSsmlAsync(azure.normalizer.WrapWithSSML(azure.normalizer.Normalize(ctx, input.Text)))
+			if res.Error != nil {
+				return res.Error
+			}
+			return nil
+		}
+		res := <-cl.StartSpeakingTextAsync(azure.normalizer.Respell(input.Text))
 		if res.Error != nil {
 			return res.Error
 		}
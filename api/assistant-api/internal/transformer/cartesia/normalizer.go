@@ -45,7 +45,7 @@ func NewCartesiaNormalizer(logger commons.Logger, opts utils.Option) internal_ty
 	var normalizers []internal_normalizers.Normalizer
 	if dictionaries, err := opts.GetString("speaker.pronunciation.dictionaries"); err == nil && dictionaries != "" {
 		normalizerNames := strings.Split(dictionaries, commons.SEPARATOR)
-		normalizers = internal_type.BuildNormalizerPipeline(logger, normalizerNames)
+		normalizers = internal_type.BuildNormalizerPipeline(logger, language, normalizerNames)
 	}
 
 	return &cartesiaNormalizer{
@@ -14,6 +14,7 @@ import (
 	"sync"
 
 	"github.com/gorilla/websocket"
+	internal_normalizers "github.com/rapidaai/api/assistant-api/internal/normalizers"
 	cartesia_internal "github.com/rapidaai/api/assistant-api/internal/transformer/cartesia/internal"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
@@ -33,6 +34,9 @@ type cartesiaTTS struct {
 	logger     commons.Logger
 	connection *websocket.Conn
 	onPacket   func(pkt ...internal_type.Packet) error
+
+	// pronunciation lexicon, spoken as its aliases and respellings
+	lexicon internal_normalizers.Normalizer
 }
 
 func NewCartesiaTextToSpeech(ctx context.Context, logger commons.Logger, credential *protos.VaultCredential,
@@ -52,6 +56,7 @@ func NewCartesiaTextToSpeech(ctx context.Context, logger commons.Logger, credent
 		ctx:            ct,
 		ctxCancel:      ctxCancel,
 		onPacket:       onPacket,
+		lexicon:        internal_type.BuildLexiconNormalizer(logger, opts, false),
 	}, nil
 }
 
@@ -135,7 +140,7 @@ func (ct *cartesiaTTS) Transform(ctx context.Context, in internal_type.LLMPacket
 
 	switch input := in.(type) {
 	case internal_type.LLMStreamPacket:
-		message := ct.GetTextToSpeechInput(input.Text, map[string]interface{}{"continue": true, "context_id": ct.contextId, "max_buffer_delay_ms": "0ms"})
+		message := ct.GetTextToSpeechInput(ct.respell(input.Text), map[string]interface{}{"continue": true, "context_id": ct.contextId, "max_buffer_delay_ms": "0ms"})
 		if err := conn.WriteJSON(message); err != nil {
 			return err
 		}
@@ -162,3 +167,11 @@ func (ct *cartesiaTTS) Close(ctx context.Context) error {
 	}
 	return nil
 }
+
+// respell speaks the entries of the pronunciation lexicon as their alias or respelling
+func (ct *cartesiaTTS) respell(text string) string {
+	if ct.lexicon == nil {
+		return text
+	}
+	return ct.lexicon.Normalize(text)
+}
@@ -30,6 +30,9 @@ type deepgramNormalizer struct {
 
 	// normalizer pipeline
 	normalizers []internal_normalizers.Normalizer
+
+	// pronunciation lexicon
+	lexicon internal_normalizers.Normalizer
 }
 
 // NewDeepgramNormalizer creates a Deepgram-specific text normalizer.
@@ -46,7 +49,7 @@ func NewDeepgramNormalizer(logger commons.Logger, opts utils.Option) internal_ty
 	var normalizers []internal_normalizers.Normalizer
 	if dictionaries, err := opts.GetString("speaker.pronunciation.dictionaries"); err == nil && dictionaries != "" {
 		normalizerNames := strings.Split(dictionaries, commons.SEPARATOR)
-		normalizers = internal_type.BuildNormalizerPipeline(logger, normalizerNames)
+		normalizers = internal_type.BuildNormalizerPipeline(logger, language, normalizerNames)
 	}
 
 	return &deepgramNormalizer{
@@ -54,6 +57,7 @@ func NewDeepgramNormalizer(logger commons.Logger, opts utils.Option) internal_ty
 		config:      cfg,
 		language:    language,
 		normalizers: normalizers,
+		lexicon:     internal_type.BuildLexiconNormalizer(logger, opts, false),
 	}
 }
 
@@ -72,6 +76,11 @@ func (n *deepgramNormalizer) Normalize(ctx context.Context, text string) string
 		text = normalizer.Normalize(text)
 	}
 
+	// Pronunciation lexicon as respellings
+	if n.lexicon != nil {
+		text = n.lexicon.Normalize(text)
+	}
+
 	// NO XML escaping - Deepgram uses plain text only
 	// NO SSML breaks - Deepgram doesn't support SSML
 
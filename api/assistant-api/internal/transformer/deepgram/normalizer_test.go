@@ -862,6 +862,25 @@ func TestNormalize_ContextCancellation(t *testing.T) {
 	assert.Equal(t, "Hello world", result)
 }
 
+// =============================================================================
+// Pronunciation Lexicon Tests
+// =============================================================================
+
+func TestNormalize_PronunciationLexicon(t *testing.T) {
+	ctx := context.Background()
+
+	normalizer := newTestNormalizer(t, utils.Option{
+		"speaker.pronunciation.lexicon": `[{"grapheme": "SQL", "alias": "sequel"}, {"grapheme": "Rapida", "phoneme": "ræˈpiːdə", "respelling": "ra-pee-da"}]`,
+	})
+	require.NotNil(t, normalizer.lexicon)
+	assert.Equal(t, "Ask ra-pee-da about sequel", normalizer.Normalize(ctx, "Ask **Rapida** about SQL"))
+
+	// an illegal lexicon is ignored
+	normalizer = newTestNormalizer(t, utils.Option{"speaker.pronunciation.lexicon": "not json"})
+	assert.Nil(t, normalizer.lexicon)
+	assert.Equal(t, "Ask Rapida about SQL", normalizer.Normalize(ctx, "Ask Rapida about SQL"))
+}
+
 func TestNormalize_NilContext(t *testing.T) {
 	normalizer := newTestNormalizer(t, utils.Option{})
 
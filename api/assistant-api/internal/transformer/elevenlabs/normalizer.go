@@ -68,7 +68,7 @@ func NewElevenLabsNormalizer(logger commons.Logger, opts utils.Option) internal_
 	var normalizers []internal_normalizers.Normalizer
 	if dictionaries, err := opts.GetString("speaker.pronunciation.dictionaries"); err == nil && dictionaries != "" {
 		normalizerNames := strings.Split(dictionaries, commons.SEPARATOR)
-		normalizers = internal_type.BuildNormalizerPipeline(logger, normalizerNames)
+		normalizers = internal_type.BuildNormalizerPipeline(logger, language, normalizerNames)
 	}
 
 	return &elevenlabsNormalizer{
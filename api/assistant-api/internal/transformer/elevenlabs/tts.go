@@ -18,6 +18,7 @@ import (
 
 	"github.com/gorilla/websocket"
 
+	internal_normalizers "github.com/rapidaai/api/assistant-api/internal/normalizers"
 	elevenlabs_internal "github.com/rapidaai/api/assistant-api/internal/transformer/elevenlabs/internal"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
@@ -37,6 +38,9 @@ type elevenlabsTTS struct {
 	logger     commons.Logger
 	connection *websocket.Conn
 	onPacket   func(pkt ...internal_type.Packet) error
+
+	// pronunciation lexicon, spoken as its aliases and respellings
+	lexicon internal_normalizers.Normalizer
 }
 
 func NewElevenlabsTextToSpeech(ctx context.Context, logger commons.Logger, credential *protos.VaultCredential, audioConfig *protos.AudioConfig,
@@ -54,6 +58,7 @@ func NewElevenlabsTextToSpeech(ctx context.Context, logger commons.Logger, crede
 		onPacket:         onPacket,
 		logger:           logger,
 		elevenLabsOption: eleOpts,
+		lexicon:          internal_type.BuildLexiconNormalizer(logger, opts, false),
 	}, nil
 }
 
@@ -137,7 +142,7 @@ func (t *elevenlabsTTS) Transform(ctx context.Context, in internal_type.LLMPacke
 	switch input := in.(type) {
 	case internal_type.LLMStreamPacket:
 		if err := cnn.WriteJSON(map[string]interface{}{
-			"text":       input.Text,
+			"text":       t.respell(input.Text),
 			"context_id": currentCtx,
 			"flush":      false,
 		}); err != nil {
@@ -169,3 +174,11 @@ func (t *elevenlabsTTS) Close(ctx context.Context) error {
 	}
 	return nil
 }
+
+// respell speaks the entries of the pronunciation lexicon as their alias or respelling
+func (t *elevenlabsTTS) respell(text string) string {
+	if t.lexicon == nil {
+		return text
+	}
+	return t.lexicon.Normalize(text)
+}
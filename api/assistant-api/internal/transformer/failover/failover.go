@@ -0,0 +1,424 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_transformer_failover
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+const (
+	// provider and credential the transformer switches to once the provider fails
+	OptionsKeyProvider   = "rapida.fallback.provider"
+	OptionsKeyCredential = "rapida.fallback.credential_id"
+
+	// consecutive errors after which the provider is considered down
+	OptionsKeyMaxErrors = "rapida.fallback.max_errors"
+
+	// seconds the provider may take input without returning anything before it is considered
+	// down, zero turns the watchdog off
+	OptionsKeyOutputTimeout = "rapida.fallback.output_timeout"
+
+	// options of the fallback provider, fallback.listen.model replaces listen.model
+	optionsPrefix = "fallback."
+
+	// audio since the last final transcript which is sent again to the fallback provider
+	maxReplayDuration = 30 * time.Second
+
+	TransformerSpeechToText = "speech-to-text"
+	TransformerTextToSpeech = "text-to-speech"
+)
+
+// Provider creates the transformer of a provider, the packets of the transformer go to onPacket
+type Provider[T any] struct {
+	Name   string
+	Create func(onPacket func(...internal_type.Packet) error) (T, error)
+}
+
+// FallbackOptions returns the options of the fallback provider, options prefixed with fallback.
+// replace the options of the provider and the fallback credential replaces the credential.
+func FallbackOptions(opts utils.Option) utils.Option {
+	fallback := make(utils.Option, len(opts))
+	for k, v := range opts {
+		if !strings.HasPrefix(k, optionsPrefix) {
+			fallback[k] = v
+		}
+	}
+	for k, v := range opts {
+		if key, ok := strings.CutPrefix(k, optionsPrefix); ok && key != "" {
+			fallback[key] = v
+		}
+	}
+	if credential, ok := opts[OptionsKeyCredential]; ok {
+		fallback["rapida.credential_id"] = credential
+	}
+	delete(fallback, OptionsKeyProvider)
+	delete(fallback, OptionsKeyCredential)
+	return fallback
+}
+
+// replayBuffer keeps the input which the provider might not have processed when it failed
+type replayBuffer[IN any] interface {
+	Add(in IN)
+	Observe(pkt internal_type.Packet)
+	Replay() []IN
+}
+
+type transformer[IN any] interface {
+	Name() string
+	internal_type.Transformers[IN]
+}
+
+// failover sends the input to the active provider and switches to the next provider once the
+// active provider keeps failing, the buffered input is replayed to the next provider
+type failover[IN any] struct {
+	logger    commons.Logger
+	kind      string
+	onPacket  func(...internal_type.Packet) error
+	maxErrors int
+	providers []Provider[transformer[IN]]
+
+	// a provider which neither fails nor answers is failed over once input waited this long
+	outputTimeout time.Duration
+
+	// only one switch at a time, providers are created without holding mu
+	switching sync.Mutex
+
+	mu          sync.Mutex
+	initialized bool
+	current     int
+	active      transformer[IN]
+	failures    int
+	buffer      replayBuffer[IN]
+	watchdog    *time.Timer
+}
+
+func newFailover[IN any](logger commons.Logger, kind string, onPacket func(...internal_type.Packet) error, opts utils.Option, buffer replayBuffer[IN], providers []Provider[transformer[IN]]) (*failover[IN], error) {
+	f := &failover[IN]{
+		logger:    logger,
+		kind:      kind,
+		onPacket:  onPacket,
+		maxErrors: 3,
+		providers: providers,
+		current:   -1,
+		buffer:    buffer,
+	}
+	if kind == TransformerTextToSpeech {
+		// every error of text to speech is a sentence the user does not hear, text is answered
+		// with audio within a few seconds while audio is not transcribed during silence
+		f.maxErrors = 1
+		f.outputTimeout = 5 * time.Second
+	}
+	if maxErrors, err := opts.GetUint32(OptionsKeyMaxErrors); err == nil && maxErrors > 0 {
+		f.maxErrors = int(maxErrors)
+	}
+	if timeout, err := opts.GetFloat64(OptionsKeyOutputTimeout); err == nil && timeout >= 0 {
+		f.outputTimeout = time.Duration(timeout * float64(time.Second))
+	}
+	if err := f.next(0, "", fmt.Errorf("no provider configured")); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// onProviderPacket observes the packets of the provider, packets of a provider which is no longer
+// active are dropped
+func (f *failover[IN]) onProviderPacket(index int) func(...internal_type.Packet) error {
+	return func(pkts ...internal_type.Packet) error {
+		f.mu.Lock()
+		if index != f.current {
+			f.mu.Unlock()
+			return nil
+		}
+		for _, pkt := range pkts {
+			f.buffer.Observe(pkt)
+		}
+		f.stopWatchdog()
+		f.mu.Unlock()
+		return f.onPacket(pkts...)
+	}
+}
+
+// next makes the first provider from index on which can be created active, it is initialized
+// when the failover was initialized. The input buffered until then is replayed to it.
+func (f *failover[IN]) next(index int, from string, reason error) error {
+	f.mu.Lock()
+	initialized := f.initialized
+	f.mu.Unlock()
+
+	for ; index < len(f.providers); index++ {
+		provider := f.providers[index]
+		next, err := provider.Create(f.onProviderPacket(index))
+		if err != nil {
+			f.logger.Errorf("%s: unable to create %s with error %v", f.kind, provider.Name, err)
+			reason = err
+			continue
+		}
+		if initialized {
+			if err := next.Initialize(); err != nil {
+				f.logger.Errorf("%s: unable to initialize %s with error %v", f.kind, provider.Name, err)
+				reason = err
+				continue
+			}
+		}
+
+		f.mu.Lock()
+		f.stopWatchdog()
+		f.current, f.active, f.failures = index, next, 0
+		replay := f.buffer.Replay()
+		if initialized && len(replay) > 0 {
+			f.watch(next)
+		}
+		f.mu.Unlock()
+		if !initialized {
+			return nil
+		}
+		for _, in := range replay {
+			if err := next.Transform(context.Background(), in); err != nil {
+				f.logger.Warnf("%s: unable to replay input to %s with error %v", f.kind, provider.Name, err)
+			}
+		}
+		return f.onPacket(internal_type.TransformerFailoverPacket{
+			Transformer: f.kind,
+			From:        from,
+			To:          provider.Name,
+			Reason:      reason.Error(),
+			Replayed:    len(replay),
+		})
+	}
+	return fmt.Errorf("%s: no provider left, last error %w", f.kind, reason)
+}
+
+// failover switches from the failed provider to the next provider
+func (f *failover[IN]) failover(failed transformer[IN], reason error) error {
+	f.switching.Lock()
+	defer f.switching.Unlock()
+
+	f.mu.Lock()
+	if failed != f.active {
+		// switched by another input meanwhile
+		f.mu.Unlock()
+		return nil
+	}
+	current := f.current
+	f.mu.Unlock()
+
+	f.logger.Warnf("%s: %s is failing, switching to the next provider, last error %v", f.kind, f.providers[current].Name, reason)
+	if err := f.next(current+1, f.providers[current].Name, reason); err != nil {
+		return err
+	}
+	utils.Go(context.Background(), func() {
+		if err := failed.Close(context.Background()); err != nil {
+			f.logger.Warnf("%s: unable to close %s with error %v", f.kind, f.providers[current].Name, err)
+		}
+	})
+	return nil
+}
+
+// watch fails the provider over when it returns nothing for the input before the output timeout,
+// the watchdog runs from the first input on which is waiting for output. Called with mu held.
+func (f *failover[IN]) watch(active transformer[IN]) {
+	if f.outputTimeout == 0 || f.watchdog != nil || f.current+1 >= len(f.providers) {
+		return
+	}
+	var watchdog *time.Timer
+	watchdog = time.AfterFunc(f.outputTimeout, func() {
+		f.mu.Lock()
+		expired := f.watchdog == watchdog && f.active == active
+		if expired {
+			f.watchdog = nil
+		}
+		f.mu.Unlock()
+		if !expired {
+			return
+		}
+		if err := f.failover(active, fmt.Errorf("no output for %s", f.outputTimeout)); err != nil {
+			f.logger.Errorf("%s: unable to switch provider without output %v", f.kind, err)
+		}
+	})
+	f.watchdog = watchdog
+}
+
+// stopWatchdog stops waiting for output, called with mu held
+func (f *failover[IN]) stopWatchdog() {
+	if f.watchdog != nil {
+		f.watchdog.Stop()
+		f.watchdog = nil
+	}
+}
+
+func (f *failover[IN]) Name() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.active.Name()
+}
+
+// Initialize initializes the provider, a provider which cannot connect is failed over at once
+func (f *failover[IN]) Initialize() error {
+	f.mu.Lock()
+	f.initialized = true
+	active := f.active
+	f.mu.Unlock()
+
+	err := active.Initialize()
+	if err == nil {
+		return nil
+	}
+	f.logger.Errorf("%s: unable to initialize %s with error %v", f.kind, active.Name(), err)
+	return f.failover(active, err)
+}
+
+func (f *failover[IN]) Transform(ctx context.Context, in IN) error {
+	f.mu.Lock()
+	f.buffer.Add(in)
+	active := f.active
+	f.mu.Unlock()
+
+	err := active.Transform(ctx, in)
+
+	f.mu.Lock()
+	if active != f.active {
+		// switched while transforming, the input was replayed to the next provider
+		f.mu.Unlock()
+		return nil
+	}
+	if err == nil {
+		f.failures = 0
+		f.watch(active)
+		f.mu.Unlock()
+		return nil
+	}
+	f.failures++
+	if f.failures < f.maxErrors || f.current+1 >= len(f.providers) {
+		f.mu.Unlock()
+		return err
+	}
+	f.mu.Unlock()
+	return f.failover(active, err)
+}
+
+func (f *failover[IN]) Close(ctx context.Context) error {
+	f.mu.Lock()
+	f.stopWatchdog()
+	active := f.active
+	f.mu.Unlock()
+	return active.Close(ctx)
+}
+
+// audioReplay keeps the audio since the last final transcript
+type audioReplay struct {
+	packets  []internal_type.UserAudioPacket
+	size     int
+	maxBytes int
+}
+
+func (b *audioReplay) Add(in internal_type.UserAudioPacket) {
+	b.packets = append(b.packets, in)
+	b.size += len(in.Audio)
+	for b.size > b.maxBytes && len(b.packets) > 1 {
+		b.size -= len(b.packets[0].Audio)
+		b.packets = b.packets[1:]
+	}
+}
+
+func (b *audioReplay) Observe(pkt internal_type.Packet) {
+	if transcript, ok := pkt.(internal_type.SpeechToTextPacket); ok && !transcript.Interim {
+		b.packets, b.size = nil, 0
+	}
+}
+
+func (b *audioReplay) Replay() []internal_type.UserAudioPacket {
+	return append([]internal_type.UserAudioPacket(nil), b.packets...)
+}
+
+// textReplay keeps the text of the current message which was not spoken yet. Audio does not tell
+// which text it speaks, text is considered spoken once the provider ended its speech: the oldest
+// text when the message is still streamed, all of it once the message was flushed. The flush is
+// always kept so the next provider completes the message.
+type textReplay struct {
+	contextID string
+	packets   []internal_type.LLMPacket
+}
+
+func (b *textReplay) Add(in internal_type.LLMPacket) {
+	if in.ContextId() != b.contextID {
+		b.contextID, b.packets = in.ContextId(), nil
+	}
+	b.packets = append(b.packets, in)
+}
+
+func (b *textReplay) Observe(pkt internal_type.Packet) {
+	if _, ok := pkt.(internal_type.TextToSpeechEndPacket); !ok || pkt.ContextId() != b.contextID {
+		return
+	}
+	flushed := slices.ContainsFunc(b.packets, func(in internal_type.LLMPacket) bool {
+		_, ok := in.(internal_type.LLMMessagePacket)
+		return ok
+	})
+	kept := make([]internal_type.LLMPacket, 0, len(b.packets))
+	spoken := false
+	for _, in := range b.packets {
+		if _, ok := in.(internal_type.LLMStreamPacket); ok && (flushed || !spoken) {
+			spoken = true
+			continue
+		}
+		kept = append(kept, in)
+	}
+	b.packets = kept
+}
+
+func (b *textReplay) Replay() []internal_type.LLMPacket {
+	return append([]internal_type.LLMPacket(nil), b.packets...)
+}
+
+// NewSpeechToText creates a speech to text transformer which switches to the next provider once
+// the provider keeps failing, the audio since the last final transcript is sent again.
+func NewSpeechToText(logger commons.Logger, audioConfig *protos.AudioConfig, onPacket func(...internal_type.Packet) error, opts utils.Option, providers ...Provider[internal_type.SpeechToTextTransformer]) (internal_type.SpeechToTextTransformer, error) {
+	converted := make([]Provider[transformer[internal_type.UserAudioPacket]], 0, len(providers))
+	for _, provider := range providers {
+		converted = append(converted, Provider[transformer[internal_type.UserAudioPacket]]{
+			Name: provider.Name,
+			Create: func(onPacket func(...internal_type.Packet) error) (transformer[internal_type.UserAudioPacket], error) {
+				return provider.Create(onPacket)
+			},
+		})
+	}
+	return newFailover[internal_type.UserAudioPacket](logger, TransformerSpeechToText, onPacket, opts, &audioReplay{maxBytes: replayBytes(audioConfig)}, converted)
+}
+
+// NewTextToSpeech creates a text to speech transformer which switches to the next provider once
+// the provider fails, the text of the message which was not spoken yet is sent again.
+func NewTextToSpeech(logger commons.Logger, onPacket func(...internal_type.Packet) error, opts utils.Option, providers ...Provider[internal_type.TextToSpeechTransformer]) (internal_type.TextToSpeechTransformer, error) {
+	converted := make([]Provider[transformer[internal_type.LLMPacket]], 0, len(providers))
+	for _, provider := range providers {
+		converted = append(converted, Provider[transformer[internal_type.LLMPacket]]{
+			Name: provider.Name,
+			Create: func(onPacket func(...internal_type.Packet) error) (transformer[internal_type.LLMPacket], error) {
+				return provider.Create(onPacket)
+			},
+		})
+	}
+	return newFailover[internal_type.LLMPacket](logger, TransformerTextToSpeech, onPacket, opts, &textReplay{}, converted)
+}
+
+// replayBytes is the size of the replayed audio, 16khz linear audio without a config
+func replayBytes(audioConfig *protos.AudioConfig) int {
+	bytesPerSecond := internal_audio.BytesPerSecond(audioConfig)
+	if audioConfig.GetSampleRate() == 0 {
+		bytesPerSecond = internal_audio.BytesPerSecond(internal_audio.NewLinear16khzMonoAudioConfig())
+	}
+	return bytesPerSecond * int(maxReplayDuration/time.Second)
+}
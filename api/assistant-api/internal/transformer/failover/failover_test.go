@@ -0,0 +1,360 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_transformer_failover
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTransformer[IN any] struct {
+	name     string
+	onPacket func(...internal_type.Packet) error
+
+	mu            sync.Mutex
+	initializeErr error
+	transformErr  error
+	received      []IN
+	closed        bool
+}
+
+func (f *fakeTransformer[IN]) Name() string { return f.name }
+
+func (f *fakeTransformer[IN]) Initialize() error { return f.initializeErr }
+
+func (f *fakeTransformer[IN]) Transform(ctx context.Context, in IN) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.transformErr != nil {
+		return f.transformErr
+	}
+	f.received = append(f.received, in)
+	return nil
+}
+
+func (f *fakeTransformer[IN]) Close(ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	return nil
+}
+
+func (f *fakeTransformer[IN]) fail(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.transformErr = err
+}
+
+func (f *fakeTransformer[IN]) Received() []IN {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]IN(nil), f.received...)
+}
+
+type packetRecorder struct {
+	mu      sync.Mutex
+	packets []internal_type.Packet
+}
+
+func (r *packetRecorder) OnPacket(pkts ...internal_type.Packet) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.packets = append(r.packets, pkts...)
+	return nil
+}
+
+func (r *packetRecorder) Failovers() []internal_type.TransformerFailoverPacket {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var failovers []internal_type.TransformerFailoverPacket
+	for _, pkt := range r.packets {
+		if failover, ok := pkt.(internal_type.TransformerFailoverPacket); ok {
+			failovers = append(failovers, failover)
+		}
+	}
+	return failovers
+}
+
+func speechToTextProvider(fake *fakeTransformer[internal_type.UserAudioPacket]) Provider[internal_type.SpeechToTextTransformer] {
+	return Provider[internal_type.SpeechToTextTransformer]{
+		Name: fake.name,
+		Create: func(onPacket func(...internal_type.Packet) error) (internal_type.SpeechToTextTransformer, error) {
+			fake.onPacket = onPacket
+			return fake, nil
+		},
+	}
+}
+
+func textToSpeechProvider(fake *fakeTransformer[internal_type.LLMPacket]) Provider[internal_type.TextToSpeechTransformer] {
+	return Provider[internal_type.TextToSpeechTransformer]{
+		Name: fake.name,
+		Create: func(onPacket func(...internal_type.Packet) error) (internal_type.TextToSpeechTransformer, error) {
+			fake.onPacket = onPacket
+			return fake, nil
+		},
+	}
+}
+
+func audio(b byte) internal_type.UserAudioPacket {
+	return internal_type.UserAudioPacket{Audio: []byte{b, b}}
+}
+
+func TestSpeechToTextFailover(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	ctx := context.Background()
+	recorder := &packetRecorder{}
+	primary := &fakeTransformer[internal_type.UserAudioPacket]{name: "deepgram"}
+	secondary := &fakeTransformer[internal_type.UserAudioPacket]{name: "azure-speech-service"}
+
+	stt, err := NewSpeechToText(logger, &protos.AudioConfig{SampleRate: 16000}, recorder.OnPacket, utils.Option{OptionsKeyMaxErrors: 2},
+		speechToTextProvider(primary), speechToTextProvider(secondary))
+	require.NoError(t, err)
+	require.NoError(t, stt.Initialize())
+	assert.Equal(t, "deepgram", stt.Name())
+
+	require.NoError(t, stt.Transform(ctx, audio(1)))
+	// the audio before the final transcript is not replayed
+	require.NoError(t, primary.onPacket(internal_type.SpeechToTextPacket{Script: "hello"}))
+	require.NoError(t, stt.Transform(ctx, audio(2)))
+	require.NoError(t, primary.onPacket(internal_type.SpeechToTextPacket{Script: "how", Interim: true}))
+
+	primary.fail(errors.New("websocket closed"))
+	assert.Error(t, stt.Transform(ctx, audio(3)))
+	assert.NoError(t, stt.Transform(ctx, audio(4)))
+
+	assert.Equal(t, "azure-speech-service", stt.Name())
+	assert.Equal(t, []internal_type.UserAudioPacket{audio(2), audio(3), audio(4)}, secondary.Received())
+	require.NoError(t, stt.Transform(ctx, audio(5)))
+	assert.Equal(t, audio(5), secondary.Received()[3])
+
+	// transcripts of the failed provider are dropped
+	require.NoError(t, primary.onPacket(internal_type.SpeechToTextPacket{Script: "stale"}))
+	require.NoError(t, secondary.onPacket(internal_type.SpeechToTextPacket{Script: "how are you"}))
+
+	failovers := recorder.Failovers()
+	require.Len(t, failovers, 1)
+	assert.Equal(t, internal_type.TransformerFailoverPacket{
+		Transformer: TransformerSpeechToText,
+		From:        "deepgram",
+		To:          "azure-speech-service",
+		Reason:      "websocket closed",
+		Replayed:    3,
+	}, failovers[0])
+	assert.Len(t, recorder.packets, 4)
+	assert.Eventually(t, func() bool {
+		primary.mu.Lock()
+		defer primary.mu.Unlock()
+		return primary.closed
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestSpeechToTextFailoverOnInitialize(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	recorder := &packetRecorder{}
+	primary := &fakeTransformer[internal_type.UserAudioPacket]{name: "deepgram", initializeErr: errors.New("unauthorized")}
+	secondary := &fakeTransformer[internal_type.UserAudioPacket]{name: "google-speech-service"}
+
+	stt, err := NewSpeechToText(logger, &protos.AudioConfig{}, recorder.OnPacket, utils.Option{},
+		speechToTextProvider(primary), speechToTextProvider(secondary))
+	require.NoError(t, err)
+	require.NoError(t, stt.Initialize())
+	assert.Equal(t, "google-speech-service", stt.Name())
+	require.Len(t, recorder.Failovers(), 1)
+	assert.Equal(t, "unauthorized", recorder.Failovers()[0].Reason)
+}
+
+func TestSpeechToTextWithoutProviderLeft(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	ctx := context.Background()
+	recorder := &packetRecorder{}
+	primary := &fakeTransformer[internal_type.UserAudioPacket]{name: "deepgram", initializeErr: errors.New("unauthorized")}
+	secondary := &fakeTransformer[internal_type.UserAudioPacket]{name: "revai", initializeErr: errors.New("timeout")}
+
+	stt, err := NewSpeechToText(logger, &protos.AudioConfig{}, recorder.OnPacket, utils.Option{},
+		speechToTextProvider(primary), speechToTextProvider(secondary))
+	require.NoError(t, err)
+	assert.ErrorContains(t, stt.Initialize(), "timeout")
+
+	primary.initializeErr = nil
+	primary.fail(errors.New("websocket closed"))
+	for i := 0; i < 5; i++ {
+		assert.Error(t, stt.Transform(ctx, audio(byte(i))))
+	}
+	assert.Empty(t, recorder.Failovers())
+}
+
+func TestAudioReplayIsBounded(t *testing.T) {
+	buffer := &audioReplay{maxBytes: 4}
+	for i := byte(0); i < 4; i++ {
+		buffer.Add(audio(i))
+	}
+	assert.Equal(t, []internal_type.UserAudioPacket{audio(2), audio(3)}, buffer.Replay())
+
+	buffer.Observe(internal_type.SpeechToTextPacket{Script: "hi", Interim: true})
+	assert.Len(t, buffer.Replay(), 2)
+	buffer.Observe(internal_type.SpeechToTextPacket{Script: "hi"})
+	assert.Empty(t, buffer.Replay())
+}
+
+func TestTextToSpeechFailover(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	ctx := context.Background()
+	recorder := &packetRecorder{}
+	primary := &fakeTransformer[internal_type.LLMPacket]{name: "elevenlabs"}
+	secondary := &fakeTransformer[internal_type.LLMPacket]{name: "cartesia"}
+
+	tts, err := NewTextToSpeech(logger, recorder.OnPacket, utils.Option{},
+		textToSpeechProvider(primary), textToSpeechProvider(secondary))
+	require.NoError(t, err)
+	require.NoError(t, tts.Initialize())
+
+	require.NoError(t, tts.Transform(ctx, internal_type.LLMStreamPacket{ContextID: "m1", Text: "Hello."}))
+	require.NoError(t, primary.onPacket(internal_type.TextToSpeechAudioPacket{ContextID: "m1", AudioChunk: []byte{1}}))
+	require.NoError(t, primary.onPacket(internal_type.TextToSpeechEndPacket{ContextID: "m1"}))
+	require.NoError(t, tts.Transform(ctx, internal_type.LLMStreamPacket{ContextID: "m1", Text: "How can I help?"}))
+
+	// a single error switches, the text which was not spoken is spoken by the fallback
+	primary.fail(errors.New("quota exceeded"))
+	require.NoError(t, tts.Transform(ctx, internal_type.LLMStreamPacket{ContextID: "m1", Text: "Anything else?"}))
+	assert.Equal(t, []internal_type.LLMPacket{
+		internal_type.LLMStreamPacket{ContextID: "m1", Text: "How can I help?"},
+		internal_type.LLMStreamPacket{ContextID: "m1", Text: "Anything else?"},
+	}, secondary.Received())
+
+	failovers := recorder.Failovers()
+	require.Len(t, failovers, 1)
+	assert.Equal(t, TransformerTextToSpeech, failovers[0].Transformer)
+	assert.Equal(t, 2, failovers[0].Replayed)
+}
+
+func TestTextToSpeechWithoutOutput(t *testing.T) {
+	tests := []struct {
+		name     string
+		answer   bool
+		failover bool
+	}{
+		{name: "provider which answers is kept", answer: true, failover: false},
+		{name: "provider without output is failed over", answer: false, failover: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := commons.NewApplicationLogger()
+			ctx := context.Background()
+			recorder := &packetRecorder{}
+			primary := &fakeTransformer[internal_type.LLMPacket]{name: "elevenlabs"}
+			secondary := &fakeTransformer[internal_type.LLMPacket]{name: "cartesia"}
+
+			tts, err := NewTextToSpeech(logger, recorder.OnPacket, utils.Option{OptionsKeyOutputTimeout: 0.05},
+				textToSpeechProvider(primary), textToSpeechProvider(secondary))
+			require.NoError(t, err)
+			require.NoError(t, tts.Initialize())
+
+			require.NoError(t, tts.Transform(ctx, internal_type.LLMStreamPacket{ContextID: "m1", Text: "Hello."}))
+			if tt.answer {
+				require.NoError(t, primary.onPacket(internal_type.TextToSpeechAudioPacket{ContextID: "m1", AudioChunk: []byte{1}}))
+			}
+
+			if !tt.failover {
+				time.Sleep(100 * time.Millisecond)
+				assert.Empty(t, recorder.Failovers())
+				assert.Equal(t, "elevenlabs", tts.Name())
+				return
+			}
+			assert.Eventually(t, func() bool { return len(recorder.Failovers()) == 1 }, time.Second, 10*time.Millisecond)
+			assert.Equal(t, "cartesia", tts.Name())
+			assert.Equal(t, "no output for 50ms", recorder.Failovers()[0].Reason)
+			assert.Equal(t, []internal_type.LLMPacket{internal_type.LLMStreamPacket{ContextID: "m1", Text: "Hello."}}, secondary.Received())
+		})
+	}
+}
+
+func TestTextReplayOfNewMessage(t *testing.T) {
+	buffer := &textReplay{}
+	buffer.Add(internal_type.LLMStreamPacket{ContextID: "m1", Text: "Hello."})
+	buffer.Add(internal_type.LLMStreamPacket{ContextID: "m2", Text: "Bye."})
+	assert.Equal(t, []internal_type.LLMPacket{internal_type.LLMStreamPacket{ContextID: "m2", Text: "Bye."}}, buffer.Replay())
+
+	// audio of a previous message does not mark the text as spoken
+	buffer.Observe(internal_type.TextToSpeechAudioPacket{ContextID: "m1"})
+	assert.Len(t, buffer.Replay(), 1)
+	buffer.Observe(internal_type.TextToSpeechEndPacket{ContextID: "m2"})
+	assert.Empty(t, buffer.Replay())
+}
+
+func TestTextReplayOfSpokenText(t *testing.T) {
+	hello := internal_type.LLMStreamPacket{ContextID: "m1", Text: "Hello."}
+	help := internal_type.LLMStreamPacket{ContextID: "m1", Text: "How can I help?"}
+	flush := internal_type.LLMMessagePacket{ContextID: "m1"}
+
+	t.Run("audio does not mark the text as spoken", func(t *testing.T) {
+		buffer := &textReplay{}
+		buffer.Add(hello)
+		buffer.Add(help)
+		buffer.Observe(internal_type.TextToSpeechAudioPacket{ContextID: "m1"})
+		assert.Equal(t, []internal_type.LLMPacket{hello, help}, buffer.Replay())
+	})
+
+	t.Run("end of speech marks the oldest text as spoken", func(t *testing.T) {
+		buffer := &textReplay{}
+		buffer.Add(hello)
+		buffer.Add(help)
+		buffer.Observe(internal_type.TextToSpeechEndPacket{ContextID: "m1"})
+		assert.Equal(t, []internal_type.LLMPacket{help}, buffer.Replay())
+	})
+
+	t.Run("flush is kept while text is not spoken", func(t *testing.T) {
+		buffer := &textReplay{}
+		buffer.Add(hello)
+		buffer.Add(help)
+		buffer.Add(flush)
+		buffer.Observe(internal_type.TextToSpeechAudioPacket{ContextID: "m1"})
+		assert.Equal(t, []internal_type.LLMPacket{hello, help, flush}, buffer.Replay())
+	})
+
+	t.Run("end of a flushed message marks all text as spoken", func(t *testing.T) {
+		buffer := &textReplay{}
+		buffer.Add(hello)
+		buffer.Add(help)
+		buffer.Add(flush)
+		buffer.Observe(internal_type.TextToSpeechEndPacket{ContextID: "m1"})
+		assert.Equal(t, []internal_type.LLMPacket{flush}, buffer.Replay())
+	})
+}
+
+func TestFallbackOptions(t *testing.T) {
+	opts := utils.Option{
+		"rapida.credential_id":   uint64(1),
+		"listen.language":        "en-US",
+		"listen.model":           "nova-2",
+		"fallback.listen.model":  "latest_long",
+		OptionsKeyProvider:       "google-speech-service",
+		OptionsKeyCredential:     uint64(2),
+		OptionsKeyMaxErrors:      3,
+		"microphone.eos.timeout": 500,
+	}
+	assert.Equal(t, utils.Option{
+		"rapida.credential_id":   uint64(2),
+		"listen.language":        "en-US",
+		"listen.model":           "latest_long",
+		OptionsKeyMaxErrors:      3,
+		"microphone.eos.timeout": 500,
+	}, FallbackOptions(opts))
+}
+
+func TestReplayBytes(t *testing.T) {
+	assert.Equal(t, 8000*30, replayBytes(&protos.AudioConfig{SampleRate: 8000, AudioFormat: protos.AudioConfig_MuLaw8}))
+	assert.Equal(t, 16000*2*30, replayBytes(&protos.AudioConfig{SampleRate: 16000, AudioFormat: protos.AudioConfig_LINEAR16}))
+}
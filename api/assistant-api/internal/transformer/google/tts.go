@@ -14,6 +14,7 @@ import (
 
 	texttospeech "cloud.google.com/go/texttospeech/apiv1"
 	"cloud.google.com/go/texttospeech/apiv1/texttospeechpb"
+	internal_normalizers "github.com/rapidaai/api/assistant-api/internal/normalizers"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/utils"
@@ -33,6 +34,9 @@ type googleTextToSpeech struct {
 	client       *texttospeech.Client                                  // Google TTS client.
 	streamClient texttospeechpb.TextToSpeech_StreamingSynthesizeClient // Streaming client for real-time TTS.
 	onPacket     func(pkt ...internal_type.Packet) error               // Callback for handling audio packets.
+
+	// pronunciation lexicon, spoken as its aliases and respellings
+	lexicon internal_normalizers.Normalizer
 }
 
 // Name returns the name of this transformer implementation.
@@ -70,6 +74,7 @@ func NewGoogleTextToSpeech(ctx context.Context, logger commons.Logger, credentia
 		onPacket:     onPacket,
 		client:       client,
 		googleOption: googleOption,
+		lexicon:      internal_type.BuildLexiconNormalizer(logger, opts, false),
 	}, nil
 }
 
@@ -140,7 +145,7 @@ func (google *googleTextToSpeech) Transform(ctx context.Context, in internal_typ
 		if err := sCli.Send(&texttospeechpb.StreamingSynthesizeRequest{
 			StreamingRequest: &texttospeechpb.StreamingSynthesizeRequest_Input{
 				Input: &texttospeechpb.StreamingSynthesisInput{
-					InputSource: &texttospeechpb.StreamingSynthesisInput_Text{Text: input.Text},
+					InputSource: &texttospeechpb.StreamingSynthesisInput_Text{Text: google.respell(input.Text)},
 				},
 			},
 		}); err != nil {
@@ -234,3 +239,11 @@ func (g *googleTextToSpeech) Close(ctx context.Context) error {
 	}
 	return combinedErr
 }
+
+// respell speaks the entries of the pronunciation lexicon as their alias or respelling
+func (google *googleTextToSpeech) respell(text string) string {
+	if google.lexicon == nil {
+		return text
+	}
+	return google.lexicon.Normalize(text)
+}
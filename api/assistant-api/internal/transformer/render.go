@@ -0,0 +1,86 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_transformer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+const renderTimeout = 30 * time.Second
+
+// RenderTextToSpeech synthesizes the text once outside of a conversation and returns the complete
+// audio, it is used to render audio ahead of time. The audio is complete once the provider ends
+// the speech, audio of providers which do not tell is never returned as it may be cut off.
+func RenderTextToSpeech(ctx context.Context,
+	logger commons.Logger,
+	provider string,
+	credential *protos.VaultCredential,
+	audioConfig *protos.AudioConfig,
+	opts utils.Option,
+	text string) ([]byte, error) {
+	const contextId = "render"
+
+	var (
+		mu    sync.Mutex
+		audio bytes.Buffer
+	)
+	completed := make(chan struct{})
+	var once sync.Once
+
+	ctx, cancel := context.WithTimeout(ctx, renderTimeout)
+	defer cancel()
+
+	transformer, err := GetTextToSpeechTransformer(ctx, logger, provider, credential, audioConfig, func(pkts ...internal_type.Packet) error {
+		for _, pkt := range pkts {
+			switch vl := pkt.(type) {
+			case internal_type.TextToSpeechAudioPacket:
+				mu.Lock()
+				audio.Write(vl.AudioChunk)
+				mu.Unlock()
+			case internal_type.TextToSpeechEndPacket:
+				once.Do(func() { close(completed) })
+			}
+		}
+		return nil
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := transformer.Initialize(); err != nil {
+		return nil, err
+	}
+	defer transformer.Close(context.Background())
+
+	if err := transformer.Transform(ctx, internal_type.LLMStreamPacket{ContextID: contextId, Text: text}); err != nil {
+		return nil, err
+	}
+	if err := transformer.Transform(ctx, internal_type.LLMMessagePacket{ContextID: contextId}); err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-completed:
+	case <-ctx.Done():
+		return nil, errors.New("speech did not end")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if audio.Len() == 0 {
+		return nil, errors.New("no audio synthesized")
+	}
+	return bytes.Clone(audio.Bytes()), nil
+}
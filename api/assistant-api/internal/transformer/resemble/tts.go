@@ -15,6 +15,7 @@ import (
 	"sync"
 
 	"github.com/gorilla/websocket"
+	internal_normalizers "github.com/rapidaai/api/assistant-api/internal/normalizers"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/utils"
@@ -35,6 +36,9 @@ type resembleTTS struct {
 
 	logger   commons.Logger
 	onPacket func(pkt ...internal_type.Packet) error
+
+	// pronunciation lexicon, spoken as its aliases and respellings
+	lexicon internal_normalizers.Normalizer
 }
 
 func NewResembleTextToSpeech(
@@ -58,6 +62,7 @@ func NewResembleTextToSpeech(
 		ctxCancel:      ctxCancel,
 		logger:         logger,
 		onPacket:       onPacket,
+		lexicon:        internal_type.BuildLexiconNormalizer(logger, opts, false),
 	}, nil
 }
 
@@ -162,7 +167,7 @@ func (rt *resembleTTS) Transform(ctx context.Context, in internal_type.LLMPacket
 
 	switch input := in.(type) {
 	case internal_type.LLMStreamPacket:
-		if err := connection.WriteJSON(rt.GetTextToSpeechRequest(currentCtx, input.Text)); err != nil {
+		if err := connection.WriteJSON(rt.GetTextToSpeechRequest(currentCtx, rt.respell(input.Text))); err != nil {
 			rt.logger.Errorf("resemble-tts: error while writing request to websocket: %v", err)
 			return err
 		}
@@ -188,3 +193,11 @@ func (rt *resembleTTS) Close(ctx context.Context) error {
 
 	return nil
 }
+
+// respell speaks the entries of the pronunciation lexicon as their alias or respelling
+func (rt *resembleTTS) respell(text string) string {
+	if rt.lexicon == nil {
+		return text
+	}
+	return rt.lexicon.Normalize(text)
+}
@@ -14,6 +14,7 @@ import (
 	"sync"
 
 	"github.com/gorilla/websocket"
+	internal_normalizers "github.com/rapidaai/api/assistant-api/internal/normalizers"
 	sarvam_internal "github.com/rapidaai/api/assistant-api/internal/transformer/sarvam/internal"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
@@ -33,6 +34,9 @@ type sarvamTextToSpeech struct {
 
 	logger   commons.Logger
 	onPacket func(pkt ...internal_type.Packet) error
+
+	// pronunciation lexicon, spoken as its aliases and respellings
+	lexicon internal_normalizers.Normalizer
 }
 
 func NewSarvamTextToSpeech(ctx context.Context, logger commons.Logger, credential *protos.VaultCredential,
@@ -51,6 +55,7 @@ func NewSarvamTextToSpeech(ctx context.Context, logger commons.Logger, credentia
 		logger:       logger,
 		sarvamOption: sarvamOpts,
 		onPacket:     onPacket,
+		lexicon:      internal_type.BuildLexiconNormalizer(logger, opts, false),
 	}, nil
 }
 
@@ -165,7 +170,7 @@ func (rt *sarvamTextToSpeech) Transform(ctx context.Context, in internal_type.LL
 		if err := connection.WriteJSON(map[string]interface{}{
 			"type": "text",
 			"data": map[string]interface{}{
-				"text": input.Text,
+				"text": rt.respell(input.Text),
 			},
 		}); err != nil {
 			rt.logger.Errorf("sarvam-tts: error writing text message to websocket: %v", err)
@@ -198,3 +203,11 @@ func (rt *sarvamTextToSpeech) Close(ctx context.Context) error {
 
 	return nil
 }
+
+// respell speaks the entries of the pronunciation lexicon as their alias or respelling
+func (rt *sarvamTextToSpeech) respell(text string) string {
+	if rt.lexicon == nil {
+		return text
+	}
+	return rt.lexicon.Normalize(text)
+}
@@ -15,6 +15,7 @@ import (
 	internal_transformer_cartesia "github.com/rapidaai/api/assistant-api/internal/transformer/cartesia"
 	internal_transformer_deepgram "github.com/rapidaai/api/assistant-api/internal/transformer/deepgram"
 	internal_transformer_elevenlabs "github.com/rapidaai/api/assistant-api/internal/transformer/elevenlabs"
+	internal_transformer_failover "github.com/rapidaai/api/assistant-api/internal/transformer/failover"
 	internal_transformer_google "github.com/rapidaai/api/assistant-api/internal/transformer/google"
 	internal_transformer_revai "github.com/rapidaai/api/assistant-api/internal/transformer/revai"
 	internal_transformer_sarvam "github.com/rapidaai/api/assistant-api/internal/transformer/sarvam"
@@ -49,7 +50,43 @@ func (at AudioTransformer) String() string {
 // // options of model
 // ModelOptions utils.Option
 
+// Fallback is a provider the transformer switches to when the provider before it fails during
+// the conversation
+type Fallback struct {
+	Provider   string
+	Credential *protos.VaultCredential
+	Options    utils.Option
+}
+
+// GetTextToSpeechTransformer creates the text to speech transformer of the provider, with fallbacks
+// the transformer switches to the next provider when the provider fails.
 func GetTextToSpeechTransformer(ctx context.Context,
+	logger commons.Logger,
+	provider string,
+	credential *protos.VaultCredential,
+	audioConfig *protos.AudioConfig, onPacket func(pkt ...internal_type.Packet) error,
+	opts utils.Option, fallbacks ...Fallback) (internal_type.TextToSpeechTransformer, error) {
+	if len(fallbacks) == 0 {
+		return getTextToSpeechTransformer(ctx, logger, provider, credential, audioConfig, onPacket, opts)
+	}
+	providers := make([]internal_transformer_failover.Provider[internal_type.TextToSpeechTransformer], 0, len(fallbacks)+1)
+	providers = append(providers, textToSpeechProvider(ctx, logger, provider, credential, audioConfig, opts))
+	for _, fallback := range fallbacks {
+		providers = append(providers, textToSpeechProvider(ctx, logger, fallback.Provider, fallback.Credential, audioConfig, fallback.Options))
+	}
+	return internal_transformer_failover.NewTextToSpeech(logger, onPacket, opts, providers...)
+}
+
+func textToSpeechProvider(ctx context.Context, logger commons.Logger, provider string, credential *protos.VaultCredential, audioConfig *protos.AudioConfig, opts utils.Option) internal_transformer_failover.Provider[internal_type.TextToSpeechTransformer] {
+	return internal_transformer_failover.Provider[internal_type.TextToSpeechTransformer]{
+		Name: provider,
+		Create: func(onPacket func(...internal_type.Packet) error) (internal_type.TextToSpeechTransformer, error) {
+			return getTextToSpeechTransformer(ctx, logger, provider, credential, audioConfig, onPacket, opts)
+		},
+	}
+}
+
+func getTextToSpeechTransformer(ctx context.Context,
 	logger commons.Logger,
 	provider string,
 	credential *protos.VaultCredential,
@@ -75,6 +112,9 @@ func GetTextToSpeechTransformer(ctx context.Context,
 	}
 }
 
+// GetSpeechToTextTransformer creates the speech to text transformer of the provider, with fallbacks
+// the transformer switches to the next provider when the provider fails and the audio since the
+// last final transcript is transcribed again.
 func GetSpeechToTextTransformer(ctx context.Context,
 	logger commons.Logger,
 	provider string,
@@ -82,6 +122,35 @@ func GetSpeechToTextTransformer(ctx context.Context,
 	audioConfig *protos.AudioConfig,
 	onPacket func(pkt ...internal_type.Packet) error,
 	opts utils.Option,
+	fallbacks ...Fallback,
+) (internal_type.SpeechToTextTransformer, error) {
+	if len(fallbacks) == 0 {
+		return getSpeechToTextTransformer(ctx, logger, provider, credential, audioConfig, onPacket, opts)
+	}
+	providers := make([]internal_transformer_failover.Provider[internal_type.SpeechToTextTransformer], 0, len(fallbacks)+1)
+	providers = append(providers, speechToTextProvider(ctx, logger, provider, credential, audioConfig, opts))
+	for _, fallback := range fallbacks {
+		providers = append(providers, speechToTextProvider(ctx, logger, fallback.Provider, fallback.Credential, audioConfig, fallback.Options))
+	}
+	return internal_transformer_failover.NewSpeechToText(logger, audioConfig, onPacket, opts, providers...)
+}
+
+func speechToTextProvider(ctx context.Context, logger commons.Logger, provider string, credential *protos.VaultCredential, audioConfig *protos.AudioConfig, opts utils.Option) internal_transformer_failover.Provider[internal_type.SpeechToTextTransformer] {
+	return internal_transformer_failover.Provider[internal_type.SpeechToTextTransformer]{
+		Name: provider,
+		Create: func(onPacket func(...internal_type.Packet) error) (internal_type.SpeechToTextTransformer, error) {
+			return getSpeechToTextTransformer(ctx, logger, provider, credential, audioConfig, onPacket, opts)
+		},
+	}
+}
+
+func getSpeechToTextTransformer(ctx context.Context,
+	logger commons.Logger,
+	provider string,
+	credential *protos.VaultCredential,
+	audioConfig *protos.AudioConfig,
+	onPacket func(pkt ...internal_type.Packet) error,
+	opts utils.Option,
 ) (internal_type.SpeechToTextTransformer, error) {
 	switch AudioTransformer(provider) {
 	case DEEPGRAM:
@@ -0,0 +1,65 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_type
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// SourceAllowList is the addresses and networks calls of a telephony without credentials of its
+// own are accepted from
+type SourceAllowList []*net.IPNet
+
+// NewSourceAllowList parses comma separated addresses and networks, e.g. 203.0.113.7,10.0.0.0/8
+func NewSourceAllowList(allowFrom string) (SourceAllowList, error) {
+	var networks SourceAllowList
+	for _, source := range strings.Split(allowFrom, ",") {
+		source = strings.TrimSpace(source)
+		if source == "" {
+			continue
+		}
+		if !strings.Contains(source, "/") {
+			ip := net.ParseIP(source)
+			if ip == nil {
+				return nil, fmt.Errorf("illegal address %s in allow list", source)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(source)
+		if err != nil {
+			return nil, fmt.Errorf("illegal network %s in allow list: %w", source, err)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// Allows tells whether the address is within the list
+func (l SourceAllowList) Allows(addr net.Addr) bool {
+	var ip net.IP
+	switch a := addr.(type) {
+	case *net.UDPAddr:
+		ip = a.IP
+	case *net.TCPAddr:
+		ip = a.IP
+	default:
+		return false
+	}
+	for _, network := range l {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_type
+
+import (
+	"context"
+	"net"
+)
+
+// AudioSocketHandler connects the audio sockets asterisk opens for its calls to assistants
+type AudioSocketHandler interface {
+	// Connected runs the assistant of the conversation which was given the uuid of the audio
+	// socket as telephony.uuid when the socket was opened from an allowed source, it returns once
+	// the call has ended
+	Connected(ctx context.Context, uuid string, source net.Addr)
+}
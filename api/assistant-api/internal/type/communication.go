@@ -40,7 +40,7 @@ type Logger interface {
 	CreateConversationToolLog(messageid string, in, out map[string]interface{}, metrics []*types.Metric) error
 	CreateWebhookLog(webhookID uint64, httpUrl, httpMethod, event string, responseStatus int64, timeTaken int64, retryCount uint32, status type_enums.RecordState, request, response []byte) error
 	CreateToolLog(toolId uint64, messageId string, toolName string, executionMethod string, status type_enums.RecordState, timeTaken int64, request, response []byte) error
-	CreateConversationRecording(body []byte) error
+	CreateConversationRecording(recording *Recording) error
 }
 
 type Communication interface {
@@ -72,6 +72,9 @@ type Communication interface {
 	// deployment behavior
 	GetBehavior() (*internal_assistant_entity.AssistantDeploymentBehavior, error)
 
+	// sends a sms to the number with the sms deployment of the assistant
+	SendSms(ctx context.Context, to, text string) error
+
 	// current conversation
 	Conversation() *internal_conversation_entity.AssistantConversation
 
@@ -95,4 +98,24 @@ type Communication interface {
 		filter map[string]interface{},
 		kc *KnowledgeRetrieveOption,
 	) ([]KnowledgeContextResult, error)
+
+	// tabular knowledge, rows are matched exactly instead of searched
+	GetKnowledgeTable(knowledgeId uint64, knowledgeDocumentId uint64) (*internal_knowledge_gorm.KnowledgeTable, error)
+
+	QueryKnowledgeTable(
+		table *internal_knowledge_gorm.KnowledgeTable,
+		conversationMessageId string,
+		query *KnowledgeTableQuery,
+		maxLimit uint32,
+	) ([]map[string]interface{}, error)
+
+	// retrieval for knowledge attached to the assistant, injected into the prompt
+	// without a tool call, ctx bounds the latency of retrieval
+	RetrieveAssistantKnowledge(
+		ctx context.Context,
+		knowledge *internal_knowledge_gorm.Knowledge,
+		conversationMessageId string,
+		query string,
+		kc *KnowledgeRetrieveOption,
+	) ([]KnowledgeContextResult, error)
 }
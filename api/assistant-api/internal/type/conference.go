@@ -0,0 +1,85 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_type
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/rapidaai/pkg/utils"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ConferenceMode is how the assistant takes part in the conference of the caller with a human agent
+type ConferenceMode string
+
+const (
+	// the assistant hears the call, nobody hears the assistant. twilio only forks the voice of the
+	// caller to the assistant, the agent is not heard
+	ConferenceModeListen ConferenceMode = "listen"
+	// only the agent hears the assistant, the assistant suggests while the agent talks to the caller
+	ConferenceModeWhisper ConferenceMode = "whisper"
+	// the caller and the agent both hear the assistant
+	ConferenceModeSpeak ConferenceMode = "speak"
+)
+
+// ErrConferenceNotSupported is returned by the telephony which can not put the call in the mode
+var ErrConferenceNotSupported = errors.New("conference mode is not supported by the telephony")
+
+// conference modes the telephony can put its calls in, twilio can not dial the websocket of the
+// assistant into a conference and only listens. the other telephony do not conference
+var conferenceModes = map[string][]ConferenceMode{
+	"vonage": {ConferenceModeListen, ConferenceModeWhisper, ConferenceModeSpeak},
+	"twilio": {ConferenceModeListen},
+}
+
+// NewConferenceMode validates the mode of the conference
+func NewConferenceMode(mode string) (ConferenceMode, error) {
+	switch ConferenceMode(mode) {
+	case ConferenceModeListen, ConferenceModeWhisper, ConferenceModeSpeak:
+		return ConferenceMode(mode), nil
+	default:
+		return "", fmt.Errorf("illegal conference mode %s", mode)
+	}
+}
+
+// IsConferenceSupported tells whether the telephony can put its calls in the conference mode
+func IsConferenceSupported(provider string, mode ConferenceMode) bool {
+	return slices.Contains(conferenceModes[provider], mode)
+}
+
+// DefaultConferenceMode is the mode of a conference without one, whisper where the telephony
+// supports it and listen otherwise
+func DefaultConferenceMode(provider string) ConferenceMode {
+	if IsConferenceSupported(provider, ConferenceModeWhisper) {
+		return ConferenceModeWhisper
+	}
+	return ConferenceModeListen
+}
+
+// ConferenceName is the room at the provider the caller, the agent and the assistant meet in
+func ConferenceName(assistantConversationId uint64) string {
+	return fmt.Sprintf("rapida-%d", assistantConversationId)
+}
+
+// ConferenceArgs reads the agent and the mode of a conference action, the agent is empty when only
+// the mode of the running conference changes
+func ConferenceArgs(args map[string]*anypb.Any) (string, ConferenceMode, error) {
+	var to, mode string
+	if v, ok := args["to"]; ok {
+		to, _ = utils.AnyToString(v)
+	}
+	if v, ok := args["mode"]; ok {
+		mode, _ = utils.AnyToString(v)
+	}
+	conferenceMode, err := NewConferenceMode(mode)
+	if err != nil {
+		return "", "", err
+	}
+	return to, conferenceMode, nil
+}
@@ -12,6 +12,7 @@ import (
 
 	internal_normalizers "github.com/rapidaai/api/assistant-api/internal/normalizers"
 	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
 )
 
 // =============================================================================
@@ -61,8 +62,13 @@ func DefaultNormalizerConfig() NormalizerConfig {
 	}
 }
 
-func BuildNormalizerPipeline(logger commons.Logger, names []string) []internal_normalizers.Normalizer {
+// BuildNormalizerPipeline creates the named normalizers for the language of the speaker. Numbers,
+// currencies, dates, times and abbreviations are read in Hindi, Spanish, German and French, and
+// with Indian numbering for Indian English. Any other language is normalized as English.
+func BuildNormalizerPipeline(logger commons.Logger, language string, names []string) []internal_normalizers.Normalizer {
 	normalizers := make([]internal_normalizers.Normalizer, 0, len(names))
+	language = internal_normalizers.NormalizerLanguage(language)
+	localized := language != internal_normalizers.LanguageEnglish
 
 	for _, name := range names {
 		name = strings.TrimSpace(strings.ToLower(name))
@@ -72,23 +78,48 @@ func BuildNormalizerPipeline(logger commons.Logger, names []string) []internal_n
 		case "url":
 			normalizer = internal_normalizers.NewUrlNormalizer(logger)
 		case "currency":
-			normalizer = internal_normalizers.NewCurrencyNormalizer(logger)
+			if localized {
+				normalizer = internal_normalizers.NewLocalizedCurrencyNormalizer(logger, language)
+			} else {
+				normalizer = internal_normalizers.NewCurrencyNormalizer(logger)
+			}
 		case "date":
-			normalizer = internal_normalizers.NewDateNormalizer(logger)
+			if localized {
+				normalizer = internal_normalizers.NewLocalizedDateNormalizer(logger, language)
+			} else {
+				normalizer = internal_normalizers.NewDateNormalizer(logger)
+			}
 		case "time":
-			normalizer = internal_normalizers.NewTimeNormalizer(logger)
+			if localized {
+				normalizer = internal_normalizers.NewLocalizedTimeNormalizer(logger, language)
+			} else {
+				normalizer = internal_normalizers.NewTimeNormalizer(logger)
+			}
 		case "number", "number-to-word":
-			normalizer = internal_normalizers.NewNumberToWordNormalizer(logger)
+			if localized {
+				normalizer = internal_normalizers.NewLocalizedNumberNormalizer(logger, language)
+			} else {
+				normalizer = internal_normalizers.NewNumberToWordNormalizer(logger)
+			}
 		case "symbol":
 			normalizer = internal_normalizers.NewSymbolNormalizer(logger)
 		case "general-abbreviation", "general":
-			normalizer = internal_normalizers.NewGeneralAbbreviationNormalizer(logger)
+			// Indian English shares the English abbreviations
+			if localized && language != internal_normalizers.LanguageIndianEnglish {
+				normalizer = internal_normalizers.NewLocalizedAbbreviationNormalizer(logger, language)
+			} else {
+				normalizer = internal_normalizers.NewGeneralAbbreviationNormalizer(logger)
+			}
 		case "role-abbreviation", "role":
 			normalizer = internal_normalizers.NewRoleAbbreviationNormalizer(logger)
 		case "tech-abbreviation", "tech":
 			normalizer = internal_normalizers.NewTechAbbreviationNormalizer(logger)
 		case "address":
-			normalizer = internal_normalizers.NewAddressNormalizer(logger)
+			if localized && language != internal_normalizers.LanguageIndianEnglish {
+				normalizer = internal_normalizers.NewLocalizedAddressNormalizer(logger, language)
+			} else {
+				normalizer = internal_normalizers.NewAddressNormalizer(logger)
+			}
 		default:
 			logger.Warnf("normalizer: unknown normalizer '%s', skipping", name)
 			continue
@@ -97,3 +128,22 @@ func BuildNormalizerPipeline(logger commons.Logger, names []string) []internal_n
 	}
 	return normalizers
 }
+
+// BuildLexiconNormalizer creates the normalizer of the speaker.pronunciation.lexicon option, entries
+// are emitted as SSML elements when the provider speaks SSML. It returns nil without a lexicon.
+// Providers which do not speak SSML apply it to the text they speak, with its aliases and respellings.
+func BuildLexiconNormalizer(logger commons.Logger, opts utils.Option, ssml bool) internal_normalizers.Normalizer {
+	raw, err := opts.GetString("speaker.pronunciation.lexicon")
+	if err != nil || strings.TrimSpace(raw) == "" {
+		return nil
+	}
+	entries, err := internal_normalizers.ParseLexicon(raw)
+	if err != nil {
+		logger.Warnf("normalizer: %v, skipping", err)
+		return nil
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	return internal_normalizers.NewLexiconNormalizer(logger, entries, ssml)
+}
@@ -142,6 +142,22 @@ func (f LLMToolPacket) ContextId() string {
 	return f.ContextID
 }
 
+// LLMToolCallPacket is sent when a tool starts executing, before its result is available
+type LLMToolCallPacket struct {
+	// name of tool which user has configured
+	Name string
+
+	// contextID identifies the context to be flushed.
+	ContextID string
+
+	// spoken while the tool is executing, empty when the tool has no filler message
+	Filler string
+}
+
+func (f LLMToolCallPacket) ContextId() string {
+	return f.ContextID
+}
+
 // =============================================================================
 // LLM Packets end
 // =============================================================================
@@ -192,6 +208,30 @@ func (f TextToSpeechEndPacket) ContextId() string {
 	return f.ContextID
 }
 
+// TransformerFailoverPacket is sent when a speech transformer switches to the fallback provider
+// because the provider failed during the conversation.
+type TransformerFailoverPacket struct {
+	// contextID of the conversation, the switch is not bound to a message
+	ContextID string
+
+	// speech-to-text or text-to-speech
+	Transformer string
+
+	// provider which failed and provider which took over
+	From string
+	To   string
+
+	// error of the failed provider
+	Reason string
+
+	// input sent again to the provider which took over
+	Replayed int
+}
+
+func (f TransformerFailoverPacket) ContextId() string {
+	return f.ContextID
+}
+
 // =============================================================================
 // User Packet
 // =============================================================================
@@ -237,6 +277,19 @@ func (f UserAudioPacket) Role() string {
 	return "user"
 }
 
+// UserDtmfPacket is a keypad digit pressed by the user on a call
+type UserDtmfPacket struct {
+	// contextID identifies the context to be flushed.
+	ContextID string
+
+	// digit 0-9, * or #
+	Digit string
+}
+
+func (f UserDtmfPacket) ContextId() string {
+	return f.ContextID
+}
+
 // =============================================================================
 // End of speech Packet
 // =============================================================================
@@ -289,3 +342,22 @@ type KnowledgeContextResult struct {
 	Content    string                 `json:"content"`
 	Score      float64                `json:"score"`
 }
+
+// operators a filter of a tabular knowledge query can use
+var KnowledgeTableOperators = []string{"eq", "neq", "gt", "gte", "lt", "lte", "contains", "in"}
+
+// KnowledgeTableFilter is a condition on a single column of a tabular knowledge
+type KnowledgeTableFilter struct {
+	Column   string      `json:"column"`
+	Operator string      `json:"operator"`
+	Value    interface{} `json:"value"`
+}
+
+// KnowledgeTableQuery selects rows of a tabular knowledge, all filters must match
+type KnowledgeTableQuery struct {
+	Columns    []string               `json:"columns"`
+	Filters    []KnowledgeTableFilter `json:"filters"`
+	OrderBy    string                 `json:"order_by"`
+	Descending bool                   `json:"descending"`
+	Limit      uint32                 `json:"limit"`
+}
@@ -7,7 +7,52 @@ package internal_type
 
 import "context"
 
+// Recording holds the wav files produced for a conversation.
+type Recording struct {
+	// Mixed is the full conversation, mono or stereo with the user on the
+	// left and the assistant on the right channel
+	Mixed []byte
+
+	// Channels of the mixed recording
+	Channels uint32
+
+	// User and Assistant are the per speaker recordings, only set when split
+	// tracks are requested. Both are aligned to the same timeline as Mixed.
+	User      []byte
+	Assistant []byte
+
+	// Source is the telephony provider which made the recording, empty for the recordings of the
+	// assistant. Format is the extension of the file, wav when empty.
+	Source string
+	Format string
+}
+
+// Recorder captures the audio of a conversation. Persist returns the recording
+// once the conversation ends, a nil recording means the recorder has already
+// written it to storage while the conversation was in progress.
 type Recorder interface {
 	Record(context.Context, Packet) error
-	Persist() ([]byte, error)
+	Persist() (*Recording, error)
+}
+
+// RecordingTrack identifies one of the files of a recording
+type RecordingTrack string
+
+const (
+	RecordingTrackMixed     RecordingTrack = "mixed"
+	RecordingTrackUser      RecordingTrack = "user"
+	RecordingTrackAssistant RecordingTrack = "assistant"
+)
+
+// RecordingWriter streams the tracks of a recording to storage while the
+// conversation is in progress. Segments of a track are written in order and
+// become a single file once the writer is closed.
+type RecordingWriter interface {
+	Write(ctx context.Context, track RecordingTrack, segment []byte) error
+
+	// Close finalizes every written track into its file
+	Close(ctx context.Context) error
+
+	// Abort discards everything written so far
+	Abort(ctx context.Context) error
 }
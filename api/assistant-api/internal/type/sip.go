@@ -0,0 +1,63 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_type
+
+import (
+	"context"
+
+	"github.com/rapidaai/pkg/types"
+)
+
+// SipCall is the signalling of a call of the sip telephony, the audio reaches the assistant
+// through the streamer of the telephony
+type SipCall interface {
+	// call id of the dialog, it is kept as telephony.uuid of the conversation
+	Id() string
+
+	// user of the request uri, the number or account the call was placed to
+	User() string
+
+	// parameter of the request uri
+	Param(name string) string
+
+	// header of the invite
+	Header(name string) string
+
+	// user of the calling and the called party
+	From() string
+	To() string
+
+	// dial of an outbound call, nil for an inbound call
+	Outbound() *SipDial
+
+	// Answer accepts an inbound call
+	Answer() error
+
+	// Reject declines an inbound call with the status code
+	Reject(statusCode int, reason string) error
+
+	Hangup() error
+}
+
+// SipDial is the conversation an outbound call was placed for
+type SipDial struct {
+	Auth                    types.SimplePrinciple
+	AssistantId             uint64
+	AssistantConversationId uint64
+}
+
+// SipCallHandler connects the calls of the sip user agent to assistants
+type SipCallHandler interface {
+	// Invite routes an inbound call, the handler answers or rejects it
+	Invite(ctx context.Context, call SipCall)
+
+	// Answered starts the conversation of an outbound call once the callee answered
+	Answered(ctx context.Context, call SipCall)
+
+	// Ended reports how an outbound call ended, completed, busy, no-answer or failed
+	Ended(ctx context.Context, call SipCall, status string, reason string)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_type
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/protos"
+)
+
+// any sms integration must impliment this interface to provide consistent behaviour
+type Sms interface {
+	// verifies the webhook was sent by the provider with the keys of the vault credential, the
+	// body of the request can be read as it is restored for the handler
+	VerifyWebhook(c *gin.Context, vaultCredential *protos.VaultCredential) error
+
+	// messages of the webhook, delivery receipts are not messages
+	ReceiveMessage(c *gin.Context) ([]*SmsMessage, error)
+
+	// acknowledges the webhook once its messages are taken, replies are sent with SendMessage
+	Acknowledge(c *gin.Context)
+
+	// sends the text from the number of the deployment to the user
+	SendMessage(ctx context.Context, vaultCredential *protos.VaultCredential, from, to, text string) error
+}
+
+// SmsMessage is a message of a user to the number of the deployment
+type SmsMessage struct {
+	Id string
+	// number of the user
+	From string
+	// number of the deployment, the replies are sent from it
+	To   string
+	Text string
+	Time time.Time
+}
+
+// TextMessage is the message the text session of the user receives
+func (m *SmsMessage) TextMessage() *TextMessage {
+	return &TextMessage{Id: m.Id, From: m.From, To: m.To, Content: m.Text, Time: m.Time}
+}
@@ -7,7 +7,11 @@
 package internal_type
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
@@ -31,7 +35,78 @@ type Telephony interface {
 	//
 	ReceiveCall(c *gin.Context) (client *string, telemetry []types.Telemetry, err error)
 	OutboundCall(auth types.SimplePrinciple, toPhone string, fromPhone string, assistantId, assistantConversationId uint64, vaultCredential *protos.VaultCredential, opts utils.Option) ([]types.Telemetry, error)
-	InboundCall(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, clientNumber string, assistantConversationId uint64) error
+	InboundCall(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, clientNumber string, assistantConversationId uint64, opts utils.Option) error
+
+	// verifies the webhook was sent by the provider with the keys of the vault credential and the
+	// options of the phone deployment, the body of the request can be read as it is restored for
+	// the handler
+	VerifyWebhook(c *gin.Context, vaultCredential *protos.VaultCredential, opts utils.Option) error
+
+	// downloads the recording the provider made of the call, the url is the one of the recording
+	// event of the status callback
+	Recording(ctx context.Context, vaultCredential *protos.VaultCredential, recordingUrl string) (*Recording, error)
+}
+
+const (
+	// ProviderRecordingOptionsKey of the phone deployment lets the provider record the call next to
+	// the recording of the assistant, it has the audio lost between the provider and the websocket
+	ProviderRecordingOptionsKey = "rapida.provider_recording"
+
+	// ProviderRecordingEvent is the event of a status callback once the recording of the provider is
+	// ready, the url of the recording is in the payload under ProviderRecordingUrlKey
+	ProviderRecordingEvent  = "recording"
+	ProviderRecordingUrlKey = "recording_url"
+
+	// MaxProviderRecordingSize is the largest recording downloaded from a provider, hours of audio
+	MaxProviderRecordingSize = 512 << 20
+)
+
+var (
+	// ErrProviderRecordingNotSupported is returned by the telephony which do not record calls
+	ErrProviderRecordingNotSupported = errors.New("recording of the call is not supported by the telephony")
+
+	// ErrProviderRecordingSensitiveCapture is returned for a phone deployment recorded by the provider
+	// which toggles sensitive capture with dtmf, the provider would record what the caller says during
+	// the capture
+	ErrProviderRecordingSensitiveCapture = errors.New("provider recording can not be enabled with the dtmf toggle of sensitive capture")
+)
+
+// IsTransferSupported tells whether the telephony carries out the transfer of its calls, only the
+// sip trunk refers the caller to another destination
+func IsTransferSupported(provider string) bool {
+	return provider == "sip"
+}
+
+// IsProviderRecordingEnabled tells whether the provider records the calls of the phone deployment
+func IsProviderRecordingEnabled(opts utils.Option) bool {
+	enabled, err := opts.GetBool(ProviderRecordingOptionsKey)
+	return err == nil && enabled
+}
+
+// ValidateProviderRecording rejects the options of a phone deployment which is recorded by the
+// provider and toggles sensitive capture with dtmf. The provider records the whole call and can not
+// leave out what the caller says during sensitive capture.
+func ValidateProviderRecording(opts utils.Option) error {
+	if !IsProviderRecordingEnabled(opts) {
+		return nil
+	}
+	if toggle, err := opts.GetString("sensitive_capture.dtmf_toggle"); err == nil && strings.TrimSpace(toggle) != "" {
+		return ErrProviderRecordingSensitiveCapture
+	}
+	return nil
+}
+
+// ReadRecording reads the recording downloaded from the provider, recordings larger than
+// MaxProviderRecordingSize are rejected
+func ReadRecording(r io.Reader) ([]byte, error) {
+	audio, err := io.ReadAll(io.LimitReader(r, MaxProviderRecordingSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(audio) > MaxProviderRecordingSize {
+		return nil, fmt.Errorf("recording is larger than %d bytes", MaxProviderRecordingSize)
+	}
+	return audio, nil
 }
 
 func GetAnswerPath(provider string, auth types.SimplePrinciple, assistantId uint64, assistantConversationId uint64, toPhone string) string {
@@ -43,6 +118,13 @@ func GetAnswerPath(provider string, auth types.SimplePrinciple, assistantId uint
 			toPhone,
 			assistantConversationId,
 			auth.GetCurrentToken())
+	case "service":
+		return fmt.Sprintf("v1/talk/%s/svc/%d/%s/%d/%s",
+			provider,
+			assistantId,
+			toPhone,
+			assistantConversationId,
+			auth.GetCurrentToken())
 	default:
 		return fmt.Sprintf("v1/talk/%s/usr/%d/%s/%d/%s/%d/%d",
 			provider,
@@ -55,6 +137,36 @@ func GetAnswerPath(provider string, auth types.SimplePrinciple, assistantId uint
 	}
 }
 
+// GetInstructionPath is fetched by providers which ask for the instructions of a call once it is
+// answered, the instructions of an outbound call are the ones of an inbound call
+func GetInstructionPath(provider string, auth types.SimplePrinciple, assistantId uint64, assistantConversationId uint64, toPhone string) string {
+	switch auth.Type() {
+	case "project":
+		return fmt.Sprintf("v1/talk/%s/prj/instruction/%d/%s/%d/%s",
+			provider,
+			assistantId,
+			toPhone,
+			assistantConversationId,
+			auth.GetCurrentToken())
+	case "service":
+		return fmt.Sprintf("v1/talk/%s/svc/instruction/%d/%s/%d/%s",
+			provider,
+			assistantId,
+			toPhone,
+			assistantConversationId,
+			auth.GetCurrentToken())
+	default:
+		return fmt.Sprintf("v1/talk/%s/usr/instruction/%d/%s/%d/%s/%d/%d",
+			provider,
+			assistantId,
+			toPhone,
+			assistantConversationId,
+			auth.GetCurrentToken(),
+			*auth.GetUserId(),
+			*auth.GetCurrentProjectId())
+	}
+}
+
 func GetEventPath(provider string, auth types.SimplePrinciple, assistantId, assistantConversationId uint64) string {
 	switch auth.Type() {
 	case "project":
@@ -63,6 +175,12 @@ func GetEventPath(provider string, auth types.SimplePrinciple, assistantId, assi
 			assistantId,
 			assistantConversationId,
 			auth.GetCurrentToken())
+	case "service":
+		return fmt.Sprintf("v1/talk/%s/svc/event/%d/%d/%s",
+			provider,
+			assistantId,
+			assistantConversationId,
+			auth.GetCurrentToken())
 	default:
 		return fmt.Sprintf("v1/talk/%s/usr/event/%d/%d/%s/%d/%d",
 			provider,
@@ -0,0 +1,48 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_type
+
+import (
+	"context"
+	"time"
+
+	internal_streamers "github.com/rapidaai/api/assistant-api/internal/streamers"
+	"github.com/rapidaai/protos"
+)
+
+// TextSender sends the replies of a text session, whatsapp and sms integrations are senders
+type TextSender interface {
+	SendMessage(ctx context.Context, vaultCredential *protos.VaultCredential, from, to, text string) error
+}
+
+// TextStreamer is the text session of a user writing to the assistant over whatsapp or sms, the
+// messages of the user are received while the session lives and the replies of the assistant are
+// sent to the user
+type TextStreamer interface {
+	internal_streamers.Streamer
+
+	// Receive hands the message to the session, it fails once the session is closed
+	Receive(message *TextMessage) error
+
+	// Ended reports whether the assistant ended the conversation, the next message of the user
+	// begins a new one
+	Ended() bool
+
+	// Stop closes the session, the pending replies are dropped and nothing more is sent to the user
+	Stop()
+}
+
+// TextMessage is a message of a user to the number of the deployment as the assistant reads it
+type TextMessage struct {
+	Id string
+	// number of the user
+	From string
+	// number of the deployment, the replies are sent from it
+	To      string
+	Content string
+	Time    time.Time
+}
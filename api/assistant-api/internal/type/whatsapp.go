@@ -0,0 +1,79 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_type
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/protos"
+)
+
+// any whatsapp integration must impliment this interface to provide consistent behaviour
+type Whatsapp interface {
+	// answers the handshake of the provider when the webhook is subscribed
+	Subscribe(c *gin.Context, vaultCredential *protos.VaultCredential) error
+
+	// verifies the webhook was sent by the provider with the keys of the vault credential, the
+	// body of the request can be read as it is restored for the handler
+	VerifyWebhook(c *gin.Context, vaultCredential *protos.VaultCredential) error
+
+	// messages of the webhook, delivery statuses and reactions are not messages
+	ReceiveMessage(c *gin.Context) ([]*WhatsappMessage, error)
+
+	// acknowledges the webhook once its messages are taken, replies are sent with SendMessage
+	Acknowledge(c *gin.Context)
+
+	// sends the text from the business number to the user
+	SendMessage(ctx context.Context, vaultCredential *protos.VaultCredential, from, to, text string) error
+}
+
+// WhatsappMessage is a message of a user to the business number
+type WhatsappMessage struct {
+	Id string
+	// number of the user
+	From string
+	// business number, the replies are sent from it
+	To string
+	// profile name of the user
+	Name  string
+	Text  string
+	Media []*WhatsappMedia
+	Time  time.Time
+}
+
+// WhatsappMedia is an attachment of a message, the reference is the url or the media id of the
+// provider the media is downloaded with
+type WhatsappMedia struct {
+	ContentType string
+	Reference   string
+	Filename    string
+}
+
+// Content is the text the assistant receives, attachments are described as the session is text
+func (m *WhatsappMessage) Content() string {
+	lines := make([]string, 0, len(m.Media)+1)
+	if text := strings.TrimSpace(m.Text); text != "" {
+		lines = append(lines, text)
+	}
+	for _, media := range m.Media {
+		name := media.ContentType
+		if media.Filename != "" {
+			name = fmt.Sprintf("%s %s", media.ContentType, media.Filename)
+		}
+		lines = append(lines, fmt.Sprintf("[attachment %s: %s]", name, media.Reference))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// TextMessage is the message the text session of the user receives
+func (m *WhatsappMessage) TextMessage() *TextMessage {
+	return &TextMessage{Id: m.Id, From: m.From, To: m.To, Content: m.Content(), Time: m.Time}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_meta
+
+// Webhook is the notification of the cloud api, the changes of a business account carry the
+// messages of users and the statuses of sent messages
+type Webhook struct {
+	Object string `json:"object"`
+	Entry  []struct {
+		Id      string `json:"id"`
+		Changes []struct {
+			Field string       `json:"field"`
+			Value WebhookValue `json:"value"`
+		} `json:"changes"`
+	} `json:"entry"`
+}
+
+type WebhookValue struct {
+	MessagingProduct string `json:"messaging_product"`
+	Metadata         struct {
+		DisplayPhoneNumber string `json:"display_phone_number"`
+		PhoneNumberId      string `json:"phone_number_id"`
+	} `json:"metadata"`
+	Contacts []struct {
+		WaId    string `json:"wa_id"`
+		Profile struct {
+			Name string `json:"name"`
+		} `json:"profile"`
+	} `json:"contacts"`
+	Messages []Message `json:"messages"`
+}
+
+type Message struct {
+	From      string `json:"from"`
+	Id        string `json:"id"`
+	Timestamp string `json:"timestamp"`
+	Type      string `json:"type"`
+	Text      *struct {
+		Body string `json:"body"`
+	} `json:"text,omitempty"`
+	Image    *Media `json:"image,omitempty"`
+	Video    *Media `json:"video,omitempty"`
+	Audio    *Media `json:"audio,omitempty"`
+	Document *Media `json:"document,omitempty"`
+	Sticker  *Media `json:"sticker,omitempty"`
+	Location *struct {
+		Latitude  float64 `json:"latitude"`
+		Longitude float64 `json:"longitude"`
+		Name      string  `json:"name"`
+		Address   string  `json:"address"`
+	} `json:"location,omitempty"`
+	Button *struct {
+		Text    string `json:"text"`
+		Payload string `json:"payload"`
+	} `json:"button,omitempty"`
+	Interactive *struct {
+		Type        string `json:"type"`
+		ButtonReply *struct {
+			Id    string `json:"id"`
+			Title string `json:"title"`
+		} `json:"button_reply,omitempty"`
+		ListReply *struct {
+			Id          string `json:"id"`
+			Title       string `json:"title"`
+			Description string `json:"description"`
+		} `json:"list_reply,omitempty"`
+	} `json:"interactive,omitempty"`
+}
+
+type Media struct {
+	Id       string `json:"id"`
+	MimeType string `json:"mime_type"`
+	Caption  string `json:"caption"`
+	Filename string `json:"filename"`
+}
+
+// TextMessage is sent to the user with the messages api
+type TextMessage struct {
+	MessagingProduct string `json:"messaging_product"`
+	RecipientType    string `json:"recipient_type"`
+	To               string `json:"to"`
+	Type             string `json:"type"`
+	Text             struct {
+		Body string `json:"body"`
+	} `json:"text"`
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_meta_whatsapp
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	internal_meta "github.com/rapidaai/api/assistant-api/internal/whatsapp/internal/meta/internal"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+)
+
+// graph api of meta, it is replaced in tests
+var apiUrl = "https://graph.facebook.com/v21.0"
+
+type metaWhatsapp struct {
+	appCfg *config.AssistantConfig
+	logger commons.Logger
+}
+
+// NewMetaWhatsapp receives the messages of a phone number of the whatsapp cloud api, the webhook
+// of the app notifies them and the replies are sent with the messages api of the phone number.
+func NewMetaWhatsapp(config *config.AssistantConfig, logger commons.Logger) (internal_type.Whatsapp, error) {
+	return &metaWhatsapp{
+		appCfg: config,
+		logger: logger,
+	}, nil
+}
+
+func valueOf(vaultCredential *protos.VaultCredential, key string) (string, error) {
+	value, ok := vaultCredential.GetValue().AsMap()[key].(string)
+	if !ok || value == "" {
+		return "", fmt.Errorf("illegal vault config %s is not found", key)
+	}
+	return value, nil
+}
+
+// Subscribe answers the challenge meta sends when the callback url of the webhook is set, the
+// verify token given in the app has to be the one of the vault credential
+func (mw *metaWhatsapp) Subscribe(c *gin.Context, vaultCredential *protos.VaultCredential) error {
+	verifyToken, err := valueOf(vaultCredential, "verify_token")
+	if err != nil {
+		return err
+	}
+	if c.Query("hub.mode") != "subscribe" {
+		return fmt.Errorf("illegal subscription mode %s", c.Query("hub.mode"))
+	}
+	if !hmac.Equal([]byte(c.Query("hub.verify_token")), []byte(verifyToken)) {
+		return fmt.Errorf("illegal verify token")
+	}
+	c.String(http.StatusOK, c.Query("hub.challenge"))
+	return nil
+}
+
+// VerifyWebhook checks the X-Hub-Signature-256 of the request, meta signs the body with the app
+// secret
+func (mw *metaWhatsapp) VerifyWebhook(c *gin.Context, vaultCredential *protos.VaultCredential) error {
+	appSecret, err := valueOf(vaultCredential, "app_secret")
+	if err != nil {
+		return err
+	}
+	signature, ok := strings.CutPrefix(c.GetHeader("X-Hub-Signature-256"), "sha256=")
+	if !ok {
+		return fmt.Errorf("missing meta signature")
+	}
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("illegal meta signature: %w", err)
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read body: %w", err)
+	}
+	mac := hmac.New(sha256.New, []byte(appSecret))
+	mac.Write(body)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return fmt.Errorf("illegal meta signature")
+	}
+	return nil
+}
+
+// ReceiveMessage reads the messages of the notification, media are listed with their media id and
+// replies to buttons and lists are given as the text of the chosen option
+func (mw *metaWhatsapp) ReceiveMessage(c *gin.Context) ([]*internal_type.WhatsappMessage, error) {
+	var webhook internal_meta.Webhook
+	if err := c.ShouldBindJSON(&webhook); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook: %w", err)
+	}
+
+	messages := make([]*internal_type.WhatsappMessage, 0)
+	for _, entry := range webhook.Entry {
+		for _, change := range entry.Changes {
+			if change.Field != "messages" {
+				continue
+			}
+			names := make(map[string]string)
+			for _, contact := range change.Value.Contacts {
+				names[contact.WaId] = contact.Profile.Name
+			}
+			for _, msg := range change.Value.Messages {
+				message := messageOf(msg)
+				if message.Text == "" && len(message.Media) == 0 {
+					mw.logger.Debugf("ignoring whatsapp message %s of type %s", msg.Id, msg.Type)
+					continue
+				}
+				message.To = change.Value.Metadata.PhoneNumberId
+				message.Name = names[msg.From]
+				messages = append(messages, message)
+			}
+		}
+	}
+	return messages, nil
+}
+
+func messageOf(msg internal_meta.Message) *internal_type.WhatsappMessage {
+	message := &internal_type.WhatsappMessage{
+		Id:   msg.Id,
+		From: msg.From,
+		Time: time.Now(),
+	}
+	if timestamp, err := strconv.ParseInt(msg.Timestamp, 10, 64); err == nil {
+		message.Time = time.Unix(timestamp, 0)
+	}
+	switch {
+	case msg.Text != nil:
+		message.Text = msg.Text.Body
+	case msg.Location != nil:
+		message.Text = strings.TrimSpace(fmt.Sprintf("location %v,%v %s %s", msg.Location.Latitude, msg.Location.Longitude, msg.Location.Name, msg.Location.Address))
+	case msg.Button != nil:
+		message.Text = msg.Button.Text
+	case msg.Interactive != nil && msg.Interactive.ButtonReply != nil:
+		message.Text = msg.Interactive.ButtonReply.Title
+	case msg.Interactive != nil && msg.Interactive.ListReply != nil:
+		message.Text = msg.Interactive.ListReply.Title
+	}
+	for _, media := range []*internal_meta.Media{msg.Image, msg.Video, msg.Audio, msg.Document, msg.Sticker} {
+		if media == nil {
+			continue
+		}
+		message.Text = media.Caption
+		message.Media = append(message.Media, &internal_type.WhatsappMedia{
+			ContentType: media.MimeType,
+			Reference:   media.Id,
+			Filename:    media.Filename,
+		})
+	}
+	return message
+}
+
+// Acknowledge answers the notification, meta retries the notifications which are not answered
+// with 200
+func (mw *metaWhatsapp) Acknowledge(c *gin.Context) {
+	c.Status(http.StatusOK)
+}
+
+// SendMessage sends the text with the messages api of the phone number, the business number of a
+// message of the cloud api is the phone number id
+func (mw *metaWhatsapp) SendMessage(ctx context.Context, vaultCredential *protos.VaultCredential, from, to, text string) error {
+	accessToken, err := valueOf(vaultCredential, "access_token")
+	if err != nil {
+		return err
+	}
+	message := internal_meta.TextMessage{
+		MessagingProduct: "whatsapp",
+		RecipientType:    "individual",
+		To:               to,
+		Type:             "text",
+	}
+	message.Text.Body = text
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/%s/messages", apiUrl, url.PathEscape(from)), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{Timeout: 60 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("status code %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_meta_whatsapp
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func credential(t *testing.T, value map[string]interface{}) *protos.VaultCredential {
+	t.Helper()
+	v, err := structpb.NewStruct(value)
+	require.NoError(t, err)
+	return &protos.VaultCredential{Value: v}
+}
+
+func newWhatsapp() *metaWhatsapp {
+	logger, _ := commons.NewApplicationLogger()
+	return &metaWhatsapp{appCfg: &config.AssistantConfig{}, logger: logger}
+}
+
+func TestSubscribe(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name    string
+		query   string
+		wantErr bool
+	}{
+		{name: "valid subscription", query: "hub.mode=subscribe&hub.verify_token=secret&hub.challenge=1158201444"},
+		{name: "wrong verify token", query: "hub.mode=subscribe&hub.verify_token=other&hub.challenge=1158201444", wantErr: true},
+		{name: "wrong mode", query: "hub.mode=unsubscribe&hub.verify_token=secret&hub.challenge=1158201444", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/v1/talk/whatsapp/meta/prj/1/key?"+tt.query, nil)
+			err := newWhatsapp().Subscribe(c, credential(t, map[string]interface{}{"verify_token": "secret"}))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "1158201444", w.Body.String())
+		})
+	}
+}
+
+func TestVerifyWebhook(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	body := `{"object":"whatsapp_business_account","entry":[]}`
+	signature := func(secret, body string) string {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(body))
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+	tests := []struct {
+		name      string
+		body      string
+		signature string
+		wantErr   bool
+	}{
+		{name: "signed notification", body: body, signature: signature("app-secret", body)},
+		{name: "forged notification", body: `{"object":"whatsapp_business_account","entry":[{}]}`, signature: signature("app-secret", body), wantErr: true},
+		{name: "signed with another secret", body: body, signature: signature("other", body), wantErr: true},
+		{name: "malformed signature", body: body, signature: "sha256=zz", wantErr: true},
+		{name: "missing signature", body: body, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/talk/whatsapp/meta/prj/1/key", strings.NewReader(tt.body))
+			if tt.signature != "" {
+				c.Request.Header.Set("X-Hub-Signature-256", tt.signature)
+			}
+			err := newWhatsapp().VerifyWebhook(c, credential(t, map[string]interface{}{"app_secret": "app-secret"}))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func notification(messages string) string {
+	return `{"object":"whatsapp_business_account","entry":[{"id":"102290129340398","changes":[{"field":"messages","value":{
+		"messaging_product":"whatsapp",
+		"metadata":{"display_phone_number":"15550783881","phone_number_id":"106540352242922"},
+		"contacts":[{"profile":{"name":"Sheena Nelson"},"wa_id":"16505551234"}],
+		"messages":[` + messages + `]}}]}]}`
+}
+
+func TestReceiveMessage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	sent := time.Unix(1749416383, 0)
+	tests := []struct {
+		name    string
+		body    string
+		want    []*internal_type.WhatsappMessage
+		wantErr bool
+	}{
+		{
+			name: "text message",
+			body: notification(`{"from":"16505551234","id":"wamid.1","timestamp":"1749416383","type":"text","text":{"body":"Does it come in another color?"}}`),
+			want: []*internal_type.WhatsappMessage{{Id: "wamid.1", From: "16505551234", To: "106540352242922", Name: "Sheena Nelson", Text: "Does it come in another color?", Time: sent}},
+		},
+		{
+			name: "image with caption",
+			body: notification(`{"from":"16505551234","id":"wamid.2","timestamp":"1749416383","type":"image","image":{"caption":"this one","mime_type":"image/jpeg","id":"1003383421387256"}}`),
+			want: []*internal_type.WhatsappMessage{{Id: "wamid.2", From: "16505551234", To: "106540352242922", Name: "Sheena Nelson", Text: "this one", Time: sent,
+				Media: []*internal_type.WhatsappMedia{{ContentType: "image/jpeg", Reference: "1003383421387256"}}}},
+		},
+		{
+			name: "document",
+			body: notification(`{"from":"16505551234","id":"wamid.3","timestamp":"1749416383","type":"document","document":{"filename":"invoice.pdf","mime_type":"application/pdf","id":"2033383421387256"}}`),
+			want: []*internal_type.WhatsappMessage{{Id: "wamid.3", From: "16505551234", To: "106540352242922", Name: "Sheena Nelson", Time: sent,
+				Media: []*internal_type.WhatsappMedia{{ContentType: "application/pdf", Reference: "2033383421387256", Filename: "invoice.pdf"}}}},
+		},
+		{
+			name: "button reply",
+			body: notification(`{"from":"16505551234","id":"wamid.4","timestamp":"1749416383","type":"interactive","interactive":{"type":"button_reply","button_reply":{"id":"yes","title":"Yes, please"}}}`),
+			want: []*internal_type.WhatsappMessage{{Id: "wamid.4", From: "16505551234", To: "106540352242922", Name: "Sheena Nelson", Text: "Yes, please", Time: sent}},
+		},
+		{
+			name: "unsupported message",
+			body: notification(`{"from":"16505551234","id":"wamid.5","timestamp":"1749416383","type":"unsupported"}`),
+			want: []*internal_type.WhatsappMessage{},
+		},
+		{
+			name: "status of a sent message",
+			body: `{"object":"whatsapp_business_account","entry":[{"id":"102290129340398","changes":[{"field":"messages","value":{"messaging_product":"whatsapp","statuses":[{"id":"wamid.9","status":"delivered"}]}}]}]}`,
+			want: []*internal_type.WhatsappMessage{},
+		},
+		{
+			name:    "malformed notification",
+			body:    `{"entry":`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/talk/whatsapp/meta/prj/1/key", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			messages, err := newWhatsapp().ReceiveMessage(c)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, messages)
+		})
+	}
+}
+
+func TestSendMessage(t *testing.T) {
+	var received map[string]interface{}
+	var path, authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		authorization = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &received)
+		if r.URL.Path == "/fail/messages" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	defer func(url string) { apiUrl = url }(apiUrl)
+	apiUrl = server.URL
+
+	wa := newWhatsapp()
+	vlt := credential(t, map[string]interface{}{"access_token": "token"})
+	require.NoError(t, wa.SendMessage(context.Background(), vlt, "106540352242922", "16505551234", "It comes in blue"))
+	assert.Equal(t, "/106540352242922/messages", path)
+	assert.Equal(t, "Bearer token", authorization)
+	assert.Equal(t, "whatsapp", received["messaging_product"])
+	assert.Equal(t, "16505551234", received["to"])
+	assert.Equal(t, "text", received["type"])
+	assert.Equal(t, map[string]interface{}{"body": "It comes in blue"}, received["text"])
+
+	assert.Error(t, wa.SendMessage(context.Background(), vlt, "fail", "16505551234", "hello"))
+	assert.Error(t, wa.SendMessage(context.Background(), credential(t, map[string]interface{}{}), "106540352242922", "16505551234", "hello"))
+}
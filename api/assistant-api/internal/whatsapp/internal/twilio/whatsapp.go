@@ -0,0 +1,163 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_twilio_whatsapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+	twilio_client "github.com/twilio/twilio-go/client"
+)
+
+// api of twilio, it is replaced in tests
+var apiUrl = "https://api.twilio.com/2010-04-01"
+
+// numbers of whatsapp are prefixed with the channel in twilio
+const channelPrefix = "whatsapp:"
+
+var errNoSubscription = errors.New("twilio does not subscribe the webhook of a sender")
+
+type twilioWhatsapp struct {
+	appCfg *config.AssistantConfig
+	logger commons.Logger
+}
+
+// NewTwilioWhatsapp receives the messages of a whatsapp sender of twilio, the incoming message
+// webhook of the sender posts them and the replies are created with the messages api.
+func NewTwilioWhatsapp(config *config.AssistantConfig, logger commons.Logger) (internal_type.Whatsapp, error) {
+	return &twilioWhatsapp{
+		appCfg: config,
+		logger: logger,
+	}, nil
+}
+
+func credentialOf(vaultCredential *protos.VaultCredential) (string, string, error) {
+	accountSid, ok := vaultCredential.GetValue().AsMap()["account_sid"].(string)
+	if !ok || accountSid == "" {
+		return "", "", fmt.Errorf("illegal vault config account_sid is not found")
+	}
+	authToken, ok := vaultCredential.GetValue().AsMap()["account_token"].(string)
+	if !ok || authToken == "" {
+		return "", "", fmt.Errorf("illegal vault config account_token not found")
+	}
+	return accountSid, authToken, nil
+}
+
+func (tw *twilioWhatsapp) Subscribe(c *gin.Context, vaultCredential *protos.VaultCredential) error {
+	return errNoSubscription
+}
+
+// VerifyWebhook checks the X-Twilio-Signature of the request, twilio signs the url it requested
+// and the posted parameters with the auth token of the account
+func (tw *twilioWhatsapp) VerifyWebhook(c *gin.Context, vaultCredential *protos.VaultCredential) error {
+	_, authToken, err := credentialOf(vaultCredential)
+	if err != nil {
+		return err
+	}
+	signature := c.GetHeader("X-Twilio-Signature")
+	if signature == "" {
+		return fmt.Errorf("missing twilio signature")
+	}
+	if err := c.Request.ParseForm(); err != nil {
+		return fmt.Errorf("failed to parse form: %w", err)
+	}
+	params := make(map[string]string)
+	for key, values := range c.Request.PostForm {
+		if len(values) > 0 {
+			params[key] = values[0]
+		}
+	}
+	validator := twilio_client.NewRequestValidator(authToken)
+	if !validator.Validate(fmt.Sprintf("https://%s%s", tw.appCfg.PublicAssistantHost, c.Request.URL.RequestURI()), params, signature) {
+		return fmt.Errorf("illegal twilio signature")
+	}
+	return nil
+}
+
+// ReceiveMessage reads the message posted by twilio, the media of the message are listed with
+// their urls and the shared location is given as text
+func (tw *twilioWhatsapp) ReceiveMessage(c *gin.Context) ([]*internal_type.WhatsappMessage, error) {
+	if err := c.Request.ParseForm(); err != nil {
+		return nil, fmt.Errorf("failed to parse form: %w", err)
+	}
+	form := c.Request.PostForm
+	if form.Get("MessageSid") == "" {
+		return nil, fmt.Errorf("missing message sid")
+	}
+
+	message := &internal_type.WhatsappMessage{
+		Id:   form.Get("MessageSid"),
+		From: strings.TrimPrefix(form.Get("From"), channelPrefix),
+		To:   strings.TrimPrefix(form.Get("To"), channelPrefix),
+		Name: form.Get("ProfileName"),
+		Text: form.Get("Body"),
+		Time: time.Now(),
+	}
+	if latitude, longitude := form.Get("Latitude"), form.Get("Longitude"); latitude != "" && longitude != "" {
+		message.Text = strings.TrimSpace(fmt.Sprintf("location %s,%s %s %s", latitude, longitude, form.Get("Label"), form.Get("Address")))
+	}
+	numMedia, _ := strconv.Atoi(form.Get("NumMedia"))
+	for i := 0; i < numMedia; i++ {
+		message.Media = append(message.Media, &internal_type.WhatsappMedia{
+			ContentType: form.Get(fmt.Sprintf("MediaContentType%d", i)),
+			Reference:   form.Get(fmt.Sprintf("MediaUrl%d", i)),
+		})
+	}
+	// status of a sent message when the status callback is the incoming message url
+	if message.Text == "" && len(message.Media) == 0 {
+		return nil, nil
+	}
+	return []*internal_type.WhatsappMessage{message}, nil
+}
+
+// Acknowledge answers with empty twiml, the reply is created with the messages api once the
+// assistant responded
+func (tw *twilioWhatsapp) Acknowledge(c *gin.Context) {
+	c.Data(http.StatusOK, "text/xml", []byte("<Response></Response>"))
+}
+
+// SendMessage creates the message with the messages api of the account
+func (tw *twilioWhatsapp) SendMessage(ctx context.Context, vaultCredential *protos.VaultCredential, from, to, text string) error {
+	accountSid, authToken, err := credentialOf(vaultCredential)
+	if err != nil {
+		return err
+	}
+	form := url.Values{}
+	form.Set("From", channelPrefix+from)
+	form.Set("To", channelPrefix+to)
+	form.Set("Body", text)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/Accounts/%s/Messages.json", apiUrl, url.PathEscape(accountSid)), strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(accountSid, authToken)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	client := &http.Client{Timeout: 60 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("status code %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_twilio_whatsapp
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func credential(t *testing.T, value map[string]interface{}) *protos.VaultCredential {
+	t.Helper()
+	v, err := structpb.NewStruct(value)
+	require.NoError(t, err)
+	return &protos.VaultCredential{Value: v}
+}
+
+func postForm(target string, form url.Values) (*gin.Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, target, strings.NewReader(form.Encode()))
+	c.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return c, w
+}
+
+// sign signs the url and the posted parameters as twilio does
+func sign(authToken, requestUrl string, form url.Values) string {
+	keys := make([]string, 0, len(form))
+	for key := range form {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	data := requestUrl
+	for _, key := range keys {
+		data += key + form.Get(key)
+	}
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(data))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestReceiveMessage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name    string
+		form    url.Values
+		want    []*internal_type.WhatsappMessage
+		wantErr bool
+	}{
+		{
+			name: "text message",
+			form: url.Values{"MessageSid": {"SM1"}, "From": {"whatsapp:+15551234567"}, "To": {"whatsapp:+15557654321"}, "ProfileName": {"Jo"}, "Body": {"hello"}, "NumMedia": {"0"}},
+			want: []*internal_type.WhatsappMessage{{Id: "SM1", From: "+15551234567", To: "+15557654321", Name: "Jo", Text: "hello"}},
+		},
+		{
+			name: "message with media",
+			form: url.Values{"MessageSid": {"SM2"}, "From": {"whatsapp:+15551234567"}, "To": {"whatsapp:+15557654321"}, "Body": {"my receipt"}, "NumMedia": {"2"},
+				"MediaUrl0": {"https://api.twilio.com/media/ME1"}, "MediaContentType0": {"image/jpeg"},
+				"MediaUrl1": {"https://api.twilio.com/media/ME2"}, "MediaContentType1": {"application/pdf"}},
+			want: []*internal_type.WhatsappMessage{{Id: "SM2", From: "+15551234567", To: "+15557654321", Text: "my receipt", Media: []*internal_type.WhatsappMedia{
+				{ContentType: "image/jpeg", Reference: "https://api.twilio.com/media/ME1"},
+				{ContentType: "application/pdf", Reference: "https://api.twilio.com/media/ME2"},
+			}}},
+		},
+		{
+			name: "shared location",
+			form: url.Values{"MessageSid": {"SM3"}, "From": {"whatsapp:+15551234567"}, "To": {"whatsapp:+15557654321"}, "Latitude": {"52.52"}, "Longitude": {"13.40"}, "Label": {"Office"}},
+			want: []*internal_type.WhatsappMessage{{Id: "SM3", From: "+15551234567", To: "+15557654321", Text: "location 52.52,13.40 Office"}},
+		},
+		{
+			name: "status callback",
+			form: url.Values{"MessageSid": {"SM4"}, "MessageStatus": {"delivered"}, "From": {"whatsapp:+15557654321"}, "To": {"whatsapp:+15551234567"}},
+			want: nil,
+		},
+		{
+			name:    "missing message sid",
+			form:    url.Values{"Body": {"hello"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := postForm("/v1/talk/whatsapp/twilio/prj/1/key", tt.form)
+			logger, _ := commons.NewApplicationLogger()
+			wa, _ := NewTwilioWhatsapp(&config.AssistantConfig{}, logger)
+			messages, err := wa.ReceiveMessage(c)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			for _, message := range messages {
+				assert.False(t, message.Time.IsZero())
+				message.Time = messages[0].Time
+			}
+			for _, message := range tt.want {
+				message.Time = messages[0].Time
+			}
+			assert.Equal(t, tt.want, messages)
+		})
+	}
+}
+
+func TestVerifyWebhook(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	target := "/v1/talk/whatsapp/twilio/prj/1/key"
+	form := url.Values{"MessageSid": {"SM1"}, "From": {"whatsapp:+15551234567"}, "Body": {"hello"}}
+	tests := []struct {
+		name      string
+		form      url.Values
+		signature string
+		wantErr   bool
+	}{
+		{name: "signed message", form: form, signature: sign("token", "https://assistant.rapida.ai"+target, form)},
+		{name: "forged message", form: url.Values{"MessageSid": {"SM1"}, "From": {"whatsapp:+15551234567"}, "Body": {"bye"}}, signature: sign("token", "https://assistant.rapida.ai"+target, form), wantErr: true},
+		{name: "signed with another token", form: form, signature: sign("other", "https://assistant.rapida.ai"+target, form), wantErr: true},
+		{name: "missing signature", form: form, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := postForm(target, tt.form)
+			if tt.signature != "" {
+				c.Request.Header.Set("X-Twilio-Signature", tt.signature)
+			}
+			wa := &twilioWhatsapp{appCfg: &config.AssistantConfig{PublicAssistantHost: "assistant.rapida.ai"}}
+			err := wa.VerifyWebhook(c, credential(t, map[string]interface{}{"account_sid": "AC1", "account_token": "token"}))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAcknowledge(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	c, w := postForm("/v1/talk/whatsapp/twilio/prj/1/key", url.Values{})
+	(&twilioWhatsapp{}).Acknowledge(c)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "<Response></Response>", w.Body.String())
+}
+
+func TestSendMessage(t *testing.T) {
+	var received url.Values
+	var path, user, password string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		user, password, _ = r.BasicAuth()
+		r.ParseForm()
+		received = r.PostForm
+		if received.Get("Body") == "fail" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+	defer func(url string) { apiUrl = url }(apiUrl)
+	apiUrl = server.URL
+
+	wa := &twilioWhatsapp{}
+	vlt := credential(t, map[string]interface{}{"account_sid": "AC1", "account_token": "token"})
+	require.NoError(t, wa.SendMessage(context.Background(), vlt, "+15557654321", "+15551234567", "hello"))
+	assert.Equal(t, "/Accounts/AC1/Messages.json", path)
+	assert.Equal(t, "AC1", user)
+	assert.Equal(t, "token", password)
+	assert.Equal(t, "whatsapp:+15557654321", received.Get("From"))
+	assert.Equal(t, "whatsapp:+15551234567", received.Get("To"))
+	assert.Equal(t, "hello", received.Get("Body"))
+
+	assert.Error(t, wa.SendMessage(context.Background(), vlt, "+15557654321", "+15551234567", "fail"))
+	assert.Error(t, wa.SendMessage(context.Background(), credential(t, map[string]interface{}{"account_sid": "AC1"}), "+15557654321", "+15551234567", "hello"))
+}
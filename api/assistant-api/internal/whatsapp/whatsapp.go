@@ -0,0 +1,65 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_whatsapp_factory
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_text_session "github.com/rapidaai/api/assistant-api/internal/text_session"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	internal_meta_whatsapp "github.com/rapidaai/api/assistant-api/internal/whatsapp/internal/meta"
+	internal_twilio_whatsapp "github.com/rapidaai/api/assistant-api/internal/whatsapp/internal/twilio"
+	"github.com/rapidaai/pkg/commons"
+)
+
+type Whatsapp string
+
+const (
+	Twilio Whatsapp = "twilio"
+	Meta   Whatsapp = "meta"
+)
+
+// SessionWindow is the customer service window of whatsapp, the business can reply freely within
+// the window since the last message of the user and the conversation of the user is resumed
+const SessionWindow = 24 * time.Hour
+
+// TextChannel is the text session of whatsapp users, twilio sends at most 1600 characters as one
+// message and free text is only delivered within the session window
+var TextChannel = internal_text_session.Channel{Name: "whatsapp", MaxMessageLength: 1600, ReplyWindow: SessionWindow}
+
+// MessageRetention is how long the id of a received message is kept, meta retries a webhook for
+// up to 7 days and a retried message is not handed to the session again
+const MessageRetention = 7 * 24 * time.Hour
+
+func (w Whatsapp) String() string {
+	return string(w)
+}
+
+func GetWhatsapp(w Whatsapp, cfg *config.AssistantConfig, logger commons.Logger) (internal_type.Whatsapp, error) {
+	switch w {
+	case Twilio:
+		return internal_twilio_whatsapp.NewTwilioWhatsapp(cfg, logger)
+	case Meta:
+		return internal_meta_whatsapp.NewMetaWhatsapp(cfg, logger)
+	default:
+		return nil, errors.New("illegal whatsapp provider")
+	}
+}
+
+// Key is where the conversation of a whatsapp user is kept for the session window, the messages
+// of the user within the window resume it
+func Key(assistantId uint64, identifier string) string {
+	return fmt.Sprintf("whatsapp::%d::%s", assistantId, identifier)
+}
+
+// MessageKey is where the id of a message received for the assistant is kept for the retention
+func MessageKey(assistantId uint64, messageId string) string {
+	return fmt.Sprintf("whatsapp::%d::message::%s", assistantId, messageId)
+}
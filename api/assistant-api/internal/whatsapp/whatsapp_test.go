@@ -0,0 +1,41 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_whatsapp_factory
+
+import (
+	"testing"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKey(t *testing.T) {
+	assert.Equal(t, "whatsapp::12::twilio-whatsapp-production-15551234567-1-2", Key(12, "twilio-whatsapp-production-15551234567-1-2"))
+	assert.Equal(t, "whatsapp::12::message::wamid.1", MessageKey(12, "wamid.1"))
+}
+
+func TestGetWhatsapp(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	for _, provider := range []Whatsapp{Twilio, Meta} {
+		whatsapp, err := GetWhatsapp(provider, nil, logger)
+		require.NoError(t, err)
+		assert.NotNil(t, whatsapp)
+	}
+	_, err := GetWhatsapp("telegram", nil, logger)
+	assert.Error(t, err)
+}
+
+func TestTextMessage(t *testing.T) {
+	message := &internal_type.WhatsappMessage{Id: "wamid.2", From: "15551234567", To: "15557654321", Text: "how are you", Media: []*internal_type.WhatsappMedia{{ContentType: "image/jpeg", Reference: "1234"}}}
+	text := message.TextMessage()
+	assert.Equal(t, "wamid.2", text.Id)
+	assert.Equal(t, "15551234567", text.From)
+	assert.Equal(t, "15557654321", text.To)
+	assert.Equal(t, "how are you\n[attachment image/jpeg: 1234]", text.Content)
+}
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS public.knowledge_evaluation_reports;
+DROP TABLE IF EXISTS public.knowledge_evaluation_questions;
+DROP TABLE IF EXISTS public.knowledge_evaluations;
@@ -0,0 +1,50 @@
+CREATE TABLE public.knowledge_evaluations (
+    id bigint PRIMARY KEY,
+    created_date timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp with time zone,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    project_id bigint NOT NULL,
+    organization_id bigint NOT NULL,
+    knowledge_id bigint NOT NULL,
+    name character varying(200) NOT NULL,
+    description text
+);
+CREATE INDEX idx_knowledge_evaluations_knowledge_id ON public.knowledge_evaluations USING btree (knowledge_id);
+CREATE INDEX idx_knowledge_evaluations_project_id ON public.knowledge_evaluations USING btree (project_id);
+
+CREATE TABLE public.knowledge_evaluation_questions (
+    id bigint PRIMARY KEY,
+    created_date timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp with time zone,
+    knowledge_evaluation_id bigint NOT NULL,
+    question text NOT NULL,
+    expected_document_ids text,
+    expected_segment_ids text
+);
+CREATE INDEX idx_knowledge_evaluation_questions_knowledge_evaluation_id ON public.knowledge_evaluation_questions USING btree (knowledge_evaluation_id);
+
+CREATE TABLE public.knowledge_evaluation_reports (
+    id bigint PRIMARY KEY,
+    created_date timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp with time zone,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    project_id bigint NOT NULL,
+    organization_id bigint NOT NULL,
+    knowledge_evaluation_id bigint NOT NULL,
+    knowledge_id bigint NOT NULL,
+    retrieval_method character varying(50),
+    rerank boolean DEFAULT false NOT NULL,
+    top_k integer,
+    score_threshold real,
+    question_count integer,
+    recall_at_k double precision,
+    mrr double precision,
+    average_time_taken bigint,
+    p95_time_taken bigint,
+    knowledge_log_id bigint
+);
+CREATE INDEX idx_knowledge_evaluation_reports_knowledge_evaluation_id ON public.knowledge_evaluation_reports USING btree (knowledge_evaluation_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS public.knowledge_document_segment_revisions;
+DROP TABLE IF EXISTS public.knowledge_embedding_jobs;
@@ -0,0 +1,43 @@
+CREATE TABLE public.knowledge_embedding_jobs (
+    id bigint PRIMARY KEY,
+    created_date timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp with time zone,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    project_id bigint NOT NULL,
+    organization_id bigint NOT NULL,
+    knowledge_id bigint NOT NULL,
+    storage_namespace character varying(400) NOT NULL,
+    previous_storage_namespace character varying(400) NOT NULL,
+    embedding_model_provider_name character varying(200) NOT NULL,
+    previous_embedding_model_provider_name character varying(200),
+    embedding_model_options text,
+    previous_embedding_model_options text,
+    total_segments bigint DEFAULT 0,
+    processed_segments bigint DEFAULT 0,
+    failed_segments bigint DEFAULT 0,
+    error text
+);
+CREATE INDEX idx_knowledge_embedding_jobs_knowledge_id ON public.knowledge_embedding_jobs USING btree (knowledge_id);
+
+CREATE TABLE public.knowledge_document_segment_revisions (
+    id bigint PRIMARY KEY,
+    created_date timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp with time zone,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    project_id bigint NOT NULL,
+    organization_id bigint NOT NULL,
+    knowledge_id bigint NOT NULL,
+    storage_namespace character varying(400) NOT NULL,
+    document_id character varying(200) NOT NULL,
+    action character varying(50) NOT NULL,
+    text text,
+    document_name character varying(400),
+    entities text,
+    reason text
+);
+CREATE INDEX idx_knowledge_document_segment_revisions_knowledge_id ON public.knowledge_document_segment_revisions USING btree (knowledge_id, created_date);
+CREATE INDEX idx_knowledge_document_segment_revisions_document_id ON public.knowledge_document_segment_revisions USING btree (document_id);
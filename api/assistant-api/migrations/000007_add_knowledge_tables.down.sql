@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS public.knowledge_table_rows;
+DROP TABLE IF EXISTS public.knowledge_tables;
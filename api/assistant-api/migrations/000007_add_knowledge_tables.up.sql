@@ -0,0 +1,28 @@
+CREATE TABLE public.knowledge_tables (
+    id bigint PRIMARY KEY,
+    created_date timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp with time zone,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    project_id bigint NOT NULL,
+    organization_id bigint NOT NULL,
+    knowledge_id bigint NOT NULL,
+    knowledge_document_id bigint NOT NULL,
+    name character varying(200) NOT NULL,
+    columns text NOT NULL,
+    row_count bigint DEFAULT 0 NOT NULL
+);
+CREATE INDEX idx_knowledge_tables_knowledge_id ON public.knowledge_tables USING btree (knowledge_id);
+CREATE UNIQUE INDEX idx_knowledge_tables_knowledge_document_id ON public.knowledge_tables USING btree (knowledge_document_id);
+
+CREATE TABLE public.knowledge_table_rows (
+    id bigint PRIMARY KEY,
+    created_date timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp with time zone,
+    knowledge_table_id bigint NOT NULL,
+    "position" bigint NOT NULL,
+    data jsonb NOT NULL
+);
+CREATE INDEX idx_knowledge_table_rows_knowledge_table_id ON public.knowledge_table_rows USING btree (knowledge_table_id, "position");
+CREATE INDEX idx_knowledge_table_rows_data ON public.knowledge_table_rows USING gin (data jsonb_path_ops);
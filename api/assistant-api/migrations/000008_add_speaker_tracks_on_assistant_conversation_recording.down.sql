@@ -0,0 +1,4 @@
+ALTER TABLE public.assistant_conversation_recordings
+    DROP COLUMN IF EXISTS assistant_recording_url,
+    DROP COLUMN IF EXISTS user_recording_url,
+    DROP COLUMN IF EXISTS channels;
@@ -0,0 +1,4 @@
+ALTER TABLE public.assistant_conversation_recordings
+    ADD COLUMN channels integer DEFAULT 1 NOT NULL,
+    ADD COLUMN user_recording_url character varying(200),
+    ADD COLUMN assistant_recording_url character varying(200);
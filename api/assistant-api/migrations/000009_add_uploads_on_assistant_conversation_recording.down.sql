@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_assistant_conversation_recordings_status;
+ALTER TABLE public.assistant_conversation_recordings DROP COLUMN IF EXISTS uploads;
@@ -0,0 +1,2 @@
+ALTER TABLE public.assistant_conversation_recordings ADD COLUMN uploads jsonb;
+CREATE INDEX idx_assistant_conversation_recordings_status ON public.assistant_conversation_recordings USING btree (status);
@@ -0,0 +1,50 @@
+CREATE TABLE public.assistant_campaigns (
+    id bigint PRIMARY KEY,
+    created_date timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp with time zone,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    project_id bigint NOT NULL,
+    organization_id bigint NOT NULL,
+    assistant_id bigint NOT NULL,
+    assistant_version character varying(50),
+    name character varying(200) NOT NULL,
+    campaign_status character varying(50) NOT NULL,
+    from_number character varying(50),
+    timezone character varying(100) DEFAULT 'UTC'::character varying NOT NULL,
+    window_start character varying(5),
+    window_end character varying(5),
+    window_days jsonb,
+    start_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    max_concurrent_calls integer DEFAULT 1 NOT NULL,
+    retry_policies jsonb,
+    options jsonb,
+    credential_id bigint NOT NULL,
+    token character varying(400) NOT NULL
+);
+CREATE INDEX idx_assistant_campaigns_project_id ON public.assistant_campaigns USING btree (project_id, organization_id);
+CREATE INDEX idx_assistant_campaigns_campaign_status ON public.assistant_campaigns USING btree (campaign_status, start_at);
+
+CREATE TABLE public.assistant_campaign_contacts (
+    id bigint PRIMARY KEY,
+    created_date timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp with time zone,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    project_id bigint NOT NULL,
+    organization_id bigint NOT NULL,
+    campaign_id bigint NOT NULL,
+    credential_id bigint NOT NULL,
+    to_number character varying(50) NOT NULL,
+    args jsonb,
+    contact_status character varying(50) NOT NULL,
+    disposition character varying(50),
+    attempts integer DEFAULT 0 NOT NULL,
+    assistant_conversation_id bigint,
+    next_attempt_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+CREATE INDEX idx_assistant_campaign_contacts_campaign_id ON public.assistant_campaign_contacts USING btree (campaign_id, contact_status, next_attempt_at);
+CREATE INDEX idx_assistant_campaign_contacts_credential_id ON public.assistant_campaign_contacts USING btree (credential_id, contact_status);
+CREATE INDEX idx_assistant_campaign_contacts_conversation_id ON public.assistant_campaign_contacts USING btree (assistant_conversation_id);
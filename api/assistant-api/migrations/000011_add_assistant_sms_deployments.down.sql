@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS public.assistant_sms_opt_outs;
+DROP TABLE IF EXISTS public.assistant_deployment_sms_options;
+DROP TABLE IF EXISTS public.assistant_sms_deployments;
@@ -0,0 +1,46 @@
+CREATE TABLE public.assistant_sms_deployments (
+    id bigint PRIMARY KEY,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone,
+    assistant_id bigint NOT NULL,
+    greeting character varying(250),
+    mistake character varying(250),
+    ideal_timeout BIGINT,
+    ideal_timeout_backoff BIGINT,
+    ideal_timeout_message CHARACTER VARYING(200),
+    max_session_duration BIGINT,
+    sms_provider character varying(50) NOT NULL
+);
+CREATE INDEX idx_assistant_sms_deployments_on_assistant_id ON public.assistant_sms_deployments USING btree (assistant_id);
+CREATE INDEX idx_assistant_sms_deployments_status ON public.assistant_sms_deployments USING btree (status);
+
+CREATE TABLE public.assistant_deployment_sms_options (
+    id bigint PRIMARY KEY,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone,
+    key character varying(200) NOT NULL,
+    value text NOT NULL,
+    assistant_deployment_sms_id bigint NOT NULL
+);
+ALTER TABLE ONLY public.assistant_deployment_sms_options
+    ADD CONSTRAINT uk_assistant_deployment_sms_options UNIQUE (key, assistant_deployment_sms_id);
+
+CREATE TABLE public.assistant_sms_opt_outs (
+    id bigint PRIMARY KEY,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone,
+    assistant_id bigint NOT NULL,
+    phone_number character varying(50) NOT NULL,
+    keyword character varying(50)
+);
+ALTER TABLE ONLY public.assistant_sms_opt_outs
+    ADD CONSTRAINT uk_assistant_sms_opt_outs UNIQUE (assistant_id, phone_number);
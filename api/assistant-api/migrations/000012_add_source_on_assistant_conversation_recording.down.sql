@@ -0,0 +1 @@
+ALTER TABLE public.assistant_conversation_recordings DROP COLUMN IF EXISTS source;
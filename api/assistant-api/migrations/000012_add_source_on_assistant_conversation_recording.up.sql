@@ -0,0 +1 @@
+ALTER TABLE public.assistant_conversation_recordings ADD COLUMN source character varying(50) DEFAULT 'rapida'::character varying NOT NULL;
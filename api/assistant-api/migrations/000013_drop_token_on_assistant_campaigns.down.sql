@@ -0,0 +1 @@
+ALTER TABLE public.assistant_campaigns ADD COLUMN token character varying(400) DEFAULT ''::character varying NOT NULL;
@@ -0,0 +1 @@
+ALTER TABLE public.assistant_campaigns DROP COLUMN IF EXISTS token;
@@ -33,11 +33,13 @@ func AssistantApiRoute(
 func AssistantDeploymentApiRoute(Cfg *config.AssistantConfig,
 	S *grpc.Server,
 	Logger commons.Logger,
-	Postgres connectors.PostgresConnector) {
+	Postgres connectors.PostgresConnector,
+	Redis connectors.RedisConnector) {
 	workflow_api.RegisterAssistantDeploymentServiceServer(S,
 		assistantDeploymentApi.NewAssistantDeploymentGRPCApi(Cfg,
 			Logger,
 			Postgres,
+			Redis,
 		))
 }
 
@@ -72,18 +74,33 @@ func TalkCallbackApiRoute(
 		apiv1.POST("/:telephony/event/:assistantId", talkRpcApi.UnviersalCallback)
 
 		// session event
-		apiv1.GET("/:telephony/usr/event/:assistantId/:conversationId/:authorization/:x-auth-id/:x-project-id", talkRpcApi.Callback)
-		apiv1.POST("/:telephony/usr/event/:assistantId/:conversationId/:authorization/:x-auth-id/:x-project-id", talkRpcApi.Callback)
-		apiv1.GET("/:telephony/prj/event/:assistantId/:conversationId/:x-api-key", talkRpcApi.Callback)
-		apiv1.POST("/:telephony/prj/event/:assistantId/:conversationId/:x-api-key", talkRpcApi.Callback)
+		apiv1.GET("/:telephony/usr/event/:assistantId/:conversationId/:authorization/:x-auth-id/:x-project-id", talkRpcApi.VerifyWebhook, talkRpcApi.Callback)
+		apiv1.POST("/:telephony/usr/event/:assistantId/:conversationId/:authorization/:x-auth-id/:x-project-id", talkRpcApi.VerifyWebhook, talkRpcApi.Callback)
+		apiv1.GET("/:telephony/prj/event/:assistantId/:conversationId/:x-api-key", talkRpcApi.VerifyWebhook, talkRpcApi.Callback)
+		apiv1.POST("/:telephony/prj/event/:assistantId/:conversationId/:x-api-key", talkRpcApi.VerifyWebhook, talkRpcApi.Callback)
+		apiv1.GET("/:telephony/svc/event/:assistantId/:conversationId/:x-internal-service-key", talkRpcApi.VerifyWebhook, talkRpcApi.Callback)
+		apiv1.POST("/:telephony/svc/event/:assistantId/:conversationId/:x-internal-service-key", talkRpcApi.VerifyWebhook, talkRpcApi.Callback)
 
-		// twilio whatsapp
-		apiv1.POST("/twilio/whatsapp/:assistantToken", talkRpcApi.WhatsappReciever)
+		// whatsapp
+		apiv1.GET("/whatsapp/:whatsapp/prj/:assistantId/:x-api-key", talkRpcApi.WhatsappSubscribe)
+		apiv1.POST("/whatsapp/:whatsapp/prj/:assistantId/:x-api-key", talkRpcApi.WhatsappReciever)
+
+		// sms
+		apiv1.POST("/sms/:sms/prj/:assistantId/:x-api-key", talkRpcApi.SmsReciever)
 
 		// vonage call
-		apiv1.GET("/:telephony/call/:assistantId", talkRpcApi.CallReciever)
+		apiv1.GET("/:telephony/call/:assistantId", talkRpcApi.VerifyWebhook, talkRpcApi.CallReciever)
 		apiv1.GET("/:telephony/usr/:assistantId/:identifier/:conversationId/:authorization/:x-auth-id/:x-project-id", talkRpcApi.CallTalker)
 		apiv1.GET("/:telephony/prj/:assistantId/:identifier/:conversationId/:x-api-key", talkRpcApi.CallTalker)
+		apiv1.GET("/:telephony/svc/:assistantId/:identifier/:conversationId/:x-internal-service-key", talkRpcApi.CallTalker)
+
+		// instruction of an answered outbound call
+		apiv1.GET("/:telephony/usr/instruction/:assistantId/:identifier/:conversationId/:authorization/:x-auth-id/:x-project-id", talkRpcApi.VerifyWebhook, talkRpcApi.CallInstruction)
+		apiv1.POST("/:telephony/usr/instruction/:assistantId/:identifier/:conversationId/:authorization/:x-auth-id/:x-project-id", talkRpcApi.VerifyWebhook, talkRpcApi.CallInstruction)
+		apiv1.GET("/:telephony/prj/instruction/:assistantId/:identifier/:conversationId/:x-api-key", talkRpcApi.VerifyWebhook, talkRpcApi.CallInstruction)
+		apiv1.POST("/:telephony/prj/instruction/:assistantId/:identifier/:conversationId/:x-api-key", talkRpcApi.VerifyWebhook, talkRpcApi.CallInstruction)
+		apiv1.GET("/:telephony/svc/instruction/:assistantId/:identifier/:conversationId/:x-internal-service-key", talkRpcApi.VerifyWebhook, talkRpcApi.CallInstruction)
+		apiv1.POST("/:telephony/svc/instruction/:assistantId/:identifier/:conversationId/:x-internal-service-key", talkRpcApi.VerifyWebhook, talkRpcApi.CallInstruction)
 
 	}
 }
@@ -0,0 +1,22 @@
+package assistant_router
+
+import (
+	"context"
+
+	assistantTalkApi "github.com/rapidaai/api/assistant-api/api/talk"
+	"github.com/rapidaai/api/assistant-api/config"
+	telephony "github.com/rapidaai/api/assistant-api/internal/telephony"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+)
+
+// AudioSocketServer takes the audio sockets of asterisk calls, it only runs when a listen address is configured.
+func AudioSocketServer(ctx context.Context, cfg *config.AssistantConfig, logger commons.Logger, postgres connectors.PostgresConnector, redis connectors.RedisConnector, opensearch connectors.OpenSearchConnector) {
+	if cfg.AudioSocketConfig.Listen == "" {
+		return
+	}
+	talkApi := assistantTalkApi.NewConversationApi(cfg, logger, postgres, redis, opensearch, opensearch)
+	if err := telephony.ListenAudioSocket(ctx, cfg, logger, talkApi); err != nil {
+		logger.Errorf("unable to start audio socket server on %s: %v", cfg.AudioSocketConfig.Listen, err)
+	}
+}
@@ -0,0 +1,37 @@
+package assistant_router
+
+import (
+	"context"
+	"time"
+
+	assistantTalkApi "github.com/rapidaai/api/assistant-api/api/talk"
+	"github.com/rapidaai/api/assistant-api/config"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/utils"
+)
+
+const (
+	// how often due contacts of running campaigns are called
+	campaignDialInterval = 10 * time.Second
+
+	// campaign calls without status callback for this long are considered failed
+	campaignCallStaleAfter = 2 * time.Hour
+)
+
+// CampaignDialer periodically places the calls of running campaigns within their calling window.
+func CampaignDialer(ctx context.Context, cfg *config.AssistantConfig, logger commons.Logger, postgres connectors.PostgresConnector, redis connectors.RedisConnector, opensearch connectors.OpenSearchConnector) {
+	talkApi := assistantTalkApi.NewConversationApi(cfg, logger, postgres, redis, opensearch, opensearch)
+	utils.Go(ctx, func() {
+		ticker := time.NewTicker(campaignDialInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				talkApi.DialCampaigns(ctx, now, campaignCallStaleAfter)
+			}
+		}
+	})
+}
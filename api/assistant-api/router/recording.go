@@ -0,0 +1,40 @@
+package assistant_router
+
+import (
+	"context"
+	"time"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_assistant_service "github.com/rapidaai/api/assistant-api/internal/services/assistant"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	storage_files "github.com/rapidaai/pkg/storages/file-storage"
+	"github.com/rapidaai/pkg/utils"
+)
+
+// streamed recordings which have not been written to for this long belong to a session
+// that ended abnormally, for example when the instance serving the call crashed
+const recordingStaleAfter = 15 * time.Minute
+
+// RecordingRecovery periodically completes streamed recordings of abandoned sessions so the
+// audio written before the session ended is kept.
+func RecordingRecovery(ctx context.Context, cfg *config.AssistantConfig, logger commons.Logger, postgres connectors.PostgresConnector) {
+	conversationService := internal_assistant_service.NewAssistantConversationService(logger, postgres, storage_files.NewStorage(cfg.AssetStoreConfig, logger))
+	utils.Go(ctx, func() {
+		ticker := time.NewTicker(recordingStaleAfter)
+		defer ticker.Stop()
+		for {
+			recovered, err := conversationService.RecoverConversationRecordings(ctx, recordingStaleAfter)
+			if err != nil {
+				logger.Errorf("unable to recover streamed recordings %v", err)
+			} else if recovered > 0 {
+				logger.Infof("recovered %d streamed recordings", recovered)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	})
+}
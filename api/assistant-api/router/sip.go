@@ -0,0 +1,22 @@
+package assistant_router
+
+import (
+	"context"
+
+	assistantTalkApi "github.com/rapidaai/api/assistant-api/api/talk"
+	"github.com/rapidaai/api/assistant-api/config"
+	telephony "github.com/rapidaai/api/assistant-api/internal/telephony"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+)
+
+// SipUserAgent answers and places the calls of sip trunks, it only runs when sip is configured.
+func SipUserAgent(ctx context.Context, cfg *config.AssistantConfig, logger commons.Logger, postgres connectors.PostgresConnector, redis connectors.RedisConnector, opensearch connectors.OpenSearchConnector) {
+	if cfg.SipConfig.Listen == "" {
+		return
+	}
+	talkApi := assistantTalkApi.NewConversationApi(cfg, logger, postgres, redis, opensearch, opensearch)
+	if err := telephony.ListenSip(ctx, cfg, logger, talkApi); err != nil {
+		logger.Errorf("unable to start sip user agent on %s: %v", cfg.SipConfig.Listen, err)
+	}
+}
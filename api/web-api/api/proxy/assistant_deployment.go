@@ -72,6 +72,15 @@ func (w *webAssistantDeploymentGRPCApi) GetAssistantWhatsappDeployment(c context
 	return w.assistantClient.GetAssistantWhatsappDeployment(c, iAuth, iRequest)
 }
 
+// GetAssistantSmsDeployment implements protos.AssistantDeploymentServiceServer.
+func (w *webAssistantDeploymentGRPCApi) GetAssistantSmsDeployment(c context.Context, iRequest *protos.GetAssistantDeploymentRequest) (*protos.GetAssistantSmsDeploymentResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(c)
+	if !isAuthenticated {
+		return nil, errors.New("unauthenticated request")
+	}
+	return w.assistantClient.GetAssistantSmsDeployment(c, iAuth, iRequest)
+}
+
 func (w *webAssistantDeploymentGRPCApi) CreateAssistantApiDeployment(c context.Context, iRequest *protos.CreateAssistantDeploymentRequest) (*protos.GetAssistantApiDeploymentResponse, error) {
 	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(c)
 	if !isAuthenticated {
@@ -116,6 +125,15 @@ func (w *webAssistantDeploymentGRPCApi) CreateAssistantWhatsappDeployment(c cont
 	return w.assistantClient.CreateAssistantWhatsappDeployment(c, iAuth, iRequest)
 }
 
+// CreateAssistantSmsDeployment implements protos.AssistantDeploymentServiceServer.
+func (w *webAssistantDeploymentGRPCApi) CreateAssistantSmsDeployment(c context.Context, iRequest *protos.CreateAssistantDeploymentRequest) (*protos.GetAssistantSmsDeploymentResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(c)
+	if !isAuthenticated {
+		return nil, errors.New("unauthenticated request")
+	}
+	return w.assistantClient.CreateAssistantSmsDeployment(c, iAuth, iRequest)
+}
+
 // G
 func NewAssistantDeploymentGRPCApi(config *config.WebAppConfig, logger commons.Logger, postgres connectors.PostgresConnector, redis connectors.RedisConnector) protos.AssistantDeploymentServiceServer {
 	return &webAssistantDeploymentGRPCApi{
@@ -179,3 +179,84 @@ func (knowledgeGRPCApi *webKnowledgeGRPCApi) GetKnowledgeLog(ctx context.Context
 	}
 	return knowledgeGRPCApi.knowledgeClient.GetKnowledgeLog(ctx, iAuth, iRequest)
 }
+
+func (knowledgeGRPCApi *webKnowledgeGRPCApi) CreateKnowledgeEvaluation(ctx context.Context, iRequest *protos.CreateKnowledgeEvaluationRequest) (*protos.GetKnowledgeEvaluationResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		knowledgeGRPCApi.logger.Errorf("unauthenticated request to CreateKnowledgeEvaluation")
+		return nil, errors.New("unauthenticated request")
+	}
+	return knowledgeGRPCApi.knowledgeClient.CreateKnowledgeEvaluation(ctx, iAuth, iRequest)
+}
+
+func (knowledgeGRPCApi *webKnowledgeGRPCApi) RunKnowledgeEvaluation(ctx context.Context, iRequest *protos.RunKnowledgeEvaluationRequest) (*protos.GetKnowledgeEvaluationResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		knowledgeGRPCApi.logger.Errorf("unauthenticated request to RunKnowledgeEvaluation")
+		return nil, errors.New("unauthenticated request")
+	}
+	return knowledgeGRPCApi.knowledgeClient.RunKnowledgeEvaluation(ctx, iAuth, iRequest)
+}
+
+func (knowledgeGRPCApi *webKnowledgeGRPCApi) GetKnowledgeEvaluation(ctx context.Context, iRequest *protos.GetKnowledgeEvaluationRequest) (*protos.GetKnowledgeEvaluationResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		knowledgeGRPCApi.logger.Errorf("unauthenticated request to GetKnowledgeEvaluation")
+		return nil, errors.New("unauthenticated request")
+	}
+	return knowledgeGRPCApi.knowledgeClient.GetKnowledgeEvaluation(ctx, iAuth, iRequest)
+}
+
+func (knowledgeGRPCApi *webKnowledgeGRPCApi) GetAllKnowledgeEvaluation(ctx context.Context, iRequest *protos.GetAllKnowledgeEvaluationRequest) (*protos.GetAllKnowledgeEvaluationResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		knowledgeGRPCApi.logger.Errorf("unauthenticated request to GetAllKnowledgeEvaluation")
+		return nil, errors.New("unauthenticated request")
+	}
+	return knowledgeGRPCApi.knowledgeClient.GetAllKnowledgeEvaluation(ctx, iAuth, iRequest)
+}
+
+func (knowledgeGRPCApi *webKnowledgeGRPCApi) ReindexKnowledge(ctx context.Context, iRequest *protos.ReindexKnowledgeRequest) (*protos.GetKnowledgeEmbeddingJobResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		knowledgeGRPCApi.logger.Errorf("unauthenticated request to ReindexKnowledge")
+		return nil, errors.New("unauthenticated request")
+	}
+	return knowledgeGRPCApi.knowledgeClient.ReindexKnowledge(ctx, iAuth, iRequest)
+}
+
+func (knowledgeGRPCApi *webKnowledgeGRPCApi) GetKnowledgeEmbeddingJob(ctx context.Context, iRequest *protos.GetKnowledgeEmbeddingJobRequest) (*protos.GetKnowledgeEmbeddingJobResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		knowledgeGRPCApi.logger.Errorf("unauthenticated request to GetKnowledgeEmbeddingJob")
+		return nil, errors.New("unauthenticated request")
+	}
+	return knowledgeGRPCApi.knowledgeClient.GetKnowledgeEmbeddingJob(ctx, iAuth, iRequest)
+}
+
+func (knowledgeGRPCApi *webKnowledgeGRPCApi) GetAllKnowledgeEmbeddingJob(ctx context.Context, iRequest *protos.GetAllKnowledgeEmbeddingJobRequest) (*protos.GetAllKnowledgeEmbeddingJobResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		knowledgeGRPCApi.logger.Errorf("unauthenticated request to GetAllKnowledgeEmbeddingJob")
+		return nil, errors.New("unauthenticated request")
+	}
+	return knowledgeGRPCApi.knowledgeClient.GetAllKnowledgeEmbeddingJob(ctx, iAuth, iRequest)
+}
+
+func (knowledgeGRPCApi *webKnowledgeGRPCApi) RollbackKnowledgeEmbeddingJob(ctx context.Context, iRequest *protos.RollbackKnowledgeEmbeddingJobRequest) (*protos.GetKnowledgeEmbeddingJobResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		knowledgeGRPCApi.logger.Errorf("unauthenticated request to RollbackKnowledgeEmbeddingJob")
+		return nil, errors.New("unauthenticated request")
+	}
+	return knowledgeGRPCApi.knowledgeClient.RollbackKnowledgeEmbeddingJob(ctx, iAuth, iRequest)
+}
+
+func (knowledgeGRPCApi *webKnowledgeGRPCApi) GetAllKnowledgeDocumentSegmentRevision(ctx context.Context, iRequest *protos.GetAllKnowledgeDocumentSegmentRevisionRequest) (*protos.GetAllKnowledgeDocumentSegmentRevisionResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		knowledgeGRPCApi.logger.Errorf("unauthenticated request to GetAllKnowledgeDocumentSegmentRevision")
+		return nil, errors.New("unauthenticated request")
+	}
+	return knowledgeGRPCApi.knowledgeClient.GetAllKnowledgeDocumentSegmentRevision(ctx, iAuth, iRequest)
+}
@@ -125,6 +125,18 @@ func main() {
 		panic(err)
 	}
 
+	// finalize recordings of sessions which ended without closing them
+	appRunner.RecoverRecordings(ctx)
+
+	// place the calls of running campaigns
+	appRunner.DialCampaigns(ctx)
+
+	// answer and place the calls of sip trunks
+	appRunner.ListenSip(ctx)
+
+	// stream the calls of on-prem asterisk
+	appRunner.ListenAudioSocket(ctx)
+
 	// add all middleware depends on configurations
 	appRunner.AllMiddlewares()
 
@@ -272,6 +284,27 @@ func (app *AppRunner) Init(ctx context.Context) error {
 	return nil
 }
 
+// RecoverRecordings periodically completes streamed recordings of abandoned sessions so the
+// audio written before the session ended is kept.
+func (app *AppRunner) RecoverRecordings(ctx context.Context) {
+	router.RecordingRecovery(ctx, app.Cfg, app.Logger, app.Postgres)
+}
+
+// DialCampaigns periodically calls the due contacts of running campaigns.
+func (app *AppRunner) DialCampaigns(ctx context.Context) {
+	router.CampaignDialer(ctx, app.Cfg, app.Logger, app.Postgres, app.Redis, app.Opensearch)
+}
+
+// ListenSip runs the sip user agent when sip trunks are configured.
+func (app *AppRunner) ListenSip(ctx context.Context) {
+	router.SipUserAgent(ctx, app.Cfg, app.Logger, app.Postgres, app.Redis, app.Opensearch)
+}
+
+// ListenAudioSocket runs the audio socket server when asterisk is configured.
+func (app *AppRunner) ListenAudioSocket(ctx context.Context) {
+	router.AudioSocketServer(ctx, app.Cfg, app.Logger, app.Postgres, app.Redis, app.Opensearch)
+}
+
 // closer for app runner
 func (app *AppRunner) Close(ctx context.Context) {
 	if len(app.Closeable) > 0 {
@@ -292,7 +325,7 @@ func (g *AppRunner) AllRouters() {
 	router.KnowledgeApiRoute(g.Cfg, g.S, g.Logger, g.Postgres, g.Redis, g.Opensearch)
 	router.DocumentApiRoute(g.Cfg, g.S, g.Logger, g.Postgres, g.Redis, g.Opensearch)
 	router.AssistantConversationApiRoute(g.Cfg, g.S, g.Logger, g.Postgres, g.Redis, g.Opensearch)
-	router.AssistantDeploymentApiRoute(g.Cfg, g.S, g.Logger, g.Postgres)
+	router.AssistantDeploymentApiRoute(g.Cfg, g.S, g.Logger, g.Postgres, g.Redis)
 
 	// rpc call handle by gin handler
 	router.TalkCallbackApiRoute(g.Cfg, g.E, g.Logger, g.Postgres, g.Redis, g.Opensearch)
@@ -327,6 +360,10 @@ func (g *AppRunner) AuthenticationMiddleware() {
 		),
 		g.Logger,
 	))
+	g.E.Use(middlewares.NewServiceAuthenticatorMiddleware(
+		authenticators.NewServiceAuthenticator(&g.Cfg.AppConfig, g.Logger, g.Postgres),
+		g.Logger,
+	))
 }
 
 func (g *AppRunner) CorsMiddleware() {
@@ -52,12 +52,14 @@ type AssistantServiceClient interface {
 	CreateAssistantApiDeployment(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.CreateAssistantDeploymentRequest) (*protos.GetAssistantApiDeploymentResponse, error)
 	CreateAssistantPhoneDeployment(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.CreateAssistantDeploymentRequest) (*protos.GetAssistantPhoneDeploymentResponse, error)
 	CreateAssistantWhatsappDeployment(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.CreateAssistantDeploymentRequest) (*protos.GetAssistantWhatsappDeploymentResponse, error)
+	CreateAssistantSmsDeployment(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.CreateAssistantDeploymentRequest) (*protos.GetAssistantSmsDeploymentResponse, error)
 	CreateAssistantWebpluginDeployment(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.CreateAssistantDeploymentRequest) (*protos.GetAssistantWebpluginDeploymentResponse, error)
 	CreateAssistantDebuggerDeployment(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.CreateAssistantDeploymentRequest) (*protos.GetAssistantDebuggerDeploymentResponse, error)
 
 	GetAssistantApiDeployment(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.GetAssistantDeploymentRequest) (*protos.GetAssistantApiDeploymentResponse, error)
 	GetAssistantPhoneDeployment(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.GetAssistantDeploymentRequest) (*protos.GetAssistantPhoneDeploymentResponse, error)
 	GetAssistantWhatsappDeployment(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.GetAssistantDeploymentRequest) (*protos.GetAssistantWhatsappDeploymentResponse, error)
+	GetAssistantSmsDeployment(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.GetAssistantDeploymentRequest) (*protos.GetAssistantSmsDeploymentResponse, error)
 	GetAssistantWebpluginDeployment(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.GetAssistantDeploymentRequest) (*protos.GetAssistantWebpluginDeploymentResponse, error)
 	GetAssistantDebuggerDeployment(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.GetAssistantDeploymentRequest) (*protos.GetAssistantDebuggerDeploymentResponse, error)
 
@@ -373,6 +375,18 @@ func (client *assistantServiceClient) CreateAssistantWhatsappDeployment(c contex
 	client.logger.Benchmark("Benchmarking: assistantDeploymentClient.CreateAssistantWhatsappDeployment", time.Since(start))
 	return res, nil
 }
+
+func (client *assistantServiceClient) CreateAssistantSmsDeployment(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.CreateAssistantDeploymentRequest) (*protos.GetAssistantSmsDeploymentResponse, error) {
+	start := time.Now()
+	res, err := client.assistantDeploymentClient.CreateAssistantSmsDeployment(client.WithAuth(c, auth), assistantRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantDeploymentClient.CreateAssistantSmsDeployment", time.Since(start))
+		client.logger.Errorf("error while calling CreateAssistantSmsDeployment %v", err)
+		return nil, err
+	}
+	client.logger.Benchmark("Benchmarking: assistantDeploymentClient.CreateAssistantSmsDeployment", time.Since(start))
+	return res, nil
+}
 func (client *assistantServiceClient) CreateAssistantWebpluginDeployment(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.CreateAssistantDeploymentRequest) (*protos.GetAssistantWebpluginDeploymentResponse, error) {
 	start := time.Now()
 	res, err := client.assistantDeploymentClient.CreateAssistantWebpluginDeployment(client.WithAuth(c, auth), assistantRequest)
@@ -429,6 +443,18 @@ func (client *assistantServiceClient) GetAssistantWhatsappDeployment(c context.C
 	client.logger.Benchmark("Benchmarking: assistantDeploymentClient.GetAssistantWhatsappDeployment", time.Since(start))
 	return res, nil
 }
+
+func (client *assistantServiceClient) GetAssistantSmsDeployment(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.GetAssistantDeploymentRequest) (*protos.GetAssistantSmsDeploymentResponse, error) {
+	start := time.Now()
+	res, err := client.assistantDeploymentClient.GetAssistantSmsDeployment(client.WithAuth(c, auth), assistantRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantDeploymentClient.GetAssistantSmsDeployment", time.Since(start))
+		client.logger.Errorf("error while calling GetAssistantSmsDeployment %v", err)
+		return nil, err
+	}
+	client.logger.Benchmark("Benchmarking: assistantDeploymentClient.GetAssistantSmsDeployment", time.Since(start))
+	return res, nil
+}
 func (client *assistantServiceClient) GetAssistantWebpluginDeployment(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.GetAssistantDeploymentRequest) (*protos.GetAssistantWebpluginDeploymentResponse, error) {
 	start := time.Now()
 	res, err := client.assistantDeploymentClient.GetAssistantWebpluginDeployment(client.WithAuth(c, auth), assistantRequest)
@@ -35,6 +35,16 @@ type KnowledgeServiceClient interface {
 
 	GetAllKnowledgeLog(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.GetAllKnowledgeLogRequest) (*knowledge_api.GetAllKnowledgeLogResponse, error)
 	GetKnowledgeLog(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.GetKnowledgeLogRequest) (*knowledge_api.GetKnowledgeLogResponse, error)
+
+	CreateKnowledgeEvaluation(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.CreateKnowledgeEvaluationRequest) (*knowledge_api.GetKnowledgeEvaluationResponse, error)
+	RunKnowledgeEvaluation(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.RunKnowledgeEvaluationRequest) (*knowledge_api.GetKnowledgeEvaluationResponse, error)
+	GetKnowledgeEvaluation(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.GetKnowledgeEvaluationRequest) (*knowledge_api.GetKnowledgeEvaluationResponse, error)
+	GetAllKnowledgeEvaluation(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.GetAllKnowledgeEvaluationRequest) (*knowledge_api.GetAllKnowledgeEvaluationResponse, error)
+	ReindexKnowledge(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.ReindexKnowledgeRequest) (*knowledge_api.GetKnowledgeEmbeddingJobResponse, error)
+	GetKnowledgeEmbeddingJob(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.GetKnowledgeEmbeddingJobRequest) (*knowledge_api.GetKnowledgeEmbeddingJobResponse, error)
+	GetAllKnowledgeEmbeddingJob(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.GetAllKnowledgeEmbeddingJobRequest) (*knowledge_api.GetAllKnowledgeEmbeddingJobResponse, error)
+	RollbackKnowledgeEmbeddingJob(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.RollbackKnowledgeEmbeddingJobRequest) (*knowledge_api.GetKnowledgeEmbeddingJobResponse, error)
+	GetAllKnowledgeDocumentSegmentRevision(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.GetAllKnowledgeDocumentSegmentRevisionRequest) (*knowledge_api.GetAllKnowledgeDocumentSegmentRevisionResponse, error)
 }
 
 type knowledgeServiceClient struct {
@@ -188,3 +198,84 @@ func (client *knowledgeServiceClient) GetKnowledgeLog(ctx context.Context, auth
 	}
 	return res, nil
 }
+
+func (client *knowledgeServiceClient) CreateKnowledgeEvaluation(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.CreateKnowledgeEvaluationRequest) (*knowledge_api.GetKnowledgeEvaluationResponse, error) {
+	res, err := client.knowledgeClient.CreateKnowledgeEvaluation(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Errorf("error while calling CreateKnowledgeEvaluation %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (client *knowledgeServiceClient) RunKnowledgeEvaluation(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.RunKnowledgeEvaluationRequest) (*knowledge_api.GetKnowledgeEvaluationResponse, error) {
+	res, err := client.knowledgeClient.RunKnowledgeEvaluation(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Errorf("error while calling RunKnowledgeEvaluation %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (client *knowledgeServiceClient) GetKnowledgeEvaluation(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.GetKnowledgeEvaluationRequest) (*knowledge_api.GetKnowledgeEvaluationResponse, error) {
+	res, err := client.knowledgeClient.GetKnowledgeEvaluation(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Errorf("error while calling GetKnowledgeEvaluation %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (client *knowledgeServiceClient) GetAllKnowledgeEvaluation(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.GetAllKnowledgeEvaluationRequest) (*knowledge_api.GetAllKnowledgeEvaluationResponse, error) {
+	res, err := client.knowledgeClient.GetAllKnowledgeEvaluation(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Errorf("error while calling GetAllKnowledgeEvaluation %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (client *knowledgeServiceClient) ReindexKnowledge(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.ReindexKnowledgeRequest) (*knowledge_api.GetKnowledgeEmbeddingJobResponse, error) {
+	res, err := client.knowledgeClient.ReindexKnowledge(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Errorf("error while calling ReindexKnowledge %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (client *knowledgeServiceClient) GetKnowledgeEmbeddingJob(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.GetKnowledgeEmbeddingJobRequest) (*knowledge_api.GetKnowledgeEmbeddingJobResponse, error) {
+	res, err := client.knowledgeClient.GetKnowledgeEmbeddingJob(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Errorf("error while calling GetKnowledgeEmbeddingJob %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (client *knowledgeServiceClient) GetAllKnowledgeEmbeddingJob(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.GetAllKnowledgeEmbeddingJobRequest) (*knowledge_api.GetAllKnowledgeEmbeddingJobResponse, error) {
+	res, err := client.knowledgeClient.GetAllKnowledgeEmbeddingJob(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Errorf("error while calling GetAllKnowledgeEmbeddingJob %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (client *knowledgeServiceClient) RollbackKnowledgeEmbeddingJob(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.RollbackKnowledgeEmbeddingJobRequest) (*knowledge_api.GetKnowledgeEmbeddingJobResponse, error) {
+	res, err := client.knowledgeClient.RollbackKnowledgeEmbeddingJob(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Errorf("error while calling RollbackKnowledgeEmbeddingJob %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (client *knowledgeServiceClient) GetAllKnowledgeDocumentSegmentRevision(ctx context.Context, auth types.SimplePrinciple, in *knowledge_api.GetAllKnowledgeDocumentSegmentRevisionRequest) (*knowledge_api.GetAllKnowledgeDocumentSegmentRevisionResponse, error) {
+	res, err := client.knowledgeClient.GetAllKnowledgeDocumentSegmentRevision(client.WithAuth(ctx, auth), in)
+	if err != nil {
+		client.logger.Errorf("error while calling GetAllKnowledgeDocumentSegmentRevision %v", err)
+		return nil, err
+	}
+	return res, nil
+}
@@ -59,6 +59,8 @@ type OpenSearchConnector interface {
 	Persist(ctx context.Context, index string, id string, body string) error
 	Update(ctx context.Context, index string, id string, body string) error
 	Bulk(ctx context.Context, body string) error
+	CreateIndex(ctx context.Context, index string, body string) error
+	DeleteIndex(ctx context.Context, index string) error
 }
 
 type openSearchConnector struct {
@@ -609,6 +611,45 @@ func (openSearch *openSearchConnector) Bulk(ctx context.Context, body string) er
 	return nil
 }
 
+// creating index with given settings and mappings, fails when the index already exists
+func (openSearch *openSearchConnector) CreateIndex(ctx context.Context, index string, body string) error {
+	openSearch.logger.Debugf("creating index %s", index)
+	req := opensearchapi.IndicesCreateRequest{
+		Index: index,
+		Body:  strings.NewReader(body),
+	}
+	createResponse, err := req.Do(ctx, openSearch.Connection)
+	if err != nil {
+		openSearch.logger.Errorf("error creating opensearch index %s got error %v", index, err)
+		return err
+	}
+	defer createResponse.Body.Close()
+	if createResponse.IsError() {
+		openSearch.logger.Errorf("error creating opensearch index status is not legal: %v", createResponse.StatusCode)
+		return fmt.Errorf("unable to create index %s, status %d", index, createResponse.StatusCode)
+	}
+	return nil
+}
+
+// deleting index with all its documents, an index which does not exist is already deleted
+func (openSearch *openSearchConnector) DeleteIndex(ctx context.Context, index string) error {
+	openSearch.logger.Debugf("deleting index %s", index)
+	req := opensearchapi.IndicesDeleteRequest{
+		Index: []string{index},
+	}
+	deleteResponse, err := req.Do(ctx, openSearch.Connection)
+	if err != nil {
+		openSearch.logger.Errorf("error deleting opensearch index %s got error %v", index, err)
+		return err
+	}
+	defer deleteResponse.Body.Close()
+	if deleteResponse.IsError() && deleteResponse.StatusCode != http.StatusNotFound {
+		openSearch.logger.Errorf("error deleting opensearch index status is not legal: %v", deleteResponse.StatusCode)
+		return fmt.Errorf("unable to delete index %s, status %d", index, deleteResponse.StatusCode)
+	}
+	return nil
+}
+
 // persisting body to index in opensearch
 func (openSearch *openSearchConnector) Persist(ctx context.Context, index string, id string, body string) error {
 	openSearch.logger.Debugf("indexing query started executing on index %s", index)
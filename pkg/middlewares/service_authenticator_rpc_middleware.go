@@ -0,0 +1,34 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+)
+
+func NewServiceAuthenticatorMiddleware(resolver types.ClaimAuthenticator[*types.ServiceScope], logger commons.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authToken := c.GetHeader(types.SERVICE_SCOPE_KEY)
+		if authToken == "" {
+			authToken = c.Param(types.SERVICE_SCOPE_KEY)
+		}
+		if authToken == "" {
+			c.Next()
+			return
+		}
+		auth, err := resolver.Claim(c, authToken)
+		if err != nil {
+			logger.Errorf("unable to resolve given internal-service-key")
+			c.Next()
+			return
+		}
+		c.Set(string(types.CTX_), auth)
+		c.Next()
+	}
+}
@@ -157,3 +157,111 @@ func (aws *awsFileStorage) GetUrl(ctx context.Context, key string) storages.Stor
 		StorageType:  configs.S3,
 	}
 }
+
+// s3 rejects parts smaller than 5 MiB except the last one
+const awsMinPartSize = 5 * 1024 * 1024
+
+func (storage *awsFileStorage) CreateMultipart(ctx context.Context, key string) (string, error) {
+	storage.logger.Debugf("s3.createMultipart with file path name %s storage path prefix %s", key, storage.config.StoragePathPrefix)
+	aws_session, err := aws_session.NewSessionWithOptions(storage.options)
+	if err != nil {
+		storage.logger.Errorf("unable to create aws s3 session to upload the document %v", err)
+		return "", err
+	}
+	output, err := s3.New(aws_session).CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(storage.config.StoragePathPrefix),
+		Key:         aws.String(key),
+		ContentType: aws.String(storage.contentType(key)),
+	})
+	if err != nil {
+		storage.logger.Errorf("Error creating multipart upload: %v", err)
+		return "", err
+	}
+	return aws.StringValue(output.UploadId), nil
+}
+
+func (storage *awsFileStorage) UploadPart(ctx context.Context, key string, uploadId string, partNumber int64, content []byte) error {
+	aws_session, err := aws_session.NewSessionWithOptions(storage.options)
+	if err != nil {
+		storage.logger.Errorf("unable to create aws s3 session to upload the document %v", err)
+		return err
+	}
+	_, err = s3.New(aws_session).UploadPartWithContext(ctx, &s3.UploadPartInput{
+		Bucket:     aws.String(storage.config.StoragePathPrefix),
+		Key:        aws.String(key),
+		UploadId:   aws.String(uploadId),
+		PartNumber: aws.Int64(partNumber),
+		Body:       bytes.NewReader(content),
+	})
+	if err != nil {
+		storage.logger.Errorf("Error uploading part %d to S3: %v", partNumber, err)
+		return err
+	}
+	return nil
+}
+
+// CompleteMultipart lists the uploaded parts from s3 so an upload can be completed
+// by a process other than the one that wrote the parts
+func (storage *awsFileStorage) CompleteMultipart(ctx context.Context, key string, uploadId string) storages.StorageOutput {
+	completePath := fmt.Sprintf("s3://%s/%s", storage.config.StoragePathPrefix, key)
+	aws_session, err := aws_session.NewSessionWithOptions(storage.options)
+	if err != nil {
+		storage.logger.Errorf("unable to create aws s3 session to upload the document %v", err)
+		return storages.StorageOutput{Error: err, StorageType: configs.S3}
+	}
+	s3Client := s3.New(aws_session)
+	parts := make([]*s3.CompletedPart, 0)
+	err = s3Client.ListPartsPagesWithContext(ctx, &s3.ListPartsInput{
+		Bucket:   aws.String(storage.config.StoragePathPrefix),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadId),
+	}, func(page *s3.ListPartsOutput, lastPage bool) bool {
+		for _, part := range page.Parts {
+			parts = append(parts, &s3.CompletedPart{ETag: part.ETag, PartNumber: part.PartNumber})
+		}
+		return true
+	})
+	if err != nil {
+		storage.logger.Errorf("Error listing parts of multipart upload: %v", err)
+		return storages.StorageOutput{CompletePath: completePath, Error: err, StorageType: configs.S3}
+	}
+	if len(parts) == 0 {
+		return storages.StorageOutput{CompletePath: completePath, Error: fmt.Errorf("multipart upload %s has no parts", uploadId), StorageType: configs.S3}
+	}
+	_, err = s3Client.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(storage.config.StoragePathPrefix),
+		Key:             aws.String(key),
+		UploadId:        aws.String(uploadId),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+	})
+	if err != nil {
+		storage.logger.Errorf("Error completing multipart upload: %v", err)
+		return storages.StorageOutput{CompletePath: completePath, Error: err, StorageType: configs.S3}
+	}
+	return storages.StorageOutput{
+		CompletePath: completePath,
+		StorageType:  configs.S3,
+	}
+}
+
+func (storage *awsFileStorage) AbortMultipart(ctx context.Context, key string, uploadId string) error {
+	aws_session, err := aws_session.NewSessionWithOptions(storage.options)
+	if err != nil {
+		storage.logger.Errorf("unable to create aws s3 session to upload the document %v", err)
+		return err
+	}
+	_, err = s3.New(aws_session).AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(storage.config.StoragePathPrefix),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadId),
+	})
+	if err != nil {
+		storage.logger.Errorf("Error aborting multipart upload: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (storage *awsFileStorage) MinPartSize() int {
+	return awsMinPartSize
+}
@@ -7,12 +7,16 @@ package storage_files
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/configs"
+	gorm_generator "github.com/rapidaai/pkg/models/gorm/generators"
 	"github.com/rapidaai/pkg/storages"
 )
 
@@ -86,3 +90,114 @@ func (lfs *localFileStorage) GetUrl(ctx context.Context, key string) storages.St
 		StorageType:  configs.LOCAL,
 	}
 }
+
+// uploadPath is the directory the parts of an upload are kept in until it is completed
+func (lfs *localFileStorage) uploadPath(key, uploadId string) string {
+	return path.Join(lfs.config.StoragePathPrefix, fmt.Sprintf("%s.%s.parts", key, uploadId))
+}
+
+// partPath is the file of a part, the names sort in the order of the parts
+func (lfs *localFileStorage) partPath(key, uploadId string, partNumber int64) string {
+	return path.Join(lfs.uploadPath(key, uploadId), fmt.Sprintf("%010d.part", partNumber))
+}
+
+func (lfs *localFileStorage) CreateMultipart(ctx context.Context, key string) (string, error) {
+	lfs.logger.Debugf("localstorage.createMultipart with file path name %s", key)
+	uploadId := fmt.Sprintf("%d", gorm_generator.ID())
+	if err := os.MkdirAll(lfs.uploadPath(key, uploadId), 0755); err != nil {
+		lfs.logger.Errorf("unable to create multipart directory, err %v", err)
+		return "", err
+	}
+	return uploadId, nil
+}
+
+// UploadPart writes the part of the upload, a part written again replaces the previous one
+func (lfs *localFileStorage) UploadPart(ctx context.Context, key string, uploadId string, partNumber int64, content []byte) error {
+	if _, err := os.Stat(lfs.uploadPath(key, uploadId)); err != nil {
+		lfs.logger.Errorf("unable to find multipart upload for part %d, err %v", partNumber, err)
+		return err
+	}
+	partPath := lfs.partPath(key, uploadId, partNumber)
+	f, err := os.OpenFile(partPath+".tmp", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		lfs.logger.Errorf("unable to create multipart file for part %d, err %v", partNumber, err)
+		return err
+	}
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		lfs.logger.Errorf("unable to write part %d, err %v", partNumber, err)
+		return err
+	}
+	// sync so the written part survives a crash of the process
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(partPath+".tmp", partPath)
+}
+
+// CompleteMultipart joins the parts in the order of their numbers
+func (lfs *localFileStorage) CompleteMultipart(ctx context.Context, key string, uploadId string) storages.StorageOutput {
+	completePath := path.Join(lfs.config.StoragePathPrefix, key)
+	if err := lfs.joinParts(key, uploadId, completePath); err != nil {
+		lfs.logger.Errorf("unable to complete multipart file, err %v", err)
+		return storages.StorageOutput{
+			CompletePath: completePath,
+			StorageType:  configs.LOCAL,
+			Error:        err,
+		}
+	}
+	os.RemoveAll(lfs.uploadPath(key, uploadId))
+	return storages.StorageOutput{
+		CompletePath: completePath,
+		StorageType:  configs.LOCAL,
+	}
+}
+
+func (lfs *localFileStorage) joinParts(key, uploadId, completePath string) error {
+	parts, err := filepath.Glob(path.Join(lfs.uploadPath(key, uploadId), "*.part"))
+	if err != nil {
+		return err
+	}
+	if len(parts) == 0 {
+		return fmt.Errorf("multipart upload %s has no parts", uploadId)
+	}
+	sort.Strings(parts)
+	f, err := os.OpenFile(completePath+".tmp", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	for _, part := range parts {
+		content, err := os.Open(part)
+		if err != nil {
+			f.Close()
+			return err
+		}
+		_, err = io.Copy(f, content)
+		content.Close()
+		if err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(completePath+".tmp", completePath)
+}
+
+func (lfs *localFileStorage) AbortMultipart(ctx context.Context, key string, uploadId string) error {
+	if err := os.RemoveAll(lfs.uploadPath(key, uploadId)); err != nil {
+		lfs.logger.Errorf("unable to abort multipart file, err %v", err)
+		return err
+	}
+	return nil
+}
+
+// MinPartSize of local storage, parts of any size are accepted
+func (lfs *localFileStorage) MinPartSize() int {
+	return 0
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/configs"
+	"github.com/rapidaai/pkg/storages"
 )
 
 func TestLocalFileStorage_Name(t *testing.T) {
@@ -168,3 +169,66 @@ func TestLocalFileStorage_GetUrl(t *testing.T) {
 	expectedPath := filepath.Join("file://", tempDir, key)
 	assert.Equal(t, expectedPath, result.CompletePath)
 }
+
+func TestLocalFileStorage_Multipart(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "local_storage_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	cfg := configs.AssetStoreConfig{
+		StorageType:       "local",
+		StoragePathPrefix: tempDir,
+	}
+	logger, _ := commons.NewApplicationLogger()
+	storage, ok := NewLocalFileStorage(cfg, logger).(storages.MultipartStorage)
+	require.True(t, ok)
+	assert.Equal(t, 0, storage.MinPartSize())
+
+	ctx := context.Background()
+	key := "recordings/file.wav"
+
+	uploadId, err := storage.CreateMultipart(ctx, key)
+	require.NoError(t, err)
+	require.NoError(t, storage.UploadPart(ctx, key, uploadId, 2, []byte("World!")))
+	require.NoError(t, storage.UploadPart(ctx, key, uploadId, 1, []byte("Hi, ")))
+	// a part written again replaces the previous one
+	require.NoError(t, storage.UploadPart(ctx, key, uploadId, 1, []byte("Hello, ")))
+
+	// nothing is visible before the upload completes
+	assert.NoFileExists(t, filepath.Join(tempDir, key))
+
+	result := storage.CompleteMultipart(ctx, key, uploadId)
+	assert.NoError(t, result.Error)
+	assert.Equal(t, filepath.Join(tempDir, key), result.CompletePath)
+
+	content, err := os.ReadFile(filepath.Join(tempDir, key))
+	require.NoError(t, err)
+	assert.Equal(t, "Hello, World!", string(content))
+	assert.NoDirExists(t, filepath.Join(tempDir, key+"."+uploadId+".parts"))
+}
+
+func TestLocalFileStorage_AbortMultipart(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "local_storage_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	cfg := configs.AssetStoreConfig{
+		StorageType:       "local",
+		StoragePathPrefix: tempDir,
+	}
+	logger, _ := commons.NewApplicationLogger()
+	storage := NewLocalFileStorage(cfg, logger).(storages.MultipartStorage)
+
+	ctx := context.Background()
+	key := "recordings/file.wav"
+
+	uploadId, err := storage.CreateMultipart(ctx, key)
+	require.NoError(t, err)
+	require.NoError(t, storage.UploadPart(ctx, key, uploadId, 1, []byte("partial")))
+	require.NoError(t, storage.AbortMultipart(ctx, key, uploadId))
+	// aborting twice is not an error
+	require.NoError(t, storage.AbortMultipart(ctx, key, uploadId))
+
+	assert.Error(t, storage.CompleteMultipart(ctx, key, uploadId).Error)
+	assert.Error(t, storage.UploadPart(ctx, key, uploadId, 2, []byte("late")))
+}
@@ -81,3 +81,31 @@ type Storage interface {
 	//   - StorageOutput containing the URL/path and any error.
 	GetUrl(ctx context.Context, key string) StorageOutput
 }
+
+// MultipartStorage is implemented by backends that can write a single object
+// in parts while it is still being produced, for example a call recording.
+//
+// Parts are joined in the order of their numbers and only become visible as the
+// object at key once the upload is completed, a part which is written again
+// replaces the previous one. An upload that is never completed can still be
+// completed or aborted later using the upload id, which makes it possible to
+// recover objects whose producer terminated abnormally.
+type MultipartStorage interface {
+	Storage
+
+	// CreateMultipart starts an upload for key and returns its upload id.
+	CreateMultipart(ctx context.Context, key string) (string, error)
+
+	// UploadPart writes a part of the upload, partNumber starts at 1.
+	UploadPart(ctx context.Context, key string, uploadId string, partNumber int64, content []byte) error
+
+	// CompleteMultipart joins all written parts into the object at key.
+	CompleteMultipart(ctx context.Context, key string, uploadId string) StorageOutput
+
+	// AbortMultipart discards the upload and all written parts.
+	AbortMultipart(ctx context.Context, key string, uploadId string) error
+
+	// MinPartSize is the smallest size in bytes the backend accepts for every
+	// part except the last one.
+	MinPartSize() int
+}
@@ -19,6 +19,8 @@ var (
 	//
 	LLM_REQUEST_ID MetricName = "LLM_REQUEST_ID"
 	//
+	ANSWERED_BY MetricName = "ANSWERED_BY"
+	//
 	TOKEN_PRE_SECOND       MetricName = "TOKEN_PRE_SECOND"
 	TIME_TO_FIRST_TOKEN    MetricName = "TIME_TO_FIRST_TOKEN"
 	PROVIDER_TOTAL_TIME    MetricName = "PROVIDER_TOTAL_TIME"
@@ -19,6 +19,7 @@ const (
 	SDK       RapidaSource = "sdk"
 	PhoneCall RapidaSource = "phone-call"
 	Whatsapp  RapidaSource = "whatsapp"
+	SMS       RapidaSource = "sms"
 )
 
 // Get returns the string value of the RapidaRegion
@@ -40,8 +41,10 @@ func FromSourceStr(label string) RapidaSource {
 		return PhoneCall
 	case "whatsapp":
 		return Whatsapp
+	case "sms":
+		return SMS
 	default:
-		log.Printf("%s The source is not supported. Supported sources are 'web-plugin', 'debugger', 'sdk', 'phone-call', and 'whatsapp'.", label)
+		log.Printf("%s The source is not supported. Supported sources are 'web-plugin', 'debugger', 'sdk', 'phone-call', 'whatsapp', and 'sms'.", label)
 		return Debugger
 	}
 }
@@ -20,6 +20,7 @@ func TestRapidaSource_Get(t *testing.T) {
 		{SDK, "sdk"},
 		{PhoneCall, "phone-call"},
 		{Whatsapp, "whatsapp"},
+		{SMS, "sms"},
 	}
 
 	for _, tt := range tests {
@@ -46,6 +47,8 @@ func TestFromSourceStr(t *testing.T) {
 		{"PHONE-CALL", PhoneCall},
 		{"whatsapp", Whatsapp},
 		{"WHATSAPP", Whatsapp},
+		{"sms", SMS},
+		{"SMS", SMS},
 		{"invalid", Debugger}, // defaults to debugger
 		{"", Debugger},
 	}
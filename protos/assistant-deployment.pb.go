@@ -617,6 +617,157 @@ func (x *AssistantWhatsappDeployment) GetIdealTimeoutBackoff() uint64 {
 	return 0
 }
 
+type AssistantSmsDeployment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssistantId         uint64                 `protobuf:"varint,5,opt,name=assistantId,proto3" json:"assistantId,omitempty"`
+	Name                string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Greeting            *string                `protobuf:"bytes,10,opt,name=greeting,proto3,oneof" json:"greeting,omitempty"`
+	Mistake             *string                `protobuf:"bytes,11,opt,name=mistake,proto3,oneof" json:"mistake,omitempty"`
+	SmsProviderName     string                 `protobuf:"bytes,15,opt,name=smsProviderName,proto3" json:"smsProviderName,omitempty"`
+	SmsOptions          []*Metadata            `protobuf:"bytes,17,rep,name=smsOptions,proto3" json:"smsOptions,omitempty"`
+	CreatedDate         *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	UpdatedDate         *timestamppb.Timestamp `protobuf:"bytes,27,opt,name=updatedDate,proto3" json:"updatedDate,omitempty"`
+	Status              string                 `protobuf:"bytes,28,opt,name=status,proto3" json:"status,omitempty"`
+	MaxSessionDuration  uint64                 `protobuf:"varint,29,opt,name=maxSessionDuration,proto3" json:"maxSessionDuration,omitempty"`
+	IdealTimeout        uint64                 `protobuf:"varint,30,opt,name=idealTimeout,proto3" json:"idealTimeout,omitempty"`
+	IdealTimeoutMessage string                 `protobuf:"bytes,31,opt,name=idealTimeoutMessage,proto3" json:"idealTimeoutMessage,omitempty"`
+	IdealTimeoutBackoff uint64                 `protobuf:"varint,32,opt,name=idealTimeoutBackoff,proto3" json:"idealTimeoutBackoff,omitempty"`
+}
+
+func (x *AssistantSmsDeployment) Reset() {
+	*x = AssistantSmsDeployment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_deployment_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssistantSmsDeployment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssistantSmsDeployment) ProtoMessage() {}
+
+func (x *AssistantSmsDeployment) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_deployment_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssistantSmsDeployment.ProtoReflect.Descriptor instead.
+func (*AssistantSmsDeployment) Descriptor() ([]byte, []int) {
+	return file_assistant_deployment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AssistantSmsDeployment) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AssistantSmsDeployment) GetAssistantId() uint64 {
+	if x != nil {
+		return x.AssistantId
+	}
+	return 0
+}
+
+func (x *AssistantSmsDeployment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssistantSmsDeployment) GetGreeting() string {
+	if x != nil && x.Greeting != nil {
+		return *x.Greeting
+	}
+	return ""
+}
+
+func (x *AssistantSmsDeployment) GetMistake() string {
+	if x != nil && x.Mistake != nil {
+		return *x.Mistake
+	}
+	return ""
+}
+
+func (x *AssistantSmsDeployment) GetSmsProviderName() string {
+	if x != nil {
+		return x.SmsProviderName
+	}
+	return ""
+}
+
+func (x *AssistantSmsDeployment) GetSmsOptions() []*Metadata {
+	if x != nil {
+		return x.SmsOptions
+	}
+	return nil
+}
+
+func (x *AssistantSmsDeployment) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *AssistantSmsDeployment) GetUpdatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return nil
+}
+
+func (x *AssistantSmsDeployment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AssistantSmsDeployment) GetMaxSessionDuration() uint64 {
+	if x != nil {
+		return x.MaxSessionDuration
+	}
+	return 0
+}
+
+func (x *AssistantSmsDeployment) GetIdealTimeout() uint64 {
+	if x != nil {
+		return x.IdealTimeout
+	}
+	return 0
+}
+
+func (x *AssistantSmsDeployment) GetIdealTimeoutMessage() string {
+	if x != nil {
+		return x.IdealTimeoutMessage
+	}
+	return ""
+}
+
+func (x *AssistantSmsDeployment) GetIdealTimeoutBackoff() uint64 {
+	if x != nil {
+		return x.IdealTimeoutBackoff
+	}
+	return 0
+}
+
 type AssistantDebuggerDeployment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -641,7 +792,7 @@ type AssistantDebuggerDeployment struct {
 func (x *AssistantDebuggerDeployment) Reset() {
 	*x = AssistantDebuggerDeployment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_assistant_deployment_proto_msgTypes[4]
+		mi := &file_assistant_deployment_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -654,7 +805,7 @@ func (x *AssistantDebuggerDeployment) String() string {
 func (*AssistantDebuggerDeployment) ProtoMessage() {}
 
 func (x *AssistantDebuggerDeployment) ProtoReflect() protoreflect.Message {
-	mi := &file_assistant_deployment_proto_msgTypes[4]
+	mi := &file_assistant_deployment_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,7 +818,7 @@ func (x *AssistantDebuggerDeployment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssistantDebuggerDeployment.ProtoReflect.Descriptor instead.
 func (*AssistantDebuggerDeployment) Descriptor() ([]byte, []int) {
-	return file_assistant_deployment_proto_rawDescGZIP(), []int{4}
+	return file_assistant_deployment_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AssistantDebuggerDeployment) GetId() uint64 {
@@ -791,7 +942,7 @@ type AssistantApiDeployment struct {
 func (x *AssistantApiDeployment) Reset() {
 	*x = AssistantApiDeployment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_assistant_deployment_proto_msgTypes[5]
+		mi := &file_assistant_deployment_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -804,7 +955,7 @@ func (x *AssistantApiDeployment) String() string {
 func (*AssistantApiDeployment) ProtoMessage() {}
 
 func (x *AssistantApiDeployment) ProtoReflect() protoreflect.Message {
-	mi := &file_assistant_deployment_proto_msgTypes[5]
+	mi := &file_assistant_deployment_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -817,7 +968,7 @@ func (x *AssistantApiDeployment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssistantApiDeployment.ProtoReflect.Descriptor instead.
 func (*AssistantApiDeployment) Descriptor() ([]byte, []int) {
-	return file_assistant_deployment_proto_rawDescGZIP(), []int{5}
+	return file_assistant_deployment_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AssistantApiDeployment) GetId() uint64 {
@@ -923,13 +1074,14 @@ type CreateAssistantDeploymentRequest struct {
 	//	*CreateAssistantDeploymentRequest_Whatsapp
 	//	*CreateAssistantDeploymentRequest_Phone
 	//	*CreateAssistantDeploymentRequest_Plugin
+	//	*CreateAssistantDeploymentRequest_Sms
 	Deployment isCreateAssistantDeploymentRequest_Deployment `protobuf_oneof:"deployment"`
 }
 
 func (x *CreateAssistantDeploymentRequest) Reset() {
 	*x = CreateAssistantDeploymentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_assistant_deployment_proto_msgTypes[6]
+		mi := &file_assistant_deployment_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -942,7 +1094,7 @@ func (x *CreateAssistantDeploymentRequest) String() string {
 func (*CreateAssistantDeploymentRequest) ProtoMessage() {}
 
 func (x *CreateAssistantDeploymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_assistant_deployment_proto_msgTypes[6]
+	mi := &file_assistant_deployment_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -955,7 +1107,7 @@ func (x *CreateAssistantDeploymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAssistantDeploymentRequest.ProtoReflect.Descriptor instead.
 func (*CreateAssistantDeploymentRequest) Descriptor() ([]byte, []int) {
-	return file_assistant_deployment_proto_rawDescGZIP(), []int{6}
+	return file_assistant_deployment_proto_rawDescGZIP(), []int{7}
 }
 
 func (m *CreateAssistantDeploymentRequest) GetDeployment() isCreateAssistantDeploymentRequest_Deployment {
@@ -1000,6 +1152,13 @@ func (x *CreateAssistantDeploymentRequest) GetPlugin() *AssistantWebpluginDeploy
 	return nil
 }
 
+func (x *CreateAssistantDeploymentRequest) GetSms() *AssistantSmsDeployment {
+	if x, ok := x.GetDeployment().(*CreateAssistantDeploymentRequest_Sms); ok {
+		return x.Sms
+	}
+	return nil
+}
+
 type isCreateAssistantDeploymentRequest_Deployment interface {
 	isCreateAssistantDeploymentRequest_Deployment()
 }
@@ -1024,6 +1183,10 @@ type CreateAssistantDeploymentRequest_Plugin struct {
 	Plugin *AssistantWebpluginDeployment `protobuf:"bytes,5,opt,name=plugin,proto3,oneof"`
 }
 
+type CreateAssistantDeploymentRequest_Sms struct {
+	Sms *AssistantSmsDeployment `protobuf:"bytes,6,opt,name=sms,proto3,oneof"`
+}
+
 func (*CreateAssistantDeploymentRequest_Api) isCreateAssistantDeploymentRequest_Deployment() {}
 
 func (*CreateAssistantDeploymentRequest_Debugger) isCreateAssistantDeploymentRequest_Deployment() {}
@@ -1034,6 +1197,8 @@ func (*CreateAssistantDeploymentRequest_Phone) isCreateAssistantDeploymentReques
 
 func (*CreateAssistantDeploymentRequest_Plugin) isCreateAssistantDeploymentRequest_Deployment() {}
 
+func (*CreateAssistantDeploymentRequest_Sms) isCreateAssistantDeploymentRequest_Deployment() {}
+
 type GetAssistantApiDeploymentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1048,7 +1213,7 @@ type GetAssistantApiDeploymentResponse struct {
 func (x *GetAssistantApiDeploymentResponse) Reset() {
 	*x = GetAssistantApiDeploymentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_assistant_deployment_proto_msgTypes[7]
+		mi := &file_assistant_deployment_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1061,7 +1226,7 @@ func (x *GetAssistantApiDeploymentResponse) String() string {
 func (*GetAssistantApiDeploymentResponse) ProtoMessage() {}
 
 func (x *GetAssistantApiDeploymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_assistant_deployment_proto_msgTypes[7]
+	mi := &file_assistant_deployment_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1074,7 +1239,7 @@ func (x *GetAssistantApiDeploymentResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetAssistantApiDeploymentResponse.ProtoReflect.Descriptor instead.
 func (*GetAssistantApiDeploymentResponse) Descriptor() ([]byte, []int) {
-	return file_assistant_deployment_proto_rawDescGZIP(), []int{7}
+	return file_assistant_deployment_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetAssistantApiDeploymentResponse) GetCode() int32 {
@@ -1119,7 +1284,7 @@ type GetAssistantPhoneDeploymentResponse struct {
 func (x *GetAssistantPhoneDeploymentResponse) Reset() {
 	*x = GetAssistantPhoneDeploymentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_assistant_deployment_proto_msgTypes[8]
+		mi := &file_assistant_deployment_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1132,7 +1297,7 @@ func (x *GetAssistantPhoneDeploymentResponse) String() string {
 func (*GetAssistantPhoneDeploymentResponse) ProtoMessage() {}
 
 func (x *GetAssistantPhoneDeploymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_assistant_deployment_proto_msgTypes[8]
+	mi := &file_assistant_deployment_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1145,7 +1310,7 @@ func (x *GetAssistantPhoneDeploymentResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetAssistantPhoneDeploymentResponse.ProtoReflect.Descriptor instead.
 func (*GetAssistantPhoneDeploymentResponse) Descriptor() ([]byte, []int) {
-	return file_assistant_deployment_proto_rawDescGZIP(), []int{8}
+	return file_assistant_deployment_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetAssistantPhoneDeploymentResponse) GetCode() int32 {
@@ -1190,7 +1355,7 @@ type GetAssistantWhatsappDeploymentResponse struct {
 func (x *GetAssistantWhatsappDeploymentResponse) Reset() {
 	*x = GetAssistantWhatsappDeploymentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_assistant_deployment_proto_msgTypes[9]
+		mi := &file_assistant_deployment_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1203,7 +1368,7 @@ func (x *GetAssistantWhatsappDeploymentResponse) String() string {
 func (*GetAssistantWhatsappDeploymentResponse) ProtoMessage() {}
 
 func (x *GetAssistantWhatsappDeploymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_assistant_deployment_proto_msgTypes[9]
+	mi := &file_assistant_deployment_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1216,7 +1381,7 @@ func (x *GetAssistantWhatsappDeploymentResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GetAssistantWhatsappDeploymentResponse.ProtoReflect.Descriptor instead.
 func (*GetAssistantWhatsappDeploymentResponse) Descriptor() ([]byte, []int) {
-	return file_assistant_deployment_proto_rawDescGZIP(), []int{9}
+	return file_assistant_deployment_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetAssistantWhatsappDeploymentResponse) GetCode() int32 {
@@ -1247,6 +1412,77 @@ func (x *GetAssistantWhatsappDeploymentResponse) GetError() *Error {
 	return nil
 }
 
+type GetAssistantSmsDeploymentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32                   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Success bool                    `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Data    *AssistantSmsDeployment `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Error   *Error                  `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetAssistantSmsDeploymentResponse) Reset() {
+	*x = GetAssistantSmsDeploymentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_deployment_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAssistantSmsDeploymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssistantSmsDeploymentResponse) ProtoMessage() {}
+
+func (x *GetAssistantSmsDeploymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_deployment_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssistantSmsDeploymentResponse.ProtoReflect.Descriptor instead.
+func (*GetAssistantSmsDeploymentResponse) Descriptor() ([]byte, []int) {
+	return file_assistant_deployment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetAssistantSmsDeploymentResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAssistantSmsDeploymentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetAssistantSmsDeploymentResponse) GetData() *AssistantSmsDeployment {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetAssistantSmsDeploymentResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
 type GetAssistantDebuggerDeploymentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1261,7 +1497,7 @@ type GetAssistantDebuggerDeploymentResponse struct {
 func (x *GetAssistantDebuggerDeploymentResponse) Reset() {
 	*x = GetAssistantDebuggerDeploymentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_assistant_deployment_proto_msgTypes[10]
+		mi := &file_assistant_deployment_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1274,7 +1510,7 @@ func (x *GetAssistantDebuggerDeploymentResponse) String() string {
 func (*GetAssistantDebuggerDeploymentResponse) ProtoMessage() {}
 
 func (x *GetAssistantDebuggerDeploymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_assistant_deployment_proto_msgTypes[10]
+	mi := &file_assistant_deployment_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,7 +1523,7 @@ func (x *GetAssistantDebuggerDeploymentResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GetAssistantDebuggerDeploymentResponse.ProtoReflect.Descriptor instead.
 func (*GetAssistantDebuggerDeploymentResponse) Descriptor() ([]byte, []int) {
-	return file_assistant_deployment_proto_rawDescGZIP(), []int{10}
+	return file_assistant_deployment_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetAssistantDebuggerDeploymentResponse) GetCode() int32 {
@@ -1332,7 +1568,7 @@ type GetAssistantWebpluginDeploymentResponse struct {
 func (x *GetAssistantWebpluginDeploymentResponse) Reset() {
 	*x = GetAssistantWebpluginDeploymentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_assistant_deployment_proto_msgTypes[11]
+		mi := &file_assistant_deployment_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1345,7 +1581,7 @@ func (x *GetAssistantWebpluginDeploymentResponse) String() string {
 func (*GetAssistantWebpluginDeploymentResponse) ProtoMessage() {}
 
 func (x *GetAssistantWebpluginDeploymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_assistant_deployment_proto_msgTypes[11]
+	mi := &file_assistant_deployment_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1358,7 +1594,7 @@ func (x *GetAssistantWebpluginDeploymentResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use GetAssistantWebpluginDeploymentResponse.ProtoReflect.Descriptor instead.
 func (*GetAssistantWebpluginDeploymentResponse) Descriptor() ([]byte, []int) {
-	return file_assistant_deployment_proto_rawDescGZIP(), []int{11}
+	return file_assistant_deployment_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetAssistantWebpluginDeploymentResponse) GetCode() int32 {
@@ -1400,7 +1636,7 @@ type GetAssistantDeploymentRequest struct {
 func (x *GetAssistantDeploymentRequest) Reset() {
 	*x = GetAssistantDeploymentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_assistant_deployment_proto_msgTypes[12]
+		mi := &file_assistant_deployment_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1413,7 +1649,7 @@ func (x *GetAssistantDeploymentRequest) String() string {
 func (*GetAssistantDeploymentRequest) ProtoMessage() {}
 
 func (x *GetAssistantDeploymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_assistant_deployment_proto_msgTypes[12]
+	mi := &file_assistant_deployment_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1426,7 +1662,7 @@ func (x *GetAssistantDeploymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAssistantDeploymentRequest.ProtoReflect.Descriptor instead.
 func (*GetAssistantDeploymentRequest) Descriptor() ([]byte, []int) {
-	return file_assistant_deployment_proto_rawDescGZIP(), []int{12}
+	return file_assistant_deployment_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetAssistantDeploymentRequest) GetAssistantId() uint64 {
@@ -1611,266 +1847,335 @@ var file_assistant_deployment_proto_rawDesc = []byte{
 	0x30, 0x01, 0x52, 0x13, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
 	0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x67, 0x72, 0x65, 0x65,
 	0x74, 0x69, 0x6e, 0x67, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65,
-	0x22, 0xae, 0x05, 0x0a, 0x1b, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65,
-	0x62, 0x75, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
-	0x74, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x61,
-	0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
-	0x0a, 0x08, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x08, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12,
-	0x1d, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x01, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46,
-	0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x64,
-	0x69, 0x6f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75,
-	0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x48, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x41, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x73,
-	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6c,
-	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f,
-	0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18,
-	0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c,
-	0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x1b, 0x20,
+	0x22, 0xec, 0x04, 0x0a, 0x16, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x53, 0x6d,
+	0x73, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x67, 0x72, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x67,
+	0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6d,
+	0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6d, 0x73,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x73, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x0a, 0x73, 0x6d, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x0a, 0x73, 0x6d, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3c,
+	0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x1a, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x02, 0x30, 0x01, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0c, 0x69, 0x64, 0x65, 0x61,
-	0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
-	0x30, 0x01, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x12, 0x30, 0x0a, 0x13, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x69,
-	0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x34, 0x0a, 0x13, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x20, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x02, 0x30, 0x01, 0x52, 0x13, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x67, 0x72, 0x65,
-	0x65, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x73, 0x74, 0x61, 0x6b,
-	0x65, 0x22, 0x95, 0x05, 0x0a, 0x16, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x41,
-	0x70, 0x69, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73,
-	0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x08, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69,
-	0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x67, 0x72, 0x65, 0x65,
-	0x74, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x74, 0x61,
-	0x6b, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x74,
-	0x61, 0x6b, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x41,
-	0x75, 0x64, 0x69, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x73, 0x73,
+	0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x32, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0c, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
+	0x52, 0x0c, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x30,
+	0x0a, 0x13, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x69, 0x64, 0x65,
+	0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x34, 0x0a, 0x13, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x20, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x13, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42,
+	0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x67, 0x72, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x22,
+	0xae, 0x05, 0x0a, 0x1b, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x08, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x08, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1d,
+	0x0a, 0x07, 0x6d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a,
+	0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69,
+	0x6f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x48, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x6f, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x73, 0x73,
 	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f,
 	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x48,
-	0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x75,
-	0x64, 0x69, 0x6f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x1c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x12,
-	0x6d, 0x61, 0x78, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x12, 0x6d, 0x61,
-	0x78, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x26, 0x0a, 0x0c, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x18, 0x1e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x61,
-	0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x69, 0x64, 0x65, 0x61,
-	0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x13, 0x69, 0x64,
-	0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66,
-	0x66, 0x18, 0x20, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x13, 0x69, 0x64, 0x65,
+	0x65, 0x72, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x12,
+	0x3c, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a,
+	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x02, 0x30, 0x01, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0c, 0x69, 0x64, 0x65, 0x61, 0x6c,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x30, 0x0a, 0x13, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x69, 0x64,
+	0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x34, 0x0a, 0x13, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x20, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x13, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x67, 0x72, 0x65, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x22, 0x95, 0x05, 0x0a, 0x16, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x41, 0x70,
+	0x69, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x08, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x67, 0x72, 0x65, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x48, 0x0a,
+	0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x1c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x12, 0x6d,
+	0x61, 0x78, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x12, 0x6d, 0x61, 0x78,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x26, 0x0a, 0x0c, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x61, 0x6c,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x69, 0x64, 0x65, 0x61, 0x6c,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x69, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x13, 0x69, 0x64, 0x65,
 	0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x0a, 0x0a,
-	0x08, 0x5f, 0x6d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x22, 0x87, 0x03, 0x0a, 0x20, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39,
-	0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x73,
-	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69,
-	0x73, 0x74, 0x61, 0x6e, 0x74, 0x41, 0x70, 0x69, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x48, 0x00, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x48, 0x0a, 0x08, 0x64, 0x65, 0x62,
-	0x75, 0x67, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x73,
+	0x18, 0x20, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x13, 0x69, 0x64, 0x65, 0x61,
+	0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x67, 0x72, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x6d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x22, 0xc2, 0x03, 0x0a, 0x20, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a,
+	0x03, 0x61, 0x70, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x41, 0x70, 0x69, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x48, 0x0a, 0x08, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x64, 0x65, 0x62, 0x75, 0x67, 0x67,
+	0x65, 0x72, 0x12, 0x48, 0x0a, 0x08, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x68,
+	0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x08, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x12, 0x3f, 0x0a, 0x05,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x73,
 	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69,
-	0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x64, 0x65, 0x62, 0x75, 0x67,
-	0x67, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x08, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
-	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57,
-	0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x48, 0x00, 0x52, 0x08, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x12, 0x3f, 0x0a,
-	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61,
-	0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73,
-	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x45,
-	0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x45, 0x0a,
+	0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x12, 0x39, 0x0a, 0x03, 0x73, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x53, 0x6d, 0x73, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x03, 0x73, 0x6d, 0x73, 0x42,
+	0x0c, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xaa, 0x01,
+	0x0a, 0x21, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x41, 0x70,
+	0x69, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x41, 0x70, 0x69, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xae, 0x01, 0x0a, 0x23, 0x47,
+	0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
 	0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41,
-	0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73,
-	0x74, 0x61, 0x6e, 0x74, 0x41, 0x70, 0x69, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
-	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x41,
-	0x70, 0x69, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x22, 0xae, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
-	0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb4, 0x01, 0x0a, 0x26,
+	0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x68, 0x61, 0x74,
+	0x73, 0x61, 0x70, 0x70, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x68, 0x61, 0x74,
+	0x73, 0x61, 0x70, 0x70, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0xaa, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x53, 0x6d, 0x73, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
 	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x68,
-	0x6f, 0x6e, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x22, 0xb4, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
-	0x6e, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73,
-	0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
-	0x6e, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb4, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74,
-	0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x65,
-	0x72, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2a, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x65,
-	0x72, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x53, 0x6d,
+	0x73, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74,
 	0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0xb6, 0x01, 0x0a, 0x27, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
-	0x57, 0x65, 0x62, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
-	0x74, 0x57, 0x65, 0x62, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x45, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41,
-	0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73,
-	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
-	0x30, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x32,
-	0xec, 0x0a, 0x0a, 0x1a, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x81,
-	0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
-	0x6e, 0x74, 0x41, 0x70, 0x69, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x2f, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x30, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x41, 0x70, 0x69,
+	0xb4, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb6, 0x01, 0x0a, 0x27, 0x47, 0x65, 0x74, 0x41, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
 	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x7b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
-	0x6e, 0x74, 0x41, 0x70, 0x69, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x2c, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6c,
-	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
-	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x41, 0x70, 0x69, 0x44, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x8d, 0x01, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6c,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x3f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x45, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x24, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x32, 0xed, 0x0c, 0x0a, 0x1a, 0x41, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x41, 0x70, 0x69, 0x44, 0x65, 0x70, 0x6c,
 	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
 	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73,
 	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
 	0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73,
-	0x74, 0x61, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x87, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
-	0x57, 0x65, 0x62, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
-	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x36, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x65,
-	0x62, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x21, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x62,
-	0x75, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x2f, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x35, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x62,
-	0x75, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41,
-	0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x65, 0x72,
+	0x74, 0x61, 0x6e, 0x74, 0x41, 0x70, 0x69, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x41, 0x70, 0x69, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x41, 0x70, 0x69, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x2e,
+	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36,
+	0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
 	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x61, 0x73, 0x73,
 	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73,
 	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73,
 	0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69,
-	0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x8b, 0x01, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
-	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69,
-	0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x8b, 0x01, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85,
+	0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x2c, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x35, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61,
+	0x70, 0x70, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x61,
+	0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e,
+	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61,
+	0x70, 0x70, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a,
+	0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x50, 0x68, 0x6f, 0x6e, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x2f, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x32, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f,
+	0x6e, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x32, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x68,
+	0x6f, 0x6e, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x53, 0x6d, 0x73, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x53, 0x6d, 0x73, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x53, 0x6d, 0x73, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
 	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01,
-	0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x68,
-	0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x2c, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35,
-	0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73,
-	0x61, 0x70, 0x70, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x44, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73,
-	0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x61, 0x73, 0x73, 0x69,
-	0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73,
-	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a,
-	0x1b, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f,
-	0x6e, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x61,
-	0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x61, 0x73, 0x73,
-	0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73,
-	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x44, 0x65, 0x70, 0x6c,
-	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1c,
-	0x5a, 0x1a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x70,
-	0x69, 0x64, 0x61, 0x61, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x53, 0x6d, 0x73, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1c, 0x5a, 0x1a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x70, 0x69, 0x64, 0x61, 0x61, 0x69, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1885,89 +2190,101 @@ func file_assistant_deployment_proto_rawDescGZIP() []byte {
 	return file_assistant_deployment_proto_rawDescData
 }
 
-var file_assistant_deployment_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_assistant_deployment_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_assistant_deployment_proto_goTypes = []any{
 	(*DeploymentAudioProvider)(nil),                 // 0: assistant_api.DeploymentAudioProvider
 	(*AssistantWebpluginDeployment)(nil),            // 1: assistant_api.AssistantWebpluginDeployment
 	(*AssistantPhoneDeployment)(nil),                // 2: assistant_api.AssistantPhoneDeployment
 	(*AssistantWhatsappDeployment)(nil),             // 3: assistant_api.AssistantWhatsappDeployment
-	(*AssistantDebuggerDeployment)(nil),             // 4: assistant_api.AssistantDebuggerDeployment
-	(*AssistantApiDeployment)(nil),                  // 5: assistant_api.AssistantApiDeployment
-	(*CreateAssistantDeploymentRequest)(nil),        // 6: assistant_api.CreateAssistantDeploymentRequest
-	(*GetAssistantApiDeploymentResponse)(nil),       // 7: assistant_api.GetAssistantApiDeploymentResponse
-	(*GetAssistantPhoneDeploymentResponse)(nil),     // 8: assistant_api.GetAssistantPhoneDeploymentResponse
-	(*GetAssistantWhatsappDeploymentResponse)(nil),  // 9: assistant_api.GetAssistantWhatsappDeploymentResponse
-	(*GetAssistantDebuggerDeploymentResponse)(nil),  // 10: assistant_api.GetAssistantDebuggerDeploymentResponse
-	(*GetAssistantWebpluginDeploymentResponse)(nil), // 11: assistant_api.GetAssistantWebpluginDeploymentResponse
-	(*GetAssistantDeploymentRequest)(nil),           // 12: assistant_api.GetAssistantDeploymentRequest
-	(*Metadata)(nil),                                // 13: Metadata
-	(*timestamppb.Timestamp)(nil),                   // 14: google.protobuf.Timestamp
-	(*Error)(nil),                                   // 15: Error
+	(*AssistantSmsDeployment)(nil),                  // 4: assistant_api.AssistantSmsDeployment
+	(*AssistantDebuggerDeployment)(nil),             // 5: assistant_api.AssistantDebuggerDeployment
+	(*AssistantApiDeployment)(nil),                  // 6: assistant_api.AssistantApiDeployment
+	(*CreateAssistantDeploymentRequest)(nil),        // 7: assistant_api.CreateAssistantDeploymentRequest
+	(*GetAssistantApiDeploymentResponse)(nil),       // 8: assistant_api.GetAssistantApiDeploymentResponse
+	(*GetAssistantPhoneDeploymentResponse)(nil),     // 9: assistant_api.GetAssistantPhoneDeploymentResponse
+	(*GetAssistantWhatsappDeploymentResponse)(nil),  // 10: assistant_api.GetAssistantWhatsappDeploymentResponse
+	(*GetAssistantSmsDeploymentResponse)(nil),       // 11: assistant_api.GetAssistantSmsDeploymentResponse
+	(*GetAssistantDebuggerDeploymentResponse)(nil),  // 12: assistant_api.GetAssistantDebuggerDeploymentResponse
+	(*GetAssistantWebpluginDeploymentResponse)(nil), // 13: assistant_api.GetAssistantWebpluginDeploymentResponse
+	(*GetAssistantDeploymentRequest)(nil),           // 14: assistant_api.GetAssistantDeploymentRequest
+	(*Metadata)(nil),                                // 15: Metadata
+	(*timestamppb.Timestamp)(nil),                   // 16: google.protobuf.Timestamp
+	(*Error)(nil),                                   // 17: Error
 }
 var file_assistant_deployment_proto_depIdxs = []int32{
-	13, // 0: assistant_api.DeploymentAudioProvider.audioOptions:type_name -> Metadata
+	15, // 0: assistant_api.DeploymentAudioProvider.audioOptions:type_name -> Metadata
 	0,  // 1: assistant_api.AssistantWebpluginDeployment.inputAudio:type_name -> assistant_api.DeploymentAudioProvider
 	0,  // 2: assistant_api.AssistantWebpluginDeployment.outputAudio:type_name -> assistant_api.DeploymentAudioProvider
-	14, // 3: assistant_api.AssistantWebpluginDeployment.createdDate:type_name -> google.protobuf.Timestamp
-	14, // 4: assistant_api.AssistantWebpluginDeployment.updatedDate:type_name -> google.protobuf.Timestamp
+	16, // 3: assistant_api.AssistantWebpluginDeployment.createdDate:type_name -> google.protobuf.Timestamp
+	16, // 4: assistant_api.AssistantWebpluginDeployment.updatedDate:type_name -> google.protobuf.Timestamp
 	0,  // 5: assistant_api.AssistantPhoneDeployment.inputAudio:type_name -> assistant_api.DeploymentAudioProvider
 	0,  // 6: assistant_api.AssistantPhoneDeployment.outputAudio:type_name -> assistant_api.DeploymentAudioProvider
-	13, // 7: assistant_api.AssistantPhoneDeployment.phoneOptions:type_name -> Metadata
-	14, // 8: assistant_api.AssistantPhoneDeployment.createdDate:type_name -> google.protobuf.Timestamp
-	14, // 9: assistant_api.AssistantPhoneDeployment.updatedDate:type_name -> google.protobuf.Timestamp
+	15, // 7: assistant_api.AssistantPhoneDeployment.phoneOptions:type_name -> Metadata
+	16, // 8: assistant_api.AssistantPhoneDeployment.createdDate:type_name -> google.protobuf.Timestamp
+	16, // 9: assistant_api.AssistantPhoneDeployment.updatedDate:type_name -> google.protobuf.Timestamp
 	0,  // 10: assistant_api.AssistantWhatsappDeployment.inputAudio:type_name -> assistant_api.DeploymentAudioProvider
 	0,  // 11: assistant_api.AssistantWhatsappDeployment.outputAudio:type_name -> assistant_api.DeploymentAudioProvider
-	13, // 12: assistant_api.AssistantWhatsappDeployment.whatsappOptions:type_name -> Metadata
-	14, // 13: assistant_api.AssistantWhatsappDeployment.createdDate:type_name -> google.protobuf.Timestamp
-	14, // 14: assistant_api.AssistantWhatsappDeployment.updatedDate:type_name -> google.protobuf.Timestamp
-	0,  // 15: assistant_api.AssistantDebuggerDeployment.inputAudio:type_name -> assistant_api.DeploymentAudioProvider
-	0,  // 16: assistant_api.AssistantDebuggerDeployment.outputAudio:type_name -> assistant_api.DeploymentAudioProvider
-	14, // 17: assistant_api.AssistantDebuggerDeployment.createdDate:type_name -> google.protobuf.Timestamp
-	14, // 18: assistant_api.AssistantDebuggerDeployment.updatedDate:type_name -> google.protobuf.Timestamp
-	0,  // 19: assistant_api.AssistantApiDeployment.inputAudio:type_name -> assistant_api.DeploymentAudioProvider
-	0,  // 20: assistant_api.AssistantApiDeployment.outputAudio:type_name -> assistant_api.DeploymentAudioProvider
-	14, // 21: assistant_api.AssistantApiDeployment.createdDate:type_name -> google.protobuf.Timestamp
-	14, // 22: assistant_api.AssistantApiDeployment.updatedDate:type_name -> google.protobuf.Timestamp
-	5,  // 23: assistant_api.CreateAssistantDeploymentRequest.api:type_name -> assistant_api.AssistantApiDeployment
-	4,  // 24: assistant_api.CreateAssistantDeploymentRequest.debugger:type_name -> assistant_api.AssistantDebuggerDeployment
-	3,  // 25: assistant_api.CreateAssistantDeploymentRequest.whatsapp:type_name -> assistant_api.AssistantWhatsappDeployment
-	2,  // 26: assistant_api.CreateAssistantDeploymentRequest.phone:type_name -> assistant_api.AssistantPhoneDeployment
-	1,  // 27: assistant_api.CreateAssistantDeploymentRequest.plugin:type_name -> assistant_api.AssistantWebpluginDeployment
-	5,  // 28: assistant_api.GetAssistantApiDeploymentResponse.data:type_name -> assistant_api.AssistantApiDeployment
-	15, // 29: assistant_api.GetAssistantApiDeploymentResponse.error:type_name -> Error
-	2,  // 30: assistant_api.GetAssistantPhoneDeploymentResponse.data:type_name -> assistant_api.AssistantPhoneDeployment
-	15, // 31: assistant_api.GetAssistantPhoneDeploymentResponse.error:type_name -> Error
-	3,  // 32: assistant_api.GetAssistantWhatsappDeploymentResponse.data:type_name -> assistant_api.AssistantWhatsappDeployment
-	15, // 33: assistant_api.GetAssistantWhatsappDeploymentResponse.error:type_name -> Error
-	4,  // 34: assistant_api.GetAssistantDebuggerDeploymentResponse.data:type_name -> assistant_api.AssistantDebuggerDeployment
-	15, // 35: assistant_api.GetAssistantDebuggerDeploymentResponse.error:type_name -> Error
-	1,  // 36: assistant_api.GetAssistantWebpluginDeploymentResponse.data:type_name -> assistant_api.AssistantWebpluginDeployment
-	15, // 37: assistant_api.GetAssistantWebpluginDeploymentResponse.error:type_name -> Error
-	6,  // 38: assistant_api.AssistantDeploymentService.CreateAssistantApiDeployment:input_type -> assistant_api.CreateAssistantDeploymentRequest
-	12, // 39: assistant_api.AssistantDeploymentService.GetAssistantApiDeployment:input_type -> assistant_api.GetAssistantDeploymentRequest
-	6,  // 40: assistant_api.AssistantDeploymentService.CreateAssistantWebpluginDeployment:input_type -> assistant_api.CreateAssistantDeploymentRequest
-	12, // 41: assistant_api.AssistantDeploymentService.GetAssistantWebpluginDeployment:input_type -> assistant_api.GetAssistantDeploymentRequest
-	6,  // 42: assistant_api.AssistantDeploymentService.CreateAssistantDebuggerDeployment:input_type -> assistant_api.CreateAssistantDeploymentRequest
-	12, // 43: assistant_api.AssistantDeploymentService.GetAssistantDebuggerDeployment:input_type -> assistant_api.GetAssistantDeploymentRequest
-	6,  // 44: assistant_api.AssistantDeploymentService.CreateAssistantWhatsappDeployment:input_type -> assistant_api.CreateAssistantDeploymentRequest
-	12, // 45: assistant_api.AssistantDeploymentService.GetAssistantWhatsappDeployment:input_type -> assistant_api.GetAssistantDeploymentRequest
-	6,  // 46: assistant_api.AssistantDeploymentService.CreateAssistantPhoneDeployment:input_type -> assistant_api.CreateAssistantDeploymentRequest
-	12, // 47: assistant_api.AssistantDeploymentService.GetAssistantPhoneDeployment:input_type -> assistant_api.GetAssistantDeploymentRequest
-	7,  // 48: assistant_api.AssistantDeploymentService.CreateAssistantApiDeployment:output_type -> assistant_api.GetAssistantApiDeploymentResponse
-	7,  // 49: assistant_api.AssistantDeploymentService.GetAssistantApiDeployment:output_type -> assistant_api.GetAssistantApiDeploymentResponse
-	11, // 50: assistant_api.AssistantDeploymentService.CreateAssistantWebpluginDeployment:output_type -> assistant_api.GetAssistantWebpluginDeploymentResponse
-	11, // 51: assistant_api.AssistantDeploymentService.GetAssistantWebpluginDeployment:output_type -> assistant_api.GetAssistantWebpluginDeploymentResponse
-	10, // 52: assistant_api.AssistantDeploymentService.CreateAssistantDebuggerDeployment:output_type -> assistant_api.GetAssistantDebuggerDeploymentResponse
-	10, // 53: assistant_api.AssistantDeploymentService.GetAssistantDebuggerDeployment:output_type -> assistant_api.GetAssistantDebuggerDeploymentResponse
-	9,  // 54: assistant_api.AssistantDeploymentService.CreateAssistantWhatsappDeployment:output_type -> assistant_api.GetAssistantWhatsappDeploymentResponse
-	9,  // 55: assistant_api.AssistantDeploymentService.GetAssistantWhatsappDeployment:output_type -> assistant_api.GetAssistantWhatsappDeploymentResponse
-	8,  // 56: assistant_api.AssistantDeploymentService.CreateAssistantPhoneDeployment:output_type -> assistant_api.GetAssistantPhoneDeploymentResponse
-	8,  // 57: assistant_api.AssistantDeploymentService.GetAssistantPhoneDeployment:output_type -> assistant_api.GetAssistantPhoneDeploymentResponse
-	48, // [48:58] is the sub-list for method output_type
-	38, // [38:48] is the sub-list for method input_type
-	38, // [38:38] is the sub-list for extension type_name
-	38, // [38:38] is the sub-list for extension extendee
-	0,  // [0:38] is the sub-list for field type_name
+	15, // 12: assistant_api.AssistantWhatsappDeployment.whatsappOptions:type_name -> Metadata
+	16, // 13: assistant_api.AssistantWhatsappDeployment.createdDate:type_name -> google.protobuf.Timestamp
+	16, // 14: assistant_api.AssistantWhatsappDeployment.updatedDate:type_name -> google.protobuf.Timestamp
+	15, // 15: assistant_api.AssistantSmsDeployment.smsOptions:type_name -> Metadata
+	16, // 16: assistant_api.AssistantSmsDeployment.createdDate:type_name -> google.protobuf.Timestamp
+	16, // 17: assistant_api.AssistantSmsDeployment.updatedDate:type_name -> google.protobuf.Timestamp
+	0,  // 18: assistant_api.AssistantDebuggerDeployment.inputAudio:type_name -> assistant_api.DeploymentAudioProvider
+	0,  // 19: assistant_api.AssistantDebuggerDeployment.outputAudio:type_name -> assistant_api.DeploymentAudioProvider
+	16, // 20: assistant_api.AssistantDebuggerDeployment.createdDate:type_name -> google.protobuf.Timestamp
+	16, // 21: assistant_api.AssistantDebuggerDeployment.updatedDate:type_name -> google.protobuf.Timestamp
+	0,  // 22: assistant_api.AssistantApiDeployment.inputAudio:type_name -> assistant_api.DeploymentAudioProvider
+	0,  // 23: assistant_api.AssistantApiDeployment.outputAudio:type_name -> assistant_api.DeploymentAudioProvider
+	16, // 24: assistant_api.AssistantApiDeployment.createdDate:type_name -> google.protobuf.Timestamp
+	16, // 25: assistant_api.AssistantApiDeployment.updatedDate:type_name -> google.protobuf.Timestamp
+	6,  // 26: assistant_api.CreateAssistantDeploymentRequest.api:type_name -> assistant_api.AssistantApiDeployment
+	5,  // 27: assistant_api.CreateAssistantDeploymentRequest.debugger:type_name -> assistant_api.AssistantDebuggerDeployment
+	3,  // 28: assistant_api.CreateAssistantDeploymentRequest.whatsapp:type_name -> assistant_api.AssistantWhatsappDeployment
+	2,  // 29: assistant_api.CreateAssistantDeploymentRequest.phone:type_name -> assistant_api.AssistantPhoneDeployment
+	1,  // 30: assistant_api.CreateAssistantDeploymentRequest.plugin:type_name -> assistant_api.AssistantWebpluginDeployment
+	4,  // 31: assistant_api.CreateAssistantDeploymentRequest.sms:type_name -> assistant_api.AssistantSmsDeployment
+	6,  // 32: assistant_api.GetAssistantApiDeploymentResponse.data:type_name -> assistant_api.AssistantApiDeployment
+	17, // 33: assistant_api.GetAssistantApiDeploymentResponse.error:type_name -> Error
+	2,  // 34: assistant_api.GetAssistantPhoneDeploymentResponse.data:type_name -> assistant_api.AssistantPhoneDeployment
+	17, // 35: assistant_api.GetAssistantPhoneDeploymentResponse.error:type_name -> Error
+	3,  // 36: assistant_api.GetAssistantWhatsappDeploymentResponse.data:type_name -> assistant_api.AssistantWhatsappDeployment
+	17, // 37: assistant_api.GetAssistantWhatsappDeploymentResponse.error:type_name -> Error
+	4,  // 38: assistant_api.GetAssistantSmsDeploymentResponse.data:type_name -> assistant_api.AssistantSmsDeployment
+	17, // 39: assistant_api.GetAssistantSmsDeploymentResponse.error:type_name -> Error
+	5,  // 40: assistant_api.GetAssistantDebuggerDeploymentResponse.data:type_name -> assistant_api.AssistantDebuggerDeployment
+	17, // 41: assistant_api.GetAssistantDebuggerDeploymentResponse.error:type_name -> Error
+	1,  // 42: assistant_api.GetAssistantWebpluginDeploymentResponse.data:type_name -> assistant_api.AssistantWebpluginDeployment
+	17, // 43: assistant_api.GetAssistantWebpluginDeploymentResponse.error:type_name -> Error
+	7,  // 44: assistant_api.AssistantDeploymentService.CreateAssistantApiDeployment:input_type -> assistant_api.CreateAssistantDeploymentRequest
+	14, // 45: assistant_api.AssistantDeploymentService.GetAssistantApiDeployment:input_type -> assistant_api.GetAssistantDeploymentRequest
+	7,  // 46: assistant_api.AssistantDeploymentService.CreateAssistantWebpluginDeployment:input_type -> assistant_api.CreateAssistantDeploymentRequest
+	14, // 47: assistant_api.AssistantDeploymentService.GetAssistantWebpluginDeployment:input_type -> assistant_api.GetAssistantDeploymentRequest
+	7,  // 48: assistant_api.AssistantDeploymentService.CreateAssistantDebuggerDeployment:input_type -> assistant_api.CreateAssistantDeploymentRequest
+	14, // 49: assistant_api.AssistantDeploymentService.GetAssistantDebuggerDeployment:input_type -> assistant_api.GetAssistantDeploymentRequest
+	7,  // 50: assistant_api.AssistantDeploymentService.CreateAssistantWhatsappDeployment:input_type -> assistant_api.CreateAssistantDeploymentRequest
+	14, // 51: assistant_api.AssistantDeploymentService.GetAssistantWhatsappDeployment:input_type -> assistant_api.GetAssistantDeploymentRequest
+	7,  // 52: assistant_api.AssistantDeploymentService.CreateAssistantPhoneDeployment:input_type -> assistant_api.CreateAssistantDeploymentRequest
+	14, // 53: assistant_api.AssistantDeploymentService.GetAssistantPhoneDeployment:input_type -> assistant_api.GetAssistantDeploymentRequest
+	7,  // 54: assistant_api.AssistantDeploymentService.CreateAssistantSmsDeployment:input_type -> assistant_api.CreateAssistantDeploymentRequest
+	14, // 55: assistant_api.AssistantDeploymentService.GetAssistantSmsDeployment:input_type -> assistant_api.GetAssistantDeploymentRequest
+	8,  // 56: assistant_api.AssistantDeploymentService.CreateAssistantApiDeployment:output_type -> assistant_api.GetAssistantApiDeploymentResponse
+	8,  // 57: assistant_api.AssistantDeploymentService.GetAssistantApiDeployment:output_type -> assistant_api.GetAssistantApiDeploymentResponse
+	13, // 58: assistant_api.AssistantDeploymentService.CreateAssistantWebpluginDeployment:output_type -> assistant_api.GetAssistantWebpluginDeploymentResponse
+	13, // 59: assistant_api.AssistantDeploymentService.GetAssistantWebpluginDeployment:output_type -> assistant_api.GetAssistantWebpluginDeploymentResponse
+	12, // 60: assistant_api.AssistantDeploymentService.CreateAssistantDebuggerDeployment:output_type -> assistant_api.GetAssistantDebuggerDeploymentResponse
+	12, // 61: assistant_api.AssistantDeploymentService.GetAssistantDebuggerDeployment:output_type -> assistant_api.GetAssistantDebuggerDeploymentResponse
+	10, // 62: assistant_api.AssistantDeploymentService.CreateAssistantWhatsappDeployment:output_type -> assistant_api.GetAssistantWhatsappDeploymentResponse
+	10, // 63: assistant_api.AssistantDeploymentService.GetAssistantWhatsappDeployment:output_type -> assistant_api.GetAssistantWhatsappDeploymentResponse
+	9,  // 64: assistant_api.AssistantDeploymentService.CreateAssistantPhoneDeployment:output_type -> assistant_api.GetAssistantPhoneDeploymentResponse
+	9,  // 65: assistant_api.AssistantDeploymentService.GetAssistantPhoneDeployment:output_type -> assistant_api.GetAssistantPhoneDeploymentResponse
+	11, // 66: assistant_api.AssistantDeploymentService.CreateAssistantSmsDeployment:output_type -> assistant_api.GetAssistantSmsDeploymentResponse
+	11, // 67: assistant_api.AssistantDeploymentService.GetAssistantSmsDeployment:output_type -> assistant_api.GetAssistantSmsDeploymentResponse
+	56, // [56:68] is the sub-list for method output_type
+	44, // [44:56] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_assistant_deployment_proto_init() }
@@ -2026,7 +2343,7 @@ func file_assistant_deployment_proto_init() {
 			}
 		}
 		file_assistant_deployment_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*AssistantDebuggerDeployment); i {
+			switch v := v.(*AssistantSmsDeployment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2038,7 +2355,7 @@ func file_assistant_deployment_proto_init() {
 			}
 		}
 		file_assistant_deployment_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*AssistantApiDeployment); i {
+			switch v := v.(*AssistantDebuggerDeployment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2050,7 +2367,7 @@ func file_assistant_deployment_proto_init() {
 			}
 		}
 		file_assistant_deployment_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateAssistantDeploymentRequest); i {
+			switch v := v.(*AssistantApiDeployment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2062,7 +2379,7 @@ func file_assistant_deployment_proto_init() {
 			}
 		}
 		file_assistant_deployment_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAssistantApiDeploymentResponse); i {
+			switch v := v.(*CreateAssistantDeploymentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2074,7 +2391,7 @@ func file_assistant_deployment_proto_init() {
 			}
 		}
 		file_assistant_deployment_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAssistantPhoneDeploymentResponse); i {
+			switch v := v.(*GetAssistantApiDeploymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2086,7 +2403,7 @@ func file_assistant_deployment_proto_init() {
 			}
 		}
 		file_assistant_deployment_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAssistantWhatsappDeploymentResponse); i {
+			switch v := v.(*GetAssistantPhoneDeploymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2098,7 +2415,7 @@ func file_assistant_deployment_proto_init() {
 			}
 		}
 		file_assistant_deployment_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAssistantDebuggerDeploymentResponse); i {
+			switch v := v.(*GetAssistantWhatsappDeploymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2110,7 +2427,7 @@ func file_assistant_deployment_proto_init() {
 			}
 		}
 		file_assistant_deployment_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAssistantWebpluginDeploymentResponse); i {
+			switch v := v.(*GetAssistantSmsDeploymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2122,6 +2439,30 @@ func file_assistant_deployment_proto_init() {
 			}
 		}
 		file_assistant_deployment_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAssistantDebuggerDeploymentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_deployment_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAssistantWebpluginDeploymentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_deployment_proto_msgTypes[14].Exporter = func(v any, i int) any {
 			switch v := v.(*GetAssistantDeploymentRequest); i {
 			case 0:
 				return &v.state
@@ -2139,12 +2480,14 @@ func file_assistant_deployment_proto_init() {
 	file_assistant_deployment_proto_msgTypes[3].OneofWrappers = []any{}
 	file_assistant_deployment_proto_msgTypes[4].OneofWrappers = []any{}
 	file_assistant_deployment_proto_msgTypes[5].OneofWrappers = []any{}
-	file_assistant_deployment_proto_msgTypes[6].OneofWrappers = []any{
+	file_assistant_deployment_proto_msgTypes[6].OneofWrappers = []any{}
+	file_assistant_deployment_proto_msgTypes[7].OneofWrappers = []any{
 		(*CreateAssistantDeploymentRequest_Api)(nil),
 		(*CreateAssistantDeploymentRequest_Debugger)(nil),
 		(*CreateAssistantDeploymentRequest_Whatsapp)(nil),
 		(*CreateAssistantDeploymentRequest_Phone)(nil),
 		(*CreateAssistantDeploymentRequest_Plugin)(nil),
+		(*CreateAssistantDeploymentRequest_Sms)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2152,7 +2495,7 @@ func file_assistant_deployment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_assistant_deployment_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -29,6 +29,8 @@ const (
 	AssistantDeploymentService_GetAssistantWhatsappDeployment_FullMethodName     = "/assistant_api.AssistantDeploymentService/GetAssistantWhatsappDeployment"
 	AssistantDeploymentService_CreateAssistantPhoneDeployment_FullMethodName     = "/assistant_api.AssistantDeploymentService/CreateAssistantPhoneDeployment"
 	AssistantDeploymentService_GetAssistantPhoneDeployment_FullMethodName        = "/assistant_api.AssistantDeploymentService/GetAssistantPhoneDeployment"
+	AssistantDeploymentService_CreateAssistantSmsDeployment_FullMethodName       = "/assistant_api.AssistantDeploymentService/CreateAssistantSmsDeployment"
+	AssistantDeploymentService_GetAssistantSmsDeployment_FullMethodName          = "/assistant_api.AssistantDeploymentService/GetAssistantSmsDeployment"
 )
 
 // AssistantDeploymentServiceClient is the client API for AssistantDeploymentService service.
@@ -45,6 +47,8 @@ type AssistantDeploymentServiceClient interface {
 	GetAssistantWhatsappDeployment(ctx context.Context, in *GetAssistantDeploymentRequest, opts ...grpc.CallOption) (*GetAssistantWhatsappDeploymentResponse, error)
 	CreateAssistantPhoneDeployment(ctx context.Context, in *CreateAssistantDeploymentRequest, opts ...grpc.CallOption) (*GetAssistantPhoneDeploymentResponse, error)
 	GetAssistantPhoneDeployment(ctx context.Context, in *GetAssistantDeploymentRequest, opts ...grpc.CallOption) (*GetAssistantPhoneDeploymentResponse, error)
+	CreateAssistantSmsDeployment(ctx context.Context, in *CreateAssistantDeploymentRequest, opts ...grpc.CallOption) (*GetAssistantSmsDeploymentResponse, error)
+	GetAssistantSmsDeployment(ctx context.Context, in *GetAssistantDeploymentRequest, opts ...grpc.CallOption) (*GetAssistantSmsDeploymentResponse, error)
 }
 
 type assistantDeploymentServiceClient struct {
@@ -155,6 +159,26 @@ func (c *assistantDeploymentServiceClient) GetAssistantPhoneDeployment(ctx conte
 	return out, nil
 }
 
+func (c *assistantDeploymentServiceClient) CreateAssistantSmsDeployment(ctx context.Context, in *CreateAssistantDeploymentRequest, opts ...grpc.CallOption) (*GetAssistantSmsDeploymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantSmsDeploymentResponse)
+	err := c.cc.Invoke(ctx, AssistantDeploymentService_CreateAssistantSmsDeployment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantDeploymentServiceClient) GetAssistantSmsDeployment(ctx context.Context, in *GetAssistantDeploymentRequest, opts ...grpc.CallOption) (*GetAssistantSmsDeploymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantSmsDeploymentResponse)
+	err := c.cc.Invoke(ctx, AssistantDeploymentService_GetAssistantSmsDeployment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AssistantDeploymentServiceServer is the server API for AssistantDeploymentService service.
 // All implementations should embed UnimplementedAssistantDeploymentServiceServer
 // for forward compatibility.
@@ -169,6 +193,8 @@ type AssistantDeploymentServiceServer interface {
 	GetAssistantWhatsappDeployment(context.Context, *GetAssistantDeploymentRequest) (*GetAssistantWhatsappDeploymentResponse, error)
 	CreateAssistantPhoneDeployment(context.Context, *CreateAssistantDeploymentRequest) (*GetAssistantPhoneDeploymentResponse, error)
 	GetAssistantPhoneDeployment(context.Context, *GetAssistantDeploymentRequest) (*GetAssistantPhoneDeploymentResponse, error)
+	CreateAssistantSmsDeployment(context.Context, *CreateAssistantDeploymentRequest) (*GetAssistantSmsDeploymentResponse, error)
+	GetAssistantSmsDeployment(context.Context, *GetAssistantDeploymentRequest) (*GetAssistantSmsDeploymentResponse, error)
 }
 
 // UnimplementedAssistantDeploymentServiceServer should be embedded to have
@@ -208,6 +234,12 @@ func (UnimplementedAssistantDeploymentServiceServer) CreateAssistantPhoneDeploym
 func (UnimplementedAssistantDeploymentServiceServer) GetAssistantPhoneDeployment(context.Context, *GetAssistantDeploymentRequest) (*GetAssistantPhoneDeploymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAssistantPhoneDeployment not implemented")
 }
+func (UnimplementedAssistantDeploymentServiceServer) CreateAssistantSmsDeployment(context.Context, *CreateAssistantDeploymentRequest) (*GetAssistantSmsDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssistantSmsDeployment not implemented")
+}
+func (UnimplementedAssistantDeploymentServiceServer) GetAssistantSmsDeployment(context.Context, *GetAssistantDeploymentRequest) (*GetAssistantSmsDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssistantSmsDeployment not implemented")
+}
 func (UnimplementedAssistantDeploymentServiceServer) testEmbeddedByValue() {}
 
 // UnsafeAssistantDeploymentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -408,6 +440,42 @@ func _AssistantDeploymentService_GetAssistantPhoneDeployment_Handler(srv interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssistantDeploymentService_CreateAssistantSmsDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssistantDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantDeploymentServiceServer).CreateAssistantSmsDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantDeploymentService_CreateAssistantSmsDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantDeploymentServiceServer).CreateAssistantSmsDeployment(ctx, req.(*CreateAssistantDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantDeploymentService_GetAssistantSmsDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssistantDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantDeploymentServiceServer).GetAssistantSmsDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantDeploymentService_GetAssistantSmsDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantDeploymentServiceServer).GetAssistantSmsDeployment(ctx, req.(*GetAssistantDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AssistantDeploymentService_ServiceDesc is the grpc.ServiceDesc for AssistantDeploymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -455,6 +523,14 @@ var AssistantDeploymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAssistantPhoneDeployment",
 			Handler:    _AssistantDeploymentService_GetAssistantPhoneDeployment_Handler,
 		},
+		{
+			MethodName: "CreateAssistantSmsDeployment",
+			Handler:    _AssistantDeploymentService_CreateAssistantSmsDeployment_Handler,
+		},
+		{
+			MethodName: "GetAssistantSmsDeployment",
+			Handler:    _AssistantDeploymentService_GetAssistantSmsDeployment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "assistant-deployment.proto",
@@ -1440,9 +1440,11 @@ type UpdateKnowledgeDocumentSegmentRequest struct {
 	Quantities    []string `protobuf:"bytes,7,rep,name=quantities,proto3" json:"quantities,omitempty"`
 	Locations     []string `protobuf:"bytes,8,rep,name=locations,proto3" json:"locations,omitempty"`
 	Industries    []string `protobuf:"bytes,9,rep,name=industries,proto3" json:"industries,omitempty"`
-	DocumentName string `protobuf:"bytes,10,opt,name=documentName,proto3" json:"documentName,omitempty"`
-	DocumentId   string `protobuf:"bytes,11,opt,name=documentId,proto3" json:"documentId,omitempty"`
-	Index        string `protobuf:"bytes,12,opt,name=index,proto3" json:"index,omitempty"`
+	DocumentName  string   `protobuf:"bytes,10,opt,name=documentName,proto3" json:"documentName,omitempty"`
+	DocumentId    string   `protobuf:"bytes,11,opt,name=documentId,proto3" json:"documentId,omitempty"`
+	Index         string   `protobuf:"bytes,12,opt,name=index,proto3" json:"index,omitempty"`
+	Text          string   `protobuf:"bytes,13,opt,name=text,proto3" json:"text,omitempty"`
+	Reason        string   `protobuf:"bytes,14,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (x *UpdateKnowledgeDocumentSegmentRequest) Reset() {
@@ -1561,6 +1563,20 @@ func (x *UpdateKnowledgeDocumentSegmentRequest) GetIndex() string {
 	return ""
 }
 
+func (x *UpdateKnowledgeDocumentSegmentRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *UpdateKnowledgeDocumentSegmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type DeleteKnowledgeDocumentSegmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2068,9 +2084,1574 @@ func (x *KnowledgeLog) GetTimeTaken() uint64 {
 	return 0
 }
 
-func (x *KnowledgeLog) GetAdditionalData() map[string]string {
+func (x *KnowledgeLog) GetAdditionalData() map[string]string {
+	if x != nil {
+		return x.AdditionalData
+	}
+	return nil
+}
+
+type KnowledgeEvaluationQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Question            string   `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	ExpectedDocumentIds []string `protobuf:"bytes,3,rep,name=expectedDocumentIds,proto3" json:"expectedDocumentIds,omitempty"`
+	ExpectedSegmentIds  []string `protobuf:"bytes,4,rep,name=expectedSegmentIds,proto3" json:"expectedSegmentIds,omitempty"`
+}
+
+func (x *KnowledgeEvaluationQuestion) Reset() {
+	*x = KnowledgeEvaluationQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KnowledgeEvaluationQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnowledgeEvaluationQuestion) ProtoMessage() {}
+
+func (x *KnowledgeEvaluationQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnowledgeEvaluationQuestion.ProtoReflect.Descriptor instead.
+func (*KnowledgeEvaluationQuestion) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *KnowledgeEvaluationQuestion) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KnowledgeEvaluationQuestion) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *KnowledgeEvaluationQuestion) GetExpectedDocumentIds() []string {
+	if x != nil {
+		return x.ExpectedDocumentIds
+	}
+	return nil
+}
+
+func (x *KnowledgeEvaluationQuestion) GetExpectedSegmentIds() []string {
+	if x != nil {
+		return x.ExpectedSegmentIds
+	}
+	return nil
+}
+
+type KnowledgeEvaluationReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	KnowledgeEvaluationId uint64                 `protobuf:"varint,2,opt,name=knowledgeEvaluationId,proto3" json:"knowledgeEvaluationId,omitempty"`
+	KnowledgeId           uint64                 `protobuf:"varint,3,opt,name=knowledgeId,proto3" json:"knowledgeId,omitempty"`
+	RetrievalMethod       string                 `protobuf:"bytes,4,opt,name=retrievalMethod,proto3" json:"retrievalMethod,omitempty"`
+	Rerank                bool                   `protobuf:"varint,5,opt,name=rerank,proto3" json:"rerank,omitempty"`
+	TopK                  uint32                 `protobuf:"varint,6,opt,name=topK,proto3" json:"topK,omitempty"`
+	ScoreThreshold        float32                `protobuf:"fixed32,7,opt,name=scoreThreshold,proto3" json:"scoreThreshold,omitempty"`
+	QuestionCount         uint32                 `protobuf:"varint,8,opt,name=questionCount,proto3" json:"questionCount,omitempty"`
+	RecallAtK             float64                `protobuf:"fixed64,9,opt,name=recallAtK,proto3" json:"recallAtK,omitempty"`
+	Mrr                   float64                `protobuf:"fixed64,10,opt,name=mrr,proto3" json:"mrr,omitempty"`
+	AverageTimeTaken      uint64                 `protobuf:"varint,11,opt,name=averageTimeTaken,proto3" json:"averageTimeTaken,omitempty"`
+	P95TimeTaken          uint64                 `protobuf:"varint,12,opt,name=p95TimeTaken,proto3" json:"p95TimeTaken,omitempty"`
+	KnowledgeLogId        uint64                 `protobuf:"varint,13,opt,name=knowledgeLogId,proto3" json:"knowledgeLogId,omitempty"`
+	Status                string                 `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedDate           *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	UpdatedDate           *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updatedDate,proto3" json:"updatedDate,omitempty"`
+}
+
+func (x *KnowledgeEvaluationReport) Reset() {
+	*x = KnowledgeEvaluationReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KnowledgeEvaluationReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnowledgeEvaluationReport) ProtoMessage() {}
+
+func (x *KnowledgeEvaluationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnowledgeEvaluationReport.ProtoReflect.Descriptor instead.
+func (*KnowledgeEvaluationReport) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *KnowledgeEvaluationReport) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KnowledgeEvaluationReport) GetKnowledgeEvaluationId() uint64 {
+	if x != nil {
+		return x.KnowledgeEvaluationId
+	}
+	return 0
+}
+
+func (x *KnowledgeEvaluationReport) GetKnowledgeId() uint64 {
+	if x != nil {
+		return x.KnowledgeId
+	}
+	return 0
+}
+
+func (x *KnowledgeEvaluationReport) GetRetrievalMethod() string {
+	if x != nil {
+		return x.RetrievalMethod
+	}
+	return ""
+}
+
+func (x *KnowledgeEvaluationReport) GetRerank() bool {
+	if x != nil {
+		return x.Rerank
+	}
+	return false
+}
+
+func (x *KnowledgeEvaluationReport) GetTopK() uint32 {
+	if x != nil {
+		return x.TopK
+	}
+	return 0
+}
+
+func (x *KnowledgeEvaluationReport) GetScoreThreshold() float32 {
+	if x != nil {
+		return x.ScoreThreshold
+	}
+	return 0
+}
+
+func (x *KnowledgeEvaluationReport) GetQuestionCount() uint32 {
+	if x != nil {
+		return x.QuestionCount
+	}
+	return 0
+}
+
+func (x *KnowledgeEvaluationReport) GetRecallAtK() float64 {
+	if x != nil {
+		return x.RecallAtK
+	}
+	return 0
+}
+
+func (x *KnowledgeEvaluationReport) GetMrr() float64 {
+	if x != nil {
+		return x.Mrr
+	}
+	return 0
+}
+
+func (x *KnowledgeEvaluationReport) GetAverageTimeTaken() uint64 {
+	if x != nil {
+		return x.AverageTimeTaken
+	}
+	return 0
+}
+
+func (x *KnowledgeEvaluationReport) GetP95TimeTaken() uint64 {
+	if x != nil {
+		return x.P95TimeTaken
+	}
+	return 0
+}
+
+func (x *KnowledgeEvaluationReport) GetKnowledgeLogId() uint64 {
+	if x != nil {
+		return x.KnowledgeLogId
+	}
+	return 0
+}
+
+func (x *KnowledgeEvaluationReport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *KnowledgeEvaluationReport) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *KnowledgeEvaluationReport) GetUpdatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return nil
+}
+
+type KnowledgeEvaluation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64                         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	KnowledgeId uint64                         `protobuf:"varint,2,opt,name=knowledgeId,proto3" json:"knowledgeId,omitempty"`
+	Name        string                         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Questions   []*KnowledgeEvaluationQuestion `protobuf:"bytes,5,rep,name=questions,proto3" json:"questions,omitempty"`
+	Reports     []*KnowledgeEvaluationReport   `protobuf:"bytes,6,rep,name=reports,proto3" json:"reports,omitempty"`
+	Status      string                         `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedBy   uint64                         `protobuf:"varint,8,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedDate *timestamppb.Timestamp         `protobuf:"bytes,9,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	UpdatedDate *timestamppb.Timestamp         `protobuf:"bytes,10,opt,name=updatedDate,proto3" json:"updatedDate,omitempty"`
+}
+
+func (x *KnowledgeEvaluation) Reset() {
+	*x = KnowledgeEvaluation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KnowledgeEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnowledgeEvaluation) ProtoMessage() {}
+
+func (x *KnowledgeEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnowledgeEvaluation.ProtoReflect.Descriptor instead.
+func (*KnowledgeEvaluation) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *KnowledgeEvaluation) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KnowledgeEvaluation) GetKnowledgeId() uint64 {
+	if x != nil {
+		return x.KnowledgeId
+	}
+	return 0
+}
+
+func (x *KnowledgeEvaluation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KnowledgeEvaluation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *KnowledgeEvaluation) GetQuestions() []*KnowledgeEvaluationQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *KnowledgeEvaluation) GetReports() []*KnowledgeEvaluationReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *KnowledgeEvaluation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *KnowledgeEvaluation) GetCreatedBy() uint64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *KnowledgeEvaluation) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *KnowledgeEvaluation) GetUpdatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return nil
+}
+
+type CreateKnowledgeEvaluationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KnowledgeId uint64                         `protobuf:"varint,1,opt,name=knowledgeId,proto3" json:"knowledgeId,omitempty"`
+	Name        string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                         `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Questions   []*KnowledgeEvaluationQuestion `protobuf:"bytes,4,rep,name=questions,proto3" json:"questions,omitempty"`
+}
+
+func (x *CreateKnowledgeEvaluationRequest) Reset() {
+	*x = CreateKnowledgeEvaluationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateKnowledgeEvaluationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKnowledgeEvaluationRequest) ProtoMessage() {}
+
+func (x *CreateKnowledgeEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateKnowledgeEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*CreateKnowledgeEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateKnowledgeEvaluationRequest) GetKnowledgeId() uint64 {
+	if x != nil {
+		return x.KnowledgeId
+	}
+	return 0
+}
+
+func (x *CreateKnowledgeEvaluationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateKnowledgeEvaluationRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateKnowledgeEvaluationRequest) GetQuestions() []*KnowledgeEvaluationQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type RunKnowledgeEvaluationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                        uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RetrievalMethods          []string    `protobuf:"bytes,2,rep,name=retrievalMethods,proto3" json:"retrievalMethods,omitempty"`
+	TopK                      uint32      `protobuf:"varint,3,opt,name=topK,proto3" json:"topK,omitempty"`
+	ScoreThreshold            float32     `protobuf:"fixed32,4,opt,name=scoreThreshold,proto3" json:"scoreThreshold,omitempty"`
+	RerankerModelProviderName string      `protobuf:"bytes,5,opt,name=rerankerModelProviderName,proto3" json:"rerankerModelProviderName,omitempty"`
+	RerankerModelOptions      []*Metadata `protobuf:"bytes,6,rep,name=rerankerModelOptions,proto3" json:"rerankerModelOptions,omitempty"`
+}
+
+func (x *RunKnowledgeEvaluationRequest) Reset() {
+	*x = RunKnowledgeEvaluationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunKnowledgeEvaluationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunKnowledgeEvaluationRequest) ProtoMessage() {}
+
+func (x *RunKnowledgeEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunKnowledgeEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*RunKnowledgeEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RunKnowledgeEvaluationRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RunKnowledgeEvaluationRequest) GetRetrievalMethods() []string {
+	if x != nil {
+		return x.RetrievalMethods
+	}
+	return nil
+}
+
+func (x *RunKnowledgeEvaluationRequest) GetTopK() uint32 {
+	if x != nil {
+		return x.TopK
+	}
+	return 0
+}
+
+func (x *RunKnowledgeEvaluationRequest) GetScoreThreshold() float32 {
+	if x != nil {
+		return x.ScoreThreshold
+	}
+	return 0
+}
+
+func (x *RunKnowledgeEvaluationRequest) GetRerankerModelProviderName() string {
+	if x != nil {
+		return x.RerankerModelProviderName
+	}
+	return ""
+}
+
+func (x *RunKnowledgeEvaluationRequest) GetRerankerModelOptions() []*Metadata {
+	if x != nil {
+		return x.RerankerModelOptions
+	}
+	return nil
+}
+
+type GetKnowledgeEvaluationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetKnowledgeEvaluationRequest) Reset() {
+	*x = GetKnowledgeEvaluationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetKnowledgeEvaluationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKnowledgeEvaluationRequest) ProtoMessage() {}
+
+func (x *GetKnowledgeEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKnowledgeEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*GetKnowledgeEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetKnowledgeEvaluationRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetKnowledgeEvaluationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32                `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Success bool                 `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Data    *KnowledgeEvaluation `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Error   *Error               `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetKnowledgeEvaluationResponse) Reset() {
+	*x = GetKnowledgeEvaluationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetKnowledgeEvaluationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKnowledgeEvaluationResponse) ProtoMessage() {}
+
+func (x *GetKnowledgeEvaluationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKnowledgeEvaluationResponse.ProtoReflect.Descriptor instead.
+func (*GetKnowledgeEvaluationResponse) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetKnowledgeEvaluationResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetKnowledgeEvaluationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetKnowledgeEvaluationResponse) GetData() *KnowledgeEvaluation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetKnowledgeEvaluationResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetAllKnowledgeEvaluationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KnowledgeId uint64      `protobuf:"varint,1,opt,name=knowledgeId,proto3" json:"knowledgeId,omitempty"`
+	Paginate    *Paginate   `protobuf:"bytes,2,opt,name=paginate,proto3" json:"paginate,omitempty"`
+	Criterias   []*Criteria `protobuf:"bytes,3,rep,name=criterias,proto3" json:"criterias,omitempty"`
+}
+
+func (x *GetAllKnowledgeEvaluationRequest) Reset() {
+	*x = GetAllKnowledgeEvaluationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllKnowledgeEvaluationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllKnowledgeEvaluationRequest) ProtoMessage() {}
+
+func (x *GetAllKnowledgeEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllKnowledgeEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*GetAllKnowledgeEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetAllKnowledgeEvaluationRequest) GetKnowledgeId() uint64 {
+	if x != nil {
+		return x.KnowledgeId
+	}
+	return 0
+}
+
+func (x *GetAllKnowledgeEvaluationRequest) GetPaginate() *Paginate {
+	if x != nil {
+		return x.Paginate
+	}
+	return nil
+}
+
+func (x *GetAllKnowledgeEvaluationRequest) GetCriterias() []*Criteria {
+	if x != nil {
+		return x.Criterias
+	}
+	return nil
+}
+
+type GetAllKnowledgeEvaluationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Success   bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Data      []*KnowledgeEvaluation `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Error     *Error                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Paginated *Paginated             `protobuf:"bytes,5,opt,name=paginated,proto3" json:"paginated,omitempty"`
+}
+
+func (x *GetAllKnowledgeEvaluationResponse) Reset() {
+	*x = GetAllKnowledgeEvaluationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllKnowledgeEvaluationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllKnowledgeEvaluationResponse) ProtoMessage() {}
+
+func (x *GetAllKnowledgeEvaluationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllKnowledgeEvaluationResponse.ProtoReflect.Descriptor instead.
+func (*GetAllKnowledgeEvaluationResponse) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetAllKnowledgeEvaluationResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAllKnowledgeEvaluationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetAllKnowledgeEvaluationResponse) GetData() []*KnowledgeEvaluation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetAllKnowledgeEvaluationResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GetAllKnowledgeEvaluationResponse) GetPaginated() *Paginated {
+	if x != nil {
+		return x.Paginated
+	}
+	return nil
+}
+
+type KnowledgeEmbeddingJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                                 uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	KnowledgeId                        uint64                 `protobuf:"varint,2,opt,name=knowledgeId,proto3" json:"knowledgeId,omitempty"`
+	StorageNamespace                   string                 `protobuf:"bytes,3,opt,name=storageNamespace,proto3" json:"storageNamespace,omitempty"`
+	PreviousStorageNamespace           string                 `protobuf:"bytes,4,opt,name=previousStorageNamespace,proto3" json:"previousStorageNamespace,omitempty"`
+	EmbeddingModelProviderName         string                 `protobuf:"bytes,5,opt,name=embeddingModelProviderName,proto3" json:"embeddingModelProviderName,omitempty"`
+	PreviousEmbeddingModelProviderName string                 `protobuf:"bytes,6,opt,name=previousEmbeddingModelProviderName,proto3" json:"previousEmbeddingModelProviderName,omitempty"`
+	TotalSegments                      uint64                 `protobuf:"varint,7,opt,name=totalSegments,proto3" json:"totalSegments,omitempty"`
+	ProcessedSegments                  uint64                 `protobuf:"varint,8,opt,name=processedSegments,proto3" json:"processedSegments,omitempty"`
+	FailedSegments                     uint64                 `protobuf:"varint,9,opt,name=failedSegments,proto3" json:"failedSegments,omitempty"`
+	Error                              string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	Status                             string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedBy                          uint64                 `protobuf:"varint,12,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedDate                        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	UpdatedDate                        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updatedDate,proto3" json:"updatedDate,omitempty"`
+}
+
+func (x *KnowledgeEmbeddingJob) Reset() {
+	*x = KnowledgeEmbeddingJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KnowledgeEmbeddingJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnowledgeEmbeddingJob) ProtoMessage() {}
+
+func (x *KnowledgeEmbeddingJob) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnowledgeEmbeddingJob.ProtoReflect.Descriptor instead.
+func (*KnowledgeEmbeddingJob) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *KnowledgeEmbeddingJob) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KnowledgeEmbeddingJob) GetKnowledgeId() uint64 {
+	if x != nil {
+		return x.KnowledgeId
+	}
+	return 0
+}
+
+func (x *KnowledgeEmbeddingJob) GetStorageNamespace() string {
+	if x != nil {
+		return x.StorageNamespace
+	}
+	return ""
+}
+
+func (x *KnowledgeEmbeddingJob) GetPreviousStorageNamespace() string {
+	if x != nil {
+		return x.PreviousStorageNamespace
+	}
+	return ""
+}
+
+func (x *KnowledgeEmbeddingJob) GetEmbeddingModelProviderName() string {
+	if x != nil {
+		return x.EmbeddingModelProviderName
+	}
+	return ""
+}
+
+func (x *KnowledgeEmbeddingJob) GetPreviousEmbeddingModelProviderName() string {
+	if x != nil {
+		return x.PreviousEmbeddingModelProviderName
+	}
+	return ""
+}
+
+func (x *KnowledgeEmbeddingJob) GetTotalSegments() uint64 {
+	if x != nil {
+		return x.TotalSegments
+	}
+	return 0
+}
+
+func (x *KnowledgeEmbeddingJob) GetProcessedSegments() uint64 {
+	if x != nil {
+		return x.ProcessedSegments
+	}
+	return 0
+}
+
+func (x *KnowledgeEmbeddingJob) GetFailedSegments() uint64 {
+	if x != nil {
+		return x.FailedSegments
+	}
+	return 0
+}
+
+func (x *KnowledgeEmbeddingJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *KnowledgeEmbeddingJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *KnowledgeEmbeddingJob) GetCreatedBy() uint64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *KnowledgeEmbeddingJob) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *KnowledgeEmbeddingJob) GetUpdatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return nil
+}
+
+type ReindexKnowledgeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KnowledgeId                    uint64      `protobuf:"varint,1,opt,name=knowledgeId,proto3" json:"knowledgeId,omitempty"`
+	EmbeddingModelProviderName     string      `protobuf:"bytes,2,opt,name=embeddingModelProviderName,proto3" json:"embeddingModelProviderName,omitempty"`
+	KnowledgeEmbeddingModelOptions []*Metadata `protobuf:"bytes,3,rep,name=knowledgeEmbeddingModelOptions,proto3" json:"knowledgeEmbeddingModelOptions,omitempty"`
+}
+
+func (x *ReindexKnowledgeRequest) Reset() {
+	*x = ReindexKnowledgeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReindexKnowledgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexKnowledgeRequest) ProtoMessage() {}
+
+func (x *ReindexKnowledgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexKnowledgeRequest.ProtoReflect.Descriptor instead.
+func (*ReindexKnowledgeRequest) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReindexKnowledgeRequest) GetKnowledgeId() uint64 {
+	if x != nil {
+		return x.KnowledgeId
+	}
+	return 0
+}
+
+func (x *ReindexKnowledgeRequest) GetEmbeddingModelProviderName() string {
+	if x != nil {
+		return x.EmbeddingModelProviderName
+	}
+	return ""
+}
+
+func (x *ReindexKnowledgeRequest) GetKnowledgeEmbeddingModelOptions() []*Metadata {
+	if x != nil {
+		return x.KnowledgeEmbeddingModelOptions
+	}
+	return nil
+}
+
+type GetKnowledgeEmbeddingJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetKnowledgeEmbeddingJobRequest) Reset() {
+	*x = GetKnowledgeEmbeddingJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetKnowledgeEmbeddingJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKnowledgeEmbeddingJobRequest) ProtoMessage() {}
+
+func (x *GetKnowledgeEmbeddingJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKnowledgeEmbeddingJobRequest.ProtoReflect.Descriptor instead.
+func (*GetKnowledgeEmbeddingJobRequest) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetKnowledgeEmbeddingJobRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RollbackKnowledgeEmbeddingJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RollbackKnowledgeEmbeddingJobRequest) Reset() {
+	*x = RollbackKnowledgeEmbeddingJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackKnowledgeEmbeddingJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackKnowledgeEmbeddingJobRequest) ProtoMessage() {}
+
+func (x *RollbackKnowledgeEmbeddingJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackKnowledgeEmbeddingJobRequest.ProtoReflect.Descriptor instead.
+func (*RollbackKnowledgeEmbeddingJobRequest) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RollbackKnowledgeEmbeddingJobRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetKnowledgeEmbeddingJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Success bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Data    *KnowledgeEmbeddingJob `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Error   *Error                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetKnowledgeEmbeddingJobResponse) Reset() {
+	*x = GetKnowledgeEmbeddingJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetKnowledgeEmbeddingJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKnowledgeEmbeddingJobResponse) ProtoMessage() {}
+
+func (x *GetKnowledgeEmbeddingJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKnowledgeEmbeddingJobResponse.ProtoReflect.Descriptor instead.
+func (*GetKnowledgeEmbeddingJobResponse) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetKnowledgeEmbeddingJobResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetKnowledgeEmbeddingJobResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetKnowledgeEmbeddingJobResponse) GetData() *KnowledgeEmbeddingJob {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetKnowledgeEmbeddingJobResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetAllKnowledgeEmbeddingJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KnowledgeId uint64      `protobuf:"varint,1,opt,name=knowledgeId,proto3" json:"knowledgeId,omitempty"`
+	Paginate    *Paginate   `protobuf:"bytes,2,opt,name=paginate,proto3" json:"paginate,omitempty"`
+	Criterias   []*Criteria `protobuf:"bytes,3,rep,name=criterias,proto3" json:"criterias,omitempty"`
+}
+
+func (x *GetAllKnowledgeEmbeddingJobRequest) Reset() {
+	*x = GetAllKnowledgeEmbeddingJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllKnowledgeEmbeddingJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllKnowledgeEmbeddingJobRequest) ProtoMessage() {}
+
+func (x *GetAllKnowledgeEmbeddingJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllKnowledgeEmbeddingJobRequest.ProtoReflect.Descriptor instead.
+func (*GetAllKnowledgeEmbeddingJobRequest) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetAllKnowledgeEmbeddingJobRequest) GetKnowledgeId() uint64 {
+	if x != nil {
+		return x.KnowledgeId
+	}
+	return 0
+}
+
+func (x *GetAllKnowledgeEmbeddingJobRequest) GetPaginate() *Paginate {
+	if x != nil {
+		return x.Paginate
+	}
+	return nil
+}
+
+func (x *GetAllKnowledgeEmbeddingJobRequest) GetCriterias() []*Criteria {
+	if x != nil {
+		return x.Criterias
+	}
+	return nil
+}
+
+type GetAllKnowledgeEmbeddingJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      int32                    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Success   bool                     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Data      []*KnowledgeEmbeddingJob `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Error     *Error                   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Paginated *Paginated               `protobuf:"bytes,5,opt,name=paginated,proto3" json:"paginated,omitempty"`
+}
+
+func (x *GetAllKnowledgeEmbeddingJobResponse) Reset() {
+	*x = GetAllKnowledgeEmbeddingJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllKnowledgeEmbeddingJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllKnowledgeEmbeddingJobResponse) ProtoMessage() {}
+
+func (x *GetAllKnowledgeEmbeddingJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllKnowledgeEmbeddingJobResponse.ProtoReflect.Descriptor instead.
+func (*GetAllKnowledgeEmbeddingJobResponse) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetAllKnowledgeEmbeddingJobResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAllKnowledgeEmbeddingJobResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetAllKnowledgeEmbeddingJobResponse) GetData() []*KnowledgeEmbeddingJob {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetAllKnowledgeEmbeddingJobResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GetAllKnowledgeEmbeddingJobResponse) GetPaginated() *Paginated {
+	if x != nil {
+		return x.Paginated
+	}
+	return nil
+}
+
+type KnowledgeDocumentSegmentRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	KnowledgeId      uint64                 `protobuf:"varint,2,opt,name=knowledgeId,proto3" json:"knowledgeId,omitempty"`
+	StorageNamespace string                 `protobuf:"bytes,3,opt,name=storageNamespace,proto3" json:"storageNamespace,omitempty"`
+	DocumentId       string                 `protobuf:"bytes,4,opt,name=documentId,proto3" json:"documentId,omitempty"`
+	Action           string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	Text             string                 `protobuf:"bytes,6,opt,name=text,proto3" json:"text,omitempty"`
+	DocumentName     string                 `protobuf:"bytes,7,opt,name=documentName,proto3" json:"documentName,omitempty"`
+	Entities         *structpb.Struct       `protobuf:"bytes,8,opt,name=entities,proto3" json:"entities,omitempty"`
+	Reason           string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedBy        uint64                 `protobuf:"varint,10,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+}
+
+func (x *KnowledgeDocumentSegmentRevision) Reset() {
+	*x = KnowledgeDocumentSegmentRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KnowledgeDocumentSegmentRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnowledgeDocumentSegmentRevision) ProtoMessage() {}
+
+func (x *KnowledgeDocumentSegmentRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnowledgeDocumentSegmentRevision.ProtoReflect.Descriptor instead.
+func (*KnowledgeDocumentSegmentRevision) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *KnowledgeDocumentSegmentRevision) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KnowledgeDocumentSegmentRevision) GetKnowledgeId() uint64 {
+	if x != nil {
+		return x.KnowledgeId
+	}
+	return 0
+}
+
+func (x *KnowledgeDocumentSegmentRevision) GetStorageNamespace() string {
+	if x != nil {
+		return x.StorageNamespace
+	}
+	return ""
+}
+
+func (x *KnowledgeDocumentSegmentRevision) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *KnowledgeDocumentSegmentRevision) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *KnowledgeDocumentSegmentRevision) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *KnowledgeDocumentSegmentRevision) GetDocumentName() string {
+	if x != nil {
+		return x.DocumentName
+	}
+	return ""
+}
+
+func (x *KnowledgeDocumentSegmentRevision) GetEntities() *structpb.Struct {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *KnowledgeDocumentSegmentRevision) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *KnowledgeDocumentSegmentRevision) GetCreatedBy() uint64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *KnowledgeDocumentSegmentRevision) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+type GetAllKnowledgeDocumentSegmentRevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KnowledgeId uint64    `protobuf:"varint,1,opt,name=knowledgeId,proto3" json:"knowledgeId,omitempty"`
+	DocumentId  string    `protobuf:"bytes,2,opt,name=documentId,proto3" json:"documentId,omitempty"`
+	Paginate    *Paginate `protobuf:"bytes,3,opt,name=paginate,proto3" json:"paginate,omitempty"`
+}
+
+func (x *GetAllKnowledgeDocumentSegmentRevisionRequest) Reset() {
+	*x = GetAllKnowledgeDocumentSegmentRevisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllKnowledgeDocumentSegmentRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllKnowledgeDocumentSegmentRevisionRequest) ProtoMessage() {}
+
+func (x *GetAllKnowledgeDocumentSegmentRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllKnowledgeDocumentSegmentRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetAllKnowledgeDocumentSegmentRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetAllKnowledgeDocumentSegmentRevisionRequest) GetKnowledgeId() uint64 {
+	if x != nil {
+		return x.KnowledgeId
+	}
+	return 0
+}
+
+func (x *GetAllKnowledgeDocumentSegmentRevisionRequest) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *GetAllKnowledgeDocumentSegmentRevisionRequest) GetPaginate() *Paginate {
+	if x != nil {
+		return x.Paginate
+	}
+	return nil
+}
+
+type GetAllKnowledgeDocumentSegmentRevisionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      int32                               `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Success   bool                                `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Data      []*KnowledgeDocumentSegmentRevision `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Error     *Error                              `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Paginated *Paginated                          `protobuf:"bytes,5,opt,name=paginated,proto3" json:"paginated,omitempty"`
+}
+
+func (x *GetAllKnowledgeDocumentSegmentRevisionResponse) Reset() {
+	*x = GetAllKnowledgeDocumentSegmentRevisionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_knowledge_api_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllKnowledgeDocumentSegmentRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllKnowledgeDocumentSegmentRevisionResponse) ProtoMessage() {}
+
+func (x *GetAllKnowledgeDocumentSegmentRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_api_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllKnowledgeDocumentSegmentRevisionResponse.ProtoReflect.Descriptor instead.
+func (*GetAllKnowledgeDocumentSegmentRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_knowledge_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetAllKnowledgeDocumentSegmentRevisionResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAllKnowledgeDocumentSegmentRevisionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetAllKnowledgeDocumentSegmentRevisionResponse) GetData() []*KnowledgeDocumentSegmentRevision {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetAllKnowledgeDocumentSegmentRevisionResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GetAllKnowledgeDocumentSegmentRevisionResponse) GetPaginated() *Paginated {
 	if x != nil {
-		return x.AdditionalData
+		return x.Paginated
 	}
 	return nil
 }
@@ -2092,7 +3673,7 @@ type KnowledgeDocumentSegment_Metadata struct {
 func (x *KnowledgeDocumentSegment_Metadata) Reset() {
 	*x = KnowledgeDocumentSegment_Metadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_knowledge_api_proto_msgTypes[23]
+		mi := &file_knowledge_api_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2105,7 +3686,7 @@ func (x *KnowledgeDocumentSegment_Metadata) String() string {
 func (*KnowledgeDocumentSegment_Metadata) ProtoMessage() {}
 
 func (x *KnowledgeDocumentSegment_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_knowledge_api_proto_msgTypes[23]
+	mi := &file_knowledge_api_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2189,7 +3770,7 @@ type KnowledgeDocumentSegment_Entities struct {
 func (x *KnowledgeDocumentSegment_Entities) Reset() {
 	*x = KnowledgeDocumentSegment_Entities{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_knowledge_api_proto_msgTypes[24]
+		mi := &file_knowledge_api_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2202,7 +3783,7 @@ func (x *KnowledgeDocumentSegment_Entities) String() string {
 func (*KnowledgeDocumentSegment_Entities) ProtoMessage() {}
 
 func (x *KnowledgeDocumentSegment_Entities) ProtoReflect() protoreflect.Message {
-	mi := &file_knowledge_api_proto_msgTypes[24]
+	mi := &file_knowledge_api_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2563,7 +4144,7 @@ var file_knowledge_api_proto_rawDesc = []byte{
 	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
 	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xfd, 0x02, 0x0a, 0x25, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa9, 0x03, 0x0a, 0x25, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
 	0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
@@ -2587,188 +4168,558 @@ var file_knowledge_api_proto_rawDesc = []byte{
 	0x1e, 0x0a, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x0b, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
 	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x75, 0x0a, 0x25, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b,
-	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xae, 0x01, 0x0a,
-	0x19, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
-	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
-	0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x08,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
-	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x08, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
-	0x61, 0x52, 0x09, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x05,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x4e, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x96, 0x01,
-	0x0a, 0x17, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
-	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c,
-	0x6f, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc3, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x75, 0x0a, 0x25, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xae, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x08, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12,
+	0x27, 0x0a, 0x09, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x09, 0x63,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
 	0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1b, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70,
 	0x69, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x04,
 	0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x12, 0x28, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65,
-	0x64, 0x52, 0x09, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x22, 0xaf, 0x06, 0x0a,
-	0x0c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x2f, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x6f, 0x72, 0x22, 0xc3, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x2f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x22, 0xaf, 0x06, 0x0a, 0x0c, 0x4b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31,
+	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x33, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65,
-	0x12, 0x3c, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24,
-	0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
-	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
-	0x30, 0x01, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x70, 0x4b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x74, 0x6f, 0x70, 0x4b, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54,
-	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x24,
-	0x0a, 0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
-	0x76, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x12, 0x20, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x61, 0x6b, 0x65, 0x6e, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x61, 0x6b,
-	0x65, 0x6e, 0x12, 0x57, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
-	0x44, 0x61, 0x74, 0x61, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6b, 0x6e, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c,
+	0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0b, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x02, 0x30, 0x01, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64,
+	0x12, 0x20, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0e,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x6f, 0x70, 0x4b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x6f,
+	0x70, 0x4b, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x20, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x54, 0x61, 0x6b, 0x65, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x02, 0x30, 0x01, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x61, 0x6b, 0x65, 0x6e, 0x12, 0x57,
+	0x0a, 0x0e, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x4c, 0x6f, 0x67, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x41, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xaf, 0x01, 0x0a, 0x1b, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x13, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x12,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0xfb, 0x04, 0x0a,
+	0x19, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38,
+	0x0a, 0x15, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x15, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x28,
+	0x0a, 0x0f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
+	0x61, 0x6c, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x72, 0x61,
+	0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x72, 0x61, 0x6e, 0x6b,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x70, 0x4b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x74, 0x6f, 0x70, 0x4b, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x24, 0x0a, 0x0d,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x41, 0x74, 0x4b, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x72, 0x65, 0x63, 0x61, 0x6c, 0x6c, 0x41, 0x74, 0x4b,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x72, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d,
+	0x72, 0x72, 0x12, 0x2e, 0x0a, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x54, 0x61, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
+	0x52, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x61, 0x6b,
+	0x65, 0x6e, 0x12, 0x26, 0x0a, 0x0c, 0x70, 0x39, 0x35, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x61, 0x6b,
+	0x65, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0c, 0x70, 0x39,
+	0x35, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x61, 0x6b, 0x65, 0x6e, 0x12, 0x2a, 0x0a, 0x0e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x49, 0x64, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x4c, 0x6f, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c,
+	0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xc9, 0x03, 0x0a, 0x13, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x07,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x6e, 0x6f,
 	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
-	0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x61, 0x64, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x41, 0x0a, 0x13, 0x41,
-	0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0x9e,
-	0x0a, 0x0a, 0x10, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
-	0x64, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
-	0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
-	0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x12,
-	0x25, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f,
-	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60,
-	0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
-	0x65, 0x12, 0x25, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
-	0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b,
-	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x63, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
-	0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x12, 0x28, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x98, 0x02, 0x0a, 0x1d, 0x52, 0x75, 0x6e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x10, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x70, 0x4b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x4b, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x3c, 0x0a, 0x19, 0x72, 0x65, 0x72, 0x61, 0x6e, 0x6b, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x19, 0x72, 0x65, 0x72, 0x61, 0x6e, 0x6b, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a,
+	0x14, 0x72, 0x65, 0x72, 0x61, 0x6e, 0x6b, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x14, 0x72, 0x65, 0x72, 0x61, 0x6e, 0x6b, 0x65, 0x72,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x33, 0x0a, 0x1d,
+	0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0xa4, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x98, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x09, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x22, 0x9d, 0x05, 0x0a, 0x15, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4a, 0x6f,
+	0x62, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x18, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x1a, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69,
+	0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x22, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x45,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x22, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69,
+	0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0d,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a,
+	0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x11, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x2a, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0e, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3c, 0x0a, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x1a, 0x65, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x51, 0x0a, 0x1e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x1e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x35, 0x0a, 0x1f,
+	0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x3a, 0x0a, 0x24, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e,
+	0x67, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0xa8, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x9a, 0x01, 0x0a, 0x22, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x24, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x52, 0x08, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x27,
+	0x0a, 0x09, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x09, 0x63, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x38, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4a, 0x6f,
+	0x62, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x64, 0x52, 0x09, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x22,
+	0xa5, 0x03, 0x0a, 0x20, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x2d, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x52, 0x09, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x64, 0x32, 0xaa, 0x13, 0x0a, 0x10, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x12, 0x28, 0x2e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x17, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
 	0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f,
-	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x23, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b,
 	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
 	0x12, 0x2d, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
 	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x2e, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44,
 	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x78, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x6b, 0x6e, 0x6f,
-	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6b, 0x6e, 0x6f, 0x77,
-	0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
-	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x1e, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x2e, 0x6b,
-	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61,
+	0x8d, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x34, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61,
 	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
 	0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x15, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x12, 0x2b, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x23, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x6e,
-	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f,
-	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x42,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x1e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f,
-	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x2e,
-	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x69, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77,
-	0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x12, 0x28, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x69, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x2b, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x1e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x2e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x65, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x34, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x42, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x12, 0x28,
+	0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
 	0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b,
-	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x12, 0x25, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x75, 0x0a, 0x16, 0x52, 0x75, 0x6e, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x75, 0x6e,
+	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2d, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x7e, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6b, 0x0a, 0x10, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x12, 0x26, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69,
+	0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x12, 0x2e, 0x2e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x12, 0x31, 0x2e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x85, 0x01, 0x0a, 0x1d, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67,
+	0x4a, 0x6f, 0x62, 0x12, 0x33, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa5, 0x01, 0x0a, 0x26, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61,
 	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a,
-	0x0f, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67,
-	0x12, 0x25, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
-	0x64, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c,
-	0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x1c, 0x5a, 0x1a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61,
-	0x70, 0x69, 0x64, 0x61, 0x61, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x1c, 0x5a, 0x1a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x72, 0x61, 0x70, 0x69, 0x64, 0x61, 0x61, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2784,126 +4735,194 @@ func file_knowledge_api_proto_rawDescGZIP() []byte {
 }
 
 var file_knowledge_api_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_knowledge_api_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_knowledge_api_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_knowledge_api_proto_goTypes = []any{
-	(CreateKnowledgeDocumentRequest_PRE_PROCESS)(0),     // 0: knowledge_api.CreateKnowledgeDocumentRequest.PRE_PROCESS
-	(CreateKnowledgeDocumentRequest_DOCUMENT_SOURCE)(0), // 1: knowledge_api.CreateKnowledgeDocumentRequest.DOCUMENT_SOURCE
-	(*CreateKnowledgeRequest)(nil),                      // 2: knowledge_api.CreateKnowledgeRequest
-	(*CreateKnowledgeResponse)(nil),                     // 3: knowledge_api.CreateKnowledgeResponse
-	(*GetAllKnowledgeRequest)(nil),                      // 4: knowledge_api.GetAllKnowledgeRequest
-	(*GetAllKnowledgeResponse)(nil),                     // 5: knowledge_api.GetAllKnowledgeResponse
-	(*GetKnowledgeRequest)(nil),                         // 6: knowledge_api.GetKnowledgeRequest
-	(*GetKnowledgeResponse)(nil),                        // 7: knowledge_api.GetKnowledgeResponse
-	(*CreateKnowledgeTagRequest)(nil),                   // 8: knowledge_api.CreateKnowledgeTagRequest
-	(*KnowledgeDocument)(nil),                           // 9: knowledge_api.KnowledgeDocument
-	(*GetAllKnowledgeDocumentRequest)(nil),              // 10: knowledge_api.GetAllKnowledgeDocumentRequest
-	(*GetAllKnowledgeDocumentResponse)(nil),             // 11: knowledge_api.GetAllKnowledgeDocumentResponse
-	(*CreateKnowledgeDocumentRequest)(nil),              // 12: knowledge_api.CreateKnowledgeDocumentRequest
-	(*CreateKnowledgeDocumentResponse)(nil),             // 13: knowledge_api.CreateKnowledgeDocumentResponse
-	(*KnowledgeDocumentSegment)(nil),                    // 14: knowledge_api.KnowledgeDocumentSegment
-	(*GetAllKnowledgeDocumentSegmentRequest)(nil),       // 15: knowledge_api.GetAllKnowledgeDocumentSegmentRequest
-	(*GetAllKnowledgeDocumentSegmentResponse)(nil),      // 16: knowledge_api.GetAllKnowledgeDocumentSegmentResponse
-	(*UpdateKnowledgeDetailRequest)(nil),                // 17: knowledge_api.UpdateKnowledgeDetailRequest
-	(*UpdateKnowledgeDocumentSegmentRequest)(nil),       // 18: knowledge_api.UpdateKnowledgeDocumentSegmentRequest
-	(*DeleteKnowledgeDocumentSegmentRequest)(nil),       // 19: knowledge_api.DeleteKnowledgeDocumentSegmentRequest
-	(*GetAllKnowledgeLogRequest)(nil),                   // 20: knowledge_api.GetAllKnowledgeLogRequest
-	(*GetKnowledgeLogRequest)(nil),                      // 21: knowledge_api.GetKnowledgeLogRequest
-	(*GetKnowledgeLogResponse)(nil),                     // 22: knowledge_api.GetKnowledgeLogResponse
-	(*GetAllKnowledgeLogResponse)(nil),                  // 23: knowledge_api.GetAllKnowledgeLogResponse
-	(*KnowledgeLog)(nil),                                // 24: knowledge_api.KnowledgeLog
-	(*KnowledgeDocumentSegment_Metadata)(nil),           // 25: knowledge_api.KnowledgeDocumentSegment.Metadata
-	(*KnowledgeDocumentSegment_Entities)(nil),           // 26: knowledge_api.KnowledgeDocumentSegment.Entities
-	nil,                           // 27: knowledge_api.KnowledgeLog.AdditionalDataEntry
-	(*Metadata)(nil),              // 28: Metadata
-	(*Knowledge)(nil),             // 29: Knowledge
-	(*Error)(nil),                 // 30: Error
-	(*Paginate)(nil),              // 31: Paginate
-	(*Criteria)(nil),              // 32: Criteria
-	(*Paginated)(nil),             // 33: Paginated
-	(*structpb.Struct)(nil),       // 34: google.protobuf.Struct
-	(*User)(nil),                  // 35: User
-	(*timestamppb.Timestamp)(nil), // 36: google.protobuf.Timestamp
-	(*Content)(nil),               // 37: Content
-	(*Ordering)(nil),              // 38: Ordering
-	(*BaseResponse)(nil),          // 39: BaseResponse
+	(CreateKnowledgeDocumentRequest_PRE_PROCESS)(0),        // 0: knowledge_api.CreateKnowledgeDocumentRequest.PRE_PROCESS
+	(CreateKnowledgeDocumentRequest_DOCUMENT_SOURCE)(0),    // 1: knowledge_api.CreateKnowledgeDocumentRequest.DOCUMENT_SOURCE
+	(*CreateKnowledgeRequest)(nil),                         // 2: knowledge_api.CreateKnowledgeRequest
+	(*CreateKnowledgeResponse)(nil),                        // 3: knowledge_api.CreateKnowledgeResponse
+	(*GetAllKnowledgeRequest)(nil),                         // 4: knowledge_api.GetAllKnowledgeRequest
+	(*GetAllKnowledgeResponse)(nil),                        // 5: knowledge_api.GetAllKnowledgeResponse
+	(*GetKnowledgeRequest)(nil),                            // 6: knowledge_api.GetKnowledgeRequest
+	(*GetKnowledgeResponse)(nil),                           // 7: knowledge_api.GetKnowledgeResponse
+	(*CreateKnowledgeTagRequest)(nil),                      // 8: knowledge_api.CreateKnowledgeTagRequest
+	(*KnowledgeDocument)(nil),                              // 9: knowledge_api.KnowledgeDocument
+	(*GetAllKnowledgeDocumentRequest)(nil),                 // 10: knowledge_api.GetAllKnowledgeDocumentRequest
+	(*GetAllKnowledgeDocumentResponse)(nil),                // 11: knowledge_api.GetAllKnowledgeDocumentResponse
+	(*CreateKnowledgeDocumentRequest)(nil),                 // 12: knowledge_api.CreateKnowledgeDocumentRequest
+	(*CreateKnowledgeDocumentResponse)(nil),                // 13: knowledge_api.CreateKnowledgeDocumentResponse
+	(*KnowledgeDocumentSegment)(nil),                       // 14: knowledge_api.KnowledgeDocumentSegment
+	(*GetAllKnowledgeDocumentSegmentRequest)(nil),          // 15: knowledge_api.GetAllKnowledgeDocumentSegmentRequest
+	(*GetAllKnowledgeDocumentSegmentResponse)(nil),         // 16: knowledge_api.GetAllKnowledgeDocumentSegmentResponse
+	(*UpdateKnowledgeDetailRequest)(nil),                   // 17: knowledge_api.UpdateKnowledgeDetailRequest
+	(*UpdateKnowledgeDocumentSegmentRequest)(nil),          // 18: knowledge_api.UpdateKnowledgeDocumentSegmentRequest
+	(*DeleteKnowledgeDocumentSegmentRequest)(nil),          // 19: knowledge_api.DeleteKnowledgeDocumentSegmentRequest
+	(*GetAllKnowledgeLogRequest)(nil),                      // 20: knowledge_api.GetAllKnowledgeLogRequest
+	(*GetKnowledgeLogRequest)(nil),                         // 21: knowledge_api.GetKnowledgeLogRequest
+	(*GetKnowledgeLogResponse)(nil),                        // 22: knowledge_api.GetKnowledgeLogResponse
+	(*GetAllKnowledgeLogResponse)(nil),                     // 23: knowledge_api.GetAllKnowledgeLogResponse
+	(*KnowledgeLog)(nil),                                   // 24: knowledge_api.KnowledgeLog
+	(*KnowledgeEvaluationQuestion)(nil),                    // 25: knowledge_api.KnowledgeEvaluationQuestion
+	(*KnowledgeEvaluationReport)(nil),                      // 26: knowledge_api.KnowledgeEvaluationReport
+	(*KnowledgeEvaluation)(nil),                            // 27: knowledge_api.KnowledgeEvaluation
+	(*CreateKnowledgeEvaluationRequest)(nil),               // 28: knowledge_api.CreateKnowledgeEvaluationRequest
+	(*RunKnowledgeEvaluationRequest)(nil),                  // 29: knowledge_api.RunKnowledgeEvaluationRequest
+	(*GetKnowledgeEvaluationRequest)(nil),                  // 30: knowledge_api.GetKnowledgeEvaluationRequest
+	(*GetKnowledgeEvaluationResponse)(nil),                 // 31: knowledge_api.GetKnowledgeEvaluationResponse
+	(*GetAllKnowledgeEvaluationRequest)(nil),               // 32: knowledge_api.GetAllKnowledgeEvaluationRequest
+	(*GetAllKnowledgeEvaluationResponse)(nil),              // 33: knowledge_api.GetAllKnowledgeEvaluationResponse
+	(*KnowledgeEmbeddingJob)(nil),                          // 34: knowledge_api.KnowledgeEmbeddingJob
+	(*ReindexKnowledgeRequest)(nil),                        // 35: knowledge_api.ReindexKnowledgeRequest
+	(*GetKnowledgeEmbeddingJobRequest)(nil),                // 36: knowledge_api.GetKnowledgeEmbeddingJobRequest
+	(*RollbackKnowledgeEmbeddingJobRequest)(nil),           // 37: knowledge_api.RollbackKnowledgeEmbeddingJobRequest
+	(*GetKnowledgeEmbeddingJobResponse)(nil),               // 38: knowledge_api.GetKnowledgeEmbeddingJobResponse
+	(*GetAllKnowledgeEmbeddingJobRequest)(nil),             // 39: knowledge_api.GetAllKnowledgeEmbeddingJobRequest
+	(*GetAllKnowledgeEmbeddingJobResponse)(nil),            // 40: knowledge_api.GetAllKnowledgeEmbeddingJobResponse
+	(*KnowledgeDocumentSegmentRevision)(nil),               // 41: knowledge_api.KnowledgeDocumentSegmentRevision
+	(*GetAllKnowledgeDocumentSegmentRevisionRequest)(nil),  // 42: knowledge_api.GetAllKnowledgeDocumentSegmentRevisionRequest
+	(*GetAllKnowledgeDocumentSegmentRevisionResponse)(nil), // 43: knowledge_api.GetAllKnowledgeDocumentSegmentRevisionResponse
+	(*KnowledgeDocumentSegment_Metadata)(nil),              // 44: knowledge_api.KnowledgeDocumentSegment.Metadata
+	(*KnowledgeDocumentSegment_Entities)(nil),              // 45: knowledge_api.KnowledgeDocumentSegment.Entities
+	nil,                           // 46: knowledge_api.KnowledgeLog.AdditionalDataEntry
+	(*Metadata)(nil),              // 47: Metadata
+	(*Knowledge)(nil),             // 48: Knowledge
+	(*Error)(nil),                 // 49: Error
+	(*Paginate)(nil),              // 50: Paginate
+	(*Criteria)(nil),              // 51: Criteria
+	(*Paginated)(nil),             // 52: Paginated
+	(*structpb.Struct)(nil),       // 53: google.protobuf.Struct
+	(*User)(nil),                  // 54: User
+	(*timestamppb.Timestamp)(nil), // 55: google.protobuf.Timestamp
+	(*Content)(nil),               // 56: Content
+	(*Ordering)(nil),              // 57: Ordering
+	(*BaseResponse)(nil),          // 58: BaseResponse
 }
 var file_knowledge_api_proto_depIdxs = []int32{
-	28, // 0: knowledge_api.CreateKnowledgeRequest.knowledgeEmbeddingModelOptions:type_name -> Metadata
-	29, // 1: knowledge_api.CreateKnowledgeResponse.data:type_name -> Knowledge
-	30, // 2: knowledge_api.CreateKnowledgeResponse.error:type_name -> Error
-	31, // 3: knowledge_api.GetAllKnowledgeRequest.paginate:type_name -> Paginate
-	32, // 4: knowledge_api.GetAllKnowledgeRequest.criterias:type_name -> Criteria
-	29, // 5: knowledge_api.GetAllKnowledgeResponse.data:type_name -> Knowledge
-	30, // 6: knowledge_api.GetAllKnowledgeResponse.error:type_name -> Error
-	33, // 7: knowledge_api.GetAllKnowledgeResponse.paginated:type_name -> Paginated
-	29, // 8: knowledge_api.GetKnowledgeResponse.data:type_name -> Knowledge
-	30, // 9: knowledge_api.GetKnowledgeResponse.error:type_name -> Error
-	34, // 10: knowledge_api.KnowledgeDocument.documentSource:type_name -> google.protobuf.Struct
-	35, // 11: knowledge_api.KnowledgeDocument.createdUser:type_name -> User
-	35, // 12: knowledge_api.KnowledgeDocument.updatedUser:type_name -> User
-	36, // 13: knowledge_api.KnowledgeDocument.createdDate:type_name -> google.protobuf.Timestamp
-	36, // 14: knowledge_api.KnowledgeDocument.updatedDate:type_name -> google.protobuf.Timestamp
-	31, // 15: knowledge_api.GetAllKnowledgeDocumentRequest.paginate:type_name -> Paginate
-	32, // 16: knowledge_api.GetAllKnowledgeDocumentRequest.criterias:type_name -> Criteria
+	47, // 0: knowledge_api.CreateKnowledgeRequest.knowledgeEmbeddingModelOptions:type_name -> Metadata
+	48, // 1: knowledge_api.CreateKnowledgeResponse.data:type_name -> Knowledge
+	49, // 2: knowledge_api.CreateKnowledgeResponse.error:type_name -> Error
+	50, // 3: knowledge_api.GetAllKnowledgeRequest.paginate:type_name -> Paginate
+	51, // 4: knowledge_api.GetAllKnowledgeRequest.criterias:type_name -> Criteria
+	48, // 5: knowledge_api.GetAllKnowledgeResponse.data:type_name -> Knowledge
+	49, // 6: knowledge_api.GetAllKnowledgeResponse.error:type_name -> Error
+	52, // 7: knowledge_api.GetAllKnowledgeResponse.paginated:type_name -> Paginated
+	48, // 8: knowledge_api.GetKnowledgeResponse.data:type_name -> Knowledge
+	49, // 9: knowledge_api.GetKnowledgeResponse.error:type_name -> Error
+	53, // 10: knowledge_api.KnowledgeDocument.documentSource:type_name -> google.protobuf.Struct
+	54, // 11: knowledge_api.KnowledgeDocument.createdUser:type_name -> User
+	54, // 12: knowledge_api.KnowledgeDocument.updatedUser:type_name -> User
+	55, // 13: knowledge_api.KnowledgeDocument.createdDate:type_name -> google.protobuf.Timestamp
+	55, // 14: knowledge_api.KnowledgeDocument.updatedDate:type_name -> google.protobuf.Timestamp
+	50, // 15: knowledge_api.GetAllKnowledgeDocumentRequest.paginate:type_name -> Paginate
+	51, // 16: knowledge_api.GetAllKnowledgeDocumentRequest.criterias:type_name -> Criteria
 	9,  // 17: knowledge_api.GetAllKnowledgeDocumentResponse.data:type_name -> knowledge_api.KnowledgeDocument
-	30, // 18: knowledge_api.GetAllKnowledgeDocumentResponse.error:type_name -> Error
-	33, // 19: knowledge_api.GetAllKnowledgeDocumentResponse.paginated:type_name -> Paginated
+	49, // 18: knowledge_api.GetAllKnowledgeDocumentResponse.error:type_name -> Error
+	52, // 19: knowledge_api.GetAllKnowledgeDocumentResponse.paginated:type_name -> Paginated
 	1,  // 20: knowledge_api.CreateKnowledgeDocumentRequest.documentSource:type_name -> knowledge_api.CreateKnowledgeDocumentRequest.DOCUMENT_SOURCE
-	37, // 21: knowledge_api.CreateKnowledgeDocumentRequest.contents:type_name -> Content
+	56, // 21: knowledge_api.CreateKnowledgeDocumentRequest.contents:type_name -> Content
 	0,  // 22: knowledge_api.CreateKnowledgeDocumentRequest.preProcess:type_name -> knowledge_api.CreateKnowledgeDocumentRequest.PRE_PROCESS
 	9,  // 23: knowledge_api.CreateKnowledgeDocumentResponse.data:type_name -> knowledge_api.KnowledgeDocument
-	30, // 24: knowledge_api.CreateKnowledgeDocumentResponse.error:type_name -> Error
-	33, // 25: knowledge_api.CreateKnowledgeDocumentResponse.paginated:type_name -> Paginated
-	25, // 26: knowledge_api.KnowledgeDocumentSegment.metadata:type_name -> knowledge_api.KnowledgeDocumentSegment.Metadata
-	26, // 27: knowledge_api.KnowledgeDocumentSegment.entities:type_name -> knowledge_api.KnowledgeDocumentSegment.Entities
-	31, // 28: knowledge_api.GetAllKnowledgeDocumentSegmentRequest.paginate:type_name -> Paginate
-	32, // 29: knowledge_api.GetAllKnowledgeDocumentSegmentRequest.criterias:type_name -> Criteria
+	49, // 24: knowledge_api.CreateKnowledgeDocumentResponse.error:type_name -> Error
+	52, // 25: knowledge_api.CreateKnowledgeDocumentResponse.paginated:type_name -> Paginated
+	44, // 26: knowledge_api.KnowledgeDocumentSegment.metadata:type_name -> knowledge_api.KnowledgeDocumentSegment.Metadata
+	45, // 27: knowledge_api.KnowledgeDocumentSegment.entities:type_name -> knowledge_api.KnowledgeDocumentSegment.Entities
+	50, // 28: knowledge_api.GetAllKnowledgeDocumentSegmentRequest.paginate:type_name -> Paginate
+	51, // 29: knowledge_api.GetAllKnowledgeDocumentSegmentRequest.criterias:type_name -> Criteria
 	14, // 30: knowledge_api.GetAllKnowledgeDocumentSegmentResponse.data:type_name -> knowledge_api.KnowledgeDocumentSegment
-	30, // 31: knowledge_api.GetAllKnowledgeDocumentSegmentResponse.error:type_name -> Error
-	33, // 32: knowledge_api.GetAllKnowledgeDocumentSegmentResponse.paginated:type_name -> Paginated
-	31, // 33: knowledge_api.GetAllKnowledgeLogRequest.paginate:type_name -> Paginate
-	32, // 34: knowledge_api.GetAllKnowledgeLogRequest.criterias:type_name -> Criteria
-	38, // 35: knowledge_api.GetAllKnowledgeLogRequest.order:type_name -> Ordering
+	49, // 31: knowledge_api.GetAllKnowledgeDocumentSegmentResponse.error:type_name -> Error
+	52, // 32: knowledge_api.GetAllKnowledgeDocumentSegmentResponse.paginated:type_name -> Paginated
+	50, // 33: knowledge_api.GetAllKnowledgeLogRequest.paginate:type_name -> Paginate
+	51, // 34: knowledge_api.GetAllKnowledgeLogRequest.criterias:type_name -> Criteria
+	57, // 35: knowledge_api.GetAllKnowledgeLogRequest.order:type_name -> Ordering
 	24, // 36: knowledge_api.GetKnowledgeLogResponse.data:type_name -> knowledge_api.KnowledgeLog
-	30, // 37: knowledge_api.GetKnowledgeLogResponse.error:type_name -> Error
+	49, // 37: knowledge_api.GetKnowledgeLogResponse.error:type_name -> Error
 	24, // 38: knowledge_api.GetAllKnowledgeLogResponse.data:type_name -> knowledge_api.KnowledgeLog
-	30, // 39: knowledge_api.GetAllKnowledgeLogResponse.error:type_name -> Error
-	33, // 40: knowledge_api.GetAllKnowledgeLogResponse.paginated:type_name -> Paginated
-	34, // 41: knowledge_api.KnowledgeLog.action:type_name -> google.protobuf.Struct
-	34, // 42: knowledge_api.KnowledgeLog.request:type_name -> google.protobuf.Struct
-	34, // 43: knowledge_api.KnowledgeLog.response:type_name -> google.protobuf.Struct
-	36, // 44: knowledge_api.KnowledgeLog.createdDate:type_name -> google.protobuf.Timestamp
-	36, // 45: knowledge_api.KnowledgeLog.updatedDate:type_name -> google.protobuf.Timestamp
-	27, // 46: knowledge_api.KnowledgeLog.additionalData:type_name -> knowledge_api.KnowledgeLog.AdditionalDataEntry
-	6,  // 47: knowledge_api.KnowledgeService.GetKnowledge:input_type -> knowledge_api.GetKnowledgeRequest
-	4,  // 48: knowledge_api.KnowledgeService.GetAllKnowledge:input_type -> knowledge_api.GetAllKnowledgeRequest
-	2,  // 49: knowledge_api.KnowledgeService.CreateKnowledge:input_type -> knowledge_api.CreateKnowledgeRequest
-	8,  // 50: knowledge_api.KnowledgeService.CreateKnowledgeTag:input_type -> knowledge_api.CreateKnowledgeTagRequest
-	12, // 51: knowledge_api.KnowledgeService.CreateKnowledgeDocument:input_type -> knowledge_api.CreateKnowledgeDocumentRequest
-	10, // 52: knowledge_api.KnowledgeService.GetAllKnowledgeDocument:input_type -> knowledge_api.GetAllKnowledgeDocumentRequest
-	15, // 53: knowledge_api.KnowledgeService.GetAllKnowledgeDocumentSegment:input_type -> knowledge_api.GetAllKnowledgeDocumentSegmentRequest
-	17, // 54: knowledge_api.KnowledgeService.UpdateKnowledgeDetail:input_type -> knowledge_api.UpdateKnowledgeDetailRequest
-	18, // 55: knowledge_api.KnowledgeService.UpdateKnowledgeDocumentSegment:input_type -> knowledge_api.UpdateKnowledgeDocumentSegmentRequest
-	19, // 56: knowledge_api.KnowledgeService.DeleteKnowledgeDocumentSegment:input_type -> knowledge_api.DeleteKnowledgeDocumentSegmentRequest
-	20, // 57: knowledge_api.KnowledgeService.GetAllKnowledgeLog:input_type -> knowledge_api.GetAllKnowledgeLogRequest
-	21, // 58: knowledge_api.KnowledgeService.GetKnowledgeLog:input_type -> knowledge_api.GetKnowledgeLogRequest
-	7,  // 59: knowledge_api.KnowledgeService.GetKnowledge:output_type -> knowledge_api.GetKnowledgeResponse
-	5,  // 60: knowledge_api.KnowledgeService.GetAllKnowledge:output_type -> knowledge_api.GetAllKnowledgeResponse
-	3,  // 61: knowledge_api.KnowledgeService.CreateKnowledge:output_type -> knowledge_api.CreateKnowledgeResponse
-	7,  // 62: knowledge_api.KnowledgeService.CreateKnowledgeTag:output_type -> knowledge_api.GetKnowledgeResponse
-	13, // 63: knowledge_api.KnowledgeService.CreateKnowledgeDocument:output_type -> knowledge_api.CreateKnowledgeDocumentResponse
-	11, // 64: knowledge_api.KnowledgeService.GetAllKnowledgeDocument:output_type -> knowledge_api.GetAllKnowledgeDocumentResponse
-	16, // 65: knowledge_api.KnowledgeService.GetAllKnowledgeDocumentSegment:output_type -> knowledge_api.GetAllKnowledgeDocumentSegmentResponse
-	7,  // 66: knowledge_api.KnowledgeService.UpdateKnowledgeDetail:output_type -> knowledge_api.GetKnowledgeResponse
-	39, // 67: knowledge_api.KnowledgeService.UpdateKnowledgeDocumentSegment:output_type -> BaseResponse
-	39, // 68: knowledge_api.KnowledgeService.DeleteKnowledgeDocumentSegment:output_type -> BaseResponse
-	23, // 69: knowledge_api.KnowledgeService.GetAllKnowledgeLog:output_type -> knowledge_api.GetAllKnowledgeLogResponse
-	22, // 70: knowledge_api.KnowledgeService.GetKnowledgeLog:output_type -> knowledge_api.GetKnowledgeLogResponse
-	59, // [59:71] is the sub-list for method output_type
-	47, // [47:59] is the sub-list for method input_type
-	47, // [47:47] is the sub-list for extension type_name
-	47, // [47:47] is the sub-list for extension extendee
-	0,  // [0:47] is the sub-list for field type_name
+	49, // 39: knowledge_api.GetAllKnowledgeLogResponse.error:type_name -> Error
+	52, // 40: knowledge_api.GetAllKnowledgeLogResponse.paginated:type_name -> Paginated
+	53, // 41: knowledge_api.KnowledgeLog.action:type_name -> google.protobuf.Struct
+	53, // 42: knowledge_api.KnowledgeLog.request:type_name -> google.protobuf.Struct
+	53, // 43: knowledge_api.KnowledgeLog.response:type_name -> google.protobuf.Struct
+	55, // 44: knowledge_api.KnowledgeLog.createdDate:type_name -> google.protobuf.Timestamp
+	55, // 45: knowledge_api.KnowledgeLog.updatedDate:type_name -> google.protobuf.Timestamp
+	46, // 46: knowledge_api.KnowledgeLog.additionalData:type_name -> knowledge_api.KnowledgeLog.AdditionalDataEntry
+	55, // 47: knowledge_api.KnowledgeEvaluationReport.createdDate:type_name -> google.protobuf.Timestamp
+	55, // 48: knowledge_api.KnowledgeEvaluationReport.updatedDate:type_name -> google.protobuf.Timestamp
+	25, // 49: knowledge_api.KnowledgeEvaluation.questions:type_name -> knowledge_api.KnowledgeEvaluationQuestion
+	26, // 50: knowledge_api.KnowledgeEvaluation.reports:type_name -> knowledge_api.KnowledgeEvaluationReport
+	55, // 51: knowledge_api.KnowledgeEvaluation.createdDate:type_name -> google.protobuf.Timestamp
+	55, // 52: knowledge_api.KnowledgeEvaluation.updatedDate:type_name -> google.protobuf.Timestamp
+	25, // 53: knowledge_api.CreateKnowledgeEvaluationRequest.questions:type_name -> knowledge_api.KnowledgeEvaluationQuestion
+	47, // 54: knowledge_api.RunKnowledgeEvaluationRequest.rerankerModelOptions:type_name -> Metadata
+	27, // 55: knowledge_api.GetKnowledgeEvaluationResponse.data:type_name -> knowledge_api.KnowledgeEvaluation
+	49, // 56: knowledge_api.GetKnowledgeEvaluationResponse.error:type_name -> Error
+	50, // 57: knowledge_api.GetAllKnowledgeEvaluationRequest.paginate:type_name -> Paginate
+	51, // 58: knowledge_api.GetAllKnowledgeEvaluationRequest.criterias:type_name -> Criteria
+	27, // 59: knowledge_api.GetAllKnowledgeEvaluationResponse.data:type_name -> knowledge_api.KnowledgeEvaluation
+	49, // 60: knowledge_api.GetAllKnowledgeEvaluationResponse.error:type_name -> Error
+	52, // 61: knowledge_api.GetAllKnowledgeEvaluationResponse.paginated:type_name -> Paginated
+	55, // 62: knowledge_api.KnowledgeEmbeddingJob.createdDate:type_name -> google.protobuf.Timestamp
+	55, // 63: knowledge_api.KnowledgeEmbeddingJob.updatedDate:type_name -> google.protobuf.Timestamp
+	47, // 64: knowledge_api.ReindexKnowledgeRequest.knowledgeEmbeddingModelOptions:type_name -> Metadata
+	34, // 65: knowledge_api.GetKnowledgeEmbeddingJobResponse.data:type_name -> knowledge_api.KnowledgeEmbeddingJob
+	49, // 66: knowledge_api.GetKnowledgeEmbeddingJobResponse.error:type_name -> Error
+	50, // 67: knowledge_api.GetAllKnowledgeEmbeddingJobRequest.paginate:type_name -> Paginate
+	51, // 68: knowledge_api.GetAllKnowledgeEmbeddingJobRequest.criterias:type_name -> Criteria
+	34, // 69: knowledge_api.GetAllKnowledgeEmbeddingJobResponse.data:type_name -> knowledge_api.KnowledgeEmbeddingJob
+	49, // 70: knowledge_api.GetAllKnowledgeEmbeddingJobResponse.error:type_name -> Error
+	52, // 71: knowledge_api.GetAllKnowledgeEmbeddingJobResponse.paginated:type_name -> Paginated
+	53, // 72: knowledge_api.KnowledgeDocumentSegmentRevision.entities:type_name -> google.protobuf.Struct
+	55, // 73: knowledge_api.KnowledgeDocumentSegmentRevision.createdDate:type_name -> google.protobuf.Timestamp
+	50, // 74: knowledge_api.GetAllKnowledgeDocumentSegmentRevisionRequest.paginate:type_name -> Paginate
+	41, // 75: knowledge_api.GetAllKnowledgeDocumentSegmentRevisionResponse.data:type_name -> knowledge_api.KnowledgeDocumentSegmentRevision
+	49, // 76: knowledge_api.GetAllKnowledgeDocumentSegmentRevisionResponse.error:type_name -> Error
+	52, // 77: knowledge_api.GetAllKnowledgeDocumentSegmentRevisionResponse.paginated:type_name -> Paginated
+	6,  // 78: knowledge_api.KnowledgeService.GetKnowledge:input_type -> knowledge_api.GetKnowledgeRequest
+	4,  // 79: knowledge_api.KnowledgeService.GetAllKnowledge:input_type -> knowledge_api.GetAllKnowledgeRequest
+	2,  // 80: knowledge_api.KnowledgeService.CreateKnowledge:input_type -> knowledge_api.CreateKnowledgeRequest
+	8,  // 81: knowledge_api.KnowledgeService.CreateKnowledgeTag:input_type -> knowledge_api.CreateKnowledgeTagRequest
+	12, // 82: knowledge_api.KnowledgeService.CreateKnowledgeDocument:input_type -> knowledge_api.CreateKnowledgeDocumentRequest
+	10, // 83: knowledge_api.KnowledgeService.GetAllKnowledgeDocument:input_type -> knowledge_api.GetAllKnowledgeDocumentRequest
+	15, // 84: knowledge_api.KnowledgeService.GetAllKnowledgeDocumentSegment:input_type -> knowledge_api.GetAllKnowledgeDocumentSegmentRequest
+	17, // 85: knowledge_api.KnowledgeService.UpdateKnowledgeDetail:input_type -> knowledge_api.UpdateKnowledgeDetailRequest
+	18, // 86: knowledge_api.KnowledgeService.UpdateKnowledgeDocumentSegment:input_type -> knowledge_api.UpdateKnowledgeDocumentSegmentRequest
+	19, // 87: knowledge_api.KnowledgeService.DeleteKnowledgeDocumentSegment:input_type -> knowledge_api.DeleteKnowledgeDocumentSegmentRequest
+	20, // 88: knowledge_api.KnowledgeService.GetAllKnowledgeLog:input_type -> knowledge_api.GetAllKnowledgeLogRequest
+	21, // 89: knowledge_api.KnowledgeService.GetKnowledgeLog:input_type -> knowledge_api.GetKnowledgeLogRequest
+	28, // 90: knowledge_api.KnowledgeService.CreateKnowledgeEvaluation:input_type -> knowledge_api.CreateKnowledgeEvaluationRequest
+	29, // 91: knowledge_api.KnowledgeService.RunKnowledgeEvaluation:input_type -> knowledge_api.RunKnowledgeEvaluationRequest
+	30, // 92: knowledge_api.KnowledgeService.GetKnowledgeEvaluation:input_type -> knowledge_api.GetKnowledgeEvaluationRequest
+	32, // 93: knowledge_api.KnowledgeService.GetAllKnowledgeEvaluation:input_type -> knowledge_api.GetAllKnowledgeEvaluationRequest
+	35, // 94: knowledge_api.KnowledgeService.ReindexKnowledge:input_type -> knowledge_api.ReindexKnowledgeRequest
+	36, // 95: knowledge_api.KnowledgeService.GetKnowledgeEmbeddingJob:input_type -> knowledge_api.GetKnowledgeEmbeddingJobRequest
+	39, // 96: knowledge_api.KnowledgeService.GetAllKnowledgeEmbeddingJob:input_type -> knowledge_api.GetAllKnowledgeEmbeddingJobRequest
+	37, // 97: knowledge_api.KnowledgeService.RollbackKnowledgeEmbeddingJob:input_type -> knowledge_api.RollbackKnowledgeEmbeddingJobRequest
+	42, // 98: knowledge_api.KnowledgeService.GetAllKnowledgeDocumentSegmentRevision:input_type -> knowledge_api.GetAllKnowledgeDocumentSegmentRevisionRequest
+	7,  // 99: knowledge_api.KnowledgeService.GetKnowledge:output_type -> knowledge_api.GetKnowledgeResponse
+	5,  // 100: knowledge_api.KnowledgeService.GetAllKnowledge:output_type -> knowledge_api.GetAllKnowledgeResponse
+	3,  // 101: knowledge_api.KnowledgeService.CreateKnowledge:output_type -> knowledge_api.CreateKnowledgeResponse
+	7,  // 102: knowledge_api.KnowledgeService.CreateKnowledgeTag:output_type -> knowledge_api.GetKnowledgeResponse
+	13, // 103: knowledge_api.KnowledgeService.CreateKnowledgeDocument:output_type -> knowledge_api.CreateKnowledgeDocumentResponse
+	11, // 104: knowledge_api.KnowledgeService.GetAllKnowledgeDocument:output_type -> knowledge_api.GetAllKnowledgeDocumentResponse
+	16, // 105: knowledge_api.KnowledgeService.GetAllKnowledgeDocumentSegment:output_type -> knowledge_api.GetAllKnowledgeDocumentSegmentResponse
+	7,  // 106: knowledge_api.KnowledgeService.UpdateKnowledgeDetail:output_type -> knowledge_api.GetKnowledgeResponse
+	58, // 107: knowledge_api.KnowledgeService.UpdateKnowledgeDocumentSegment:output_type -> BaseResponse
+	58, // 108: knowledge_api.KnowledgeService.DeleteKnowledgeDocumentSegment:output_type -> BaseResponse
+	23, // 109: knowledge_api.KnowledgeService.GetAllKnowledgeLog:output_type -> knowledge_api.GetAllKnowledgeLogResponse
+	22, // 110: knowledge_api.KnowledgeService.GetKnowledgeLog:output_type -> knowledge_api.GetKnowledgeLogResponse
+	31, // 111: knowledge_api.KnowledgeService.CreateKnowledgeEvaluation:output_type -> knowledge_api.GetKnowledgeEvaluationResponse
+	31, // 112: knowledge_api.KnowledgeService.RunKnowledgeEvaluation:output_type -> knowledge_api.GetKnowledgeEvaluationResponse
+	31, // 113: knowledge_api.KnowledgeService.GetKnowledgeEvaluation:output_type -> knowledge_api.GetKnowledgeEvaluationResponse
+	33, // 114: knowledge_api.KnowledgeService.GetAllKnowledgeEvaluation:output_type -> knowledge_api.GetAllKnowledgeEvaluationResponse
+	38, // 115: knowledge_api.KnowledgeService.ReindexKnowledge:output_type -> knowledge_api.GetKnowledgeEmbeddingJobResponse
+	38, // 116: knowledge_api.KnowledgeService.GetKnowledgeEmbeddingJob:output_type -> knowledge_api.GetKnowledgeEmbeddingJobResponse
+	40, // 117: knowledge_api.KnowledgeService.GetAllKnowledgeEmbeddingJob:output_type -> knowledge_api.GetAllKnowledgeEmbeddingJobResponse
+	38, // 118: knowledge_api.KnowledgeService.RollbackKnowledgeEmbeddingJob:output_type -> knowledge_api.GetKnowledgeEmbeddingJobResponse
+	43, // 119: knowledge_api.KnowledgeService.GetAllKnowledgeDocumentSegmentRevision:output_type -> knowledge_api.GetAllKnowledgeDocumentSegmentRevisionResponse
+	99, // [99:120] is the sub-list for method output_type
+	78, // [78:99] is the sub-list for method input_type
+	78, // [78:78] is the sub-list for extension type_name
+	78, // [78:78] is the sub-list for extension extendee
+	0,  // [0:78] is the sub-list for field type_name
 }
 
 func init() { file_knowledge_api_proto_init() }
@@ -3190,7 +5209,7 @@ func file_knowledge_api_proto_init() {
 			}
 		}
 		file_knowledge_api_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*KnowledgeDocumentSegment_Metadata); i {
+			switch v := v.(*KnowledgeEvaluationQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3202,6 +5221,234 @@ func file_knowledge_api_proto_init() {
 			}
 		}
 		file_knowledge_api_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*KnowledgeEvaluationReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*KnowledgeEvaluation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateKnowledgeEvaluationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*RunKnowledgeEvaluationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*GetKnowledgeEvaluationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*GetKnowledgeEvaluationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllKnowledgeEvaluationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllKnowledgeEvaluationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*KnowledgeEmbeddingJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*ReindexKnowledgeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*GetKnowledgeEmbeddingJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*RollbackKnowledgeEmbeddingJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*GetKnowledgeEmbeddingJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllKnowledgeEmbeddingJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllKnowledgeEmbeddingJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*KnowledgeDocumentSegmentRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllKnowledgeDocumentSegmentRevisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllKnowledgeDocumentSegmentRevisionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*KnowledgeDocumentSegment_Metadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_knowledge_api_proto_msgTypes[43].Exporter = func(v any, i int) any {
 			switch v := v.(*KnowledgeDocumentSegment_Entities); i {
 			case 0:
 				return &v.state
@@ -3220,7 +5467,7 @@ func file_knowledge_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_knowledge_api_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   26,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
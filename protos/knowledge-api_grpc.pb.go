@@ -19,18 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	KnowledgeService_GetKnowledge_FullMethodName                   = "/knowledge_api.KnowledgeService/GetKnowledge"
-	KnowledgeService_GetAllKnowledge_FullMethodName                = "/knowledge_api.KnowledgeService/GetAllKnowledge"
-	KnowledgeService_CreateKnowledge_FullMethodName                = "/knowledge_api.KnowledgeService/CreateKnowledge"
-	KnowledgeService_CreateKnowledgeTag_FullMethodName             = "/knowledge_api.KnowledgeService/CreateKnowledgeTag"
-	KnowledgeService_CreateKnowledgeDocument_FullMethodName        = "/knowledge_api.KnowledgeService/CreateKnowledgeDocument"
-	KnowledgeService_GetAllKnowledgeDocument_FullMethodName        = "/knowledge_api.KnowledgeService/GetAllKnowledgeDocument"
-	KnowledgeService_GetAllKnowledgeDocumentSegment_FullMethodName = "/knowledge_api.KnowledgeService/GetAllKnowledgeDocumentSegment"
-	KnowledgeService_UpdateKnowledgeDetail_FullMethodName          = "/knowledge_api.KnowledgeService/UpdateKnowledgeDetail"
-	KnowledgeService_UpdateKnowledgeDocumentSegment_FullMethodName = "/knowledge_api.KnowledgeService/UpdateKnowledgeDocumentSegment"
-	KnowledgeService_DeleteKnowledgeDocumentSegment_FullMethodName = "/knowledge_api.KnowledgeService/DeleteKnowledgeDocumentSegment"
-	KnowledgeService_GetAllKnowledgeLog_FullMethodName             = "/knowledge_api.KnowledgeService/GetAllKnowledgeLog"
-	KnowledgeService_GetKnowledgeLog_FullMethodName                = "/knowledge_api.KnowledgeService/GetKnowledgeLog"
+	KnowledgeService_GetKnowledge_FullMethodName                           = "/knowledge_api.KnowledgeService/GetKnowledge"
+	KnowledgeService_GetAllKnowledge_FullMethodName                        = "/knowledge_api.KnowledgeService/GetAllKnowledge"
+	KnowledgeService_CreateKnowledge_FullMethodName                        = "/knowledge_api.KnowledgeService/CreateKnowledge"
+	KnowledgeService_CreateKnowledgeTag_FullMethodName                     = "/knowledge_api.KnowledgeService/CreateKnowledgeTag"
+	KnowledgeService_CreateKnowledgeDocument_FullMethodName                = "/knowledge_api.KnowledgeService/CreateKnowledgeDocument"
+	KnowledgeService_GetAllKnowledgeDocument_FullMethodName                = "/knowledge_api.KnowledgeService/GetAllKnowledgeDocument"
+	KnowledgeService_GetAllKnowledgeDocumentSegment_FullMethodName         = "/knowledge_api.KnowledgeService/GetAllKnowledgeDocumentSegment"
+	KnowledgeService_UpdateKnowledgeDetail_FullMethodName                  = "/knowledge_api.KnowledgeService/UpdateKnowledgeDetail"
+	KnowledgeService_UpdateKnowledgeDocumentSegment_FullMethodName         = "/knowledge_api.KnowledgeService/UpdateKnowledgeDocumentSegment"
+	KnowledgeService_DeleteKnowledgeDocumentSegment_FullMethodName         = "/knowledge_api.KnowledgeService/DeleteKnowledgeDocumentSegment"
+	KnowledgeService_GetAllKnowledgeLog_FullMethodName                     = "/knowledge_api.KnowledgeService/GetAllKnowledgeLog"
+	KnowledgeService_GetKnowledgeLog_FullMethodName                        = "/knowledge_api.KnowledgeService/GetKnowledgeLog"
+	KnowledgeService_CreateKnowledgeEvaluation_FullMethodName              = "/knowledge_api.KnowledgeService/CreateKnowledgeEvaluation"
+	KnowledgeService_RunKnowledgeEvaluation_FullMethodName                 = "/knowledge_api.KnowledgeService/RunKnowledgeEvaluation"
+	KnowledgeService_GetKnowledgeEvaluation_FullMethodName                 = "/knowledge_api.KnowledgeService/GetKnowledgeEvaluation"
+	KnowledgeService_GetAllKnowledgeEvaluation_FullMethodName              = "/knowledge_api.KnowledgeService/GetAllKnowledgeEvaluation"
+	KnowledgeService_ReindexKnowledge_FullMethodName                       = "/knowledge_api.KnowledgeService/ReindexKnowledge"
+	KnowledgeService_GetKnowledgeEmbeddingJob_FullMethodName               = "/knowledge_api.KnowledgeService/GetKnowledgeEmbeddingJob"
+	KnowledgeService_GetAllKnowledgeEmbeddingJob_FullMethodName            = "/knowledge_api.KnowledgeService/GetAllKnowledgeEmbeddingJob"
+	KnowledgeService_RollbackKnowledgeEmbeddingJob_FullMethodName          = "/knowledge_api.KnowledgeService/RollbackKnowledgeEmbeddingJob"
+	KnowledgeService_GetAllKnowledgeDocumentSegmentRevision_FullMethodName = "/knowledge_api.KnowledgeService/GetAllKnowledgeDocumentSegmentRevision"
 )
 
 // KnowledgeServiceClient is the client API for KnowledgeService service.
@@ -50,6 +59,15 @@ type KnowledgeServiceClient interface {
 	// knowledge log retrieval log
 	GetAllKnowledgeLog(ctx context.Context, in *GetAllKnowledgeLogRequest, opts ...grpc.CallOption) (*GetAllKnowledgeLogResponse, error)
 	GetKnowledgeLog(ctx context.Context, in *GetKnowledgeLogRequest, opts ...grpc.CallOption) (*GetKnowledgeLogResponse, error)
+	CreateKnowledgeEvaluation(ctx context.Context, in *CreateKnowledgeEvaluationRequest, opts ...grpc.CallOption) (*GetKnowledgeEvaluationResponse, error)
+	RunKnowledgeEvaluation(ctx context.Context, in *RunKnowledgeEvaluationRequest, opts ...grpc.CallOption) (*GetKnowledgeEvaluationResponse, error)
+	GetKnowledgeEvaluation(ctx context.Context, in *GetKnowledgeEvaluationRequest, opts ...grpc.CallOption) (*GetKnowledgeEvaluationResponse, error)
+	GetAllKnowledgeEvaluation(ctx context.Context, in *GetAllKnowledgeEvaluationRequest, opts ...grpc.CallOption) (*GetAllKnowledgeEvaluationResponse, error)
+	ReindexKnowledge(ctx context.Context, in *ReindexKnowledgeRequest, opts ...grpc.CallOption) (*GetKnowledgeEmbeddingJobResponse, error)
+	GetKnowledgeEmbeddingJob(ctx context.Context, in *GetKnowledgeEmbeddingJobRequest, opts ...grpc.CallOption) (*GetKnowledgeEmbeddingJobResponse, error)
+	GetAllKnowledgeEmbeddingJob(ctx context.Context, in *GetAllKnowledgeEmbeddingJobRequest, opts ...grpc.CallOption) (*GetAllKnowledgeEmbeddingJobResponse, error)
+	RollbackKnowledgeEmbeddingJob(ctx context.Context, in *RollbackKnowledgeEmbeddingJobRequest, opts ...grpc.CallOption) (*GetKnowledgeEmbeddingJobResponse, error)
+	GetAllKnowledgeDocumentSegmentRevision(ctx context.Context, in *GetAllKnowledgeDocumentSegmentRevisionRequest, opts ...grpc.CallOption) (*GetAllKnowledgeDocumentSegmentRevisionResponse, error)
 }
 
 type knowledgeServiceClient struct {
@@ -180,6 +198,96 @@ func (c *knowledgeServiceClient) GetKnowledgeLog(ctx context.Context, in *GetKno
 	return out, nil
 }
 
+func (c *knowledgeServiceClient) CreateKnowledgeEvaluation(ctx context.Context, in *CreateKnowledgeEvaluationRequest, opts ...grpc.CallOption) (*GetKnowledgeEvaluationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKnowledgeEvaluationResponse)
+	err := c.cc.Invoke(ctx, KnowledgeService_CreateKnowledgeEvaluation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeServiceClient) RunKnowledgeEvaluation(ctx context.Context, in *RunKnowledgeEvaluationRequest, opts ...grpc.CallOption) (*GetKnowledgeEvaluationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKnowledgeEvaluationResponse)
+	err := c.cc.Invoke(ctx, KnowledgeService_RunKnowledgeEvaluation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeServiceClient) GetKnowledgeEvaluation(ctx context.Context, in *GetKnowledgeEvaluationRequest, opts ...grpc.CallOption) (*GetKnowledgeEvaluationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKnowledgeEvaluationResponse)
+	err := c.cc.Invoke(ctx, KnowledgeService_GetKnowledgeEvaluation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeServiceClient) GetAllKnowledgeEvaluation(ctx context.Context, in *GetAllKnowledgeEvaluationRequest, opts ...grpc.CallOption) (*GetAllKnowledgeEvaluationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllKnowledgeEvaluationResponse)
+	err := c.cc.Invoke(ctx, KnowledgeService_GetAllKnowledgeEvaluation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeServiceClient) ReindexKnowledge(ctx context.Context, in *ReindexKnowledgeRequest, opts ...grpc.CallOption) (*GetKnowledgeEmbeddingJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKnowledgeEmbeddingJobResponse)
+	err := c.cc.Invoke(ctx, KnowledgeService_ReindexKnowledge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeServiceClient) GetKnowledgeEmbeddingJob(ctx context.Context, in *GetKnowledgeEmbeddingJobRequest, opts ...grpc.CallOption) (*GetKnowledgeEmbeddingJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKnowledgeEmbeddingJobResponse)
+	err := c.cc.Invoke(ctx, KnowledgeService_GetKnowledgeEmbeddingJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeServiceClient) GetAllKnowledgeEmbeddingJob(ctx context.Context, in *GetAllKnowledgeEmbeddingJobRequest, opts ...grpc.CallOption) (*GetAllKnowledgeEmbeddingJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllKnowledgeEmbeddingJobResponse)
+	err := c.cc.Invoke(ctx, KnowledgeService_GetAllKnowledgeEmbeddingJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeServiceClient) RollbackKnowledgeEmbeddingJob(ctx context.Context, in *RollbackKnowledgeEmbeddingJobRequest, opts ...grpc.CallOption) (*GetKnowledgeEmbeddingJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKnowledgeEmbeddingJobResponse)
+	err := c.cc.Invoke(ctx, KnowledgeService_RollbackKnowledgeEmbeddingJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeServiceClient) GetAllKnowledgeDocumentSegmentRevision(ctx context.Context, in *GetAllKnowledgeDocumentSegmentRevisionRequest, opts ...grpc.CallOption) (*GetAllKnowledgeDocumentSegmentRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllKnowledgeDocumentSegmentRevisionResponse)
+	err := c.cc.Invoke(ctx, KnowledgeService_GetAllKnowledgeDocumentSegmentRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KnowledgeServiceServer is the server API for KnowledgeService service.
 // All implementations should embed UnimplementedKnowledgeServiceServer
 // for forward compatibility.
@@ -197,6 +305,15 @@ type KnowledgeServiceServer interface {
 	// knowledge log retrieval log
 	GetAllKnowledgeLog(context.Context, *GetAllKnowledgeLogRequest) (*GetAllKnowledgeLogResponse, error)
 	GetKnowledgeLog(context.Context, *GetKnowledgeLogRequest) (*GetKnowledgeLogResponse, error)
+	CreateKnowledgeEvaluation(context.Context, *CreateKnowledgeEvaluationRequest) (*GetKnowledgeEvaluationResponse, error)
+	RunKnowledgeEvaluation(context.Context, *RunKnowledgeEvaluationRequest) (*GetKnowledgeEvaluationResponse, error)
+	GetKnowledgeEvaluation(context.Context, *GetKnowledgeEvaluationRequest) (*GetKnowledgeEvaluationResponse, error)
+	GetAllKnowledgeEvaluation(context.Context, *GetAllKnowledgeEvaluationRequest) (*GetAllKnowledgeEvaluationResponse, error)
+	ReindexKnowledge(context.Context, *ReindexKnowledgeRequest) (*GetKnowledgeEmbeddingJobResponse, error)
+	GetKnowledgeEmbeddingJob(context.Context, *GetKnowledgeEmbeddingJobRequest) (*GetKnowledgeEmbeddingJobResponse, error)
+	GetAllKnowledgeEmbeddingJob(context.Context, *GetAllKnowledgeEmbeddingJobRequest) (*GetAllKnowledgeEmbeddingJobResponse, error)
+	RollbackKnowledgeEmbeddingJob(context.Context, *RollbackKnowledgeEmbeddingJobRequest) (*GetKnowledgeEmbeddingJobResponse, error)
+	GetAllKnowledgeDocumentSegmentRevision(context.Context, *GetAllKnowledgeDocumentSegmentRevisionRequest) (*GetAllKnowledgeDocumentSegmentRevisionResponse, error)
 }
 
 // UnimplementedKnowledgeServiceServer should be embedded to have
@@ -242,6 +359,33 @@ func (UnimplementedKnowledgeServiceServer) GetAllKnowledgeLog(context.Context, *
 func (UnimplementedKnowledgeServiceServer) GetKnowledgeLog(context.Context, *GetKnowledgeLogRequest) (*GetKnowledgeLogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKnowledgeLog not implemented")
 }
+func (UnimplementedKnowledgeServiceServer) CreateKnowledgeEvaluation(context.Context, *CreateKnowledgeEvaluationRequest) (*GetKnowledgeEvaluationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKnowledgeEvaluation not implemented")
+}
+func (UnimplementedKnowledgeServiceServer) RunKnowledgeEvaluation(context.Context, *RunKnowledgeEvaluationRequest) (*GetKnowledgeEvaluationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunKnowledgeEvaluation not implemented")
+}
+func (UnimplementedKnowledgeServiceServer) GetKnowledgeEvaluation(context.Context, *GetKnowledgeEvaluationRequest) (*GetKnowledgeEvaluationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKnowledgeEvaluation not implemented")
+}
+func (UnimplementedKnowledgeServiceServer) GetAllKnowledgeEvaluation(context.Context, *GetAllKnowledgeEvaluationRequest) (*GetAllKnowledgeEvaluationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllKnowledgeEvaluation not implemented")
+}
+func (UnimplementedKnowledgeServiceServer) ReindexKnowledge(context.Context, *ReindexKnowledgeRequest) (*GetKnowledgeEmbeddingJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReindexKnowledge not implemented")
+}
+func (UnimplementedKnowledgeServiceServer) GetKnowledgeEmbeddingJob(context.Context, *GetKnowledgeEmbeddingJobRequest) (*GetKnowledgeEmbeddingJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKnowledgeEmbeddingJob not implemented")
+}
+func (UnimplementedKnowledgeServiceServer) GetAllKnowledgeEmbeddingJob(context.Context, *GetAllKnowledgeEmbeddingJobRequest) (*GetAllKnowledgeEmbeddingJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllKnowledgeEmbeddingJob not implemented")
+}
+func (UnimplementedKnowledgeServiceServer) RollbackKnowledgeEmbeddingJob(context.Context, *RollbackKnowledgeEmbeddingJobRequest) (*GetKnowledgeEmbeddingJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackKnowledgeEmbeddingJob not implemented")
+}
+func (UnimplementedKnowledgeServiceServer) GetAllKnowledgeDocumentSegmentRevision(context.Context, *GetAllKnowledgeDocumentSegmentRevisionRequest) (*GetAllKnowledgeDocumentSegmentRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllKnowledgeDocumentSegmentRevision not implemented")
+}
 func (UnimplementedKnowledgeServiceServer) testEmbeddedByValue() {}
 
 // UnsafeKnowledgeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -478,6 +622,168 @@ func _KnowledgeService_GetKnowledgeLog_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KnowledgeService_CreateKnowledgeEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKnowledgeEvaluationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeServiceServer).CreateKnowledgeEvaluation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeService_CreateKnowledgeEvaluation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeServiceServer).CreateKnowledgeEvaluation(ctx, req.(*CreateKnowledgeEvaluationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeService_RunKnowledgeEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunKnowledgeEvaluationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeServiceServer).RunKnowledgeEvaluation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeService_RunKnowledgeEvaluation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeServiceServer).RunKnowledgeEvaluation(ctx, req.(*RunKnowledgeEvaluationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeService_GetKnowledgeEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKnowledgeEvaluationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeServiceServer).GetKnowledgeEvaluation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeService_GetKnowledgeEvaluation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeServiceServer).GetKnowledgeEvaluation(ctx, req.(*GetKnowledgeEvaluationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeService_GetAllKnowledgeEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllKnowledgeEvaluationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeServiceServer).GetAllKnowledgeEvaluation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeService_GetAllKnowledgeEvaluation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeServiceServer).GetAllKnowledgeEvaluation(ctx, req.(*GetAllKnowledgeEvaluationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeService_ReindexKnowledge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReindexKnowledgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeServiceServer).ReindexKnowledge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeService_ReindexKnowledge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeServiceServer).ReindexKnowledge(ctx, req.(*ReindexKnowledgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeService_GetKnowledgeEmbeddingJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKnowledgeEmbeddingJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeServiceServer).GetKnowledgeEmbeddingJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeService_GetKnowledgeEmbeddingJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeServiceServer).GetKnowledgeEmbeddingJob(ctx, req.(*GetKnowledgeEmbeddingJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeService_GetAllKnowledgeEmbeddingJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllKnowledgeEmbeddingJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeServiceServer).GetAllKnowledgeEmbeddingJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeService_GetAllKnowledgeEmbeddingJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeServiceServer).GetAllKnowledgeEmbeddingJob(ctx, req.(*GetAllKnowledgeEmbeddingJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeService_RollbackKnowledgeEmbeddingJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackKnowledgeEmbeddingJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeServiceServer).RollbackKnowledgeEmbeddingJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeService_RollbackKnowledgeEmbeddingJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeServiceServer).RollbackKnowledgeEmbeddingJob(ctx, req.(*RollbackKnowledgeEmbeddingJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeService_GetAllKnowledgeDocumentSegmentRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllKnowledgeDocumentSegmentRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeServiceServer).GetAllKnowledgeDocumentSegmentRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeService_GetAllKnowledgeDocumentSegmentRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeServiceServer).GetAllKnowledgeDocumentSegmentRevision(ctx, req.(*GetAllKnowledgeDocumentSegmentRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KnowledgeService_ServiceDesc is the grpc.ServiceDesc for KnowledgeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -533,6 +839,42 @@ var KnowledgeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetKnowledgeLog",
 			Handler:    _KnowledgeService_GetKnowledgeLog_Handler,
 		},
+		{
+			MethodName: "CreateKnowledgeEvaluation",
+			Handler:    _KnowledgeService_CreateKnowledgeEvaluation_Handler,
+		},
+		{
+			MethodName: "RunKnowledgeEvaluation",
+			Handler:    _KnowledgeService_RunKnowledgeEvaluation_Handler,
+		},
+		{
+			MethodName: "GetKnowledgeEvaluation",
+			Handler:    _KnowledgeService_GetKnowledgeEvaluation_Handler,
+		},
+		{
+			MethodName: "GetAllKnowledgeEvaluation",
+			Handler:    _KnowledgeService_GetAllKnowledgeEvaluation_Handler,
+		},
+		{
+			MethodName: "ReindexKnowledge",
+			Handler:    _KnowledgeService_ReindexKnowledge_Handler,
+		},
+		{
+			MethodName: "GetKnowledgeEmbeddingJob",
+			Handler:    _KnowledgeService_GetKnowledgeEmbeddingJob_Handler,
+		},
+		{
+			MethodName: "GetAllKnowledgeEmbeddingJob",
+			Handler:    _KnowledgeService_GetAllKnowledgeEmbeddingJob_Handler,
+		},
+		{
+			MethodName: "RollbackKnowledgeEmbeddingJob",
+			Handler:    _KnowledgeService_RollbackKnowledgeEmbeddingJob_Handler,
+		},
+		{
+			MethodName: "GetAllKnowledgeDocumentSegmentRevision",
+			Handler:    _KnowledgeService_GetAllKnowledgeDocumentSegmentRevision_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "knowledge-api.proto",
@@ -26,6 +26,12 @@ const (
 	TalkService_CreateConversationMetric_FullMethodName    = "/talk_api.TalkService/CreateConversationMetric"
 	TalkService_CreatePhoneCall_FullMethodName             = "/talk_api.TalkService/CreatePhoneCall"
 	TalkService_CreateBulkPhoneCall_FullMethodName         = "/talk_api.TalkService/CreateBulkPhoneCall"
+	TalkService_CreateSms_FullMethodName                   = "/talk_api.TalkService/CreateSms"
+	TalkService_CreateCampaign_FullMethodName              = "/talk_api.TalkService/CreateCampaign"
+	TalkService_GetCampaign_FullMethodName                 = "/talk_api.TalkService/GetCampaign"
+	TalkService_GetAllCampaign_FullMethodName              = "/talk_api.TalkService/GetAllCampaign"
+	TalkService_UpdateCampaignStatus_FullMethodName        = "/talk_api.TalkService/UpdateCampaignStatus"
+	TalkService_GetAllCampaignContact_FullMethodName       = "/talk_api.TalkService/GetAllCampaignContact"
 )
 
 // TalkServiceClient is the client API for TalkService service.
@@ -39,6 +45,12 @@ type TalkServiceClient interface {
 	CreateConversationMetric(ctx context.Context, in *CreateConversationMetricRequest, opts ...grpc.CallOption) (*CreateConversationMetricResponse, error)
 	CreatePhoneCall(ctx context.Context, in *CreatePhoneCallRequest, opts ...grpc.CallOption) (*CreatePhoneCallResponse, error)
 	CreateBulkPhoneCall(ctx context.Context, in *CreateBulkPhoneCallRequest, opts ...grpc.CallOption) (*CreateBulkPhoneCallResponse, error)
+	CreateSms(ctx context.Context, in *CreateSmsRequest, opts ...grpc.CallOption) (*CreateSmsResponse, error)
+	CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error)
+	GetCampaign(ctx context.Context, in *GetCampaignRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error)
+	GetAllCampaign(ctx context.Context, in *GetAllCampaignRequest, opts ...grpc.CallOption) (*GetAllCampaignResponse, error)
+	UpdateCampaignStatus(ctx context.Context, in *UpdateCampaignStatusRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error)
+	GetAllCampaignContact(ctx context.Context, in *GetAllCampaignContactRequest, opts ...grpc.CallOption) (*GetAllCampaignContactResponse, error)
 }
 
 type talkServiceClient struct {
@@ -122,6 +134,66 @@ func (c *talkServiceClient) CreateBulkPhoneCall(ctx context.Context, in *CreateB
 	return out, nil
 }
 
+func (c *talkServiceClient) CreateSms(ctx context.Context, in *CreateSmsRequest, opts ...grpc.CallOption) (*CreateSmsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSmsResponse)
+	err := c.cc.Invoke(ctx, TalkService_CreateSms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *talkServiceClient) CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCampaignResponse)
+	err := c.cc.Invoke(ctx, TalkService_CreateCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *talkServiceClient) GetCampaign(ctx context.Context, in *GetCampaignRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCampaignResponse)
+	err := c.cc.Invoke(ctx, TalkService_GetCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *talkServiceClient) GetAllCampaign(ctx context.Context, in *GetAllCampaignRequest, opts ...grpc.CallOption) (*GetAllCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllCampaignResponse)
+	err := c.cc.Invoke(ctx, TalkService_GetAllCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *talkServiceClient) UpdateCampaignStatus(ctx context.Context, in *UpdateCampaignStatusRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCampaignResponse)
+	err := c.cc.Invoke(ctx, TalkService_UpdateCampaignStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *talkServiceClient) GetAllCampaignContact(ctx context.Context, in *GetAllCampaignContactRequest, opts ...grpc.CallOption) (*GetAllCampaignContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllCampaignContactResponse)
+	err := c.cc.Invoke(ctx, TalkService_GetAllCampaignContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TalkServiceServer is the server API for TalkService service.
 // All implementations should embed UnimplementedTalkServiceServer
 // for forward compatibility.
@@ -133,6 +205,12 @@ type TalkServiceServer interface {
 	CreateConversationMetric(context.Context, *CreateConversationMetricRequest) (*CreateConversationMetricResponse, error)
 	CreatePhoneCall(context.Context, *CreatePhoneCallRequest) (*CreatePhoneCallResponse, error)
 	CreateBulkPhoneCall(context.Context, *CreateBulkPhoneCallRequest) (*CreateBulkPhoneCallResponse, error)
+	CreateSms(context.Context, *CreateSmsRequest) (*CreateSmsResponse, error)
+	CreateCampaign(context.Context, *CreateCampaignRequest) (*GetCampaignResponse, error)
+	GetCampaign(context.Context, *GetCampaignRequest) (*GetCampaignResponse, error)
+	GetAllCampaign(context.Context, *GetAllCampaignRequest) (*GetAllCampaignResponse, error)
+	UpdateCampaignStatus(context.Context, *UpdateCampaignStatusRequest) (*GetCampaignResponse, error)
+	GetAllCampaignContact(context.Context, *GetAllCampaignContactRequest) (*GetAllCampaignContactResponse, error)
 }
 
 // UnimplementedTalkServiceServer should be embedded to have
@@ -163,6 +241,24 @@ func (UnimplementedTalkServiceServer) CreatePhoneCall(context.Context, *CreatePh
 func (UnimplementedTalkServiceServer) CreateBulkPhoneCall(context.Context, *CreateBulkPhoneCallRequest) (*CreateBulkPhoneCallResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBulkPhoneCall not implemented")
 }
+func (UnimplementedTalkServiceServer) CreateSms(context.Context, *CreateSmsRequest) (*CreateSmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSms not implemented")
+}
+func (UnimplementedTalkServiceServer) CreateCampaign(context.Context, *CreateCampaignRequest) (*GetCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCampaign not implemented")
+}
+func (UnimplementedTalkServiceServer) GetCampaign(context.Context, *GetCampaignRequest) (*GetCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCampaign not implemented")
+}
+func (UnimplementedTalkServiceServer) GetAllCampaign(context.Context, *GetAllCampaignRequest) (*GetAllCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCampaign not implemented")
+}
+func (UnimplementedTalkServiceServer) UpdateCampaignStatus(context.Context, *UpdateCampaignStatusRequest) (*GetCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCampaignStatus not implemented")
+}
+func (UnimplementedTalkServiceServer) GetAllCampaignContact(context.Context, *GetAllCampaignContactRequest) (*GetAllCampaignContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCampaignContact not implemented")
+}
 func (UnimplementedTalkServiceServer) testEmbeddedByValue() {}
 
 // UnsafeTalkServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -298,6 +394,114 @@ func _TalkService_CreateBulkPhoneCall_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TalkService_CreateSms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TalkServiceServer).CreateSms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TalkService_CreateSms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TalkServiceServer).CreateSms(ctx, req.(*CreateSmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TalkService_CreateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TalkServiceServer).CreateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TalkService_CreateCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TalkServiceServer).CreateCampaign(ctx, req.(*CreateCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TalkService_GetCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TalkServiceServer).GetCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TalkService_GetCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TalkServiceServer).GetCampaign(ctx, req.(*GetCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TalkService_GetAllCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TalkServiceServer).GetAllCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TalkService_GetAllCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TalkServiceServer).GetAllCampaign(ctx, req.(*GetAllCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TalkService_UpdateCampaignStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCampaignStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TalkServiceServer).UpdateCampaignStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TalkService_UpdateCampaignStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TalkServiceServer).UpdateCampaignStatus(ctx, req.(*UpdateCampaignStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TalkService_GetAllCampaignContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCampaignContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TalkServiceServer).GetAllCampaignContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TalkService_GetAllCampaignContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TalkServiceServer).GetAllCampaignContact(ctx, req.(*GetAllCampaignContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TalkService_ServiceDesc is the grpc.ServiceDesc for TalkService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -329,6 +533,30 @@ var TalkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBulkPhoneCall",
 			Handler:    _TalkService_CreateBulkPhoneCall_Handler,
 		},
+		{
+			MethodName: "CreateSms",
+			Handler:    _TalkService_CreateSms_Handler,
+		},
+		{
+			MethodName: "CreateCampaign",
+			Handler:    _TalkService_CreateCampaign_Handler,
+		},
+		{
+			MethodName: "GetCampaign",
+			Handler:    _TalkService_GetCampaign_Handler,
+		},
+		{
+			MethodName: "GetAllCampaign",
+			Handler:    _TalkService_GetAllCampaign_Handler,
+		},
+		{
+			MethodName: "UpdateCampaignStatus",
+			Handler:    _TalkService_UpdateCampaignStatus_Handler,
+		},
+		{
+			MethodName: "GetAllCampaignContact",
+			Handler:    _TalkService_GetAllCampaignContact_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
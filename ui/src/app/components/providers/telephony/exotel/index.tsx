@@ -24,6 +24,17 @@ export const ValidateExotelTelephonyOptions = (
       return false;
     }
   }
+  // exotel does not sign its webhooks, the secret is the basic auth of the urls
+  const webhookSecret = options.find(
+    opt => opt.getKey() === 'exotel.webhook_secret',
+  );
+  if (
+    !webhookSecret ||
+    !webhookSecret.getValue() ||
+    webhookSecret.getValue().length < 16
+  ) {
+    return false;
+  }
   return true;
 };
 
@@ -80,6 +91,24 @@ export const ConfigureExotelTelephony: React.FC<{
           to connect to once the From number picks up the call.
         </InputHelper>
       </FieldSet>
+      <FieldSet className="col-span-1">
+        <FormLabel>Webhook Secret</FormLabel>
+        <Input
+          className="bg-light-background"
+          type="password"
+          value={getParamValue('exotel.webhook_secret')}
+          placeholder="Enter a random secret of at least 16 characters"
+          onChange={v => {
+            updateParameter('exotel.webhook_secret', v.target.value);
+          }}
+        />
+        <InputHelper>
+          Exotel does not sign its webhooks. Configure the answer url of the
+          app as
+          https://rapida:&lt;secret&gt;@&lt;host&gt;/v1/talk/exotel/call/&lt;assistantId&gt;,
+          calls without the secret are rejected.
+        </InputHelper>
+      </FieldSet>
     </>
   );
 };